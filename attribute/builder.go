@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attribute // import "go.opentelemetry.io/otel/attribute"
+
+// SetBuilder builds Sets from attributes added to it. It retains the memory
+// used to build a Set so it can be reused to build subsequent Sets without
+// allocating more than the storage of the Set itself.
+//
+// The zero value is ready for use. A SetBuilder is not safe for concurrent
+// use, but it can be stored in a sync.Pool once it has been Reset. For
+// example:
+//
+//	var pool = sync.Pool{New: func() interface{} { return new(attribute.SetBuilder) }}
+//
+//	b := pool.Get().(*attribute.SetBuilder)
+//	b.Add(kvs...)
+//	s := b.Set()
+//	b.Reset()
+//	pool.Put(b)
+type SetBuilder struct {
+	kvs      []KeyValue
+	sortable Sortable
+}
+
+// NewSetBuilder returns a SetBuilder with storage pre-allocated for capacity
+// attributes.
+func NewSetBuilder(capacity int) *SetBuilder {
+	return &SetBuilder{kvs: make([]KeyValue, 0, capacity)}
+}
+
+// Add adds kvs to the attributes the next Set is built from. If a key is
+// added more than once the last value added is used.
+func (b *SetBuilder) Add(kvs ...KeyValue) {
+	b.kvs = append(b.kvs, kvs...)
+}
+
+// Len returns the number of attributes added to b since it was last Reset,
+// including duplicate keys.
+func (b *SetBuilder) Len() int {
+	return len(b.kvs)
+}
+
+// Set returns a Set containing the attributes added to b. The attributes
+// added remain in b, call Reset to clear them. See the documentation for
+// NewSetWithSortableFiltered for more details.
+func (b *SetBuilder) Set() Set {
+	s, _ := b.FilteredSet(nil)
+	return s
+}
+
+// FilteredSet returns a Set containing the attributes added to b that filter
+// keeps. The attributes excluded by filter are returned. The returned slice
+// shares memory with b and is only valid until b is next modified. See the
+// documentation for NewSetWithSortableFiltered for more details.
+func (b *SetBuilder) FilteredSet(filter Filter) (Set, []KeyValue) {
+	if len(b.kvs) == 0 {
+		return empty(), nil
+	}
+	return NewSetWithSortableFiltered(b.kvs, &b.sortable, filter)
+}
+
+// Reset clears all attributes added to b. The memory held by b is retained
+// for reuse.
+func (b *SetBuilder) Reset() {
+	// Clear the stored values so they can be garbage collected.
+	for i := range b.kvs {
+		b.kvs[i] = KeyValue{}
+	}
+	b.kvs = b.kvs[:0]
+}
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attribute_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestSetBuilder(t *testing.T) {
+	var b attribute.SetBuilder
+	assert.Equal(t, *attribute.EmptySet(), b.Set(), "zero value builder")
+
+	b.Add(attribute.String("A", "a"), attribute.Int("B", 1))
+	b.Add(attribute.String("A", "b"))
+	assert.Equal(t, 3, b.Len())
+
+	want := attribute.NewSet(attribute.String("A", "b"), attribute.Int("B", 1))
+	got := b.Set()
+	assert.Equal(t, want.Equivalent(), got.Equivalent())
+	again := b.Set()
+	assert.Equal(t, got.Equivalent(), again.Equivalent(), "Set is not repeatable")
+
+	b.Add(attribute.String("A", "c"))
+	want = attribute.NewSet(attribute.String("A", "c"), attribute.Int("B", 1))
+	got = b.Set()
+	assert.Equal(t, want.Equivalent(), got.Equivalent(), "last value does not win")
+
+	b.Reset()
+	assert.Equal(t, 0, b.Len())
+	assert.Equal(t, *attribute.EmptySet(), b.Set(), "Reset builder")
+
+	b.Add(attribute.Bool("C", true))
+	want = attribute.NewSet(attribute.Bool("C", true))
+	got = b.Set()
+	assert.Equal(t, want.Equivalent(), got.Equivalent(), "reused builder")
+}
+
+func TestSetBuilderFilteredSet(t *testing.T) {
+	b := attribute.NewSetBuilder(3)
+	b.Add(attribute.String("A", "a"), attribute.Int("B", 1), attribute.Bool("C", true))
+
+	s, excluded := b.FilteredSet(func(kv attribute.KeyValue) bool {
+		return kv.Key != "B"
+	})
+	want := attribute.NewSet(attribute.String("A", "a"), attribute.Bool("C", true))
+	assert.Equal(t, want.Equivalent(), s.Equivalent())
+	assert.Equal(t, []attribute.KeyValue{attribute.Int("B", 1)}, excluded)
+}
+
+func TestSetBuilderAllocs(t *testing.T) {
+	kvs := []attribute.KeyValue{
+		attribute.String("A", "a"),
+		attribute.Int("B", 1),
+		attribute.Bool("C", true),
+	}
+	b := attribute.NewSetBuilder(len(kvs))
+	allocs := testing.AllocsPerRun(100, func() {
+		b.Add(kvs...)
+		_ = b.Set()
+		b.Reset()
+	})
+	// Only the storage of the returned Set is expected to be allocated.
+	assert.LessOrEqual(t, allocs, 1.0)
+}
+
+func BenchmarkSetBuilder(b *testing.B) {
+	kvs := []attribute.KeyValue{
+		attribute.String("A", "a"),
+		attribute.Int("B", 1),
+		attribute.Bool("C", true),
+	}
+
+	b.Run("NewSet", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = attribute.NewSet(kvs...)
+		}
+	})
+	b.Run("SetBuilder", func(b *testing.B) {
+		sb := attribute.NewSetBuilder(len(kvs))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sb.Add(kvs...)
+			_ = sb.Set()
+			sb.Reset()
+		}
+	})
+}
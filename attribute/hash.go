@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attribute // import "go.opentelemetry.io/otel/attribute"
+
+import (
+	"math"
+	"reflect"
+	"sort"
+)
+
+// FNV-1a 64-bit parameters.
+const (
+	fnvOffset64 uint64 = 14695981039346656037
+	fnvPrime64  uint64 = 1099511628211
+)
+
+// emptyFingerprint is the fingerprint of a set with no attributes.
+const emptyFingerprint = fnvOffset64
+
+// fingerprint returns the FNV-1a hash of kvs. The kvs are assumed to already
+// be sorted and de-duplicated.
+func fingerprint(kvs []KeyValue) uint64 {
+	h := fnvOffset64
+	for _, kv := range kvs {
+		h = hashKeyValue(h, kv)
+	}
+	return h
+}
+
+func hashKeyValue(h uint64, kv KeyValue) uint64 {
+	h = hashString(h, string(kv.Key))
+	return hashValue(h, kv.Value)
+}
+
+func hashValue(h uint64, v Value) uint64 {
+	h = hashUint64(h, uint64(v.vtype))
+	switch v.vtype {
+	case BOOL, INT64, FLOAT64, TIMESTAMP:
+		h = hashUint64(h, v.numeric)
+	case STRING, BYTES:
+		h = hashString(h, v.stringly)
+	case BOOLSLICE, INT64SLICE, FLOAT64SLICE, STRINGSLICE:
+		// Read the elements directly from the stored array so no slice needs
+		// to be allocated.
+		rv := reflect.ValueOf(v.slice)
+		n := rv.Len()
+		h = hashUint64(h, uint64(n))
+		for i := 0; i < n; i++ {
+			e := rv.Index(i)
+			switch v.vtype {
+			case BOOLSLICE:
+				var b uint64
+				if e.Bool() {
+					b = 1
+				}
+				h = hashUint64(h, b)
+			case INT64SLICE:
+				h = hashUint64(h, uint64(e.Int()))
+			case FLOAT64SLICE:
+				h = hashUint64(h, math.Float64bits(e.Float()))
+			case STRINGSLICE:
+				h = hashString(h, e.String())
+			}
+		}
+	case MAP:
+		m := v.AsMap()
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		h = hashUint64(h, uint64(len(keys)))
+		for _, k := range keys {
+			h = hashString(h, k)
+			h = hashValue(h, m[k])
+		}
+	}
+	return h
+}
+
+func hashUint64(h, v uint64) uint64 {
+	for i := 0; i < 8; i++ {
+		h ^= v & 0xff
+		h *= fnvPrime64
+		v >>= 8
+	}
+	return h
+}
+
+// hashString hashes the length of s followed by its bytes so adjacent
+// strings cannot be rearranged to produce the same input.
+func hashString(h uint64, s string) uint64 {
+	h = hashUint64(h, uint64(len(s)))
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= fnvPrime64
+	}
+	return h
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attribute // import "go.opentelemetry.io/otel/attribute"
+
+import "sync"
+
+// DefaultInternLimit is the maximum number of strings the package level
+// interner, used by InternKey and InternString, holds.
+const DefaultInternLimit = 4096
+
+var defaultInterner = NewInterner(DefaultInternLimit)
+
+// InternKey returns a Key for k using the package level Interner. See
+// Interner.Key for more information.
+func InternKey(k string) Key {
+	return defaultInterner.Key(k)
+}
+
+// InternString returns s using the package level Interner. See
+// Interner.String for more information.
+func InternString(s string) string {
+	return defaultInterner.String(s)
+}
+
+// Interner deduplicates the memory used by attribute keys and string values.
+// Strings passed to an Interner are retained and the same copy is returned
+// for all equal strings, allowing the duplicates to be garbage collected.
+//
+// An Interner holds a bounded number of strings. Once the limit is reached
+// new strings are returned as-is, so only low-cardinality strings, like keys
+// and common values, should be interned.
+//
+// An Interner is safe for concurrent use.
+type Interner struct {
+	mu    sync.RWMutex
+	strs  map[string]string
+	limit int
+}
+
+// NewInterner returns an Interner that holds at most limit strings. If limit
+// is less than or equal to zero the Interner holds an unlimited number of
+// strings.
+func NewInterner(limit int) *Interner {
+	return &Interner{
+		strs:  make(map[string]string),
+		limit: limit,
+	}
+}
+
+// Key returns k as a Key that shares its memory with all other equal keys
+// returned by i.
+func (i *Interner) Key(k string) Key {
+	return Key(i.String(k))
+}
+
+// String returns a string equal to s that shares its memory with all other
+// equal strings returned by i.
+func (i *Interner) String(s string) string {
+	i.mu.RLock()
+	v, ok := i.strs[s]
+	i.mu.RUnlock()
+	if ok {
+		return v
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if v, ok := i.strs[s]; ok {
+		return v
+	}
+	if i.limit > 0 && len(i.strs) >= i.limit {
+		return s
+	}
+	// Copy s so it does not keep a larger backing array from being
+	// garbage collected.
+	v = string([]byte(s))
+	i.strs[v] = v
+	return v
+}
+
+// Len returns the number of strings held by i.
+func (i *Interner) Len() int {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return len(i.strs)
+}
@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attribute_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// data returns a pointer to the bytes of s.
+func data(s string) uintptr {
+	return (*reflect.StringHeader)(unsafe.Pointer(&s)).Data
+}
+
+func TestInterner(t *testing.T) {
+	i := attribute.NewInterner(0)
+
+	s0 := strings.Repeat("a", 3)
+	s1 := strings.Repeat("a", 3)
+	assert.NotEqual(t, data(s0), data(s1))
+
+	got0, got1 := i.String(s0), i.String(s1)
+	assert.Equal(t, s0, got0)
+	assert.Equal(t, data(got0), data(got1), "strings not interned")
+
+	k := i.Key(strings.Repeat("a", 3))
+	assert.Equal(t, attribute.Key("aaa"), k)
+	assert.Equal(t, data(got0), data(string(k)), "key not interned")
+	assert.Equal(t, 1, i.Len())
+}
+
+func TestInternerLimit(t *testing.T) {
+	i := attribute.NewInterner(2)
+	i.String("a")
+	i.String("b")
+	assert.Equal(t, 2, i.Len())
+
+	c0 := strings.Repeat("c", 1)
+	c1 := i.String(c0)
+	assert.Equal(t, c0, c1)
+	assert.Equal(t, data(c0), data(c1), "input not returned when limit reached")
+	assert.Equal(t, 2, i.Len())
+}
+
+func TestInternerConcurrentSafe(t *testing.T) {
+	i := attribute.NewInterner(10)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 5; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := 0; n < 100; n++ {
+				_ = i.Key(fmt.Sprint(n % 20))
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 10, i.Len())
+}
+
+func TestInternKey(t *testing.T) {
+	k0 := attribute.InternKey(strings.Repeat("key", 2))
+	k1 := attribute.InternKey(strings.Repeat("key", 2))
+	assert.Equal(t, attribute.Key("keykey"), k0)
+	assert.Equal(t, data(string(k0)), data(string(k1)))
+
+	s0 := attribute.InternString(strings.Repeat("value", 2))
+	s1 := attribute.InternString(strings.Repeat("value", 2))
+	assert.Equal(t, data(s0), data(s1))
+}
+
+func BenchmarkInternKey(b *testing.B) {
+	k := "http.method"
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		outKV = attribute.InternKey(k).String("GET")
+	}
+}
@@ -14,6 +14,8 @@
 
 package attribute // import "go.opentelemetry.io/otel/attribute"
 
+import "time"
+
 // Key represents the key part in key-value pairs. It's a string. The
 // allowed character set in the key depends on the use of the key.
 type Key string
@@ -128,6 +130,39 @@ func (k Key) StringSlice(v []string) KeyValue {
 	}
 }
 
+// Bytes creates a KeyValue instance with a BYTES Value.
+//
+// If creating both a key and value at the same time, use the provided
+// convenience function instead -- Bytes(name, value).
+func (k Key) Bytes(v []byte) KeyValue {
+	return KeyValue{
+		Key:   k,
+		Value: BytesValue(v),
+	}
+}
+
+// Timestamp creates a KeyValue instance with a TIMESTAMP Value.
+//
+// If creating both a key and value at the same time, use the provided
+// convenience function instead -- Timestamp(name, value).
+func (k Key) Timestamp(v time.Time) KeyValue {
+	return KeyValue{
+		Key:   k,
+		Value: TimestampValue(v),
+	}
+}
+
+// Map creates a KeyValue instance with a MAP Value.
+//
+// If creating both a key and value at the same time, use the provided
+// convenience function instead -- Map(name, value).
+func (k Key) Map(v map[string]Value) KeyValue {
+	return KeyValue{
+		Key:   k,
+		Value: MapValue(v),
+	}
+}
+
 // Defined returns true for non-empty keys.
 func (k Key) Defined() bool {
 	return len(k) != 0
@@ -17,6 +17,7 @@ package attribute_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -61,6 +62,15 @@ func TestJSONValue(t *testing.T) {
 	require.Equal(t,
 		`[{"Key":"A","Value":{"Type":"STRING","Value":"B"}},{"Key":"C","Value":{"Type":"INT64","Value":1}}]`,
 		string(data))
+
+	kv := attribute.Map("M", map[string]attribute.Value{
+		"A": attribute.BoolValue(true),
+	})
+	data, err = json.Marshal(kv)
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"Key":"M","Value":{"Type":"MAP","Value":{"A":true}}}`,
+		string(data))
 }
 
 func TestEmit(t *testing.T) {
@@ -89,6 +99,26 @@ func TestEmit(t *testing.T) {
 			v:    attribute.StringValue("foo"),
 			want: "foo",
 		},
+		{
+			name: `test Key.Emit() can emit a string representing self.BYTES`,
+			v:    attribute.BytesValue([]byte("foo")),
+			want: "Zm9v",
+		},
+		{
+			name: `test Key.Emit() can emit a string representing self.TIMESTAMP`,
+			v:    attribute.TimestampValue(time.Date(2022, 11, 1, 2, 3, 4, 5, time.UTC)),
+			want: "2022-11-01T02:03:04.000000005Z",
+		},
+		{
+			name: `test Key.Emit() can emit a string representing self.MAP`,
+			v: attribute.MapValue(map[string]attribute.Value{
+				"b": attribute.Int64SliceValue([]int64{1, 2}),
+				"a": attribute.MapValue(map[string]attribute.Value{
+					"c": attribute.StringValue("d"),
+				}),
+			}),
+			want: `{"a":{"c":"d"},"b":[1,2]}`,
+		},
 	} {
 		t.Run(testcase.name, func(t *testing.T) {
 			//proto: func (v attribute.Value) Emit() string {
@@ -16,6 +16,7 @@ package attribute // import "go.opentelemetry.io/otel/attribute"
 
 import (
 	"fmt"
+	"time"
 )
 
 // KeyValue holds a key and value pair.
@@ -79,6 +80,21 @@ func StringSlice(k string, v []string) KeyValue {
 	return Key(k).StringSlice(v)
 }
 
+// Bytes creates a KeyValue with a BYTES Value type.
+func Bytes(k string, v []byte) KeyValue {
+	return Key(k).Bytes(v)
+}
+
+// Timestamp creates a KeyValue with a TIMESTAMP Value type.
+func Timestamp(k string, v time.Time) KeyValue {
+	return Key(k).Timestamp(v)
+}
+
+// Map creates a KeyValue with a MAP Value type.
+func Map(k string, v map[string]Value) KeyValue {
+	return Key(k).Map(v)
+}
+
 // Stringer creates a new key-value pair with a passed name and a string
 // value generated by the passed Stringer interface.
 func Stringer(k string, v fmt.Stringer) KeyValue {
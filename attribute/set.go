@@ -81,6 +81,29 @@ func (d Distinct) Valid() bool {
 	return d.iface != nil
 }
 
+// Fingerprint returns a 64-bit FNV-1a hash of the attributes d refers to.
+//
+// The fingerprint is not stored with d, so creating a Set does not pay for
+// it. It is computed from the attributes each time this is called, users
+// keying data by fingerprint should compute it once and keep it.
+//
+// Equal Distinct values always have the same fingerprint, but different
+// Distinct values may collide and have the same fingerprint. Users keying
+// data by fingerprint need to handle collisions by comparing the Distinct
+// values, or Sets, that share a fingerprint.
+func (d Distinct) Fingerprint() uint64 {
+	if !d.Valid() {
+		return emptyFingerprint
+	}
+	rv := d.reflectValue()
+	if rv.Len() == 0 {
+		return emptyFingerprint
+	}
+	kvs := make([]KeyValue, rv.Len())
+	reflect.Copy(reflect.ValueOf(kvs), rv)
+	return fingerprint(kvs)
+}
+
 // Len returns the number of attributes in this set.
 func (l *Set) Len() int {
 	if l == nil || !l.equivalent.Valid() {
@@ -161,6 +184,12 @@ func (l *Set) Equivalent() Distinct {
 	return l.equivalent
 }
 
+// Fingerprint returns the fingerprint of the attributes in this set. See
+// Distinct.Fingerprint for more information.
+func (l *Set) Fingerprint() uint64 {
+	return l.Equivalent().Fingerprint()
+}
+
 // Equals returns true if the argument set is equivalent to this set.
 func (l *Set) Equals(o *Set) bool {
 	return l.Equivalent() == o.Equivalent()
@@ -188,3 +188,96 @@ func TestLookup(t *testing.T) {
 	_, has = set.Value("D")
 	require.False(t, has)
 }
+
+func TestFingerprint(t *testing.T) {
+	sets := []attribute.Set{
+		attribute.NewSet(),
+		attribute.NewSet(attribute.String("A", "a")),
+		attribute.NewSet(attribute.String("A", "b")),
+		attribute.NewSet(attribute.String("B", "a")),
+		attribute.NewSet(attribute.Int("A", 1)),
+		attribute.NewSet(attribute.Float64("A", 1)),
+		attribute.NewSet(attribute.Bytes("A", []byte("a"))),
+		attribute.NewSet(attribute.StringSlice("A", []string{"a", "b"})),
+		attribute.NewSet(attribute.StringSlice("A", []string{"ab"})),
+		attribute.NewSet(attribute.Int64Slice("A", []int64{1, 2})),
+		attribute.NewSet(attribute.Map("A", map[string]attribute.Value{
+			"a": attribute.BoolValue(true),
+		})),
+		attribute.NewSet(attribute.String("A", "a"), attribute.String("B", "b")),
+		attribute.NewSet(attribute.String("A", "aB"), attribute.String("", "b")),
+	}
+
+	for i := range sets {
+		for j := range sets {
+			if i == j {
+				continue
+			}
+			require.NotEqualf(t, sets[i].Fingerprint(), sets[j].Fingerprint(), "%d and %d", i, j)
+			require.Falsef(t, sets[i].Equals(&sets[j]), "%d and %d", i, j)
+		}
+	}
+
+	kvs := []attribute.KeyValue{
+		attribute.String("B", "b"),
+		attribute.Int64Slice("C", []int64{1, 2}),
+		attribute.String("A", "a"),
+	}
+	s0 := attribute.NewSet(kvs...)
+	s1 := attribute.NewSet(kvs[2], kvs[0], kvs[1])
+	require.Equal(t, s0.Fingerprint(), s1.Fingerprint())
+	require.True(t, s0.Equals(&s1))
+	require.Equal(t, s0.Fingerprint(), s0.Equivalent().Fingerprint())
+
+	var zero attribute.Set
+	require.Equal(t, attribute.EmptySet().Fingerprint(), zero.Fingerprint())
+	require.True(t, zero.Equals(attribute.EmptySet()))
+
+	filtered, _ := s0.Filter(func(kv attribute.KeyValue) bool { return kv.Key != "C" })
+	want := attribute.NewSet(attribute.String("A", "a"), attribute.String("B", "b"))
+	require.Equal(t, want.Fingerprint(), filtered.Fingerprint())
+}
+
+func BenchmarkFingerprint(b *testing.B) {
+	s := attribute.NewSet(
+		attribute.String("A", "a"),
+		attribute.Int("B", 1),
+		attribute.StringSlice("C", []string{"a", "b"}),
+	)
+	bySet := map[attribute.Set]int{s: 1}
+	byDistinct := map[attribute.Distinct]int{s.Equivalent(): 1}
+	byFingerprint := map[uint64]int{s.Fingerprint(): 1}
+
+	b.Run("Set", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = bySet[s]
+		}
+	})
+	b.Run("Distinct", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = byDistinct[s.Equivalent()]
+		}
+	})
+	b.Run("Fingerprint", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = byFingerprint[s.Fingerprint()]
+		}
+	})
+}
+
+func BenchmarkNewSet(b *testing.B) {
+	kvs := []attribute.KeyValue{
+		attribute.String("A", "a"),
+		attribute.Int("B", 1),
+		attribute.StringSlice("C", []string{"a", "b"}),
+		attribute.Map("D", map[string]attribute.Value{"a": attribute.IntValue(1)}),
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = attribute.NewSet(kvs...)
+	}
+}
@@ -15,10 +15,13 @@
 package attribute // import "go.opentelemetry.io/otel/attribute"
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
+	"time"
 
 	"go.opentelemetry.io/otel/internal"
 	"go.opentelemetry.io/otel/internal/attribute"
@@ -56,6 +59,12 @@ const (
 	FLOAT64SLICE
 	// STRINGSLICE is a slice of strings Type Value.
 	STRINGSLICE
+	// BYTES is a byte slice Type Value.
+	BYTES
+	// TIMESTAMP is a point in time Type Value.
+	TIMESTAMP
+	// MAP is a string keyed map of Values Type Value.
+	MAP
 )
 
 // BoolValue creates a BOOL Value.
@@ -128,6 +137,39 @@ func StringSliceValue(v []string) Value {
 	return Value{vtype: STRINGSLICE, slice: attribute.SliceValue(v)}
 }
 
+// BytesValue creates a BYTES Value. The Value holds a copy of v.
+func BytesValue(v []byte) Value {
+	return Value{
+		vtype:    BYTES,
+		stringly: string(v),
+	}
+}
+
+// TimestampValue creates a TIMESTAMP Value. The Value holds v with
+// nanosecond precision, the location of v is not retained.
+func TimestampValue(v time.Time) Value {
+	return Value{
+		vtype:   TIMESTAMP,
+		numeric: internal.Int64ToRaw(v.UnixNano()),
+	}
+}
+
+// MapValue creates a MAP Value. The Value holds a copy of v, values may
+// themselves be MAP Values.
+func MapValue(v map[string]Value) Value {
+	kvs := make([]KeyValue, 0, len(v))
+	for k, val := range v {
+		kvs = append(kvs, KeyValue{Key: Key(k), Value: val})
+	}
+	// Store entries in a sorted array so equal maps compare and hash equal.
+	sort.Slice(kvs, func(i, j int) bool { return kvs[i].Key < kvs[j].Key })
+	cp := reflect.New(reflect.ArrayOf(len(kvs), reflect.TypeOf(KeyValue{}))).Elem()
+	for i, kv := range kvs {
+		cp.Index(i).Set(reflect.ValueOf(kv))
+	}
+	return Value{vtype: MAP, slice: cp.Interface()}
+}
+
 // Type returns a type of the Value.
 func (v Value) Type() Type {
 	return v.vtype
@@ -181,6 +223,33 @@ func (v Value) AsStringSlice() []string {
 	return attribute.AsSlice[string](v.slice)
 }
 
+// AsBytes returns a copy of the []byte value. Make sure that the Value's type
+// is BYTES.
+func (v Value) AsBytes() []byte {
+	return []byte(v.stringly)
+}
+
+// AsTimestamp returns the time.Time value, in UTC. Make sure that the Value's
+// type is TIMESTAMP.
+func (v Value) AsTimestamp() time.Time {
+	return time.Unix(0, internal.RawToInt64(v.numeric)).UTC()
+}
+
+// AsMap returns the map[string]Value value. Make sure that the Value's type
+// is MAP.
+func (v Value) AsMap() map[string]Value {
+	rv := reflect.ValueOf(v.slice)
+	if rv.Kind() != reflect.Array {
+		return nil
+	}
+	m := make(map[string]Value, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		kv := rv.Index(i).Interface().(KeyValue)
+		m[string(kv.Key)] = kv.Value
+	}
+	return m
+}
+
 type unknownValueType struct{}
 
 // AsInterface returns Value's data as interface{}.
@@ -202,6 +271,17 @@ func (v Value) AsInterface() interface{} {
 		return v.stringly
 	case STRINGSLICE:
 		return v.AsStringSlice()
+	case BYTES:
+		return v.AsBytes()
+	case TIMESTAMP:
+		return v.AsTimestamp()
+	case MAP:
+		m := v.AsMap()
+		out := make(map[string]interface{}, len(m))
+		for k, val := range m {
+			out[k] = val.AsInterface()
+		}
+		return out
 	}
 	return unknownValueType{}
 }
@@ -225,6 +305,16 @@ func (v Value) Emit() string {
 		return fmt.Sprint(v.AsStringSlice())
 	case STRING:
 		return v.stringly
+	case BYTES:
+		return base64.StdEncoding.EncodeToString([]byte(v.stringly))
+	case TIMESTAMP:
+		return v.AsTimestamp().Format(time.RFC3339Nano)
+	case MAP:
+		data, err := json.Marshal(v.AsInterface())
+		if err != nil {
+			return fmt.Sprint(v.AsInterface())
+		}
+		return string(data)
 	default:
 		return "unknown"
 	}
@@ -16,6 +16,7 @@ package attribute_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
@@ -91,6 +92,32 @@ func TestValue(t *testing.T) {
 			wantType:  attribute.STRINGSLICE,
 			wantValue: []string{"forty-two", "negative three", "twelve"},
 		},
+		{
+			name:      "Key.Bytes() correctly returns keys's internal []byte value",
+			value:     k.Bytes([]byte{0, 1, 2}).Value,
+			wantType:  attribute.BYTES,
+			wantValue: []byte{0, 1, 2},
+		},
+		{
+			name:      "Key.Timestamp() correctly returns keys's internal time.Time value",
+			value:     k.Timestamp(time.Unix(1, 2)).Value,
+			wantType:  attribute.TIMESTAMP,
+			wantValue: time.Unix(1, 2).UTC(),
+		},
+		{
+			name: "Key.Map() correctly returns keys's internal map value",
+			value: k.Map(map[string]attribute.Value{
+				"a": attribute.StringValue("b"),
+				"c": attribute.MapValue(map[string]attribute.Value{
+					"d": attribute.Int64Value(1),
+				}),
+			}).Value,
+			wantType: attribute.MAP,
+			wantValue: map[string]interface{}{
+				"a": "b",
+				"c": map[string]interface{}{"d": int64(1)},
+			},
+		},
 	} {
 		t.Logf("Running test case %s", testcase.name)
 		if testcase.value.Type() != testcase.wantType {
@@ -148,6 +175,24 @@ func TestSetComparability(t *testing.T) {
 			attribute.StringSlice("StringSlice", []string{"one", "two", "three"}),
 			attribute.StringSlice("StringSlice", []string{"one", "two", "three"}),
 		},
+		{
+			attribute.Bytes("Bytes", []byte("bytes value")),
+			attribute.Bytes("Bytes", []byte("bytes value")),
+		},
+		{
+			attribute.Timestamp("Timestamp", time.Unix(1, 2)),
+			attribute.Timestamp("Timestamp", time.Unix(1, 2).In(time.FixedZone("zone", 3600))),
+		},
+		{
+			attribute.Map("Map", map[string]attribute.Value{
+				"one": attribute.Int64Value(1),
+				"two": attribute.StringSliceValue([]string{"t", "w", "o"}),
+			}),
+			attribute.Map("Map", map[string]attribute.Value{
+				"two": attribute.StringSliceValue([]string{"t", "w", "o"}),
+				"one": attribute.Int64Value(1),
+			}),
+		},
 	}
 
 	for _, p := range pairs {
@@ -184,3 +229,35 @@ func TestAsSlice(t *testing.T) {
 	ss2 := kv.Value.AsStringSlice()
 	assert.Equal(t, ss1, ss2)
 }
+
+func TestBytesValueCopies(t *testing.T) {
+	b := []byte("value")
+	v := attribute.BytesValue(b)
+	b[0] = 'V'
+	assert.Equal(t, []byte("value"), v.AsBytes(), "value modified by input slice")
+
+	got := v.AsBytes()
+	got[0] = 'V'
+	assert.Equal(t, []byte("value"), v.AsBytes(), "value modified by returned slice")
+}
+
+func TestMapValue(t *testing.T) {
+	m := map[string]attribute.Value{
+		"bool":   attribute.BoolValue(true),
+		"string": attribute.StringValue("value"),
+	}
+	v := attribute.MapValue(m)
+	m["bool"] = attribute.BoolValue(false)
+	assert.Equal(t, map[string]attribute.Value{
+		"bool":   attribute.BoolValue(true),
+		"string": attribute.StringValue("value"),
+	}, v.AsMap(), "value modified by input map")
+
+	s0 := attribute.NewSet(attribute.Map("k", m))
+	s1 := attribute.NewSet(attribute.Map("k", v.AsMap()))
+	assert.NotEqual(t, s0.Equivalent(), s1.Equivalent())
+
+	empty := attribute.MapValue(nil)
+	assert.Equal(t, attribute.MAP, empty.Type())
+	assert.Empty(t, empty.AsMap())
+}
@@ -52,6 +52,7 @@ var (
 	errMemberNumber    = errors.New("too many list-members in baggage-string")
 	errMemberBytes     = errors.New("list-member too large")
 	errBaggageBytes    = errors.New("baggage-string too large")
+	errMemberNotFound  = errors.New("baggage list-member not found")
 )
 
 // Property is an additional metadata entry for a baggage list-member.
@@ -398,19 +399,26 @@ func New(members ...Member) (Baggage, error) {
 		}
 	}
 
-	// Check member numbers after deduplication.
-	if len(b) > maxMembers {
-		return Baggage{}, errMemberNumber
-	}
-
 	bag := Baggage{b}
-	if n := len(bag.String()); n > maxBytesPerBaggageString {
-		return Baggage{}, fmt.Errorf("%w: %d", errBaggageBytes, n)
+	if err := bag.validateLimits(); err != nil {
+		return Baggage{}, err
 	}
-
 	return bag, nil
 }
 
+// validateLimits ensures b does not exceed the limits set in the W3C Baggage
+// specification, returning an error otherwise.
+func (b Baggage) validateLimits() error {
+	// Check member numbers after deduplication.
+	if len(b.list) > maxMembers {
+		return errMemberNumber
+	}
+	if n := len(b.String()); n > maxBytesPerBaggageString {
+		return fmt.Errorf("%w: %d", errBaggageBytes, n)
+	}
+	return nil
+}
+
 // Parse attempts to decode a baggage-string from the passed string. It
 // returns an error if the input is invalid according to the W3C Baggage
 // specification.
@@ -549,6 +557,19 @@ func (b Baggage) DeleteMember(key string) Baggage {
 	return Baggage{list: list}
 }
 
+// Merge returns a copy of the Baggage with all the list-members of other
+// included. List-members of other replace any in the Baggage with the same
+// key.
+//
+// If the merged Baggage exceeds the limits of the W3C Baggage specification,
+// it is truncated as described by Truncate and the removed list-members are
+// reported to the global ErrorHandler.
+func (b Baggage) Merge(other Baggage) Baggage {
+	// Merging valid Baggage cannot produce an invalid mutation error.
+	merged, _ := NewBuilder(b).Merge(other).Build()
+	return merged
+}
+
 // Len returns the number of list-members in the Baggage.
 func (b Baggage) Len() int {
 	return len(b.list)
@@ -0,0 +1,177 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baggage // import "go.opentelemetry.io/otel/baggage"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/internal/baggage"
+	"go.opentelemetry.io/otel/internal/handler"
+)
+
+// Builder builds a Baggage from a batch of mutations.
+//
+// Mutations are not validated individually. Instead, the first error
+// encountered is retained and returned from Build, the single validation
+// step. This allows Builder methods to be chained:
+//
+//	b, err := baggage.NewBuilder(baggage.Baggage{}).
+//		SetMember("user", "alice").
+//		SetMember("tenant", "acme").
+//		DeleteMember("debug").
+//		Build()
+type Builder struct {
+	list   baggage.List
+	limits Limits
+	err    error
+}
+
+// NewBuilder returns a Builder that starts with the list-members of b.
+func NewBuilder(b Baggage) *Builder {
+	list := make(baggage.List, len(b.list))
+	for k, v := range b.list {
+		list[k] = v
+	}
+	return &Builder{list: list}
+}
+
+// setErr records err if no other error has been recorded.
+func (b *Builder) setErr(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// SetMember includes a list-member for key and value with props, replacing
+// any existing list-member with the same key. The value is url decoded the
+// same way NewMember does.
+func (b *Builder) SetMember(key, value string, props ...Property) *Builder {
+	m, err := NewMember(key, value, props...)
+	if err != nil {
+		b.setErr(err)
+		return b
+	}
+	return b.SetMembers(m)
+}
+
+// SetMembers includes members, replacing any existing list-members with the
+// same keys. If more than one of members has the same key, the last one is
+// kept.
+func (b *Builder) SetMembers(members ...Member) *Builder {
+	for _, m := range members {
+		if !m.hasData {
+			b.setErr(errInvalidMember)
+			continue
+		}
+		b.list[m.key] = baggage.Item{
+			Value:      m.value,
+			Properties: m.properties.asInternal(),
+		}
+	}
+	return b
+}
+
+// DeleteMember removes the list-members identified by keys.
+func (b *Builder) DeleteMember(keys ...string) *Builder {
+	for _, k := range keys {
+		delete(b.list, k)
+	}
+	return b
+}
+
+// SetProperty includes props in the properties of the list-member identified
+// by key, replacing any existing property with the same key. An error is
+// returned from Build if there is no list-member for key.
+func (b *Builder) SetProperty(key string, props ...Property) *Builder {
+	item, ok := b.list[key]
+	if !ok {
+		b.setErr(fmt.Errorf("%w: %q", errMemberNotFound, key))
+		return b
+	}
+
+	// Copy the properties, they may be shared with another Baggage.
+	iProps := make([]baggage.Property, len(item.Properties), len(item.Properties)+len(props))
+	copy(iProps, item.Properties)
+	for _, p := range props {
+		if err := p.validate(); err != nil {
+			b.setErr(err)
+			continue
+		}
+		iProps = setProperty(iProps, baggage.Property{
+			Key:      p.key,
+			Value:    p.value,
+			HasValue: p.hasValue,
+		})
+	}
+	item.Properties = iProps
+	b.list[key] = item
+	return b
+}
+
+// setProperty returns props with p included, replacing any property with the
+// same key.
+func setProperty(props []baggage.Property, p baggage.Property) []baggage.Property {
+	for i := range props {
+		if props[i].Key == p.Key {
+			props[i] = p
+			return props
+		}
+	}
+	return append(props, p)
+}
+
+// SetLimits sets the limits the Baggage returned from Build is held to. By
+// default, the limits defined by the W3C Baggage specification are used.
+func (b *Builder) SetLimits(limits Limits) *Builder {
+	b.limits = limits
+	return b
+}
+
+// Merge includes all the list-members of other, replacing any existing
+// list-members with the same keys.
+func (b *Builder) Merge(other Baggage) *Builder {
+	for k, v := range other.list {
+		b.list[k] = v
+	}
+	return b
+}
+
+// Build returns the Baggage containing all the list-members of b. An error
+// is returned if any mutation of b was invalid.
+//
+// If the Baggage exceeds the limits of b, it is truncated as described by
+// Baggage.Truncate and the removed list-members are reported to the global
+// ErrorHandler.
+//
+// The Builder can continue to be used after Build is called, changes made to
+// it do not affect the returned Baggage.
+func (b *Builder) Build() (Baggage, error) {
+	if b.err != nil {
+		return Baggage{}, b.err
+	}
+	if len(b.list) == 0 {
+		return Baggage{}, nil
+	}
+
+	list := make(baggage.List, len(b.list))
+	for k, v := range b.list {
+		list[k] = v
+	}
+	bag, err := Baggage{list: list}.Truncate(b.limits)
+	if err != nil {
+		handler.Handle(err)
+	}
+	return bag, nil
+}
@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baggage
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/internal/baggage"
+	"go.opentelemetry.io/otel/internal/handler"
+)
+
+func TestBuilderEmpty(t *testing.T) {
+	b, err := NewBuilder(Baggage{}).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, Baggage{}, b)
+}
+
+func TestBuilder(t *testing.T) {
+	p, err := NewKeyValueProperty("p", "1")
+	require.NoError(t, err)
+	m, err := NewMember("c", "3")
+	require.NoError(t, err)
+
+	b, err := NewBuilder(Baggage{}).
+		SetMember("a", "1", p).
+		SetMember("b", "2").
+		SetMember("a", "%20one").
+		SetMembers(m).
+		DeleteMember("b", "missing").
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, Baggage{list: baggage.List{
+		"a": {Value: " one"},
+		"c": {Value: "3"},
+	}}, b)
+}
+
+func TestBuilderStartsFromBaggage(t *testing.T) {
+	orig := Baggage{list: baggage.List{"a": {Value: "1"}, "b": {Value: "2"}}}
+	b, err := NewBuilder(orig).DeleteMember("a").SetMember("c", "3").Build()
+	require.NoError(t, err)
+	assert.Equal(t, Baggage{list: baggage.List{
+		"b": {Value: "2"},
+		"c": {Value: "3"},
+	}}, b)
+	assert.Equal(t, Baggage{list: baggage.List{
+		"a": {Value: "1"},
+		"b": {Value: "2"},
+	}}, orig, "original Baggage modified")
+}
+
+func TestBuilderSetProperty(t *testing.T) {
+	p1, err := NewKeyProperty("p1")
+	require.NoError(t, err)
+	p2, err := NewKeyValueProperty("p2", "2")
+	require.NoError(t, err)
+	p2b, err := NewKeyValueProperty("p2", "b")
+	require.NoError(t, err)
+
+	orig, err := NewBuilder(Baggage{}).SetMember("a", "1", p1).Build()
+	require.NoError(t, err)
+
+	b, err := NewBuilder(orig).SetProperty("a", p2, p2b).Build()
+	require.NoError(t, err)
+	assert.Equal(t, Baggage{list: baggage.List{
+		"a": {
+			Value: "1",
+			Properties: []baggage.Property{
+				{Key: "p1"},
+				{Key: "p2", Value: "b", HasValue: true},
+			},
+		},
+	}}, b)
+	assert.Equal(t, Baggage{list: baggage.List{
+		"a": {Value: "1", Properties: []baggage.Property{{Key: "p1"}}},
+	}}, orig, "original Baggage modified")
+}
+
+func TestBuilderErrors(t *testing.T) {
+	_, err := NewBuilder(Baggage{}).SetMember("a b", "1").SetMember("c", "3").Build()
+	assert.ErrorIs(t, err, errInvalidKey)
+
+	_, err = NewBuilder(Baggage{}).SetMembers(Member{}).Build()
+	assert.ErrorIs(t, err, errInvalidMember)
+
+	_, err = NewBuilder(Baggage{}).SetProperty("a", Property{}).Build()
+	assert.ErrorIs(t, err, errMemberNotFound)
+
+	_, err = NewBuilder(Baggage{}).SetMember("a", "1").SetProperty("a", Property{}).Build()
+	assert.ErrorIs(t, err, errInvalidProperty)
+}
+
+func TestBuilderLimits(t *testing.T) {
+	var handled []error
+	handler.SetHandleFunc(func(err error) { handled = append(handled, err) })
+	t.Cleanup(func() { handler.SetHandleFunc(nil) })
+
+	bld := NewBuilder(Baggage{})
+	for i := 0; i < maxMembers+1; i++ {
+		bld.SetMember(fmt.Sprintf("%03d", i), "v")
+	}
+	b, err := bld.Build()
+	require.NoError(t, err)
+	assert.Equal(t, maxMembers, b.Len())
+	assert.Equal(t, Member{}, b.Member(fmt.Sprintf("%03d", maxMembers)), "last key not truncated")
+	require.Len(t, handled, 1)
+	assert.ErrorIs(t, handled[0], errTruncated)
+
+	handled = nil
+	b, err = bld.SetLimits(Limits{MaxMembers: 2}).Build()
+	require.NoError(t, err)
+	assert.Equal(t, Baggage{list: baggage.List{
+		"000": {Value: "v"},
+		"001": {Value: "v"},
+	}}, b)
+	assert.Len(t, handled, 1)
+
+	handled = nil
+	_, err = bld.DeleteMember("000").SetLimits(Limits{MaxMembers: maxMembers}).Build()
+	require.NoError(t, err)
+	assert.Empty(t, handled, "Baggage within limits truncated")
+}
+
+func TestBuilderBuildIsolated(t *testing.T) {
+	bld := NewBuilder(Baggage{}).SetMember("a", "1")
+	b, err := bld.Build()
+	require.NoError(t, err)
+
+	bld.SetMember("b", "2")
+	assert.Equal(t, 1, b.Len(), "Baggage modified after Build")
+}
+
+func TestBaggageMerge(t *testing.T) {
+	b0 := Baggage{list: baggage.List{"a": {Value: "1"}, "b": {Value: "2"}}}
+	b1 := Baggage{list: baggage.List{"b": {Value: "two"}, "c": {Value: "3"}}}
+
+	assert.Equal(t, Baggage{list: baggage.List{
+		"a": {Value: "1"},
+		"b": {Value: "two"},
+		"c": {Value: "3"},
+	}}, b0.Merge(b1))
+	assert.Equal(t, b0, b0.Merge(Baggage{}))
+}
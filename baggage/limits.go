@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baggage // import "go.opentelemetry.io/otel/baggage"
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel/internal/baggage"
+)
+
+var errTruncated = errors.New("baggage truncated")
+
+// Limits are size limits a Baggage is held to. A field that is less than or
+// equal to zero uses the limit defined by the W3C Baggage specification.
+type Limits struct {
+	// MaxMembers is the maximum number of list-members.
+	MaxMembers int
+	// MaxBytesPerMember is the maximum number of bytes of an encoded
+	// list-member, including its properties.
+	MaxBytesPerMember int
+	// MaxBytes is the maximum number of bytes of the encoded baggage-string.
+	MaxBytes int
+}
+
+// DefaultLimits returns the limits defined by the W3C Baggage specification.
+func DefaultLimits() Limits {
+	return Limits{
+		MaxMembers:        maxMembers,
+		MaxBytesPerMember: maxBytesPerMembers,
+		MaxBytes:          maxBytesPerBaggageString,
+	}
+}
+
+// withDefaults returns l with all unset fields set to their default.
+func (l Limits) withDefaults() Limits {
+	d := DefaultLimits()
+	if l.MaxMembers <= 0 {
+		l.MaxMembers = d.MaxMembers
+	}
+	if l.MaxBytesPerMember <= 0 {
+		l.MaxBytesPerMember = d.MaxBytesPerMember
+	}
+	if l.MaxBytes <= 0 {
+		l.MaxBytes = d.MaxBytes
+	}
+	return l
+}
+
+// Truncate returns the Baggage with list-members removed so it is within
+// limits.
+//
+// Truncation is deterministic. List-members larger than
+// limits.MaxBytesPerMember are removed. The remaining list-members are then
+// included in ascending key order while they fit within limits.MaxMembers
+// and limits.MaxBytes.
+//
+// If any list-members are removed, an error identifying them is returned
+// along with the truncated Baggage.
+func (b Baggage) Truncate(limits Limits) (Baggage, error) {
+	bag, _, err := b.truncate(limits)
+	return bag, err
+}
+
+// TruncatedString returns the encoded baggage-string of the Baggage
+// truncated to limits as described by Truncate. It is equivalent to calling
+// String on the Baggage returned from Truncate, but each list-member is only
+// encoded once.
+//
+// If any list-members are removed, an error identifying them is returned
+// along with the baggage-string.
+func (b Baggage) TruncatedString(limits Limits) (string, error) {
+	_, s, err := b.truncate(limits)
+	return s, err
+}
+
+// encodedMember is a list-member key and its encoding.
+type encodedMember struct {
+	key     string
+	encoded string
+}
+
+// truncate returns the Baggage truncated to limits and its encoded
+// baggage-string.
+func (b Baggage) truncate(limits Limits) (Baggage, string, error) {
+	if len(b.list) == 0 {
+		return b, "", nil
+	}
+	limits = limits.withDefaults()
+
+	members := make([]encodedMember, 0, len(b.list))
+	fit := len(b.list) <= limits.MaxMembers
+	// Account for the list delimiters.
+	n := (len(b.list) - 1) * len(listDelimiter)
+	for k, v := range b.list {
+		encoded := Member{
+			key:        k,
+			value:      v.Value,
+			properties: fromInternalProperties(v.Properties),
+		}.String()
+		members = append(members, encodedMember{key: k, encoded: encoded})
+		n += len(encoded)
+		fit = fit && len(encoded) <= limits.MaxBytesPerMember
+	}
+	if fit && n <= limits.MaxBytes {
+		return b, joinMembers(members), nil
+	}
+
+	sort.Slice(members, func(i, j int) bool { return members[i].key < members[j].key })
+
+	var (
+		list    = make(baggage.List)
+		kept    = members[:0]
+		dropped []string
+	)
+	n = 0
+	for _, m := range members {
+		size := len(m.encoded)
+		if size > limits.MaxBytesPerMember {
+			dropped = append(dropped, m.key)
+			continue
+		}
+		if len(list) > 0 {
+			// Account for the list delimiter.
+			size += len(listDelimiter)
+		}
+		if len(list) >= limits.MaxMembers || n+size > limits.MaxBytes {
+			dropped = append(dropped, m.key)
+			continue
+		}
+		list[m.key] = b.list[m.key]
+		kept = append(kept, m)
+		n += size
+	}
+
+	err := fmt.Errorf("%w: removed list-members %q", errTruncated, dropped)
+	return Baggage{list: list}, joinMembers(kept), err
+}
+
+// joinMembers returns the baggage-string of the encoded members.
+func joinMembers(members []encodedMember) string {
+	var sb strings.Builder
+	for i, m := range members {
+		if i > 0 {
+			sb.WriteString(listDelimiter)
+		}
+		sb.WriteString(m.encoded)
+	}
+	return sb.String()
+}
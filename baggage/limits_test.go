@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baggage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/internal/baggage"
+)
+
+func TestDefaultLimits(t *testing.T) {
+	assert.Equal(t, Limits{
+		MaxMembers:        180,
+		MaxBytesPerMember: 4096,
+		MaxBytes:          8192,
+	}, DefaultLimits())
+	assert.Equal(t, DefaultLimits(), Limits{}.withDefaults())
+}
+
+func TestTruncate(t *testing.T) {
+	long := strings.Repeat("v", 10)
+	b := Baggage{list: baggage.List{
+		"a": {Value: "1"},
+		"b": {Value: long},
+		"c": {Value: "3"},
+		"d": {Value: "4"},
+	}}
+
+	testcases := []struct {
+		name   string
+		limits Limits
+		want   baggage.List
+	}{
+		{
+			name:   "Default",
+			limits: Limits{},
+			want:   b.list,
+		},
+		{
+			name:   "MaxMembers",
+			limits: Limits{MaxMembers: 2},
+			want:   baggage.List{"a": {Value: "1"}, "b": {Value: long}},
+		},
+		{
+			name:   "MaxBytesPerMember",
+			limits: Limits{MaxBytesPerMember: 3},
+			want:   baggage.List{"a": {Value: "1"}, "c": {Value: "3"}, "d": {Value: "4"}},
+		},
+		{
+			// "a=1,b=vvvvvvvvvv" is 16 bytes, "c=3" still fits after "a=1".
+			name:   "MaxBytes",
+			limits: Limits{MaxBytes: 8},
+			want:   baggage.List{"a": {Value: "1"}, "c": {Value: "3"}},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := b.Truncate(tc.limits)
+			if len(tc.want) == len(b.list) {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, errTruncated)
+			}
+			assert.Equal(t, Baggage{list: tc.want}, got)
+			assert.LessOrEqual(t, len(got.String()), tc.limits.withDefaults().MaxBytes)
+
+			s, sErr := b.TruncatedString(tc.limits)
+			assert.Equal(t, err, sErr)
+			parsed, pErr := Parse(s)
+			require.NoError(t, pErr)
+			assert.Equal(t, got, parsed)
+		})
+	}
+}
+
+func TestTruncatedStringEmpty(t *testing.T) {
+	s, err := Baggage{}.TruncatedString(Limits{})
+	assert.NoError(t, err)
+	assert.Equal(t, "", s)
+}
+
+func BenchmarkTruncatedString(b *testing.B) {
+	list := make(baggage.List)
+	for _, k := range strings.Split("qwertyuiopasdfghjklzxcvbnm", "") {
+		list[k] = baggage.Item{Value: strings.Repeat(k, 20)}
+	}
+	bag := Baggage{list: list}
+
+	b.Run("WithinLimits", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = bag.TruncatedString(Limits{})
+		}
+	})
+	b.Run("Truncated", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = bag.TruncatedString(Limits{MaxMembers: 5})
+		}
+	})
+}
+
+func TestTruncateDeterministic(t *testing.T) {
+	list := make(baggage.List)
+	for _, k := range strings.Split("qwertyuiopasdfghjklzxcvbnm", "") {
+		list[k] = baggage.Item{Value: k}
+	}
+	b := Baggage{list: list}
+	want, _ := b.Truncate(Limits{MaxMembers: 5})
+	for i := 0; i < 10; i++ {
+		got, _ := b.Truncate(Limits{MaxMembers: 5})
+		assert.Equal(t, want, got)
+	}
+}
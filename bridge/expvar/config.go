@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expvar // import "go.opentelemetry.io/otel/bridge/expvar"
+
+// config contains options for the producer.
+type config struct {
+	nameFunc func(string) string
+	counters map[string]bool
+}
+
+// newConfig creates a validated config configured with options.
+func newConfig(opts ...Option) config {
+	cfg := config{counters: make(map[string]bool)}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+
+	if cfg.nameFunc == nil {
+		cfg.nameFunc = func(name string) string { return name }
+	}
+
+	return cfg
+}
+
+// Option sets producer option values.
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(cfg config) config {
+	return fn(cfg)
+}
+
+// WithNameFunc configures how the names of expvar variables are mapped to
+// metric names. The fn function is called with the name of each variable
+// and returns the name of the metric it is reported as. If fn returns an
+// empty string, the variable is not reported. If this option is not used,
+// variables are reported with their expvar name.
+func WithNameFunc(fn func(name string) string) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.nameFunc = fn
+		return cfg
+	})
+}
+
+// WithCounter configures the expvar variables with names to be reported as
+// cumulative monotonic sums instead of gauges. The names are the expvar
+// names of the variables, not the names they are mapped to with
+// WithNameFunc. This option can be used multiple times.
+func WithCounter(names ...string) Option {
+	return optionFunc(func(cfg config) config {
+		for _, name := range names {
+			cfg.counters[name] = true
+		}
+		return cfg
+	})
+}
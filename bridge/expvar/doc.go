@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package expvar provides a bridge from expvar to OpenTelemetry.
+//
+// The MetricProducer this package provides snapshots the variables published
+// with the standard library expvar package and converts them to
+// OpenTelemetry metric data. Register it with an OpenTelemetry Reader using
+// the metric.WithProducer option to export these values with any
+// OpenTelemetry exporter, e.g. OTLP or Prometheus.
+//
+// Int and Float variables, and Func variables returning an integer or
+// floating point number, are converted to gauges. Map variables are
+// converted to a gauge with a data point for each numeric entry of the Map,
+// identified by the "key" attribute. Variables can be reported as cumulative
+// monotonic sums instead with the WithCounter option. All other variables,
+// e.g. String variables, are not reported.
+//
+// Variables are reported with their expvar name unless the WithNameFunc
+// option is used to map it to a different metric name.
+package expvar // import "go.opentelemetry.io/otel/bridge/expvar"
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expvar // import "go.opentelemetry.io/otel/bridge/expvar"
+
+import (
+	"context"
+	"expvar"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const scopeName = "go.opentelemetry.io/otel/bridge/expvar"
+
+// mapKey is the attribute key identifying the entry of a Map variable a
+// data point is reported for.
+const mapKey = attribute.Key("key")
+
+// MetricProducer is a metric.Producer that provides the variables published
+// with expvar to an OpenTelemetry Reader.
+type MetricProducer struct {
+	nameFunc func(string) string
+	counters map[string]bool
+	// startTime is reported as the start time of all cumulative data. expvar
+	// does not record it.
+	startTime time.Time
+}
+
+var _ metric.Producer = (*MetricProducer)(nil)
+
+// NewMetricProducer returns a MetricProducer that reports the numeric
+// variables published with expvar.
+func NewMetricProducer(opts ...Option) *MetricProducer {
+	cfg := newConfig(opts...)
+	return &MetricProducer{
+		nameFunc:  cfg.nameFunc,
+		counters:  cfg.counters,
+		startTime: time.Now(),
+	}
+}
+
+// Produce snapshots the variables published with expvar and returns them
+// converted to OpenTelemetry metric data.
+//
+// This method is safe to call concurrently.
+func (p *MetricProducer) Produce(context.Context) ([]metricdata.ScopeMetrics, error) {
+	now := time.Now()
+	var metrics []metricdata.Metrics
+	expvar.Do(func(kv expvar.KeyValue) {
+		name := p.nameFunc(kv.Key)
+		if name == "" {
+			return
+		}
+		data, ok := p.convert(kv.Key, kv.Value, now)
+		if !ok {
+			return
+		}
+		metrics = append(metrics, metricdata.Metrics{Name: name, Data: data})
+	})
+
+	if len(metrics) == 0 {
+		return nil, nil
+	}
+	return []metricdata.ScopeMetrics{{
+		Scope:   instrumentation.Scope{Name: scopeName},
+		Metrics: metrics,
+	}}, nil
+}
+
+// convert converts the expvar variable v with name to an Aggregation. False
+// is returned if v has no numeric value.
+func (p *MetricProducer) convert(name string, v expvar.Var, now time.Time) (metricdata.Aggregation, bool) {
+	counter := p.counters[name]
+	switch v := v.(type) {
+	case *expvar.Int:
+		return single(counter, p.startTime, now, v.Value()), true
+	case *expvar.Float:
+		return single(counter, p.startTime, now, v.Value()), true
+	case expvar.Func:
+		switch x := v.Value().(type) {
+		case int:
+			return single(counter, p.startTime, now, int64(x)), true
+		case int64:
+			return single(counter, p.startTime, now, x), true
+		case float64:
+			return single(counter, p.startTime, now, x), true
+		}
+	case *expvar.Map:
+		var dPts []metricdata.DataPoint[float64]
+		v.Do(func(kv expvar.KeyValue) {
+			f, ok := floatValue(kv.Value)
+			if !ok {
+				return
+			}
+			dPts = append(dPts, metricdata.DataPoint[float64]{
+				Attributes: attribute.NewSet(mapKey.String(kv.Key)),
+				Value:      f,
+			})
+		})
+		if len(dPts) > 0 {
+			return aggregation(counter, p.startTime, now, dPts), true
+		}
+	}
+	return nil, false
+}
+
+// single returns an Aggregation with a single data point of value v.
+func single[N int64 | float64](counter bool, start, now time.Time, v N) metricdata.Aggregation {
+	return aggregation(counter, start, now, []metricdata.DataPoint[N]{{
+		Attributes: *attribute.EmptySet(),
+		Value:      v,
+	}})
+}
+
+// floatValue returns the numeric value of v as a float64. False is returned
+// if v has no numeric value.
+func floatValue(v expvar.Var) (float64, bool) {
+	switch v := v.(type) {
+	case *expvar.Int:
+		return float64(v.Value()), true
+	case *expvar.Float:
+		return v.Value(), true
+	case expvar.Func:
+		switch x := v.Value().(type) {
+		case int:
+			return float64(x), true
+		case int64:
+			return float64(x), true
+		case float64:
+			return x, true
+		}
+	}
+	return 0, false
+}
+
+// aggregation returns dPts as a cumulative monotonic Sum if counter is true,
+// otherwise as a Gauge.
+func aggregation[N int64 | float64](counter bool, start, now time.Time, dPts []metricdata.DataPoint[N]) metricdata.Aggregation {
+	for i := range dPts {
+		dPts[i].Time = now
+		if counter {
+			dPts[i].StartTime = start
+		}
+	}
+	if counter {
+		return metricdata.Sum[N]{
+			DataPoints:  dPts,
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+		}
+	}
+	return metricdata.Gauge[N]{DataPoints: dPts}
+}
@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expvar
+
+import (
+	"context"
+	"expvar"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+const prefix = "bridge_test."
+
+func init() {
+	expvar.NewInt(prefix + "requests").Add(3)
+	expvar.NewFloat(prefix + "temperature").Set(21.5)
+	expvar.NewString(prefix + "version").Set("v1")
+
+	m := expvar.NewMap(prefix + "codes")
+	m.Add("200", 5)
+	m.AddFloat("500", 1)
+	m.Set("name", new(expvar.String))
+
+	expvar.Publish(prefix+"goroutines", expvar.Func(func() interface{} { return 7 }))
+	expvar.Publish(prefix+"ratio", expvar.Func(func() interface{} { return 0.25 }))
+	expvar.Publish(prefix+"struct", expvar.Func(func() interface{} { return struct{}{} }))
+}
+
+// testOnly only reports the variables published by this test.
+func testOnly(name string) string {
+	if !strings.HasPrefix(name, prefix) {
+		return ""
+	}
+	return strings.TrimPrefix(name, prefix)
+}
+
+func produce(t *testing.T, opts ...Option) metricdata.ScopeMetrics {
+	t.Helper()
+
+	reader := metric.NewManualReader(metric.WithProducer(NewMetricProducer(opts...)))
+	_ = metric.NewMeterProvider(metric.WithReader(reader))
+
+	rm, err := reader.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, rm.ScopeMetrics, 1)
+	return rm.ScopeMetrics[0]
+}
+
+func TestMetricProducer(t *testing.T) {
+	got := produce(t, WithNameFunc(testOnly))
+
+	want := metricdata.ScopeMetrics{
+		Scope: instrumentation.Scope{Name: scopeName},
+		Metrics: []metricdata.Metrics{
+			{
+				Name: "codes",
+				Data: metricdata.Gauge[float64]{
+					DataPoints: []metricdata.DataPoint[float64]{
+						{Attributes: attribute.NewSet(mapKey.String("200")), Value: 5},
+						{Attributes: attribute.NewSet(mapKey.String("500")), Value: 1},
+					},
+				},
+			},
+			{
+				Name: "goroutines",
+				Data: metricdata.Gauge[int64]{
+					DataPoints: []metricdata.DataPoint[int64]{{Attributes: attribute.NewSet(), Value: 7}},
+				},
+			},
+			{
+				Name: "ratio",
+				Data: metricdata.Gauge[float64]{
+					DataPoints: []metricdata.DataPoint[float64]{{Attributes: attribute.NewSet(), Value: 0.25}},
+				},
+			},
+			{
+				Name: "requests",
+				Data: metricdata.Gauge[int64]{
+					DataPoints: []metricdata.DataPoint[int64]{{Attributes: attribute.NewSet(), Value: 3}},
+				},
+			},
+			{
+				Name: "temperature",
+				Data: metricdata.Gauge[float64]{
+					DataPoints: []metricdata.DataPoint[float64]{{Attributes: attribute.NewSet(), Value: 21.5}},
+				},
+			},
+		},
+	}
+	metricdatatest.AssertEqual(t, want, got, metricdatatest.IgnoreTimestamp())
+}
+
+func TestMetricProducerCounter(t *testing.T) {
+	got := produce(t,
+		WithNameFunc(func(name string) string {
+			if name != prefix+"requests" {
+				return ""
+			}
+			return "requests_total"
+		}),
+		WithCounter(prefix+"requests"),
+	)
+
+	want := metricdata.ScopeMetrics{
+		Scope: instrumentation.Scope{Name: scopeName},
+		Metrics: []metricdata.Metrics{{
+			Name: "requests_total",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints:  []metricdata.DataPoint[int64]{{Attributes: attribute.NewSet(), Value: 3}},
+			},
+		}},
+	}
+	metricdatatest.AssertEqual(t, want, got, metricdatatest.IgnoreTimestamp())
+	require.False(t, got.Metrics[0].Data.(metricdata.Sum[int64]).DataPoints[0].StartTime.IsZero())
+}
+
+func TestMetricProducerNoVariables(t *testing.T) {
+	p := NewMetricProducer(WithNameFunc(func(string) string { return "" }))
+	got, err := p.Produce(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
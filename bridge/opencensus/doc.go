@@ -19,6 +19,11 @@
 // spans for traces. These spans will be exported by the OpenTelemetry
 // TracerProvider the original OpenTelemetry Tracer came from.
 //
+// The NewMetricProducer function should be used to bridge OpenCensus
+// metrics. The returned MetricProducer can be registered with an
+// OpenTelemetry Reader using the metric.WithProducer option so OpenCensus
+// and OpenTelemetry metrics are collected and exported together.
+//
 // There are known limitations to this bridge:
 //
 // - The AddLink method for OpenCensus Spans is not compatible with the
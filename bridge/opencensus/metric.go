@@ -22,6 +22,7 @@ import (
 
 	ocmetricdata "go.opencensus.io/metric/metricdata"
 	"go.opencensus.io/metric/metricexport"
+	"go.opencensus.io/metric/metricproducer"
 
 	"go.opentelemetry.io/otel"
 	internal "go.opentelemetry.io/otel/bridge/opencensus/internal/ocmetric"
@@ -67,3 +68,46 @@ func (e *exporter) ExportMetrics(ctx context.Context, ocmetrics []*ocmetricdata.
 			},
 		}})
 }
+
+// MetricProducer is a metric.Producer that provides the metrics of all
+// OpenCensus metric producers registered with the OpenCensus global
+// metricproducer.Manager, e.g. the default view worker and metric
+// registries.
+//
+// Register a MetricProducer with a Reader using the metric.WithProducer
+// option to collect and export OpenCensus and OpenTelemetry metrics through
+// the same Reader and exporter.
+type MetricProducer struct {
+	manager *metricproducer.Manager
+}
+
+var _ metric.Producer = (*MetricProducer)(nil)
+
+// NewMetricProducer returns a MetricProducer that reads metrics from the
+// OpenCensus global metricproducer.Manager.
+func NewMetricProducer() *MetricProducer {
+	return &MetricProducer{manager: metricproducer.GlobalManager()}
+}
+
+// Produce reads the metrics of all registered OpenCensus producers and
+// returns them converted to OpenTelemetry metric data. Metrics that cannot
+// be converted are dropped and an error is returned along with the
+// remaining metrics.
+//
+// This method is safe to call concurrently.
+func (p *MetricProducer) Produce(context.Context) ([]metricdata.ScopeMetrics, error) {
+	var data []*ocmetricdata.Metric
+	for _, ocProducer := range p.manager.GetAll() {
+		data = append(data, ocProducer.Read()...)
+	}
+	otelmetrics, err := internal.ConvertMetrics(data)
+	if len(otelmetrics) == 0 {
+		return nil, err
+	}
+	return []metricdata.ScopeMetrics{{
+		Scope: instrumentation.Scope{
+			Name: scopeName,
+		},
+		Metrics: otelmetrics,
+	}}, err
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	ocmetricdata "go.opencensus.io/metric/metricdata"
+	"go.opencensus.io/metric/metricproducer"
 	ocresource "go.opencensus.io/resource"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -155,3 +156,69 @@ func (f *fakeExporter) Export(ctx context.Context, data metricdata.ResourceMetri
 	}
 	return f.err
 }
+
+type fakeOCProducer []*ocmetricdata.Metric
+
+func (p *fakeOCProducer) Read() []*ocmetricdata.Metric { return *p }
+
+func TestMetricProducer(t *testing.T) {
+	now := time.Now()
+	ocProducer := &fakeOCProducer{
+		{
+			Descriptor: ocmetricdata.Descriptor{
+				Name:        "oc.counter",
+				Description: "an OpenCensus counter",
+				Unit:        ocmetricdata.UnitDimensionless,
+				Type:        ocmetricdata.TypeCumulativeInt64,
+				LabelKeys:   []ocmetricdata.LabelKey{{Key: "k"}},
+			},
+			TimeSeries: []*ocmetricdata.TimeSeries{
+				{
+					LabelValues: []ocmetricdata.LabelValue{{Value: "v", Present: true}},
+					StartTime:   now,
+					Points:      []ocmetricdata.Point{{Value: int64(5), Time: now}},
+				},
+			},
+		},
+	}
+	metricproducer.GlobalManager().AddProducer(ocProducer)
+	t.Cleanup(func() { metricproducer.GlobalManager().DeleteProducer(ocProducer) })
+
+	reader := metric.NewManualReader(metric.WithProducer(NewMetricProducer()))
+	_ = metric.NewMeterProvider(metric.WithReader(reader), metric.WithResource(resource.Empty()))
+
+	rm, err := reader.Collect(context.Background())
+	require.NoError(t, err)
+	metricdatatest.AssertEqual(t, metricdata.ResourceMetrics{
+		Resource: resource.Empty(),
+		ScopeMetrics: []metricdata.ScopeMetrics{
+			{
+				Scope: instrumentation.Scope{Name: scopeName},
+				Metrics: []metricdata.Metrics{
+					{
+						Name:        "oc.counter",
+						Description: "an OpenCensus counter",
+						Unit:        "1",
+						Data: metricdata.Sum[int64]{
+							Temporality: metricdata.CumulativeTemporality,
+							DataPoints: []metricdata.DataPoint[int64]{
+								{
+									Attributes: attribute.NewSet(attribute.String("k", "v")),
+									StartTime:  now,
+									Time:       now,
+									Value:      5,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, rm)
+}
+
+func TestMetricProducerEmpty(t *testing.T) {
+	sm, err := NewMetricProducer().Produce(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, sm)
+}
@@ -17,7 +17,9 @@ package opentracing // import "go.opentelemetry.io/otel/bridge/opentracing"
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"reflect"
 	"strings"
 	"sync"
 
@@ -314,9 +316,16 @@ type BridgeTracer struct {
 	warningHandler BridgeWarningHandler
 	warnOnce       sync.Once
 
-	propagator propagation.TextMapPropagator
+	propagator       propagation.TextMapPropagator
+	binaryPropagator propagation.BinaryPropagator
+	carrierFormats   map[interface{}]CarrierAdapter
 }
 
+// CarrierAdapter adapts a carrier of a custom OpenTracing format to a
+// propagation.TextMapCarrier. An error, e.g. ot.ErrInvalidCarrier, is
+// returned if carrier cannot be adapted.
+type CarrierAdapter func(carrier interface{}) (propagation.TextMapCarrier, error)
+
 var _ ot.Tracer = &BridgeTracer{}
 var _ ot.TracerContextWithSpanExtension = &BridgeTracer{}
 
@@ -356,6 +365,49 @@ func (t *BridgeTracer) SetTextMapPropagator(propagator propagation.TextMapPropag
 	t.propagator = propagator
 }
 
+// SetBinaryPropagator sets propagator as the BinaryPropagator used by the
+// BridgeTracer for the OpenTracing Binary format. By default the
+// propagation.BinaryTraceContext propagator is used, it only propagates the
+// span context, not baggage.
+func (t *BridgeTracer) SetBinaryPropagator(propagator propagation.BinaryPropagator) {
+	t.binaryPropagator = propagator
+}
+
+// RegisterCarrierFormat registers adapter to be used to inject into and
+// extract from carriers of format. The TextMapPropagator of the BridgeTracer
+// is used with the propagation.TextMapCarrier returned by adapter. This
+// allows instrumentation using custom OpenTracing formats, e.g. message
+// headers, to keep propagating the span context. An adapter registered for
+// a builtin format replaces the builtin support of that format.
+//
+// The format needs to be comparable, otherwise this function panics. This
+// function should be called before the BridgeTracer is used, it is not safe
+// to call concurrently with Inject or Extract.
+func (t *BridgeTracer) RegisterCarrierFormat(format interface{}, adapter CarrierAdapter) {
+	if !isComparable(format) {
+		panic(fmt.Sprintf("opentracing bridge: carrier format of type %T is not comparable", format))
+	}
+	if t.carrierFormats == nil {
+		t.carrierFormats = make(map[interface{}]CarrierAdapter)
+	}
+	t.carrierFormats[format] = adapter
+}
+
+// carrierAdapter returns the CarrierAdapter registered for format, if any.
+func (t *BridgeTracer) carrierAdapter(format interface{}) (CarrierAdapter, bool) {
+	if len(t.carrierFormats) == 0 || !isComparable(format) {
+		return nil, false
+	}
+	adapter, ok := t.carrierFormats[format]
+	return adapter, ok
+}
+
+// isComparable returns if v can be used as a map key.
+func isComparable(v interface{}) bool {
+	typ := reflect.TypeOf(v)
+	return typ != nil && typ.Comparable()
+}
+
 // NewHookedContext returns a Context that has ctx as its parent and is
 // wrapped to handle baggage set and get operations.
 func (t *BridgeTracer) NewHookedContext(ctx context.Context) context.Context {
@@ -645,7 +697,10 @@ func (s fakeSpan) SpanContext() trace.SpanContext {
 // Inject is a part of the implementation of the OpenTracing Tracer
 // interface.
 //
-// Currently only the HTTPHeaders and TextMap formats are supported.
+// The HTTPHeaders, TextMap, and Binary formats are supported, as well as
+// formats registered with RegisterCarrierFormat. The Binary format carrier
+// needs to be an io.Writer, the span context is written to it using the
+// BinaryPropagator of the BridgeTracer.
 func (t *BridgeTracer) Inject(sm ot.SpanContext, format interface{}, carrier interface{}) error {
 	bridgeSC, ok := sm.(*bridgeSpanContext)
 	if !ok {
@@ -655,6 +710,22 @@ func (t *BridgeTracer) Inject(sm ot.SpanContext, format interface{}, carrier int
 		return ot.ErrInvalidSpanContext
 	}
 
+	fs := fakeSpan{
+		Span: noopSpan,
+		sc:   bridgeSC.otelSpanContext,
+	}
+	ctx := trace.ContextWithSpan(context.Background(), fs)
+	ctx = baggage.ContextWithBaggage(ctx, bridgeSC.bag)
+
+	if adapter, ok := t.carrierAdapter(format); ok {
+		textCarrier, err := adapter(carrier)
+		if err != nil {
+			return err
+		}
+		t.getPropagator().Inject(ctx, textCarrier)
+		return nil
+	}
+
 	builtinFormat, ok := format.(ot.BuiltinFormat)
 	if !ok {
 		return ot.ErrUnsupportedFormat
@@ -677,16 +748,17 @@ func (t *BridgeTracer) Inject(sm ot.SpanContext, format interface{}, carrier int
 				return err
 			}
 		}
+	case ot.Binary:
+		w, ok := carrier.(io.Writer)
+		if !ok {
+			return ot.ErrInvalidCarrier
+		}
+		_, err := w.Write(t.getBinaryPropagator().Inject(ctx))
+		return err
 	default:
 		return ot.ErrUnsupportedFormat
 	}
 
-	fs := fakeSpan{
-		Span: noopSpan,
-		sc:   bridgeSC.otelSpanContext,
-	}
-	ctx := trace.ContextWithSpan(context.Background(), fs)
-	ctx = baggage.ContextWithBaggage(ctx, bridgeSC.bag)
 	t.getPropagator().Inject(ctx, textCarrier)
 	return nil
 }
@@ -694,8 +766,19 @@ func (t *BridgeTracer) Inject(sm ot.SpanContext, format interface{}, carrier int
 // Extract is a part of the implementation of the OpenTracing Tracer
 // interface.
 //
-// Currently only the HTTPHeaders and TextMap formats are supported.
+// The HTTPHeaders, TextMap, and Binary formats are supported, as well as
+// formats registered with RegisterCarrierFormat. The Binary format carrier
+// needs to be an io.Reader, the span context is read from it using the
+// BinaryPropagator of the BridgeTracer.
 func (t *BridgeTracer) Extract(format interface{}, carrier interface{}) (ot.SpanContext, error) {
+	if adapter, ok := t.carrierAdapter(format); ok {
+		textCarrier, err := adapter(carrier)
+		if err != nil {
+			return nil, err
+		}
+		return newExtractedSpanContext(t.getPropagator().Extract(context.Background(), textCarrier))
+	}
+
 	builtinFormat, ok := format.(ot.BuiltinFormat)
 	if !ok {
 		return nil, ot.ErrUnsupportedFormat
@@ -718,11 +801,26 @@ func (t *BridgeTracer) Extract(format interface{}, carrier interface{}) (ot.Span
 				return nil, err
 			}
 		}
+	case ot.Binary:
+		r, ok := carrier.(io.Reader)
+		if !ok {
+			return nil, ot.ErrInvalidCarrier
+		}
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		return newExtractedSpanContext(t.getBinaryPropagator().Extract(context.Background(), data))
 	default:
 		return nil, ot.ErrUnsupportedFormat
 	}
 
-	ctx := t.getPropagator().Extract(context.Background(), textCarrier)
+	return newExtractedSpanContext(t.getPropagator().Extract(context.Background(), textCarrier))
+}
+
+// newExtractedSpanContext returns a bridgeSpanContext with the remote span
+// context and baggage extracted into ctx.
+func newExtractedSpanContext(ctx context.Context) (ot.SpanContext, error) {
 	bag := baggage.FromContext(ctx)
 	bridgeSC := &bridgeSpanContext{
 		bag:             bag,
@@ -741,6 +839,13 @@ func (t *BridgeTracer) getPropagator() propagation.TextMapPropagator {
 	return otel.GetTextMapPropagator()
 }
 
+func (t *BridgeTracer) getBinaryPropagator() propagation.BinaryPropagator {
+	if t.binaryPropagator != nil {
+		return t.binaryPropagator
+	}
+	return propagation.BinaryTraceContext{}
+}
+
 // textMapWrapper Provides operating.TextMapWriter and operating.TextMapReader to
 // propagation.TextMapCarrier compatibility.
 // Usually, Inject method will only use the write-related interface.
@@ -15,6 +15,7 @@
 package opentracing
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -329,15 +330,29 @@ func TestBridgeTracer_ExtractAndInject(t *testing.T) {
 			extractErr:         ot.ErrInvalidCarrier,
 		},
 		{
-			name:              "inject: unsupported format type",
+			name:              "inject: format type is Binary, but carrier is not io.Writer",
 			injectCarrierType: ot.Binary,
+			injectCarrier:     struct{}{},
+			injectErr:         ot.ErrInvalidCarrier,
+		},
+		{
+			name:               "extract: format type is Binary, but carrier is not io.Reader",
+			injectCarrierType:  ot.TextMap,
+			injectCarrier:      otTextMap,
+			extractCarrierType: ot.Binary,
+			extractCarrier:     struct{}{},
+			extractErr:         ot.ErrInvalidCarrier,
+		},
+		{
+			name:              "inject: unsupported format type",
+			injectCarrierType: ot.BuiltinFormat(255),
 			injectErr:         ot.ErrUnsupportedFormat,
 		},
 		{
 			name:               "extract: unsupported format type",
 			injectCarrierType:  ot.TextMap,
 			injectCarrier:      otTextMap,
-			extractCarrierType: ot.Binary,
+			extractCarrierType: ot.BuiltinFormat(255),
 			extractCarrier:     struct{}{},
 			extractErr:         ot.ErrUnsupportedFormat,
 		},
@@ -367,6 +382,120 @@ func TestBridgeTracer_ExtractAndInject(t *testing.T) {
 	}
 }
 
+func TestBridgeTracer_BinaryFormat(t *testing.T) {
+	bridge := NewBridgeTracer()
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    [16]byte{byte(1)},
+		SpanID:     [8]byte{byte(2)},
+		TraceFlags: trace.FlagsSampled,
+	})
+
+	var buf bytes.Buffer
+	err := bridge.Inject(newBridgeSpanContext(sc, nil), ot.Binary, &buf)
+	assert.NoError(t, err)
+	assert.Equal(t, propagation.BinaryTraceContext{}.Inject(trace.ContextWithSpanContext(context.Background(), sc)), buf.Bytes())
+
+	spanContext, err := bridge.Extract(ot.Binary, &buf)
+	assert.NoError(t, err)
+	bsc, ok := spanContext.(*bridgeSpanContext)
+	assert.True(t, ok)
+	assert.Equal(t, sc.WithRemote(true), bsc.otelSpanContext)
+
+	_, err = bridge.Extract(ot.Binary, bytes.NewReader([]byte{1, 2, 3}))
+	assert.Equal(t, ot.ErrSpanContextNotFound, err)
+}
+
+type testBinaryPropagator struct{}
+
+func (testBinaryPropagator) Inject(context.Context) []byte { return []byte("test") }
+
+func (testBinaryPropagator) Extract(ctx context.Context, data []byte) context.Context {
+	if string(data) != "test" {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+	}))
+}
+
+func TestBridgeTracer_SetBinaryPropagator(t *testing.T) {
+	bridge := NewBridgeTracer()
+	bridge.SetBinaryPropagator(testBinaryPropagator{})
+
+	var buf bytes.Buffer
+	err := bridge.Inject(newBridgeSpanContext(trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: [16]byte{byte(1)},
+		SpanID:  [8]byte{byte(2)},
+	}), nil), ot.Binary, &buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "test", buf.String())
+
+	spanContext, err := bridge.Extract(ot.Binary, &buf)
+	assert.NoError(t, err)
+	bsc, ok := spanContext.(*bridgeSpanContext)
+	assert.True(t, ok)
+	assert.Equal(t, traceID, bsc.otelSpanContext.TraceID())
+	assert.Equal(t, spanID, bsc.otelSpanContext.SpanID())
+}
+
+// messageHeaders is a custom carrier of messaging instrumentation.
+type messageHeaders map[string][]byte
+
+type messageHeadersFormat struct{}
+
+func TestBridgeTracer_RegisterCarrierFormat(t *testing.T) {
+	bridge := NewBridgeTracer()
+	bridge.SetTextMapPropagator(new(testTextMapPropagator))
+	bridge.RegisterCarrierFormat(messageHeadersFormat{}, func(carrier interface{}) (propagation.TextMapCarrier, error) {
+		headers, ok := carrier.(messageHeaders)
+		if !ok {
+			return nil, ot.ErrInvalidCarrier
+		}
+		tmc := newTextCarrier()
+		for k, v := range headers {
+			tmc.Set(k, string(v))
+		}
+		return headersCarrier{textMapCarrier: tmc, headers: headers}, nil
+	})
+
+	headers := messageHeaders{}
+	sc := newBridgeSpanContext(trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: [16]byte{byte(1)},
+		SpanID:  [8]byte{byte(2)},
+	}), nil)
+	assert.NoError(t, bridge.Inject(sc, messageHeadersFormat{}, headers))
+	assert.Contains(t, headers, testHeader)
+
+	spanContext, err := bridge.Extract(messageHeadersFormat{}, headers)
+	assert.NoError(t, err)
+	bsc, ok := spanContext.(*bridgeSpanContext)
+	assert.True(t, ok)
+	assert.Equal(t, traceID, bsc.otelSpanContext.TraceID())
+	assert.Equal(t, spanID, bsc.otelSpanContext.SpanID())
+
+	assert.Equal(t, ot.ErrInvalidCarrier, bridge.Inject(sc, messageHeadersFormat{}, struct{}{}))
+	_, err = bridge.Extract(messageHeadersFormat{}, struct{}{})
+	assert.Equal(t, ot.ErrInvalidCarrier, err)
+
+	_, err = bridge.Extract([]string{"not comparable"}, headers)
+	assert.Equal(t, ot.ErrUnsupportedFormat, err)
+	assert.Panics(t, func() {
+		bridge.RegisterCarrierFormat([]string{"not comparable"}, nil)
+	})
+}
+
+// headersCarrier writes the values set on the textMapCarrier to headers.
+type headersCarrier struct {
+	*textMapCarrier
+	headers messageHeaders
+}
+
+func (c headersCarrier) Set(key, value string) {
+	c.textMapCarrier.Set(key, value)
+	c.headers[key] = []byte(value)
+}
+
 type nonDeferWrapperTracer struct {
 	*WrapperTracer
 }
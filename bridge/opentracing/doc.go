@@ -102,4 +102,13 @@
 // LogFields() function, so when the call to the function gets
 // translated to OpenTelemetry AddEvent() function, an empty context
 // is passed.
+//
+// The Inject() and Extract() functions of BridgeTracer support the
+// HTTPHeaders and TextMap formats, using the TextMapPropagator set
+// with SetTextMapPropagator() or the global one, and the Binary
+// format, using the BinaryPropagator set with SetBinaryPropagator().
+// Carriers of custom formats, e.g. the message headers of legacy
+// messaging instrumentation, can be supported by registering an
+// adapter to a propagation.TextMapCarrier with the
+// RegisterCarrierFormat() function.
 package opentracing // import "go.opentelemetry.io/otel/bridge/opentracing"
@@ -192,6 +192,7 @@ type MockSpan struct {
 	EndTime      time.Time
 	ParentSpanID trace.SpanID
 	Events       []MockEvent
+	Links        []trace.Link
 }
 
 var _ trace.Span = &MockSpan{}
@@ -221,6 +222,10 @@ func (s *MockSpan) SetAttributes(attributes ...attribute.KeyValue) {
 	s.applyUpdate(attributes)
 }
 
+func (s *MockSpan) AddLink(link trace.Link) {
+	s.Links = append(s.Links, link)
+}
+
 func (s *MockSpan) applyUpdate(update []attribute.KeyValue) {
 	updateM := make(map[attribute.Key]attribute.Value, len(update))
 	for _, kv := range update {
@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otelslog provides a bridge between the log/slog package of the Go
+// standard library and the OpenTelemetry Logs Bridge API.
+//
+// Use NewHandler to create a slog.Handler that emits every log record it
+// handles as an OpenTelemetry log record with a Logger. The record is
+// converted as follows:
+//
+//   - The time of the record is the Timestamp.
+//   - The level of the record is mapped to the Severity, slog.LevelDebug,
+//     slog.LevelInfo, slog.LevelWarn, and slog.LevelError map to
+//     log.SeverityDebug, log.SeverityInfo, log.SeverityWarn, and
+//     log.SeverityError. The SeverityText is the name of the level.
+//   - The message of the record is the Body.
+//   - The attributes of the record, and those added with WithAttrs, are the
+//     Attributes. Attributes in groups have their key prefixed with the
+//     names of the groups, separated by dots.
+//
+// The context passed to the slog logging methods is passed to the Logger.
+// This means the log record is correlated with the span in that context,
+// if any.
+//
+// This package requires Go 1.21 or later.
+package otelslog // import "go.opentelemetry.io/otel/bridge/otelslog"
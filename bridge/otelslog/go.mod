@@ -0,0 +1,22 @@
+module go.opentelemetry.io/otel/bridge/otelslog
+
+go 1.21
+
+require (
+	github.com/stretchr/testify v1.8.0
+	go.opentelemetry.io/otel v1.11.1
+	go.opentelemetry.io/otel/log v0.0.1
+	go.opentelemetry.io/otel/trace v1.11.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace go.opentelemetry.io/otel => ../..
+
+replace go.opentelemetry.io/otel/log => ../../log
+
+replace go.opentelemetry.io/otel/trace => ../../trace
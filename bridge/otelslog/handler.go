@@ -0,0 +1,196 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.21
+// +build go1.21
+
+package otelslog // import "go.opentelemetry.io/otel/bridge/otelslog"
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+)
+
+// Handler is a slog.Handler that emits the records it handles with an
+// OpenTelemetry Logger.
+type Handler struct {
+	logger log.Logger
+
+	// attrs are the attributes added with WithAttrs.
+	attrs []attribute.KeyValue
+	// prefix is the key prefix of the groups opened with WithGroup.
+	prefix string
+}
+
+var _ slog.Handler = (*Handler)(nil)
+
+// NewHandler returns a new Handler that emits records with a Logger named
+// name, created by provider with opts.
+//
+// The name should be the name of the package, or component, the Handler is
+// used by.
+func NewHandler(name string, provider log.LoggerProvider, opts ...log.LoggerOption) *Handler {
+	return &Handler{logger: provider.Logger(name, opts...)}
+}
+
+// Enabled reports true for all levels. The Logger decides which records are
+// processed.
+func (h *Handler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+// Handle emits r as an OpenTelemetry log record with ctx.
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	record := log.Record{
+		Timestamp:    r.Time,
+		Severity:     convertLevel(r.Level),
+		SeverityText: r.Level.String(),
+		Body:         attribute.StringValue(r.Message),
+	}
+
+	if n := len(h.attrs) + r.NumAttrs(); n > 0 {
+		record.Attributes = make([]attribute.KeyValue, 0, n)
+		record.Attributes = append(record.Attributes, h.attrs...)
+		r.Attrs(func(a slog.Attr) bool {
+			record.Attributes = appendAttr(record.Attributes, h.prefix, a)
+			return true
+		})
+	}
+
+	h.logger.Emit(ctx, record)
+	return nil
+}
+
+// WithAttrs returns a new Handler that includes attrs in the attributes of
+// all the records it handles.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+
+	h2 := *h
+	h2.attrs = make([]attribute.KeyValue, len(h.attrs), len(h.attrs)+len(attrs))
+	copy(h2.attrs, h.attrs)
+	for _, a := range attrs {
+		h2.attrs = appendAttr(h2.attrs, h.prefix, a)
+	}
+	return &h2
+}
+
+// WithGroup returns a new Handler that prefixes the keys of all subsequent
+// attributes with name.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	h2 := *h
+	h2.prefix = h.prefix + name + "."
+	return &h2
+}
+
+// convertLevel returns the Severity level maps to. Levels between the slog
+// levels map to the finer severities of the same range, e.g.
+// slog.LevelInfo+1 maps to log.SeverityInfo2.
+func convertLevel(level slog.Level) log.Severity {
+	// slog.LevelDebug (-4) maps to log.SeverityDebug (5), the offset is the
+	// same for all the other slog levels.
+	s := log.Severity(level + 9)
+	switch {
+	case s < log.SeverityTrace1:
+		return log.SeverityTrace1
+	case s > log.SeverityFatal4:
+		return log.SeverityFatal4
+	}
+	return s
+}
+
+// appendAttr appends the attributes a converts to, with keys prefixed by
+// prefix, to attrs.
+func appendAttr(attrs []attribute.KeyValue, prefix string, a slog.Attr) []attribute.KeyValue {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		// Ignore empty attributes.
+		return attrs
+	}
+
+	if a.Value.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			// Attributes of a group with an empty key are inlined.
+			prefix += a.Key + "."
+		}
+		for _, ga := range a.Value.Group() {
+			attrs = appendAttr(attrs, prefix, ga)
+		}
+		return attrs
+	}
+	return append(attrs, attribute.KeyValue{
+		Key:   attribute.Key(prefix + a.Key),
+		Value: convertValue(a.Value),
+	})
+}
+
+// convertValue returns the attribute.Value v converts to. Values of kinds
+// that have no attribute equivalent are converted to strings.
+func convertValue(v slog.Value) attribute.Value {
+	switch v.Kind() {
+	case slog.KindBool:
+		return attribute.BoolValue(v.Bool())
+	case slog.KindFloat64:
+		return attribute.Float64Value(v.Float64())
+	case slog.KindInt64:
+		return attribute.Int64Value(v.Int64())
+	case slog.KindUint64:
+		if u := v.Uint64(); u <= math.MaxInt64 {
+			return attribute.Int64Value(int64(u))
+		}
+		return attribute.StringValue(v.String())
+	case slog.KindDuration:
+		return attribute.Int64Value(v.Duration().Nanoseconds())
+	case slog.KindTime:
+		return attribute.StringValue(v.Time().Format(time.RFC3339Nano))
+	case slog.KindString:
+		return attribute.StringValue(v.String())
+	case slog.KindAny:
+		return convertAny(v.Any())
+	}
+	return attribute.StringValue(v.String())
+}
+
+// convertAny returns the attribute.Value v converts to.
+func convertAny(v interface{}) attribute.Value {
+	switch v := v.(type) {
+	case error:
+		return attribute.StringValue(v.Error())
+	case fmt.Stringer:
+		return attribute.StringValue(v.String())
+	case []string:
+		return attribute.StringSliceValue(v)
+	case []bool:
+		return attribute.BoolSliceValue(v)
+	case []int64:
+		return attribute.Int64SliceValue(v)
+	case []int:
+		return attribute.IntSliceValue(v)
+	case []float64:
+		return attribute.Float64SliceValue(v)
+	}
+	return attribute.StringValue(fmt.Sprintf("%+v", v))
+}
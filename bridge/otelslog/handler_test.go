@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.21
+// +build go1.21
+
+package otelslog
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type recorder struct {
+	name    string
+	version string
+
+	ctxs    []context.Context
+	records []log.Record
+}
+
+func (r *recorder) Logger(name string, opts ...log.LoggerOption) log.Logger {
+	r.name = name
+	r.version = log.NewLoggerConfig(opts...).InstrumentationVersion()
+	return r
+}
+
+func (r *recorder) Emit(ctx context.Context, record log.Record) {
+	r.ctxs = append(r.ctxs, ctx)
+	r.records = append(r.records, record)
+}
+
+func TestNewHandler(t *testing.T) {
+	rec := new(recorder)
+	NewHandler("name", rec, log.WithInstrumentationVersion("v1.0.0"))
+	assert.Equal(t, "name", rec.name)
+	assert.Equal(t, "v1.0.0", rec.version)
+}
+
+func TestHandlerHandle(t *testing.T) {
+	rec := new(recorder)
+	logger := slog.New(NewHandler("TestHandlerHandle", rec))
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	before := time.Now()
+	logger.WarnContext(ctx, "message", "key", "value")
+
+	require.Len(t, rec.records, 1)
+	r := rec.records[0]
+	assert.False(t, r.Timestamp.Before(before), "timestamp")
+	assert.Equal(t, log.SeverityWarn, r.Severity)
+	assert.Equal(t, "WARN", r.SeverityText)
+	assert.Equal(t, attribute.StringValue("message"), r.Body)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("key", "value")}, r.Attributes)
+	assert.Equal(t, sc, trace.SpanContextFromContext(rec.ctxs[0]), "trace context not passed")
+}
+
+func TestHandlerAttributes(t *testing.T) {
+	rec := new(recorder)
+	logger := slog.New(NewHandler("TestHandlerAttributes", rec)).
+		With("a", 1).
+		WithGroup("g").
+		With("b", true)
+
+	logger.Info(
+		"message",
+		slog.Group("h", slog.Float64("c", 1.5), slog.Group("empty")),
+		slog.Group("", slog.String("inline", "v")),
+		slog.Attr{},
+		"d", time.Second,
+	)
+
+	require.Len(t, rec.records, 1)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.Int64("a", 1),
+		attribute.Bool("g.b", true),
+		attribute.Float64("g.h.c", 1.5),
+		attribute.String("g.inline", "v"),
+		attribute.Int64("g.d", time.Second.Nanoseconds()),
+	}, rec.records[0].Attributes)
+}
+
+type valuer struct{}
+
+func (valuer) LogValue() slog.Value { return slog.StringValue("resolved") }
+
+func TestConvertValue(t *testing.T) {
+	ts := time.Date(2022, 11, 1, 2, 3, 4, 5, time.UTC)
+	tests := []struct {
+		name string
+		v    slog.Value
+		want attribute.Value
+	}{
+		{"bool", slog.BoolValue(true), attribute.BoolValue(true)},
+		{"float64", slog.Float64Value(1.5), attribute.Float64Value(1.5)},
+		{"int64", slog.Int64Value(-1), attribute.Int64Value(-1)},
+		{"uint64", slog.Uint64Value(1), attribute.Int64Value(1)},
+		{"uint64 overflow", slog.Uint64Value(1 << 63), attribute.StringValue("9223372036854775808")},
+		{"duration", slog.DurationValue(time.Millisecond), attribute.Int64Value(1e6)},
+		{"time", slog.TimeValue(ts), attribute.StringValue("2022-11-01T02:03:04.000000005Z")},
+		{"string", slog.StringValue("s"), attribute.StringValue("s")},
+		{"error", slog.AnyValue(errors.New("err")), attribute.StringValue("err")},
+		{"string slice", slog.AnyValue([]string{"a", "b"}), attribute.StringSliceValue([]string{"a", "b"})},
+		{"int slice", slog.AnyValue([]int{1, 2}), attribute.IntSliceValue([]int{1, 2})},
+		{"struct", slog.AnyValue(struct{ A int }{1}), attribute.StringValue("{A:1}")},
+		{"valuer", slog.AnyValue(valuer{}), attribute.StringValue("resolved")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := appendAttr(nil, "", slog.Attr{Key: "k", Value: test.v})
+			require.Len(t, got, 1)
+			assert.Equal(t, test.want, got[0].Value)
+		})
+	}
+}
+
+func TestConvertLevel(t *testing.T) {
+	tests := []struct {
+		level slog.Level
+		want  log.Severity
+	}{
+		{slog.LevelDebug - 10, log.SeverityTrace1},
+		{slog.LevelDebug - 1, log.SeverityTrace4},
+		{slog.LevelDebug, log.SeverityDebug},
+		{slog.LevelInfo, log.SeverityInfo},
+		{slog.LevelInfo + 1, log.SeverityInfo2},
+		{slog.LevelWarn, log.SeverityWarn},
+		{slog.LevelError, log.SeverityError},
+		{slog.LevelError + 4, log.SeverityFatal},
+		{slog.LevelError + 100, log.SeverityFatal4},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.want, convertLevel(test.level), test.level.String())
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package prometheus provides a bridge from Prometheus to OpenTelemetry.
+//
+// The MetricProducer this package provides gathers the metrics of a
+// Prometheus Gatherer, e.g. the metrics of existing client_golang
+// instrumentation registered with the default Registry, and converts them
+// to OpenTelemetry metric data. Register it with an OpenTelemetry Reader
+// using the metric.WithProducer option to export these metrics with any
+// OpenTelemetry exporter, e.g. OTLP.
+//
+// Prometheus counters are converted to cumulative monotonic sums, gauges and
+// untyped metrics to gauges, histograms to cumulative histograms, and
+// summaries to summaries.
+package prometheus // import "go.opentelemetry.io/otel/bridge/prometheus"
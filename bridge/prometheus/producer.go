@@ -0,0 +1,239 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus // import "go.opentelemetry.io/otel/bridge/prometheus"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const scopeName = "go.opentelemetry.io/otel/bridge/prometheus"
+
+var (
+	errUnsupportedType = errors.New("unsupported Prometheus metric type")
+	errGather          = errors.New("failed to gather Prometheus metrics")
+)
+
+// MetricProducer is a metric.Producer that provides the metrics of
+// Prometheus Gatherers to an OpenTelemetry Reader.
+type MetricProducer struct {
+	gatherers prometheus.Gatherers
+	// startTime is reported as the start time of all cumulative data. The
+	// Prometheus data model does not include it.
+	startTime time.Time
+}
+
+var _ metric.Producer = (*MetricProducer)(nil)
+
+// NewMetricProducer returns a MetricProducer that gathers metrics from the
+// Prometheus DefaultGatherer, or the Gatherers set with WithGatherer.
+func NewMetricProducer(opts ...Option) *MetricProducer {
+	cfg := newConfig(opts...)
+	return &MetricProducer{
+		gatherers: cfg.gatherers,
+		startTime: time.Now(),
+	}
+}
+
+// Produce gathers the metrics of the Prometheus Gatherers and returns them
+// converted to OpenTelemetry metric data. If gathering fails, or metrics
+// cannot be converted, the metrics that were gathered and converted are
+// returned along with an error.
+//
+// This method is safe to call concurrently.
+func (p *MetricProducer) Produce(context.Context) ([]metricdata.ScopeMetrics, error) {
+	now := time.Now()
+	families, err := p.gatherers.Gather()
+	if err != nil {
+		err = fmt.Errorf("%w: %v", errGather, err)
+	}
+
+	metrics, convErr := convertFamilies(families, p.startTime, now)
+	if convErr != nil {
+		if err == nil {
+			err = convErr
+		} else {
+			err = fmt.Errorf("%w; %v", err, convErr)
+		}
+	}
+	if len(metrics) == 0 {
+		return nil, err
+	}
+	return []metricdata.ScopeMetrics{{
+		Scope:   instrumentation.Scope{Name: scopeName},
+		Metrics: metrics,
+	}}, err
+}
+
+// convertFamilies converts Prometheus metric families to OpenTelemetry
+// Metrics. Families of unsupported types are dropped and an error listing
+// them is returned.
+func convertFamilies(families []*dto.MetricFamily, start, now time.Time) ([]metricdata.Metrics, error) {
+	metrics := make([]metricdata.Metrics, 0, len(families))
+	var unsupported []string
+	for _, family := range families {
+		var data metricdata.Aggregation
+		switch family.GetType() {
+		case dto.MetricType_COUNTER:
+			data = convertCounter(family.GetMetric(), start, now)
+		case dto.MetricType_GAUGE:
+			data = convertGauge(family.GetMetric(), now, (*dto.Metric).GetGauge)
+		case dto.MetricType_UNTYPED:
+			data = convertGauge(family.GetMetric(), now, (*dto.Metric).GetUntyped)
+		case dto.MetricType_HISTOGRAM:
+			data = convertHistogram(family.GetMetric(), start, now)
+		case dto.MetricType_SUMMARY:
+			data = convertSummary(family.GetMetric(), start, now)
+		default:
+			unsupported = append(unsupported, family.GetName())
+			continue
+		}
+		metrics = append(metrics, metricdata.Metrics{
+			Name:        family.GetName(),
+			Description: family.GetHelp(),
+			Data:        data,
+		})
+	}
+
+	if len(unsupported) > 0 {
+		return metrics, fmt.Errorf("%w: %v", errUnsupportedType, unsupported)
+	}
+	return metrics, nil
+}
+
+// valuer is implemented by the Prometheus gauge and untyped metric values.
+type valuer interface {
+	GetValue() float64
+}
+
+func convertGauge[V valuer](metrics []*dto.Metric, now time.Time, value func(*dto.Metric) V) metricdata.Gauge[float64] {
+	dPts := make([]metricdata.DataPoint[float64], 0, len(metrics))
+	for _, m := range metrics {
+		dPts = append(dPts, metricdata.DataPoint[float64]{
+			Attributes: convertLabels(m.GetLabel()),
+			Time:       timestamp(m, now),
+			Value:      value(m).GetValue(),
+		})
+	}
+	return metricdata.Gauge[float64]{DataPoints: dPts}
+}
+
+func convertCounter(metrics []*dto.Metric, start, now time.Time) metricdata.Sum[float64] {
+	dPts := make([]metricdata.DataPoint[float64], 0, len(metrics))
+	for _, m := range metrics {
+		dPts = append(dPts, metricdata.DataPoint[float64]{
+			Attributes: convertLabels(m.GetLabel()),
+			StartTime:  start,
+			Time:       timestamp(m, now),
+			Value:      m.GetCounter().GetValue(),
+		})
+	}
+	return metricdata.Sum[float64]{
+		DataPoints:  dPts,
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+	}
+}
+
+func convertHistogram(metrics []*dto.Metric, start, now time.Time) metricdata.Histogram {
+	dPts := make([]metricdata.HistogramDataPoint, 0, len(metrics))
+	for _, m := range metrics {
+		h := m.GetHistogram()
+		// Prometheus buckets are cumulative and may include the +Inf
+		// bucket. OpenTelemetry bucket counts are not cumulative and the
+		// last bucket is implicitly unbounded.
+		buckets := h.GetBucket()
+		bounds := make([]float64, 0, len(buckets))
+		counts := make([]uint64, 0, len(buckets)+1)
+		var prev uint64
+		for _, b := range buckets {
+			if math.IsInf(b.GetUpperBound(), 1) {
+				break
+			}
+			bounds = append(bounds, b.GetUpperBound())
+			counts = append(counts, b.GetCumulativeCount()-prev)
+			prev = b.GetCumulativeCount()
+		}
+		counts = append(counts, h.GetSampleCount()-prev)
+
+		dPts = append(dPts, metricdata.HistogramDataPoint{
+			Attributes:   convertLabels(m.GetLabel()),
+			StartTime:    start,
+			Time:         timestamp(m, now),
+			Count:        h.GetSampleCount(),
+			Bounds:       bounds,
+			BucketCounts: counts,
+			Sum:          h.GetSampleSum(),
+		})
+	}
+	return metricdata.Histogram{
+		DataPoints:  dPts,
+		Temporality: metricdata.CumulativeTemporality,
+	}
+}
+
+func convertSummary(metrics []*dto.Metric, start, now time.Time) metricdata.Summary {
+	dPts := make([]metricdata.SummaryDataPoint, 0, len(metrics))
+	for _, m := range metrics {
+		s := m.GetSummary()
+		quantiles := make([]metricdata.QuantileValue, 0, len(s.GetQuantile()))
+		for _, q := range s.GetQuantile() {
+			quantiles = append(quantiles, metricdata.QuantileValue{
+				Quantile: q.GetQuantile(),
+				Value:    q.GetValue(),
+			})
+		}
+		dPts = append(dPts, metricdata.SummaryDataPoint{
+			Attributes:     convertLabels(m.GetLabel()),
+			StartTime:      start,
+			Time:           timestamp(m, now),
+			Count:          s.GetSampleCount(),
+			Sum:            s.GetSampleSum(),
+			QuantileValues: quantiles,
+		})
+	}
+	return metricdata.Summary{
+		DataPoints:  dPts,
+		Temporality: metricdata.CumulativeTemporality,
+	}
+}
+
+// convertLabels returns the attribute Set of Prometheus labels.
+func convertLabels(labels []*dto.LabelPair) attribute.Set {
+	kvs := make([]attribute.KeyValue, 0, len(labels))
+	for _, l := range labels {
+		kvs = append(kvs, attribute.String(l.GetName(), l.GetValue()))
+	}
+	return attribute.NewSet(kvs...)
+}
+
+// timestamp returns the explicit timestamp of m, or now if it has none.
+func timestamp(m *dto.Metric, now time.Time) time.Time {
+	if m.TimestampMs == nil {
+		return now
+	}
+	return time.UnixMilli(m.GetTimestampMs())
+}
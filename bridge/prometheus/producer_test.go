@@ -0,0 +1,214 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func TestMetricProducer(t *testing.T) {
+	reg := prometheus.NewRegistry()
+
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "requests_total",
+		Help: "a counter",
+	}, []string{"code"})
+	counter.WithLabelValues("200").Add(3)
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "temperature",
+		Help: "a gauge",
+	})
+	gauge.Set(21.5)
+	untyped := prometheus.NewUntypedFunc(prometheus.UntypedOpts{
+		Name: "untyped",
+		Help: "an untyped metric",
+	}, func() float64 { return 7 })
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "latency",
+		Help:    "a histogram",
+		Buckets: []float64{1, 5},
+	})
+	histogram.Observe(0.5)
+	histogram.Observe(3)
+	histogram.Observe(3)
+	histogram.Observe(10)
+	summary := prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "size",
+		Help:       "a summary",
+		Objectives: map[float64]float64{0.5: 0.05},
+	})
+	summary.Observe(4)
+	reg.MustRegister(counter, gauge, untyped, histogram, summary)
+
+	reader := metric.NewManualReader(metric.WithProducer(NewMetricProducer(WithGatherer(reg))))
+	_ = metric.NewMeterProvider(metric.WithReader(reader))
+
+	rm, err := reader.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, rm.ScopeMetrics, 1)
+
+	want := metricdata.ScopeMetrics{
+		Scope: instrumentation.Scope{Name: scopeName},
+		Metrics: []metricdata.Metrics{
+			{
+				Name:        "latency",
+				Description: "a histogram",
+				Data: metricdata.Histogram{
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints: []metricdata.HistogramDataPoint{{
+						Attributes:   attribute.NewSet(),
+						Count:        4,
+						Bounds:       []float64{1, 5},
+						BucketCounts: []uint64{1, 2, 1},
+						Sum:          16.5,
+					}},
+				},
+			},
+			{
+				Name:        "requests_total",
+				Description: "a counter",
+				Data: metricdata.Sum[float64]{
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints: []metricdata.DataPoint[float64]{{
+						Attributes: attribute.NewSet(attribute.String("code", "200")),
+						Value:      3,
+					}},
+				},
+			},
+			{
+				Name:        "size",
+				Description: "a summary",
+				Data: metricdata.Summary{
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints: []metricdata.SummaryDataPoint{{
+						Attributes:     attribute.NewSet(),
+						Count:          1,
+						Sum:            4,
+						QuantileValues: []metricdata.QuantileValue{{Quantile: 0.5, Value: 4}},
+					}},
+				},
+			},
+			{
+				Name:        "temperature",
+				Description: "a gauge",
+				Data: metricdata.Gauge[float64]{
+					DataPoints: []metricdata.DataPoint[float64]{{
+						Attributes: attribute.NewSet(),
+						Value:      21.5,
+					}},
+				},
+			},
+			{
+				Name:        "untyped",
+				Description: "an untyped metric",
+				Data: metricdata.Gauge[float64]{
+					DataPoints: []metricdata.DataPoint[float64]{{
+						Attributes: attribute.NewSet(),
+						Value:      7,
+					}},
+				},
+			},
+		},
+	}
+	metricdatatest.AssertEqual(t, want, rm.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		if sum, ok := m.Data.(metricdata.Sum[float64]); ok {
+			dPt := sum.DataPoints[0]
+			assert.False(t, dPt.StartTime.IsZero(), "start time not set")
+			assert.False(t, dPt.Time.Before(dPt.StartTime), "time before start time")
+		}
+	}
+}
+
+func TestMetricProducerTimestamp(t *testing.T) {
+	ms := int64(1_600_000_000_000)
+	got, err := convertFamilies([]*dto.MetricFamily{{
+		Name: strPtr("gauge"),
+		Type: dto.MetricType_GAUGE.Enum(),
+		Metric: []*dto.Metric{{
+			Gauge:       &dto.Gauge{Value: float64Ptr(1)},
+			TimestampMs: &ms,
+		}},
+	}}, time.Time{}, time.Now())
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	gauge := got[0].Data.(metricdata.Gauge[float64])
+	assert.Equal(t, time.UnixMilli(ms), gauge.DataPoints[0].Time)
+}
+
+type gathererFunc func() ([]*dto.MetricFamily, error)
+
+func (f gathererFunc) Gather() ([]*dto.MetricFamily, error) { return f() }
+
+func TestMetricProducerGatherError(t *testing.T) {
+	gatherErr := errors.New("gather failure")
+	p := NewMetricProducer(WithGatherer(gathererFunc(func() ([]*dto.MetricFamily, error) {
+		return []*dto.MetricFamily{{
+			Name:   strPtr("gauge"),
+			Type:   dto.MetricType_GAUGE.Enum(),
+			Metric: []*dto.Metric{{Gauge: &dto.Gauge{Value: float64Ptr(1)}}},
+		}}, gatherErr
+	})))
+
+	sm, err := p.Produce(context.Background())
+	assert.ErrorIs(t, err, errGather)
+	assert.ErrorContains(t, err, gatherErr.Error())
+	require.Len(t, sm, 1)
+	require.Len(t, sm[0].Metrics, 1)
+	assert.Equal(t, "gauge", sm[0].Metrics[0].Name)
+}
+
+func TestConvertUnsupportedType(t *testing.T) {
+	got, err := convertFamilies([]*dto.MetricFamily{
+		{
+			Name:   strPtr("gauge"),
+			Type:   dto.MetricType_GAUGE.Enum(),
+			Metric: []*dto.Metric{{Gauge: &dto.Gauge{Value: float64Ptr(1)}}},
+		},
+		{
+			Name: strPtr("unknown"),
+			Type: dto.MetricType(100).Enum(),
+		},
+	}, time.Time{}, time.Now())
+	assert.ErrorIs(t, err, errUnsupportedType)
+	assert.ErrorContains(t, err, "unknown")
+	require.Len(t, got, 1)
+	assert.Equal(t, "gauge", got[0].Name)
+}
+
+func TestNewConfigDefaultGatherer(t *testing.T) {
+	cfg := newConfig()
+	assert.Equal(t, []prometheus.Gatherer{prometheus.DefaultGatherer}, cfg.gatherers)
+}
+
+func strPtr(s string) *string { return &s }
+
+func float64Ptr(f float64) *float64 { return &f }
@@ -259,6 +259,19 @@ func keyValueToTag(keyValue attribute.KeyValue) *gen.Tag {
 			VDouble: &f,
 			VType:   gen.TagType_DOUBLE,
 		}
+	case attribute.BYTES:
+		tag = &gen.Tag{
+			Key:     string(keyValue.Key),
+			VBinary: keyValue.Value.AsBytes(),
+			VType:   gen.TagType_BINARY,
+		}
+	case attribute.TIMESTAMP, attribute.MAP:
+		s := keyValue.Value.Emit()
+		tag = &gen.Tag{
+			Key:   string(keyValue.Key),
+			VStr:  &s,
+			VType: gen.TagType_STRING,
+		}
 	case attribute.BOOLSLICE,
 		attribute.INT64SLICE,
 		attribute.FLOAT64SLICE,
@@ -0,0 +1,387 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diskqueue provides a file-backed queue of serialized export
+// requests. Payloads written to the queue are persisted to disk and sent
+// asynchronously, in order, with an exponential backoff between failed
+// attempts. Payloads the SendFunc rejects permanently are dropped instead of
+// being sent again. Payloads that have not been sent when the queue is stopped remain
+// on disk and are sent once a queue is started again with the same directory.
+package diskqueue // import "go.opentelemetry.io/otel/exporters/otlp/internal/diskqueue"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	// fileExt is the extension of files holding a queued payload.
+	fileExt = ".otlp"
+	// tmpExt is the extension of files holding a payload that is being
+	// written. These are renamed once fully written so a payload is never
+	// read partially.
+	tmpExt = ".tmp"
+)
+
+// DefaultConfig are the recommended defaults to use. The Directory still
+// needs to be set for the queue to be enabled.
+var DefaultConfig = Config{
+	MaxSize:         64 << 20, // 64 MiB
+	InitialInterval: 5 * time.Second,
+	MaxInterval:     time.Minute,
+}
+
+// Config defines configuration for a file-backed queue of export requests.
+type Config struct {
+	// Directory is where queued payloads are stored. The queue is disabled
+	// if this is empty.
+	Directory string
+	// MaxSize is the maximum number of bytes stored in Directory. When
+	// adding a payload would exceed this, the oldest payloads are dropped to
+	// make room for it. If MaxSize is not positive, DefaultConfig.MaxSize is
+	// used.
+	MaxSize int64
+	// InitialInterval is the time to wait after the first failure to send a
+	// payload before sending it again.
+	InitialInterval time.Duration
+	// MaxInterval is the upper bound on the backoff interval. Once this value
+	// is reached the delay between consecutive attempts will always be
+	// MaxInterval.
+	MaxInterval time.Duration
+}
+
+// Enabled returns if c configures a queue.
+func (c Config) Enabled() bool { return c.Directory != "" }
+
+// SendFunc sends a payload that was read from the queue. If an error is
+// returned, the payload is kept and sent again after a backoff, unless the
+// error was created with Permanent. In that case the payload is dropped.
+type SendFunc func(ctx context.Context, payload []byte) error
+
+var errTooLarge = errors.New("payload larger than queue max size")
+
+// permanentError is an error sending a payload that will not be resolved by
+// sending the payload again.
+type permanentError struct {
+	err error
+}
+
+// Permanent returns err wrapped to signal to a Queue that the payload that
+// caused it will never be sent successfully and needs to be dropped.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Queue is a file-backed FIFO queue of payloads.
+type Queue struct {
+	cfg  Config
+	send SendFunc
+
+	// mu guards the fields below it.
+	mu   sync.Mutex
+	seqs []uint64
+	size map[uint64]int64
+	used int64
+	next uint64
+
+	// sendMu ensures payloads are sent one at a time and in order.
+	sendMu sync.Mutex
+
+	notify  chan struct{}
+	stopped chan struct{}
+	done    chan struct{}
+	cancel  context.CancelFunc
+}
+
+// New returns a Queue that stores payloads in the directory set in cfg and
+// sends them with send. The Queue does not send payloads until it is
+// started.
+func New(cfg Config, send SendFunc) *Queue {
+	if cfg.MaxSize <= 0 {
+		cfg.MaxSize = DefaultConfig.MaxSize
+	}
+	if cfg.InitialInterval <= 0 {
+		cfg.InitialInterval = DefaultConfig.InitialInterval
+	}
+	if cfg.MaxInterval < cfg.InitialInterval {
+		cfg.MaxInterval = cfg.InitialInterval
+	}
+	return &Queue{
+		cfg:  cfg,
+		send: send,
+		size: make(map[uint64]int64),
+	}
+}
+
+// Start loads any payloads persisted by a previous Queue using the same
+// directory and starts sending them in the background.
+func (q *Queue) Start() error {
+	if err := os.MkdirAll(q.cfg.Directory, 0o700); err != nil {
+		return fmt.Errorf("persistent queue: %w", err)
+	}
+	entries, err := os.ReadDir(q.cfg.Directory)
+	if err != nil {
+		return fmt.Errorf("persistent queue: %w", err)
+	}
+
+	q.mu.Lock()
+	for _, e := range entries {
+		name := e.Name()
+		if strings.HasSuffix(name, tmpExt) {
+			// An interrupted write, the payload was never queued.
+			_ = os.Remove(filepath.Join(q.cfg.Directory, name))
+			continue
+		}
+		if !strings.HasSuffix(name, fileExt) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, fileExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		q.seqs = append(q.seqs, seq)
+		q.size[seq] = info.Size()
+		q.used += info.Size()
+		if seq >= q.next {
+			q.next = seq + 1
+		}
+	}
+	sort.Slice(q.seqs, func(i, j int) bool { return q.seqs[i] < q.seqs[j] })
+	q.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	q.cancel = cancel
+	q.notify = make(chan struct{}, 1)
+	q.stopped = make(chan struct{})
+	q.done = make(chan struct{})
+	go q.run(ctx)
+	q.wake()
+	return nil
+}
+
+// Stop stops sending payloads in the background. Any send in progress is
+// canceled. Stop then makes a final attempt to send all queued payloads
+// within the lifetime of ctx. Payloads that are not sent remain on disk.
+func (q *Queue) Stop(ctx context.Context) error {
+	if q.cancel != nil {
+		q.cancel()
+		close(q.stopped)
+		<-q.done
+		q.cancel = nil
+	}
+	return q.Flush(ctx)
+}
+
+// Len returns the number of queued payloads.
+func (q *Queue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.seqs)
+}
+
+// Enqueue persists payload to disk and schedules it to be sent. The Queue
+// must be started before payloads are enqueued.
+func (q *Queue) Enqueue(payload []byte) error {
+	n := int64(len(payload))
+	if n > q.cfg.MaxSize {
+		return fmt.Errorf("persistent queue: %w (%d > %d)", errTooLarge, n, q.cfg.MaxSize)
+	}
+
+	q.mu.Lock()
+	seq := q.next
+	q.next++
+	q.mu.Unlock()
+
+	name := q.path(seq)
+	tmp := name + tmpExt
+	if err := os.WriteFile(tmp, payload, 0o600); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("persistent queue: %w", err)
+	}
+	if err := os.Rename(tmp, name); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("persistent queue: %w", err)
+	}
+
+	q.mu.Lock()
+	var dropped int
+	for q.used+n > q.cfg.MaxSize && len(q.seqs) > 0 {
+		q.removeLocked(q.seqs[0])
+		dropped++
+	}
+	// A concurrent Enqueue with a larger seq may have been added while this
+	// payload was written. Insert in order so payloads are sent, and dropped
+	// when full, oldest first.
+	i := sort.Search(len(q.seqs), func(i int) bool { return q.seqs[i] > seq })
+	q.seqs = append(q.seqs, 0)
+	copy(q.seqs[i+1:], q.seqs[i:])
+	q.seqs[i] = seq
+	q.size[seq] = n
+	q.used += n
+	q.mu.Unlock()
+
+	if dropped > 0 {
+		otel.Handle(fmt.Errorf("persistent queue full: dropped %d oldest payloads", dropped))
+	}
+	q.wake()
+	return nil
+}
+
+// Flush sends all queued payloads. It returns the first error encountered
+// sending a payload, or the error of ctx if it is done first.
+func (q *Queue) Flush(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		sent, err := q.sendOne(ctx)
+		if err != nil || !sent {
+			return err
+		}
+	}
+}
+
+// run sends queued payloads until ctx is canceled, backing off after each
+// failed attempt.
+func (q *Queue) run(ctx context.Context) {
+	defer close(q.done)
+
+	delay := q.cfg.InitialInterval
+	for {
+		sent, err := q.sendOne(ctx)
+		switch {
+		case err != nil:
+			if ctx.Err() != nil {
+				// Stopped during the send.
+				return
+			}
+			otel.Handle(fmt.Errorf("persistent queue: retrying in %s: %w", delay, err))
+			timer := time.NewTimer(delay)
+			select {
+			case <-timer.C:
+			case <-q.stopped:
+				timer.Stop()
+				return
+			}
+			if delay *= 2; delay > q.cfg.MaxInterval {
+				delay = q.cfg.MaxInterval
+			}
+		case sent:
+			delay = q.cfg.InitialInterval
+		default:
+			// Empty, wait for a new payload.
+			select {
+			case <-q.notify:
+			case <-q.stopped:
+				return
+			}
+		}
+	}
+}
+
+// sendOne sends the oldest queued payload and removes it from the queue if
+// it is sent successfully or rejected permanently. It returns false if the
+// queue is empty.
+func (q *Queue) sendOne(ctx context.Context) (bool, error) {
+	q.sendMu.Lock()
+	defer q.sendMu.Unlock()
+
+	q.mu.Lock()
+	if len(q.seqs) == 0 {
+		q.mu.Unlock()
+		return false, nil
+	}
+	seq := q.seqs[0]
+	q.mu.Unlock()
+
+	payload, err := os.ReadFile(q.path(seq))
+	if err != nil {
+		q.mu.Lock()
+		q.removeLocked(seq)
+		q.mu.Unlock()
+		if errors.Is(err, os.ErrNotExist) {
+			// Dropped while full.
+			return true, nil
+		}
+		otel.Handle(fmt.Errorf("persistent queue: dropping unreadable payload: %w", err))
+		return true, nil
+	}
+
+	if err := q.send(ctx, payload); err != nil {
+		var pErr *permanentError
+		if !errors.As(err, &pErr) {
+			return false, err
+		}
+		otel.Handle(fmt.Errorf("persistent queue: dropping rejected payload: %w", pErr.err))
+	}
+
+	q.mu.Lock()
+	q.removeLocked(seq)
+	q.mu.Unlock()
+	return true, nil
+}
+
+// removeLocked removes the payload seq from disk and the queue. The caller
+// must hold q.mu.
+func (q *Queue) removeLocked(seq uint64) {
+	n, ok := q.size[seq]
+	if !ok {
+		return
+	}
+	delete(q.size, seq)
+	q.used -= n
+	for i, s := range q.seqs {
+		if s == seq {
+			q.seqs = append(q.seqs[:i], q.seqs[i+1:]...)
+			break
+		}
+	}
+	_ = os.Remove(q.path(seq))
+}
+
+// wake notifies the background sender a payload is queued.
+func (q *Queue) wake() {
+	if q.notify == nil {
+		return
+	}
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (q *Queue) path(seq uint64) string {
+	return filepath.Join(q.cfg.Directory, fmt.Sprintf("%020d%s", seq, fileExt))
+}
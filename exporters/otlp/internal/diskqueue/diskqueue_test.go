@@ -0,0 +1,226 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diskqueue
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder records the payloads sent by a Queue.
+type recorder struct {
+	mu   sync.Mutex
+	err  error
+	sent []string
+	ch   chan struct{}
+}
+
+func newRecorder() *recorder {
+	return &recorder{ch: make(chan struct{}, 10)}
+}
+
+func (r *recorder) send(_ context.Context, payload []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	r.sent = append(r.sent, string(payload))
+	r.ch <- struct{}{}
+	return nil
+}
+
+func (r *recorder) setErr(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.err = err
+}
+
+func (r *recorder) payloads() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.sent...)
+}
+
+func (r *recorder) wait(t *testing.T, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		select {
+		case <-r.ch:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timeout waiting for payload %d", i)
+		}
+	}
+}
+
+func TestQueueSendsInOrder(t *testing.T) {
+	r := newRecorder()
+	q := New(Config{Directory: t.TempDir()}, r.send)
+	require.NoError(t, q.Start())
+	t.Cleanup(func() { require.NoError(t, q.Stop(context.Background())) })
+
+	for _, p := range []string{"a", "b", "c"} {
+		require.NoError(t, q.Enqueue([]byte(p)))
+	}
+	r.wait(t, 3)
+	assert.Equal(t, []string{"a", "b", "c"}, r.payloads())
+	assert.Eventually(t, func() bool { return q.Len() == 0 }, time.Second, time.Millisecond)
+}
+
+func TestQueuePersists(t *testing.T) {
+	dir := t.TempDir()
+	errUnavailable := errors.New("unavailable")
+
+	r := newRecorder()
+	r.setErr(errUnavailable)
+	q := New(Config{Directory: dir, InitialInterval: time.Hour}, r.send)
+	require.NoError(t, q.Start())
+	require.NoError(t, q.Enqueue([]byte("a")))
+	require.NoError(t, q.Enqueue([]byte("b")))
+	assert.ErrorIs(t, q.Stop(context.Background()), errUnavailable)
+	assert.Equal(t, 2, q.Len())
+
+	// An interrupted write is not loaded.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "x"+tmpExt), []byte("x"), 0o600))
+
+	r = newRecorder()
+	q = New(Config{Directory: dir}, r.send)
+	require.NoError(t, q.Start())
+	r.wait(t, 2)
+	require.NoError(t, q.Enqueue([]byte("c")))
+	r.wait(t, 1)
+	require.NoError(t, q.Stop(context.Background()))
+	assert.Equal(t, []string{"a", "b", "c"}, r.payloads())
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 0)
+}
+
+func TestQueueBackoff(t *testing.T) {
+	r := newRecorder()
+	r.setErr(errors.New("unavailable"))
+	q := New(Config{
+		Directory:       t.TempDir(),
+		InitialInterval: time.Millisecond,
+		MaxInterval:     time.Millisecond,
+	}, r.send)
+	require.NoError(t, q.Start())
+	t.Cleanup(func() { require.NoError(t, q.Stop(context.Background())) })
+
+	require.NoError(t, q.Enqueue([]byte("a")))
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, 1, q.Len())
+
+	r.setErr(nil)
+	r.wait(t, 1)
+	assert.Equal(t, []string{"a"}, r.payloads())
+}
+
+func TestQueueMaxSize(t *testing.T) {
+	r := newRecorder()
+	r.setErr(errors.New("unavailable"))
+	q := New(Config{
+		Directory:       t.TempDir(),
+		MaxSize:         4,
+		InitialInterval: time.Hour,
+	}, r.send)
+	require.NoError(t, q.Start())
+
+	require.NoError(t, q.Enqueue([]byte("aa")))
+	require.NoError(t, q.Enqueue([]byte("bb")))
+	// Drops "aa" to make room.
+	require.NoError(t, q.Enqueue([]byte("cc")))
+	assert.ErrorIs(t, q.Enqueue([]byte("ddddd")), errTooLarge)
+	assert.Equal(t, 2, q.Len())
+
+	r.setErr(nil)
+	require.NoError(t, q.Stop(context.Background()))
+	assert.Equal(t, []string{"bb", "cc"}, r.payloads())
+}
+
+func TestQueueFlushContext(t *testing.T) {
+	q := New(Config{Directory: t.TempDir()}, func(ctx context.Context, _ []byte) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, q.Flush(ctx), context.Canceled)
+}
+
+func TestQueuePermanentError(t *testing.T) {
+	r := newRecorder()
+	errRejected := errors.New("rejected")
+	var attempts int
+	q := New(Config{Directory: t.TempDir(), InitialInterval: time.Hour}, func(ctx context.Context, payload []byte) error {
+		if string(payload) == "a" {
+			attempts++
+			return Permanent(errRejected)
+		}
+		return r.send(ctx, payload)
+	})
+
+	require.NoError(t, q.Enqueue([]byte("a")))
+	require.NoError(t, q.Enqueue([]byte("b")))
+	require.NoError(t, q.Flush(context.Background()))
+	assert.Equal(t, 1, attempts, "rejected payload sent again")
+	assert.Equal(t, []string{"b"}, r.payloads())
+	assert.Equal(t, 0, q.Len())
+}
+
+func TestPermanent(t *testing.T) {
+	assert.NoError(t, Permanent(nil))
+
+	err := errors.New("rejected")
+	assert.ErrorIs(t, Permanent(err), err)
+	assert.EqualError(t, Permanent(err), "rejected")
+}
+
+func TestQueueDefaultMaxSize(t *testing.T) {
+	q := New(Config{Directory: t.TempDir()}, newRecorder().send)
+	assert.Equal(t, DefaultConfig.MaxSize, q.cfg.MaxSize)
+	assert.ErrorIs(t, q.Enqueue(make([]byte, DefaultConfig.MaxSize+1)), errTooLarge)
+}
+
+func TestQueueConcurrentEnqueueOrdered(t *testing.T) {
+	q := New(Config{Directory: t.TempDir()}, newRecorder().send)
+
+	const n = 50
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, q.Enqueue([]byte("a")))
+		}()
+	}
+	wg.Wait()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	require.Len(t, q.seqs, n)
+	for i := 1; i < n; i++ {
+		assert.Less(t, q.seqs[i-1], q.seqs[i])
+	}
+}
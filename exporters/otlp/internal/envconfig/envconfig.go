@@ -23,6 +23,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"go.opentelemetry.io/otel"
 )
 
 // ConfigFn is the generic function used to set a config.
@@ -90,16 +92,61 @@ func WithURL(n string, fn func(*url.URL)) func(e *EnvOptionsReader) {
 	}
 }
 
-// WithTLSConfig retrieves the specified config and passes it to ConfigFn as a crypto/tls.Config.
-func WithTLSConfig(n string, fn func(*tls.Config)) func(e *EnvOptionsReader) {
+// WithCertPool retrieves the specified config and passes it to ConfigFn as a
+// crypto/x509.CertPool. The config value is the path of a PEM encoded
+// certificate file. Errors reading or parsing that file are sent to the
+// global ErrorHandler.
+func WithCertPool(n string, fn func(*x509.CertPool)) func(e *EnvOptionsReader) {
 	return func(e *EnvOptionsReader) {
 		if v, ok := e.GetEnvValue(n); ok {
-			if b, err := e.ReadFile(v); err == nil {
-				if c, err := createTLSConfig(b); err == nil {
-					fn(c)
-				}
+			b, err := e.ReadFile(v)
+			if err != nil {
+				otel.Handle(fmt.Errorf("failed to read %s: %w", keyWithNamespace(e.Namespace, n), err))
+				return
+			}
+			c, err := createCertPool(b)
+			if err != nil {
+				otel.Handle(fmt.Errorf("invalid %s: %w", keyWithNamespace(e.Namespace, n), err))
+				return
 			}
+			fn(c)
+		}
+	}
+}
+
+// WithClientCert retrieves the specified client certificate (nc) and key (nk)
+// configs and passes them to ConfigFn as a crypto/tls.Certificate. The config
+// values are the paths of PEM encoded files. Both configs need to be set.
+// Errors reading or parsing the files are sent to the global ErrorHandler.
+func WithClientCert(nc, nk string, fn func(tls.Certificate)) func(e *EnvOptionsReader) {
+	return func(e *EnvOptionsReader) {
+		vc, okc := e.GetEnvValue(nc)
+		vk, okk := e.GetEnvValue(nk)
+		if !okc && !okk {
+			return
+		}
+		nc, nk = keyWithNamespace(e.Namespace, nc), keyWithNamespace(e.Namespace, nk)
+		if !okc || !okk {
+			otel.Handle(fmt.Errorf("%s and %s need to both be set", nc, nk))
+			return
 		}
+
+		cert, err := e.ReadFile(vc)
+		if err != nil {
+			otel.Handle(fmt.Errorf("failed to read %s: %w", nc, err))
+			return
+		}
+		key, err := e.ReadFile(vk)
+		if err != nil {
+			otel.Handle(fmt.Errorf("failed to read %s: %w", nk, err))
+			return
+		}
+		crt, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			otel.Handle(fmt.Errorf("invalid %s or %s: %w", nc, nk, err))
+			return
+		}
+		fn(crt)
 	}
 }
 
@@ -136,13 +183,10 @@ func stringToHeader(value string) map[string]string {
 	return headers
 }
 
-func createTLSConfig(certBytes []byte) (*tls.Config, error) {
+func createCertPool(certBytes []byte) (*x509.CertPool, error) {
 	cp := x509.NewCertPool()
 	if ok := cp.AppendCertsFromPEM(certBytes); !ok {
 		return nil, errors.New("failed to append certificate to the cert pool")
 	}
-
-	return &tls.Config{
-		RootCAs: cp,
-	}, nil
+	return cp, nil
 }
@@ -27,6 +27,9 @@ const (
 
 	// MetricsPartialSuccess indicates that some metric data points were rejected.
 	MetricsPartialSuccess PartialSuccessDropKind = "metric data points"
+
+	// LogsPartialSuccess indicates that some log records were rejected.
+	LogsPartialSuccess PartialSuccessDropKind = "log records"
 )
 
 // PartialSuccess represents the underlying error for all handling
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlplog // import "go.opentelemetry.io/otel/exporters/otlp/otlplog"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/internal/transform"
+	"go.opentelemetry.io/otel/sdk/log"
+	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+// Compile time check *Exporter implements log.Exporter.
+var _ log.Exporter = (*Exporter)(nil)
+
+// Exporter exports log data as OTLP.
+type Exporter struct {
+	// Ensure synchronous access to the client across all functionality.
+	clientMu sync.Mutex
+	client   Client
+
+	shutdownOnce sync.Once
+}
+
+// New return an Exporter that uses client to transmits the OTLP data it
+// receives. The client is assumed to be fully started and able to
+// communicate with its OTLP receiving endpoint.
+func New(client Client) *Exporter {
+	return &Exporter{client: client}
+}
+
+// Export transforms records into OTLP log data and transmits it to an OTLP
+// receiver.
+func (e *Exporter) Export(ctx context.Context, records []log.Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	rl := transform.ResourceLogs(records)
+
+	e.clientMu.Lock()
+	defer e.clientMu.Unlock()
+	return e.client.UploadLogs(ctx, rl)
+}
+
+// ForceFlush flushes any log data held by the Exporter.
+func (e *Exporter) ForceFlush(ctx context.Context) error {
+	// The Exporter does not hold data, forward the command to the client.
+	e.clientMu.Lock()
+	defer e.clientMu.Unlock()
+	return e.client.ForceFlush(ctx)
+}
+
+var errShutdown = fmt.Errorf("exporter is shutdown")
+
+// Shutdown flushes all log data held by the Exporter and releases any held
+// computational resources.
+func (e *Exporter) Shutdown(ctx context.Context) error {
+	err := errShutdown
+	e.shutdownOnce.Do(func() {
+		e.clientMu.Lock()
+		client := e.client
+		e.client = shutdownClient{}
+		e.clientMu.Unlock()
+		err = client.Shutdown(ctx)
+	})
+	return err
+}
+
+type shutdownClient struct{}
+
+func (c shutdownClient) err(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return errShutdown
+}
+
+func (c shutdownClient) UploadLogs(ctx context.Context, _ []*logpb.ResourceLogs) error {
+	return c.err(ctx)
+}
+
+func (c shutdownClient) ForceFlush(ctx context.Context) error {
+	return c.err(ctx)
+}
+
+func (c shutdownClient) Shutdown(ctx context.Context) error {
+	return c.err(ctx)
+}
@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlplog // import "go.opentelemetry.io/otel/exporters/otlp/otlplog"
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+type client struct {
+	// n is incremented by all Client methods. If these methods are called
+	// concurrently this should fail tests run with the race detector.
+	n int
+
+	uploads int
+	rl      []*logpb.ResourceLogs
+}
+
+func (c *client) UploadLogs(_ context.Context, rl []*logpb.ResourceLogs) error {
+	c.n++
+	c.uploads++
+	c.rl = rl
+	return nil
+}
+
+func (c *client) ForceFlush(context.Context) error {
+	c.n++
+	return nil
+}
+
+func (c *client) Shutdown(context.Context) error {
+	c.n++
+	return nil
+}
+
+func TestExporterClientConcurrency(t *testing.T) {
+	const goroutines = 5
+
+	exp := New(&client{})
+	rl := []sdklog.Record{{}}
+	ctx := context.Background()
+
+	done := make(chan struct{})
+	first := make(chan struct{}, goroutines)
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, exp.Export(ctx, rl))
+			assert.NoError(t, exp.ForceFlush(ctx))
+			// Ensure some work is done before shutting down.
+			first <- struct{}{}
+
+			for {
+				_ = exp.Export(ctx, rl)
+				_ = exp.ForceFlush(ctx)
+
+				select {
+				case <-done:
+					return
+				default:
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < goroutines; i++ {
+		<-first
+	}
+	close(first)
+	assert.NoError(t, exp.Shutdown(ctx))
+	assert.ErrorIs(t, exp.Shutdown(ctx), errShutdown)
+
+	close(done)
+	wg.Wait()
+}
+
+func TestExporterExportEmpty(t *testing.T) {
+	c := &client{}
+	exp := New(c)
+	assert.NoError(t, exp.Export(context.Background(), nil))
+	assert.Equal(t, 0, c.uploads, "empty export uploaded")
+}
+
+func TestExporterExport(t *testing.T) {
+	c := &client{}
+	exp := New(c)
+	records := []sdklog.Record{{
+		Record:               log.Record{Severity: log.SeverityInfo, SeverityText: "INFO"},
+		Resource:             resource.Empty(),
+		InstrumentationScope: instrumentation.Scope{Name: "test"},
+	}}
+	require.NoError(t, exp.Export(context.Background(), records))
+
+	require.Len(t, c.rl, 1)
+	require.Len(t, c.rl[0].ScopeLogs, 1)
+	assert.Equal(t, "test", c.rl[0].ScopeLogs[0].Scope.Name)
+	require.Len(t, c.rl[0].ScopeLogs[0].LogRecords, 1)
+	lr := c.rl[0].ScopeLogs[0].LogRecords[0]
+	assert.Equal(t, logpb.SeverityNumber_SEVERITY_NUMBER_INFO, lr.SeverityNumber)
+	assert.Equal(t, "INFO", lr.SeverityText)
+}
+
+func TestExporterShutdown(t *testing.T) {
+	exp := New(&client{})
+	ctx := context.Background()
+	assert.NoError(t, exp.Shutdown(ctx))
+
+	rl := []sdklog.Record{{}}
+	assert.ErrorIs(t, exp.Export(ctx, rl), errShutdown)
+	assert.ErrorIs(t, exp.ForceFlush(ctx), errShutdown)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.ErrorIs(t, exp.Export(cancelled, rl), context.Canceled)
+}
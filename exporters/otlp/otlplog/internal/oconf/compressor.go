@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oconf // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/internal/oconf"
+
+import (
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/encoding"
+)
+
+const (
+	// ZstdCompressorName is the name the zstd gRPC compressor is registered
+	// with.
+	ZstdCompressorName = "zstd"
+	// SnappyCompressorName is the name the snappy gRPC compressor is
+	// registered with.
+	SnappyCompressorName = "snappy"
+)
+
+func init() {
+	// Do not override compressors the user has already registered.
+	if encoding.GetCompressor(ZstdCompressorName) == nil {
+		encoding.RegisterCompressor(&zstdCompressor{})
+	}
+	if encoding.GetCompressor(SnappyCompressorName) == nil {
+		encoding.RegisterCompressor(&snappyCompressor{})
+	}
+}
+
+// zstdCompressor is a gRPC compressor using zstd.
+type zstdCompressor struct {
+	encoders sync.Pool
+	decoders sync.Pool
+}
+
+func (c *zstdCompressor) Name() string { return ZstdCompressorName }
+
+func (c *zstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	enc, ok := c.encoders.Get().(*zstd.Encoder)
+	if ok {
+		enc.Reset(w)
+	} else {
+		var err error
+		enc, err = zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &zstdWriter{Encoder: enc, pool: &c.encoders}, nil
+}
+
+func (c *zstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	dec, ok := c.decoders.Get().(*zstd.Decoder)
+	if ok {
+		if err := dec.Reset(r); err != nil {
+			c.decoders.Put(dec)
+			return nil, err
+		}
+	} else {
+		var err error
+		dec, err = zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &zstdReader{Decoder: dec, pool: &c.decoders}, nil
+}
+
+// zstdWriter returns its encoder to pool when closed.
+type zstdWriter struct {
+	*zstd.Encoder
+	pool *sync.Pool
+}
+
+func (w *zstdWriter) Close() error {
+	defer w.pool.Put(w.Encoder)
+	return w.Encoder.Close()
+}
+
+// zstdReader returns its decoder to pool once it has been read to the end.
+type zstdReader struct {
+	*zstd.Decoder
+	pool *sync.Pool
+}
+
+func (r *zstdReader) Read(p []byte) (int, error) {
+	if r.Decoder == nil {
+		return 0, io.EOF
+	}
+	n, err := r.Decoder.Read(p)
+	if err == io.EOF {
+		r.pool.Put(r.Decoder)
+		r.Decoder = nil
+	}
+	return n, err
+}
+
+// snappyCompressor is a gRPC compressor using the snappy framing format.
+type snappyCompressor struct {
+	writers sync.Pool
+}
+
+func (c *snappyCompressor) Name() string { return SnappyCompressorName }
+
+func (c *snappyCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	sw, ok := c.writers.Get().(*snappy.Writer)
+	if ok {
+		sw.Reset(w)
+	} else {
+		sw = snappy.NewBufferedWriter(w)
+	}
+	return &snappyWriter{Writer: sw, pool: &c.writers}, nil
+}
+
+func (c *snappyCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	return snappy.NewReader(r), nil
+}
+
+// snappyWriter returns its writer to pool when closed.
+type snappyWriter struct {
+	*snappy.Writer
+	pool *sync.Pool
+}
+
+func (w *snappyWriter) Close() error {
+	defer w.pool.Put(w.Writer)
+	return w.Writer.Close()
+}
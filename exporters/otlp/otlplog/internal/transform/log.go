@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/internal/transform"
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/log"
+	cpb "go.opentelemetry.io/proto/otlp/common/v1"
+	lpb "go.opentelemetry.io/proto/otlp/logs/v1"
+	rpb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+// ResourceLogs transforms records into OTLP ResourceLogs. Records are grouped
+// by their resource and then by their instrumentation scope, in the order
+// they are first seen.
+func ResourceLogs(records []log.Record) []*lpb.ResourceLogs {
+	if len(records) == 0 {
+		return nil
+	}
+
+	type key struct {
+		r  attribute.Distinct
+		is instrumentation.Scope
+	}
+	var out []*lpb.ResourceLogs
+	rlm := make(map[attribute.Distinct]*lpb.ResourceLogs)
+	slm := make(map[key]*lpb.ScopeLogs)
+	for _, r := range records {
+		rKey := r.Resource.Equivalent()
+		rl, ok := rlm[rKey]
+		if !ok {
+			rl = &lpb.ResourceLogs{
+				Resource:  &rpb.Resource{Attributes: AttrIter(r.Resource.Iter())},
+				SchemaUrl: r.Resource.SchemaURL(),
+			}
+			rlm[rKey] = rl
+			out = append(out, rl)
+		}
+
+		k := key{r: rKey, is: r.InstrumentationScope}
+		sl, ok := slm[k]
+		if !ok {
+			sl = &lpb.ScopeLogs{
+				Scope:     scope(r.InstrumentationScope),
+				SchemaUrl: r.InstrumentationScope.SchemaURL,
+			}
+			slm[k] = sl
+			rl.ScopeLogs = append(rl.ScopeLogs, sl)
+		}
+		sl.LogRecords = append(sl.LogRecords, LogRecord(r))
+	}
+	return out
+}
+
+// LogRecord transforms r into an OTLP LogRecord.
+func LogRecord(r log.Record) *lpb.LogRecord {
+	lr := &lpb.LogRecord{
+		SeverityNumber:         lpb.SeverityNumber(r.Severity),
+		SeverityText:           r.SeverityText,
+		Attributes:             KeyValues(r.Attributes),
+		DroppedAttributesCount: uint32(r.DroppedAttributes),
+	}
+	if !r.Timestamp.IsZero() {
+		lr.TimeUnixNano = uint64(r.Timestamp.UnixNano())
+	}
+	if !r.ObservedTimestamp.IsZero() {
+		lr.ObservedTimeUnixNano = uint64(r.ObservedTimestamp.UnixNano())
+	}
+	if r.Body.Type() != attribute.INVALID {
+		lr.Body = Value(r.Body)
+	}
+	if sc := r.SpanContext; sc.IsValid() {
+		tid, sid := sc.TraceID(), sc.SpanID()
+		lr.TraceId = tid[:]
+		lr.SpanId = sid[:]
+		lr.Flags = uint32(sc.TraceFlags())
+	}
+	return lr
+}
+
+func scope(s instrumentation.Scope) *cpb.InstrumentationScope {
+	if s == (instrumentation.Scope{}) {
+		return nil
+	}
+	return &cpb.InstrumentationScope{
+		Name:       s.Name,
+		Version:    s.Version,
+		Attributes: AttrIter(s.Attributes.Iter()),
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/internal/transform"
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+	cpb "go.opentelemetry.io/proto/otlp/common/v1"
+	lpb "go.opentelemetry.io/proto/otlp/logs/v1"
+	rpb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+var (
+	now      = time.Unix(1, 2)
+	observed = time.Unix(3, 4)
+
+	traceID = trace.TraceID{0x01}
+	spanID  = trace.SpanID{0x02}
+	sc      = trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+
+	res   = resource.NewSchemaless(attribute.String("service.name", "test"))
+	other = resource.NewSchemaless(attribute.String("service.name", "other"))
+
+	scopeA = instrumentation.Scope{Name: "a", Version: "v0.1.0", SchemaURL: "http://schema"}
+	scopeB = instrumentation.Scope{Name: "b"}
+
+	record = sdklog.Record{
+		Record: log.Record{
+			Timestamp:         now,
+			ObservedTimestamp: observed,
+			Severity:          log.SeverityWarn,
+			SeverityText:      "WARN",
+			Body:              attribute.StringValue("message"),
+			Attributes:        []attribute.KeyValue{attrString},
+			SpanContext:       sc,
+		},
+		DroppedAttributes:    2,
+		Resource:             res,
+		InstrumentationScope: scopeA,
+	}
+)
+
+func TestLogRecord(t *testing.T) {
+	want := &lpb.LogRecord{
+		TimeUnixNano:           uint64(now.UnixNano()),
+		ObservedTimeUnixNano:   uint64(observed.UnixNano()),
+		SeverityNumber:         lpb.SeverityNumber_SEVERITY_NUMBER_WARN,
+		SeverityText:           "WARN",
+		Body:                   &cpb.AnyValue{Value: &cpb.AnyValue_StringValue{StringValue: "message"}},
+		Attributes:             []*cpb.KeyValue{{Key: "string", Value: valStrO}},
+		DroppedAttributesCount: 2,
+		Flags:                  uint32(trace.FlagsSampled),
+		TraceId:                traceID[:],
+		SpanId:                 spanID[:],
+	}
+	assert.Equal(t, want, LogRecord(record))
+}
+
+func TestLogRecordEmpty(t *testing.T) {
+	assert.Equal(t, &lpb.LogRecord{}, LogRecord(sdklog.Record{}))
+}
+
+func TestResourceLogs(t *testing.T) {
+	assert.Nil(t, ResourceLogs(nil))
+
+	withScope := func(r *resource.Resource, s instrumentation.Scope, body string) sdklog.Record {
+		rec := record
+		rec.Resource = r
+		rec.InstrumentationScope = s
+		rec.Body = attribute.StringValue(body)
+		return rec
+	}
+	got := ResourceLogs([]sdklog.Record{
+		withScope(res, scopeA, "1"),
+		withScope(other, scopeA, "2"),
+		withScope(res, scopeB, "3"),
+		withScope(res, scopeA, "4"),
+	})
+
+	bodies := func(sl *lpb.ScopeLogs) []string {
+		var out []string
+		for _, lr := range sl.LogRecords {
+			out = append(out, lr.Body.GetStringValue())
+		}
+		return out
+	}
+
+	require.Len(t, got, 2)
+	assert.Equal(t, &rpb.Resource{Attributes: []*cpb.KeyValue{{
+		Key:   "service.name",
+		Value: &cpb.AnyValue{Value: &cpb.AnyValue_StringValue{StringValue: "test"}},
+	}}}, got[0].Resource)
+	require.Len(t, got[0].ScopeLogs, 2)
+	assert.Equal(t, &cpb.InstrumentationScope{Name: "a", Version: "v0.1.0"}, got[0].ScopeLogs[0].Scope)
+	assert.Equal(t, "http://schema", got[0].ScopeLogs[0].SchemaUrl)
+	assert.Equal(t, []string{"1", "4"}, bodies(got[0].ScopeLogs[0]))
+	assert.Equal(t, &cpb.InstrumentationScope{Name: "b"}, got[0].ScopeLogs[1].Scope)
+	assert.Equal(t, []string{"3"}, bodies(got[0].ScopeLogs[1]))
+
+	require.Len(t, got[1].ScopeLogs, 1)
+	assert.Equal(t, []string{"2"}, bodies(got[1].ScopeLogs[0]))
+}
@@ -0,0 +1,248 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlploggrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/internal/oconf"
+	collogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+// New returns an OpenTelemetry log Exporter. The Exporter can be used to
+// export OTLP log data to an OTLP receiving endpoint using gRPC.
+//
+// If an already established gRPC ClientConn is not passed in options using
+// WithGRPCConn, a connection to the OTLP endpoint will be established based
+// on options. If a connection cannot be establishes in the lifetime of ctx,
+// an error will be returned.
+func New(ctx context.Context, options ...Option) (*otlplog.Exporter, error) {
+	c, err := newClient(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	return otlplog.New(c), nil
+}
+
+type client struct {
+	metadata        metadata.MD
+	headersProvider func(context.Context) (map[string]string, error)
+	exportTimeout   time.Duration
+	requestFunc     retry.RequestFunc
+
+	// ourConn keeps track of where conn was created: true if created here in
+	// NewClient, or false if passed with an option. This is important on
+	// Shutdown as the conn should only be closed if we created it. Otherwise,
+	// it is up to the processes that passed the conn to close it.
+	ourConn bool
+	conn    *grpc.ClientConn
+	lsc     collogpb.LogsServiceClient
+}
+
+// newClient creates a new gRPC log client.
+func newClient(ctx context.Context, options ...Option) (otlplog.Client, error) {
+	cfg := oconf.NewGRPCConfig(asGRPCOptions(options)...)
+
+	c := &client{
+		headersProvider: cfg.Logs.HeadersProvider,
+		exportTimeout:   cfg.Logs.Timeout,
+		requestFunc:     cfg.RetryConfig.RequestFunc(retryable),
+		conn:            cfg.GRPCConn,
+	}
+
+	if len(cfg.Logs.Headers) > 0 {
+		c.metadata = metadata.New(cfg.Logs.Headers)
+	}
+
+	if c.conn == nil {
+		// If the caller did not provide a ClientConn when the client was
+		// created, create one using the configuration they did provide.
+		conn, err := grpc.DialContext(ctx, cfg.Logs.Endpoint, cfg.DialOptions...)
+		if err != nil {
+			return nil, err
+		}
+		// Keep track that we own the lifecycle of this conn and need to close
+		// it on Shutdown.
+		c.ourConn = true
+		c.conn = conn
+	}
+
+	c.lsc = collogpb.NewLogsServiceClient(c.conn)
+
+	return c, nil
+}
+
+// ForceFlush does nothing, the client holds no state.
+func (c *client) ForceFlush(ctx context.Context) error { return ctx.Err() }
+
+// Shutdown shuts down the client, freeing all resource.
+//
+// Any active connections to a remote endpoint are closed if they were created
+// by the client. Any gRPC connection passed during creation using
+// WithGRPCConn will not be closed. It is the caller's responsibility to
+// handle cleanup of that resource.
+func (c *client) Shutdown(ctx context.Context) error {
+	// The otlplog.Exporter synchronizes access to client methods and ensures
+	// this is called only once. The only thing that needs to be done here is
+	// to release any computational resources the client holds.
+
+	c.metadata = nil
+	c.requestFunc = nil
+	c.lsc = nil
+
+	err := ctx.Err()
+	if c.ourConn {
+		closeErr := c.conn.Close()
+		// A context timeout error takes precedence over this error.
+		if err == nil && closeErr != nil {
+			err = closeErr
+		}
+	}
+	c.conn = nil
+	return err
+}
+
+// UploadLogs sends protoLogs to connected endpoint.
+//
+// Retryable errors from the server will be handled according to any
+// RetryConfig the client was created with.
+func (c *client) UploadLogs(ctx context.Context, protoLogs []*logpb.ResourceLogs) error {
+	// The otlplog.Exporter synchronizes access to client methods, and ensures
+	// this is not called after the Exporter is shutdown. Only thing to do
+	// here is send data.
+
+	select {
+	case <-ctx.Done():
+		// Do not upload if the context is already expired.
+		return ctx.Err()
+	default:
+	}
+
+	ctx, cancel := c.exportContext(ctx)
+	defer cancel()
+
+	req := &collogpb.ExportLogsServiceRequest{ResourceLogs: protoLogs}
+	return c.requestFunc(ctx, func(iCtx context.Context) error {
+		iCtx, err := c.headersContext(iCtx)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.lsc.Export(iCtx, req)
+		if resp != nil && resp.PartialSuccess != nil {
+			msg := resp.PartialSuccess.GetErrorMessage()
+			n := resp.PartialSuccess.GetRejectedLogRecords()
+			if n != 0 || msg != "" {
+				otel.Handle(internal.PartialSuccessToError(
+					internal.LogsPartialSuccess, n, msg,
+				))
+			}
+		}
+		// nil is converted to OK.
+		if status.Code(err) == codes.OK {
+			// Success.
+			return nil
+		}
+		return err
+	})
+}
+
+// exportContext returns a copy of parent with an appropriate deadline and
+// cancellation function based on the clients configured export timeout.
+//
+// It is the callers responsibility to cancel the returned context once its
+// use is complete, via the parent or directly with the returned CancelFunc, to
+// ensure all resources are correctly released.
+func (c *client) exportContext(parent context.Context) (context.Context, context.CancelFunc) {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	if c.exportTimeout > 0 {
+		ctx, cancel = context.WithTimeout(parent, c.exportTimeout)
+	} else {
+		ctx, cancel = context.WithCancel(parent)
+	}
+
+	if c.metadata.Len() > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, c.metadata)
+	}
+
+	return ctx, cancel
+}
+
+// headersContext returns a copy of ctx with the headers returned from the
+// headers provider of the client merged into its outgoing metadata. If no
+// headers provider is configured, ctx is returned unmodified.
+func (c *client) headersContext(ctx context.Context) (context.Context, error) {
+	if c.headersProvider == nil {
+		return ctx, nil
+	}
+
+	h, err := c.headersProvider(ctx)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to get headers: %w", err)
+	}
+
+	md := c.metadata.Copy()
+	for k, v := range h {
+		md.Set(k, v)
+	}
+	return metadata.NewOutgoingContext(ctx, md), nil
+}
+
+// retryable returns if err identifies a request that can be retried and a
+// duration to wait for if an explicit throttle time is included in err.
+func retryable(err error) (bool, time.Duration) {
+	s := status.Convert(err)
+	switch s.Code() {
+	case codes.Canceled,
+		codes.DeadlineExceeded,
+		codes.ResourceExhausted,
+		codes.Aborted,
+		codes.OutOfRange,
+		codes.Unavailable,
+		codes.DataLoss:
+		return true, throttleDelay(s)
+	}
+
+	// Not a retry-able error.
+	return false, 0
+}
+
+// throttleDelay returns a duration to wait for if an explicit throttle time
+// is included in the response status.
+func throttleDelay(s *status.Status) time.Duration {
+	for _, detail := range s.Details() {
+		if t, ok := detail.(*errdetails.RetryInfo); ok {
+			return t.RetryDelay.AsDuration()
+		}
+	}
+	return 0
+}
@@ -0,0 +1,295 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlploggrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/internal/otest"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	collogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+)
+
+func TestThrottleDuration(t *testing.T) {
+	c := codes.ResourceExhausted
+	testcases := []struct {
+		status   *status.Status
+		expected time.Duration
+	}{
+		{
+			status:   status.New(c, "NoRetryInfo"),
+			expected: 0,
+		},
+		{
+			status: func() *status.Status {
+				s, err := status.New(c, "SingleRetryInfo").WithDetails(
+					&errdetails.RetryInfo{
+						RetryDelay: durationpb.New(15 * time.Millisecond),
+					},
+				)
+				require.NoError(t, err)
+				return s
+			}(),
+			expected: 15 * time.Millisecond,
+		},
+		{
+			status: func() *status.Status {
+				s, err := status.New(c, "ErrorInfo").WithDetails(
+					&errdetails.ErrorInfo{Reason: "no throttle detail"},
+				)
+				require.NoError(t, err)
+				return s
+			}(),
+			expected: 0,
+		},
+		{
+			status: func() *status.Status {
+				s, err := status.New(c, "ErrorAndRetryInfo").WithDetails(
+					&errdetails.ErrorInfo{Reason: "with throttle detail"},
+					&errdetails.RetryInfo{
+						RetryDelay: durationpb.New(13 * time.Minute),
+					},
+				)
+				require.NoError(t, err)
+				return s
+			}(),
+			expected: 13 * time.Minute,
+		},
+		{
+			status: func() *status.Status {
+				s, err := status.New(c, "DoubleRetryInfo").WithDetails(
+					&errdetails.RetryInfo{
+						RetryDelay: durationpb.New(13 * time.Minute),
+					},
+					&errdetails.RetryInfo{
+						RetryDelay: durationpb.New(15 * time.Minute),
+					},
+				)
+				require.NoError(t, err)
+				return s
+			}(),
+			expected: 13 * time.Minute,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.status.Message(), func(t *testing.T) {
+			require.Equal(t, tc.expected, throttleDelay(tc.status))
+		})
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	retryableCodes := map[codes.Code]bool{
+		codes.OK:                 false,
+		codes.Canceled:           true,
+		codes.Unknown:            false,
+		codes.InvalidArgument:    false,
+		codes.DeadlineExceeded:   true,
+		codes.NotFound:           false,
+		codes.AlreadyExists:      false,
+		codes.PermissionDenied:   false,
+		codes.ResourceExhausted:  true,
+		codes.FailedPrecondition: false,
+		codes.Aborted:            true,
+		codes.OutOfRange:         true,
+		codes.Unimplemented:      false,
+		codes.Internal:           false,
+		codes.Unavailable:        true,
+		codes.DataLoss:           true,
+		codes.Unauthenticated:    false,
+	}
+
+	for c, want := range retryableCodes {
+		got, _ := retryable(status.Error(c, ""))
+		assert.Equalf(t, want, got, "evaluate(%s)", c)
+	}
+}
+
+var records = []sdklog.Record{{
+	Record: log.Record{SeverityText: "INFO"},
+}}
+
+func TestConfig(t *testing.T) {
+	factoryFunc := func(rCh <-chan otest.ExportResult, o ...Option) (*otlplog.Exporter, *otest.GRPCCollector) {
+		coll, err := otest.NewGRPCCollector("", rCh)
+		require.NoError(t, err)
+
+		ctx := context.Background()
+		opts := append([]Option{
+			WithEndpoint(coll.Addr().String()),
+			WithInsecure(),
+		}, o...)
+		exp, err := New(ctx, opts...)
+		require.NoError(t, err)
+		return exp, coll
+	}
+
+	t.Run("Export", func(t *testing.T) {
+		exp, coll := factoryFunc(nil)
+		t.Cleanup(coll.Shutdown)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		require.NoError(t, exp.Export(ctx, records))
+
+		got := coll.Collect().Dump()
+		require.Len(t, got, 1)
+		assert.Equal(t, "INFO", got[0].ScopeLogs[0].LogRecords[0].SeverityText)
+	})
+
+	t.Run("WithHeaders", func(t *testing.T) {
+		key := "my-custom-header"
+		headers := map[string]string{key: "custom-value"}
+		exp, coll := factoryFunc(nil, WithHeaders(headers))
+		t.Cleanup(coll.Shutdown)
+		ctx := context.Background()
+		require.NoError(t, exp.Export(ctx, records))
+		// Ensure everything is flushed.
+		require.NoError(t, exp.Shutdown(ctx))
+
+		got := coll.Headers()
+		require.Regexp(t, "OTel OTLP Exporter Go/1\\..*", got)
+		require.Contains(t, got, key)
+		assert.Equal(t, got[key], []string{headers[key]})
+	})
+
+	t.Run("WithHeadersProvider", func(t *testing.T) {
+		key := "my-custom-header"
+		var calls int
+		provider := func(context.Context) (map[string]string, error) {
+			calls++
+			return map[string]string{key: fmt.Sprintf("value-%d", calls)}, nil
+		}
+		exp, coll := factoryFunc(
+			nil,
+			WithHeaders(map[string]string{key: "static-value"}),
+			WithHeadersProvider(provider),
+		)
+		t.Cleanup(coll.Shutdown)
+		ctx := context.Background()
+		require.NoError(t, exp.Export(ctx, records))
+		// Ensure everything is flushed.
+		require.NoError(t, exp.Shutdown(ctx))
+
+		got := coll.Headers()
+		require.Contains(t, got, key)
+		assert.Equal(t, []string{"value-1"}, got[key])
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("WithHeadersProviderError", func(t *testing.T) {
+		errProvider := errors.New("provider failure")
+		provider := func(context.Context) (map[string]string, error) {
+			return nil, errProvider
+		}
+		exp, coll := factoryFunc(nil, WithHeadersProvider(provider))
+		t.Cleanup(coll.Shutdown)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		err := exp.Export(ctx, records)
+		assert.ErrorIs(t, err, errProvider)
+		assert.Len(t, coll.Collect().Dump(), 0)
+	})
+
+	t.Run("WithCompressor", func(t *testing.T) {
+		for _, compressor := range []string{"gzip", "zstd", "snappy"} {
+			t.Run(compressor, func(t *testing.T) {
+				exp, coll := factoryFunc(nil, WithCompressor(compressor))
+				t.Cleanup(coll.Shutdown)
+				ctx := context.Background()
+				t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+				assert.NoError(t, exp.Export(ctx, records))
+				assert.Len(t, coll.Collect().Dump(), 1)
+			})
+		}
+	})
+
+	t.Run("WithRetry", func(t *testing.T) {
+		rCh := make(chan otest.ExportResult, 2)
+		rCh <- otest.ExportResult{Err: status.Error(codes.Unavailable, "unavailable")}
+		rCh <- otest.ExportResult{}
+		exp, coll := factoryFunc(rCh, WithRetry(RetryConfig{
+			Enabled:         true,
+			InitialInterval: time.Nanosecond,
+			MaxInterval:     time.Millisecond,
+			MaxElapsedTime:  time.Minute,
+		}))
+		t.Cleanup(coll.Shutdown)
+		// Push this after Shutdown so the gRPC server doesn't hang.
+		t.Cleanup(func() { close(rCh) })
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		assert.NoError(t, exp.Export(ctx, records), "failed retry")
+		assert.Len(t, rCh, 0, "failed gRPC responses did not occur")
+	})
+
+	t.Run("WithTimeout", func(t *testing.T) {
+		// Do not send on rCh so the Collector never responds to the client.
+		rCh := make(chan otest.ExportResult)
+		t.Cleanup(func() { close(rCh) })
+		exp, coll := factoryFunc(
+			rCh,
+			WithTimeout(time.Millisecond),
+			WithRetry(RetryConfig{Enabled: false}),
+		)
+		t.Cleanup(coll.Shutdown)
+		ctx := context.Background()
+		t.Cleanup(func() { _ = exp.Shutdown(ctx) })
+		err := exp.Export(ctx, records)
+		assert.Equal(t, codes.DeadlineExceeded, status.Convert(err).Code())
+	})
+
+	t.Run("PartialSuccess", func(t *testing.T) {
+		orig := otel.GetErrorHandler()
+		t.Cleanup(func() { otel.SetErrorHandler(orig) })
+		var errs []error
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+			errs = append(errs, err)
+		}))
+
+		rCh := make(chan otest.ExportResult, 1)
+		rCh <- otest.ExportResult{Response: &collogpb.ExportLogsServiceResponse{
+			PartialSuccess: &collogpb.ExportLogsPartialSuccess{
+				RejectedLogRecords: 2,
+				ErrorMessage:       "partially successful",
+			},
+		}}
+		exp, coll := factoryFunc(rCh)
+		t.Cleanup(coll.Shutdown)
+		t.Cleanup(func() { close(rCh) })
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		require.NoError(t, exp.Export(ctx, records))
+
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], internal.PartialSuccess{})
+		assert.Contains(t, errs[0].Error(), "2 log records rejected")
+	})
+}
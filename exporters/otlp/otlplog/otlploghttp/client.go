@@ -0,0 +1,368 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlploghttp // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/internal/oconf"
+	collogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logpb "go.opentelemetry.io/proto/otlp/logs/v1"
+)
+
+// New returns an OpenTelemetry log Exporter. The Exporter can be used to
+// export OTLP log data to an OTLP receiving endpoint using protobufs over
+// HTTP.
+func New(_ context.Context, opts ...Option) (*otlplog.Exporter, error) {
+	c, err := newClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return otlplog.New(c), nil
+}
+
+type client struct {
+	// req is cloned for every upload the client makes.
+	req             *http.Request
+	headersProvider func(context.Context) (map[string]string, error)
+	compression     Compression
+	requestFunc     retry.RequestFunc
+	httpClient      *http.Client
+}
+
+// Keep it in sync with golang's DefaultTransport from net/http! We
+// have our own copy to avoid handling a situation where the
+// DefaultTransport is overwritten with some different implementation
+// of http.RoundTripper or it's modified by another package.
+var ourTransport = &http.Transport{
+	Proxy: http.ProxyFromEnvironment,
+	DialContext: (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}).DialContext,
+	ForceAttemptHTTP2:     true,
+	MaxIdleConns:          100,
+	IdleConnTimeout:       90 * time.Second,
+	TLSHandshakeTimeout:   10 * time.Second,
+	ExpectContinueTimeout: 1 * time.Second,
+}
+
+// newClient creates a new HTTP log client.
+func newClient(opts ...Option) (otlplog.Client, error) {
+	cfg := oconf.NewHTTPConfig(asHTTPOptions(opts)...)
+
+	httpClient := &http.Client{
+		Transport: ourTransport,
+		Timeout:   cfg.Logs.Timeout,
+	}
+	if cfg.Logs.TLSCfg != nil || cfg.Logs.Proxy != nil {
+		transport := ourTransport.Clone()
+		if cfg.Logs.TLSCfg != nil {
+			transport.TLSClientConfig = cfg.Logs.TLSCfg
+		}
+		if cfg.Logs.Proxy != nil {
+			transport.Proxy = cfg.Logs.Proxy
+		}
+		httpClient.Transport = transport
+	}
+
+	u := &url.URL{
+		Scheme: "https",
+		Host:   cfg.Logs.Endpoint,
+		Path:   cfg.Logs.URLPath,
+	}
+	if cfg.Logs.Insecure {
+		u.Scheme = "http"
+	}
+	// Body is set when this is cloned during upload.
+	req, err := http.NewRequest(http.MethodPost, u.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", internal.GetUserAgentHeader())
+
+	if n := len(cfg.Logs.Headers); n > 0 {
+		for k, v := range cfg.Logs.Headers {
+			req.Header.Set(k, v)
+		}
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+
+	return &client{
+		compression:     Compression(cfg.Logs.Compression),
+		req:             req,
+		headersProvider: cfg.Logs.HeadersProvider,
+		requestFunc:     cfg.RetryConfig.RequestFunc(evaluate),
+		httpClient:      httpClient,
+	}, nil
+}
+
+// ForceFlush does nothing, the client holds no state.
+func (c *client) ForceFlush(ctx context.Context) error { return ctx.Err() }
+
+// Shutdown shuts down the client, freeing all resources.
+func (c *client) Shutdown(ctx context.Context) error {
+	// The otlplog.Exporter synchronizes access to client methods and
+	// ensures this is called only once. The only thing that needs to be done
+	// here is to release any computational resources the client holds.
+
+	c.requestFunc = nil
+	c.httpClient = nil
+	return ctx.Err()
+}
+
+// UploadLogs sends protoLogs to the connected endpoint.
+//
+// Retryable errors from the server will be handled according to any
+// RetryConfig the client was created with.
+func (c *client) UploadLogs(ctx context.Context, protoLogs []*logpb.ResourceLogs) error {
+	// The otlplog.Exporter synchronizes access to client methods, and ensures
+	// this is not called after the Exporter is shutdown. Only thing to do
+	// here is send data.
+
+	pbRequest := &collogpb.ExportLogsServiceRequest{ResourceLogs: protoLogs}
+	body, err := proto.Marshal(pbRequest)
+	if err != nil {
+		return err
+	}
+	request, err := c.newRequest(ctx, body)
+	if err != nil {
+		return err
+	}
+
+	return c.requestFunc(ctx, func(iCtx context.Context) error {
+		return c.send(iCtx, &request)
+	})
+}
+
+// send makes a single attempt to send request.
+func (c *client) send(ctx context.Context, request *request) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	request.reset(ctx)
+	if c.headersProvider != nil {
+		h, err := c.headersProvider(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get headers: %w", err)
+		}
+		for k, v := range h {
+			request.Header.Set(k, v)
+		}
+	}
+	resp, err := c.httpClient.Do(request.Request)
+	if err != nil {
+		return err
+	}
+
+	var rErr error
+	switch resp.StatusCode {
+	case http.StatusOK:
+		// Success, do not retry.
+
+		// Read the partial success message, if any.
+		var respData bytes.Buffer
+		if _, err := io.Copy(&respData, resp.Body); err != nil {
+			_ = resp.Body.Close()
+			return err
+		}
+
+		if respData.Len() != 0 {
+			var respProto collogpb.ExportLogsServiceResponse
+			if err := proto.Unmarshal(respData.Bytes(), &respProto); err != nil {
+				_ = resp.Body.Close()
+				return err
+			}
+
+			if respProto.PartialSuccess != nil {
+				msg := respProto.PartialSuccess.GetErrorMessage()
+				n := respProto.PartialSuccess.GetRejectedLogRecords()
+				if n != 0 || msg != "" {
+					otel.Handle(internal.PartialSuccessToError(
+						internal.LogsPartialSuccess, n, msg,
+					))
+				}
+			}
+		}
+	case http.StatusTooManyRequests,
+		http.StatusServiceUnavailable:
+		// Retry-able failure.
+		rErr = newResponseError(resp.Header)
+
+		// Going to retry, drain the body to reuse the connection.
+		if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+			_ = resp.Body.Close()
+			return err
+		}
+	default:
+		rErr = fmt.Errorf("failed to send logs to %s: %s", request.URL, resp.Status)
+	}
+
+	if err := resp.Body.Close(); err != nil {
+		return err
+	}
+	return rErr
+}
+
+var gzPool = sync.Pool{
+	New: func() interface{} {
+		w := gzip.NewWriter(io.Discard)
+		return w
+	},
+}
+
+var zstdPool = sync.Pool{
+	New: func() interface{} {
+		// This only returns an error for invalid options.
+		w, _ := zstd.NewWriter(io.Discard, zstd.WithEncoderConcurrency(1))
+		return w
+	},
+}
+
+var snappyPool = sync.Pool{
+	New: func() interface{} {
+		return snappy.NewBufferedWriter(io.Discard)
+	},
+}
+
+func (c *client) newRequest(ctx context.Context, body []byte) (request, error) {
+	r := c.req.Clone(ctx)
+	req := request{Request: r}
+
+	switch c.compression {
+	case NoCompression:
+		r.ContentLength = (int64)(len(body))
+		req.bodyReader = bodyReader(body)
+	case GzipCompression:
+		return req, compressBody(&req, "gzip", &gzPool, body)
+	case ZstdCompression:
+		return req, compressBody(&req, "zstd", &zstdPool, body)
+	case SnappyCompression:
+		return req, compressBody(&req, "snappy", &snappyPool, body)
+	}
+
+	return req, nil
+}
+
+// resetWriteCloser is a compressing writer that can be reused.
+type resetWriteCloser interface {
+	io.WriteCloser
+	Reset(io.Writer)
+}
+
+// compressBody sets the body of req to body compressed with a
+// resetWriteCloser from pool and sets the Content-Encoding header of req to
+// encoding.
+func compressBody(req *request, encoding string, pool *sync.Pool, body []byte) error {
+	// Ensure the content length is not used.
+	req.ContentLength = -1
+	req.Header.Set("Content-Encoding", encoding)
+
+	w := pool.Get().(resetWriteCloser)
+	defer pool.Put(w)
+
+	var b bytes.Buffer
+	w.Reset(&b)
+
+	if _, err := w.Write(body); err != nil {
+		return err
+	}
+	// Close needs to be called to ensure body if fully written.
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	req.bodyReader = bodyReader(b.Bytes())
+	return nil
+}
+
+// bodyReader returns a closure returning a new reader for buf.
+func bodyReader(buf []byte) func() io.ReadCloser {
+	return func() io.ReadCloser {
+		return io.NopCloser(bytes.NewReader(buf))
+	}
+}
+
+// request wraps an http.Request with a resettable body reader.
+type request struct {
+	*http.Request
+
+	// bodyReader allows the same body to be used for multiple requests.
+	bodyReader func() io.ReadCloser
+}
+
+// reset reinitializes the request Body and uses ctx for the request.
+func (r *request) reset(ctx context.Context) {
+	r.Body = r.bodyReader()
+	r.Request = r.Request.WithContext(ctx)
+}
+
+// retryableError represents a request failure that can be retried.
+type retryableError struct {
+	throttle int64
+}
+
+// newResponseError returns a retryableError and will extract any explicit
+// throttle delay contained in headers.
+func newResponseError(header http.Header) error {
+	var rErr retryableError
+	if v := header.Get("Retry-After"); v != "" {
+		if t, err := strconv.ParseInt(v, 10, 64); err == nil {
+			rErr.throttle = t
+		}
+	}
+	return rErr
+}
+
+func (e retryableError) Error() string {
+	return "retry-able request failure"
+}
+
+// evaluate returns if err is retry-able. If it is and it includes an explicit
+// throttling delay, that delay is also returned.
+func evaluate(err error) (bool, time.Duration) {
+	if err == nil {
+		return false, 0
+	}
+
+	rErr, ok := err.(retryableError)
+	if !ok {
+		return false, 0
+	}
+
+	return true, time.Duration(rErr.throttle)
+}
@@ -0,0 +1,209 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlploghttp
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/internal/otest"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	collogpb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+)
+
+var records = []sdklog.Record{{
+	Record: log.Record{SeverityText: "INFO"},
+}}
+
+func TestConfig(t *testing.T) {
+	factoryFunc := func(ePt string, rCh <-chan otest.ExportResult, o ...Option) (*otlplog.Exporter, *otest.HTTPCollector) {
+		coll, err := otest.NewHTTPCollector(ePt, rCh)
+		require.NoError(t, err)
+
+		opts := []Option{WithEndpoint(coll.Addr().String())}
+		if !strings.HasPrefix(strings.ToLower(ePt), "https") {
+			opts = append(opts, WithInsecure())
+		}
+		opts = append(opts, o...)
+
+		ctx := context.Background()
+		exp, err := New(ctx, opts...)
+		require.NoError(t, err)
+		return exp, coll
+	}
+
+	t.Run("Export", func(t *testing.T) {
+		exp, coll := factoryFunc("", nil)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		require.NoError(t, exp.Export(ctx, records))
+
+		got := coll.Collect().Dump()
+		require.Len(t, got, 1)
+		assert.Equal(t, "INFO", got[0].ScopeLogs[0].LogRecords[0].SeverityText)
+	})
+
+	t.Run("WithHeaders", func(t *testing.T) {
+		key := http.CanonicalHeaderKey("my-custom-header")
+		headers := map[string]string{key: "custom-value"}
+		exp, coll := factoryFunc("", nil, WithHeaders(headers))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		require.NoError(t, exp.Export(ctx, records))
+		// Ensure everything is flushed.
+		require.NoError(t, exp.Shutdown(ctx))
+
+		got := coll.Headers()
+		require.Regexp(t, "OTel OTLP Exporter Go/1\\..*", got)
+		require.Contains(t, got, key)
+		assert.Equal(t, got[key], []string{headers[key]})
+	})
+
+	t.Run("WithHeadersProviderError", func(t *testing.T) {
+		errProvider := errors.New("provider failure")
+		provider := func(context.Context) (map[string]string, error) {
+			return nil, errProvider
+		}
+		exp, coll := factoryFunc("", nil, WithHeadersProvider(provider))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		err := exp.Export(ctx, records)
+		assert.ErrorIs(t, err, errProvider)
+		assert.Len(t, coll.Collect().Dump(), 0)
+	})
+
+	t.Run("WithTimeout", func(t *testing.T) {
+		// Do not send on rCh so the Collector never responds to the client.
+		rCh := make(chan otest.ExportResult)
+		exp, coll := factoryFunc(
+			"",
+			rCh,
+			WithTimeout(time.Millisecond),
+			WithRetry(RetryConfig{Enabled: false}),
+		)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		// Push this after Shutdown so the HTTP server doesn't hang.
+		t.Cleanup(func() { close(rCh) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		err := exp.Export(ctx, records)
+		assert.ErrorContains(t, err, context.DeadlineExceeded.Error())
+	})
+
+	t.Run("WithCompression", func(t *testing.T) {
+		for _, c := range []Compression{GzipCompression, ZstdCompression, SnappyCompression} {
+			exp, coll := factoryFunc("", nil, WithCompression(c))
+			ctx := context.Background()
+			t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+			t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+			assert.NoError(t, exp.Export(ctx, records))
+			assert.Len(t, coll.Collect().Dump(), 1)
+		}
+	})
+
+	t.Run("WithRetry", func(t *testing.T) {
+		emptyErr := errors.New("")
+		rCh := make(chan otest.ExportResult, 3)
+		header := http.Header{http.CanonicalHeaderKey("Retry-After"): {"10"}}
+		// Both retryable errors.
+		rCh <- otest.ExportResult{Err: &otest.HTTPResponseError{
+			Status: http.StatusServiceUnavailable,
+			Err:    emptyErr,
+			Header: header,
+		}}
+		rCh <- otest.ExportResult{Err: &otest.HTTPResponseError{
+			Status: http.StatusTooManyRequests,
+			Err:    emptyErr,
+		}}
+		rCh <- otest.ExportResult{}
+		exp, coll := factoryFunc("", rCh, WithRetry(RetryConfig{
+			Enabled:         true,
+			InitialInterval: time.Nanosecond,
+			MaxInterval:     time.Millisecond,
+			MaxElapsedTime:  time.Minute,
+		}))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		// Push this after Shutdown so the HTTP server doesn't hang.
+		t.Cleanup(func() { close(rCh) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		assert.NoError(t, exp.Export(ctx, records), "failed retry")
+		assert.Len(t, rCh, 0, "failed HTTP responses did not occur")
+	})
+
+	t.Run("WithURLPath", func(t *testing.T) {
+		path := "/prefix/v2/logs"
+		ePt := fmt.Sprintf("http://localhost:0%s", path)
+		exp, coll := factoryFunc(ePt, nil, WithURLPath(path))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		assert.NoError(t, exp.Export(ctx, records))
+		assert.Len(t, coll.Collect().Dump(), 1)
+	})
+
+	t.Run("WithTLSClientConfig", func(t *testing.T) {
+		ePt := "https://localhost:0"
+		tlsCfg := &tls.Config{InsecureSkipVerify: true}
+		exp, coll := factoryFunc(ePt, nil, WithTLSClientConfig(tlsCfg))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		assert.NoError(t, exp.Export(ctx, records))
+		assert.Len(t, coll.Collect().Dump(), 1)
+	})
+
+	t.Run("PartialSuccess", func(t *testing.T) {
+		orig := otel.GetErrorHandler()
+		t.Cleanup(func() { otel.SetErrorHandler(orig) })
+		var errs []error
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+			errs = append(errs, err)
+		}))
+
+		rCh := make(chan otest.ExportResult, 1)
+		rCh <- otest.ExportResult{Response: &collogpb.ExportLogsServiceResponse{
+			PartialSuccess: &collogpb.ExportLogsPartialSuccess{
+				RejectedLogRecords: 2,
+				ErrorMessage:       "partially successful",
+			},
+		}}
+		exp, coll := factoryFunc("", rCh)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { close(rCh) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		require.NoError(t, exp.Export(ctx, records))
+
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], internal.PartialSuccess{})
+		assert.Contains(t, errs[0].Error(), "2 log records rejected")
+	})
+}
@@ -0,0 +1,225 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlploghttp // import "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/internal/oconf"
+)
+
+// Compression describes the compression used for payloads sent to the
+// collector.
+type Compression oconf.Compression
+
+const (
+	// NoCompression tells the driver to send payloads without
+	// compression.
+	NoCompression = Compression(oconf.NoCompression)
+	// GzipCompression tells the driver to send payloads after
+	// compressing them with gzip.
+	GzipCompression = Compression(oconf.GzipCompression)
+	// ZstdCompression tells the driver to send payloads after
+	// compressing them with zstd.
+	ZstdCompression = Compression(oconf.ZstdCompression)
+	// SnappyCompression tells the driver to send payloads after
+	// compressing them with snappy.
+	SnappyCompression = Compression(oconf.SnappyCompression)
+)
+
+// Option applies an option to the Exporter.
+type Option interface {
+	applyHTTPOption(oconf.Config) oconf.Config
+}
+
+func asHTTPOptions(opts []Option) []oconf.HTTPOption {
+	converted := make([]oconf.HTTPOption, len(opts))
+	for i, o := range opts {
+		converted[i] = oconf.NewHTTPOption(o.applyHTTPOption)
+	}
+	return converted
+}
+
+// RetryConfig defines configuration for retrying the export of log data
+// that failed.
+type RetryConfig retry.Config
+
+// HTTPTransportProxyFunc is a function that resolves which URL to use as proxy
+// for a given request. This type is compatible with http.Transport.Proxy and
+// can be used to set a custom proxy function with WithProxy.
+type HTTPTransportProxyFunc func(*http.Request) (*url.URL, error)
+
+type wrappedOption struct {
+	oconf.HTTPOption
+}
+
+func (w wrappedOption) applyHTTPOption(cfg oconf.Config) oconf.Config {
+	return w.ApplyHTTPOption(cfg)
+}
+
+// WithEndpoint sets the target endpoint the Exporter will connect to. This
+// endpoint is specified as a host and optional port, no path or scheme should
+// be included (see WithInsecure and WithURLPath).
+//
+// If the OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_LOGS_ENDPOINT
+// environment variable is set, and this option is not passed, that variable
+// value will be used. If both are set, OTEL_EXPORTER_OTLP_LOGS_ENDPOINT
+// will take precedence.
+//
+// By default, if an environment variable is not set, and this option is not
+// passed, "localhost:4318" will be used.
+func WithEndpoint(endpoint string) Option {
+	return wrappedOption{oconf.WithEndpoint(endpoint)}
+}
+
+// WithCompression sets the compression strategy the Exporter will use to
+// compress the HTTP body.
+//
+// If the OTEL_EXPORTER_OTLP_COMPRESSION or
+// OTEL_EXPORTER_OTLP_LOGS_COMPRESSION environment variable is set, and
+// this option is not passed, that variable value will be used. That value can
+// be either "none", "gzip", "zstd", or "snappy". If both are set,
+// OTEL_EXPORTER_OTLP_LOGS_COMPRESSION will take precedence.
+//
+// By default, if an environment variable is not set, and this option is not
+// passed, no compression strategy will be used.
+func WithCompression(compression Compression) Option {
+	return wrappedOption{oconf.WithCompression(oconf.Compression(compression))}
+}
+
+// WithURLPath sets the URL path the Exporter will send requests to.
+//
+// If the OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_LOGS_ENDPOINT
+// environment variable is set, and this option is not passed, the path
+// contained in that variable value will be used. If both are set,
+// OTEL_EXPORTER_OTLP_LOGS_ENDPOINT will take precedence. The path of
+// OTEL_EXPORTER_OTLP_LOGS_ENDPOINT is used exactly as it is set, while
+// "/v1/logs" is appended to the path of OTEL_EXPORTER_OTLP_ENDPOINT.
+//
+// By default, if an environment variable is not set, and this option is not
+// passed, "/v1/logs" will be used.
+func WithURLPath(urlPath string) Option {
+	return wrappedOption{oconf.WithURLPath(urlPath)}
+}
+
+// WithTLSClientConfig sets the TLS configuration the Exporter will use for
+// HTTP requests.
+//
+// If the OTEL_EXPORTER_OTLP_CERTIFICATE or
+// OTEL_EXPORTER_OTLP_LOGS_CERTIFICATE environment variable is set, and
+// this option is not passed, that variable value will be used. The value will
+// be parsed the filepath of the TLS certificate chain to use. If both are
+// set, OTEL_EXPORTER_OTLP_LOGS_CERTIFICATE will take precedence.
+//
+// If the OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE and
+// OTEL_EXPORTER_OTLP_CLIENT_KEY, or the
+// OTEL_EXPORTER_OTLP_LOGS_CLIENT_CERTIFICATE and
+// OTEL_EXPORTER_OTLP_LOGS_CLIENT_KEY, environment variables are set, and
+// this option is not passed, those variable values will be parsed as the
+// filepaths of the PEM encoded client certificate and key used for mutual
+// TLS. The LOGS variables take precedence if both pairs are set.
+//
+// By default, if an environment variable is not set, and this option is not
+// passed, the system default configuration is used.
+func WithTLSClientConfig(tlsCfg *tls.Config) Option {
+	return wrappedOption{oconf.WithTLSClientConfig(tlsCfg)}
+}
+
+// WithInsecure disables client transport security for the Exporter's HTTP
+// connection.
+//
+// If the OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_LOGS_ENDPOINT
+// environment variable is set, and this option is not passed, that variable
+// value will be used to determine client security. If the endpoint has a
+// scheme of "http" or "unix" client security will be disabled. If both are
+// set, OTEL_EXPORTER_OTLP_LOGS_ENDPOINT will take precedence.
+//
+// By default, if an environment variable is not set, and this option is not
+// passed, client security will be used.
+func WithInsecure() Option {
+	return wrappedOption{oconf.WithInsecure()}
+}
+
+// WithHeaders will send the provided headers with each HTTP requests.
+//
+// If the OTEL_EXPORTER_OTLP_HEADERS or OTEL_EXPORTER_OTLP_LOGS_HEADERS
+// environment variable is set, and this option is not passed, that variable
+// value will be used. The value will be parsed as a list of key value pairs.
+// These pairs are expected to be in the W3C Correlation-Context format
+// without additional semi-colon delimited metadata (i.e. "k1=v1,k2=v2"). If
+// both are set, OTEL_EXPORTER_OTLP_LOGS_HEADERS will take precedence.
+//
+// By default, if an environment variable is not set, and this option is not
+// passed, no user headers will be set.
+func WithHeaders(headers map[string]string) Option {
+	return wrappedOption{oconf.WithHeaders(headers)}
+}
+
+// WithTimeout sets the max amount of time an Exporter will attempt an export.
+//
+// This takes precedence over any retry settings defined by WithRetry. Once
+// this time limit has been reached the export is abandoned and the log
+// data is dropped.
+//
+// If the OTEL_EXPORTER_OTLP_TIMEOUT or OTEL_EXPORTER_OTLP_LOGS_TIMEOUT
+// environment variable is set, and this option is not passed, that variable
+// value will be used. The value will be parsed as an integer representing the
+// timeout in milliseconds. If both are set,
+// OTEL_EXPORTER_OTLP_LOGS_TIMEOUT will take precedence.
+//
+// By default, if an environment variable is not set, and this option is not
+// passed, a timeout of 10 seconds will be used.
+func WithTimeout(duration time.Duration) Option {
+	return wrappedOption{oconf.WithTimeout(duration)}
+}
+
+// WithRetry sets the retry policy for transient retryable errors that are
+// returned by the target endpoint.
+//
+// If the target endpoint responds with not only a retryable error, but
+// explicitly returns a backoff time in the response, that time will take
+// precedence over these settings.
+//
+// If unset, the default retry policy will be used. It will retry the export
+// 5 seconds after receiving a retryable error and increase exponentially
+// after each error for no more than a total time of 1 minute.
+func WithRetry(rc RetryConfig) Option {
+	return wrappedOption{oconf.WithRetry(retry.Config(rc))}
+}
+
+// WithHeadersProvider sets fn to be called before every export request to
+// provide additional headers to send with that request. This allows
+// short-lived credentials (e.g. OAuth tokens) to be refreshed without
+// recreating the Exporter.
+//
+// Headers returned by fn take precedence over any headers with the same key
+// set using WithHeaders. If fn returns an error, the export request is not
+// sent and that error is returned.
+func WithHeadersProvider(fn func(ctx context.Context) (map[string]string, error)) Option {
+	return wrappedOption{oconf.WithHeadersProvider(fn)}
+}
+
+// WithProxy sets the Proxy function the client will use to determine the
+// proxy to use for an HTTP request. If this option is not used, the client
+// will use http.ProxyFromEnvironment, which respects the HTTPS_PROXY,
+// HTTP_PROXY, and NO_PROXY environment variables.
+func WithProxy(pf HTTPTransportProxyFunc) Option {
+	return wrappedOption{oconf.WithProxy(oconf.HTTPTransportProxyFunc(pf))}
+}
@@ -21,7 +21,9 @@ import (
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/transform"
 	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/view"
 	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
 )
 
@@ -34,6 +36,20 @@ type exporter struct {
 	shutdownOnce sync.Once
 }
 
+// Temporality returns the Temporality to use for an instrument kind.
+func (e *exporter) Temporality(k view.InstrumentKind) metricdata.Temporality {
+	e.clientMu.Lock()
+	defer e.clientMu.Unlock()
+	return e.client.Temporality(k)
+}
+
+// Aggregation returns the Aggregation to use for an instrument kind.
+func (e *exporter) Aggregation(k view.InstrumentKind) aggregation.Aggregation {
+	e.clientMu.Lock()
+	defer e.clientMu.Unlock()
+	return e.client.Aggregation(k)
+}
+
 // Export transforms and transmits metric data to an OTLP receiver.
 func (e *exporter) Export(ctx context.Context, rm metricdata.ResourceMetrics) error {
 	otlpRm, err := transform.ResourceMetrics(rm)
@@ -68,7 +84,10 @@ func (e *exporter) Shutdown(ctx context.Context) error {
 	e.shutdownOnce.Do(func() {
 		e.clientMu.Lock()
 		client := e.client
-		e.client = shutdownClient{}
+		e.client = shutdownClient{
+			temporalitySelector: client.Temporality,
+			aggregationSelector: client.Aggregation,
+		}
 		e.clientMu.Unlock()
 		err = client.Shutdown(ctx)
 	})
@@ -82,7 +101,10 @@ func New(client Client) metric.Exporter {
 	return &exporter{client: client}
 }
 
-type shutdownClient struct{}
+type shutdownClient struct {
+	temporalitySelector metric.TemporalitySelector
+	aggregationSelector metric.AggregationSelector
+}
 
 func (c shutdownClient) err(ctx context.Context) error {
 	if err := ctx.Err(); err != nil {
@@ -91,6 +113,14 @@ func (c shutdownClient) err(ctx context.Context) error {
 	return errShutdown
 }
 
+func (c shutdownClient) Temporality(k view.InstrumentKind) metricdata.Temporality {
+	return c.temporalitySelector(k)
+}
+
+func (c shutdownClient) Aggregation(k view.InstrumentKind) aggregation.Aggregation {
+	return c.aggregationSelector(k)
+}
+
 func (c shutdownClient) UploadMetrics(ctx context.Context, _ *mpb.ResourceMetrics) error {
 	return c.err(ctx)
 }
@@ -21,7 +21,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/view"
 	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
 )
 
@@ -29,6 +32,25 @@ type client struct {
 	// n is incremented by all Client methods. If these methods are called
 	// concurrently this should fail tests run with the race detector.
 	n int
+
+	temporalitySelector metric.TemporalitySelector
+	aggregationSelector metric.AggregationSelector
+}
+
+func (c *client) Temporality(k view.InstrumentKind) metricdata.Temporality {
+	c.n++
+	if c.temporalitySelector != nil {
+		return c.temporalitySelector(k)
+	}
+	return metric.DefaultTemporalitySelector(k)
+}
+
+func (c *client) Aggregation(k view.InstrumentKind) aggregation.Aggregation {
+	c.n++
+	if c.aggregationSelector != nil {
+		return c.aggregationSelector(k)
+	}
+	return metric.DefaultAggregationSelector(k)
 }
 
 func (c *client) UploadMetrics(context.Context, *mpb.ResourceMetrics) error {
@@ -88,3 +110,33 @@ func TestExporterClientConcurrency(t *testing.T) {
 	close(done)
 	wg.Wait()
 }
+
+func deltaSelector(view.InstrumentKind) metricdata.Temporality {
+	return metricdata.DeltaTemporality
+}
+
+func TestExporterTemporality(t *testing.T) {
+	exp := New(&client{temporalitySelector: deltaSelector})
+
+	var unknownKind view.InstrumentKind
+	assert.Equal(t, metricdata.DeltaTemporality, exp.Temporality(unknownKind))
+
+	// The Temporality of the client needs to be retained after shutdown.
+	assert.NoError(t, exp.Shutdown(context.Background()))
+	assert.Equal(t, metricdata.DeltaTemporality, exp.Temporality(unknownKind))
+}
+
+func dropSelector(view.InstrumentKind) aggregation.Aggregation {
+	return aggregation.Drop{}
+}
+
+func TestExporterAggregation(t *testing.T) {
+	exp := New(&client{aggregationSelector: dropSelector})
+
+	var unknownKind view.InstrumentKind
+	assert.Equal(t, aggregation.Drop{}, exp.Aggregation(unknownKind))
+
+	// The Aggregation of the client needs to be retained after shutdown.
+	assert.NoError(t, exp.Shutdown(context.Background()))
+	assert.Equal(t, aggregation.Drop{}, exp.Aggregation(unknownKind))
+}
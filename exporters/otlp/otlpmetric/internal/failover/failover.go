@@ -0,0 +1,200 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package failover provides an otlpmetric.Client that fails over between
+// multiple clients.
+package failover // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/failover"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+const (
+	// DefaultMaxFailures is the default number of consecutive failed uploads
+	// before failing over to the next client.
+	DefaultMaxFailures = 3
+	// DefaultProbeInterval is the default interval at which clients with a
+	// higher priority than the active one are probed.
+	DefaultProbeInterval = time.Minute
+	// DefaultProbeTimeout is the default timeout of an upload probing a
+	// client with a higher priority than the active one.
+	DefaultProbeTimeout = 5 * time.Second
+)
+
+type probeKey struct{}
+
+// ProbeContext returns a copy of parent marking uploads made with it as
+// probes. Clients must make a single attempt for these uploads and not
+// retry them.
+func ProbeContext(parent context.Context) context.Context {
+	return context.WithValue(parent, probeKey{}, true)
+}
+
+// IsProbe returns if ctx was returned from ProbeContext.
+func IsProbe(ctx context.Context) bool {
+	probe, _ := ctx.Value(probeKey{}).(bool)
+	return probe
+}
+
+type client struct {
+	// Client is the first of clients. It provides the Temporality and
+	// Aggregation for all clients as they share the same configuration
+	// other than their endpoint.
+	otlpmetric.Client
+	// clients are ordered by priority.
+	clients []otlpmetric.Client
+
+	maxFailures   int
+	probeInterval time.Duration
+	probeTimeout  time.Duration
+	now           func() time.Time
+
+	mu        sync.Mutex
+	active    int
+	failures  int
+	nextProbe time.Time
+}
+
+// NewClient returns a Client that uploads with the first of clients until
+// maxFailures consecutive uploads fail. It then fails over to the next
+// client. While a client other than the first is active, uploads are first
+// attempted with each client of a higher priority, at most once every
+// probeInterval, and the first to succeed becomes active again. These probes
+// are made with a context returned from ProbeContext and are canceled after
+// DefaultProbeTimeout.
+//
+// Default values are used if maxFailures or probeInterval are not positive.
+func NewClient(clients []otlpmetric.Client, maxFailures int, probeInterval time.Duration) otlpmetric.Client {
+	if maxFailures <= 0 {
+		maxFailures = DefaultMaxFailures
+	}
+	if probeInterval <= 0 {
+		probeInterval = DefaultProbeInterval
+	}
+	return &client{
+		Client:        clients[0],
+		clients:       clients,
+		maxFailures:   maxFailures,
+		probeInterval: probeInterval,
+		probeTimeout:  DefaultProbeTimeout,
+		now:           time.Now,
+	}
+}
+
+// UploadMetrics uploads protoMetrics with the active client. If this causes
+// a fail over, the upload is attempted again with the newly active client.
+//
+// Uploads are made without holding the lock guarding the active client so
+// a slow or unavailable endpoint does not block other uploads.
+func (c *client) UploadMetrics(ctx context.Context, protoMetrics *mpb.ResourceMetrics) error {
+	if c.probe(ctx, protoMetrics) {
+		return nil
+	}
+
+	for {
+		c.mu.Lock()
+		active := c.active
+		c.mu.Unlock()
+
+		err := c.clients[active].UploadMetrics(ctx, protoMetrics)
+
+		c.mu.Lock()
+		failedOver := c.record(ctx, active, err)
+		c.mu.Unlock()
+		if !failedOver {
+			return err
+		}
+	}
+}
+
+// probe uploads protoMetrics with each client of a higher priority than the
+// active one if the probe interval has elapsed. The first client to succeed
+// becomes active and true is returned.
+func (c *client) probe(ctx context.Context, protoMetrics *mpb.ResourceMetrics) bool {
+	c.mu.Lock()
+	active := c.active
+	due := active > 0 && !c.now().Before(c.nextProbe)
+	if due {
+		c.nextProbe = c.now().Add(c.probeInterval)
+	}
+	c.mu.Unlock()
+	if !due {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(ProbeContext(ctx), c.probeTimeout)
+	defer cancel()
+	for i := 0; i < active; i++ {
+		if c.clients[i].UploadMetrics(ctx, protoMetrics) == nil {
+			c.mu.Lock()
+			if i < c.active {
+				c.active, c.failures = i, 0
+			}
+			c.mu.Unlock()
+			return true
+		}
+	}
+	return false
+}
+
+// record updates the state of c with the result, err, of an upload made with
+// the client at index active. It returns true if this caused a fail over.
+//
+// c.mu must be held.
+func (c *client) record(ctx context.Context, active int, err error) bool {
+	if active != c.active {
+		// The active client was changed by a concurrent upload.
+		return false
+	}
+	if err == nil {
+		c.failures = 0
+		return false
+	}
+
+	c.failures++
+	if c.failures < c.maxFailures || c.active == len(c.clients)-1 || ctx.Err() != nil {
+		return false
+	}
+	c.active++
+	c.failures = 0
+	c.nextProbe = c.now().Add(c.probeInterval)
+	return true
+}
+
+// ForceFlush flushes all clients.
+func (c *client) ForceFlush(ctx context.Context) error {
+	var err error
+	for _, cl := range c.clients {
+		if fErr := cl.ForceFlush(ctx); err == nil {
+			err = fErr
+		}
+	}
+	return err
+}
+
+// Shutdown shuts down all clients.
+func (c *client) Shutdown(ctx context.Context) error {
+	var err error
+	for _, cl := range c.clients {
+		if sErr := cl.Shutdown(ctx); err == nil {
+			err = sErr
+		}
+	}
+	return err
+}
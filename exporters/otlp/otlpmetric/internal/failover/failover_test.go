@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package failover
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/view"
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+var errUnavailable = errors.New("unavailable")
+
+type fakeClient struct {
+	err      error
+	uploads  int
+	probes   int
+	flushed  bool
+	shutdown bool
+}
+
+func (c *fakeClient) Temporality(view.InstrumentKind) metricdata.Temporality {
+	return metricdata.CumulativeTemporality
+}
+
+func (c *fakeClient) Aggregation(view.InstrumentKind) aggregation.Aggregation {
+	return aggregation.Default{}
+}
+
+func (c *fakeClient) UploadMetrics(ctx context.Context, _ *mpb.ResourceMetrics) error {
+	c.uploads++
+	if _, ok := ctx.Deadline(); ok && IsProbe(ctx) {
+		c.probes++
+	}
+	return c.err
+}
+
+func (c *fakeClient) ForceFlush(context.Context) error {
+	c.flushed = true
+	return nil
+}
+
+func (c *fakeClient) Shutdown(context.Context) error {
+	c.shutdown = true
+	return nil
+}
+
+func TestClientFailover(t *testing.T) {
+	primary := &fakeClient{err: errUnavailable}
+	secondary := &fakeClient{}
+	c := NewClient([]otlpmetric.Client{primary, secondary}, 2, time.Minute)
+	ctx := context.Background()
+
+	// The first failure is returned.
+	assert.ErrorIs(t, c.UploadMetrics(ctx, nil), errUnavailable)
+	assert.Equal(t, 0, secondary.uploads)
+
+	// The second consecutive failure fails over and the upload is sent with
+	// the secondary.
+	assert.NoError(t, c.UploadMetrics(ctx, nil))
+	assert.Equal(t, 2, primary.uploads)
+	assert.Equal(t, 1, secondary.uploads)
+
+	assert.NoError(t, c.UploadMetrics(ctx, nil))
+	assert.Equal(t, 2, primary.uploads)
+	assert.Equal(t, 2, secondary.uploads)
+}
+
+func TestClientLastClientErrors(t *testing.T) {
+	primary := &fakeClient{err: errUnavailable}
+	secondary := &fakeClient{err: errUnavailable}
+	c := NewClient([]otlpmetric.Client{primary, secondary}, 1, time.Minute)
+	ctx := context.Background()
+
+	assert.ErrorIs(t, c.UploadMetrics(ctx, nil), errUnavailable)
+	assert.ErrorIs(t, c.UploadMetrics(ctx, nil), errUnavailable)
+	assert.Equal(t, 1, primary.uploads)
+	assert.Equal(t, 2, secondary.uploads)
+}
+
+func TestClientProbe(t *testing.T) {
+	primary := &fakeClient{err: errUnavailable}
+	secondary := &fakeClient{}
+	c := NewClient([]otlpmetric.Client{primary, secondary}, 1, time.Minute)
+	now := time.Now()
+	c.(*client).now = func() time.Time { return now }
+	ctx := context.Background()
+
+	require.NoError(t, c.UploadMetrics(ctx, nil))
+	require.Equal(t, 1, primary.uploads)
+
+	// Not probed before the interval.
+	require.NoError(t, c.UploadMetrics(ctx, nil))
+	assert.Equal(t, 1, primary.uploads)
+
+	// A failed probe keeps the secondary active.
+	now = now.Add(time.Minute)
+	require.NoError(t, c.UploadMetrics(ctx, nil))
+	assert.Equal(t, 2, primary.uploads)
+	assert.Equal(t, 3, secondary.uploads)
+
+	// A successful probe falls back to the primary.
+	primary.err = nil
+	now = now.Add(time.Minute)
+	require.NoError(t, c.UploadMetrics(ctx, nil))
+	require.NoError(t, c.UploadMetrics(ctx, nil))
+	assert.Equal(t, 4, primary.uploads)
+	assert.Equal(t, 3, secondary.uploads)
+
+	// Only probes are made with a probe context.
+	assert.Equal(t, 2, primary.probes)
+	assert.Equal(t, 0, secondary.probes)
+}
+
+// blockingClient is an otlpmetric.Client whose uploads block until their
+// context is done.
+type blockingClient struct {
+	fakeClient
+
+	started chan struct{}
+}
+
+func (c *blockingClient) UploadMetrics(ctx context.Context, _ *mpb.ResourceMetrics) error {
+	c.started <- struct{}{}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestClientProbeDoesNotBlockUploads(t *testing.T) {
+	primary := &blockingClient{started: make(chan struct{}, 1)}
+	secondary := &fakeClient{}
+	c := NewClient([]otlpmetric.Client{primary, secondary}, 1, time.Minute)
+	c.(*client).active = 1
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() { done <- c.UploadMetrics(ctx, nil) }()
+	<-primary.started
+
+	// The pending probe does not hold the lock of the client.
+	require.NoError(t, c.UploadMetrics(context.Background(), nil))
+	assert.Equal(t, 1, secondary.uploads)
+
+	// Once the probe is done the upload is made with the active client.
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+func TestClientFlushAndShutdown(t *testing.T) {
+	primary, secondary := &fakeClient{}, &fakeClient{}
+	c := NewClient([]otlpmetric.Client{primary, secondary}, 0, 0)
+	ctx := context.Background()
+
+	require.NoError(t, c.ForceFlush(ctx))
+	require.NoError(t, c.Shutdown(ctx))
+	assert.True(t, primary.flushed && secondary.flushed)
+	assert.True(t, primary.shutdown && secondary.shutdown)
+}
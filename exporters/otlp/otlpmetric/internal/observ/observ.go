@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package observ provides instrumentation the OTLP metric exporter clients
+// use to measure their own operation.
+package observ // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/observ"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncfloat64"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
+	"go.opentelemetry.io/otel/metric/unit"
+)
+
+// Names of the instruments an Instrumentation records measurements with.
+const (
+	AttemptsName = "otlp.exporter.attempts"
+	FailuresName = "otlp.exporter.failures"
+	RejectedName = "otlp.exporter.rejected_data_points"
+	SizeName     = "otlp.exporter.payload.size"
+	DurationName = "otlp.exporter.export.duration"
+)
+
+// Attribute keys used to annotate measurements.
+const (
+	SignalKey    = attribute.Key("signal")
+	TransportKey = attribute.Key("transport")
+	ErrorCodeKey = attribute.Key("error.code")
+)
+
+// unknownCode is the error code used when a failed attempt did not receive a
+// response with a status code.
+const unknownCode = "unknown"
+
+// Instrumentation records measurements about the exports made by a client.
+type Instrumentation struct {
+	attrs []attribute.KeyValue
+
+	attempts syncint64.Counter
+	failures syncint64.Counter
+	rejected syncint64.Counter
+	size     syncint64.Histogram
+	duration syncfloat64.Histogram
+}
+
+// New returns an Instrumentation that records measurements with instruments
+// from a Meter named name provided by mp. All measurements are annotated with
+// the signal and transport of the client. If mp is nil, no measurements are
+// recorded.
+//
+// If the instruments cannot be created, the error is sent to the global
+// ErrorHandler and the returned Instrumentation will not record measurements.
+func New(mp metric.MeterProvider, name, signal, transport string) *Instrumentation {
+	if mp == nil {
+		mp = metric.NewNoopMeterProvider()
+	}
+	attrs := []attribute.KeyValue{
+		SignalKey.String(signal),
+		TransportKey.String(transport),
+	}
+
+	i, err := newInstrumentation(mp.Meter(name), attrs)
+	if err != nil {
+		otel.Handle(err)
+		// The no-op Meter does not return errors.
+		i, _ = newInstrumentation(metric.NewNoopMeter(), attrs)
+	}
+	return i
+}
+
+func newInstrumentation(m metric.Meter, attrs []attribute.KeyValue) (*Instrumentation, error) {
+	i := &Instrumentation{attrs: attrs}
+
+	var err error
+	i.attempts, err = m.SyncInt64().Counter(
+		AttemptsName,
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("Number of export requests attempted, including retries"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	i.failures, err = m.SyncInt64().Counter(
+		FailuresName,
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("Number of export request attempts that failed"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	i.rejected, err = m.SyncInt64().Counter(
+		RejectedName,
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("Number of data points rejected by the server in partially successful exports"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	i.size, err = m.SyncInt64().Histogram(
+		SizeName,
+		instrument.WithUnit(unit.Bytes),
+		instrument.WithDescription("Size of the uncompressed export request payloads"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	i.duration, err = m.SyncFloat64().Histogram(
+		DurationName,
+		instrument.WithUnit(unit.Milliseconds),
+		instrument.WithDescription("Duration of exports, including all retries"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+// Attempt records an attempt to send an export request. If err is not nil,
+// the attempt is also recorded as a failure with the error code set to code.
+// An empty code is recorded as "unknown".
+func (i *Instrumentation) Attempt(ctx context.Context, code string, err error) {
+	i.attempts.Add(ctx, 1, i.attrs...)
+	if err == nil {
+		return
+	}
+
+	if code == "" {
+		code = unknownCode
+	}
+	attrs := make([]attribute.KeyValue, len(i.attrs), len(i.attrs)+1)
+	copy(attrs, i.attrs)
+	attrs = append(attrs, ErrorCodeKey.String(code))
+	i.failures.Add(ctx, 1, attrs...)
+}
+
+// Rejected records n data points were rejected by the server in response to
+// a partially successful export request.
+func (i *Instrumentation) Rejected(ctx context.Context, n int64) {
+	if n <= 0 {
+		return
+	}
+	i.rejected.Add(ctx, n, i.attrs...)
+}
+
+// Export records an export request of size bytes that took d to complete,
+// including all retries.
+func (i *Instrumentation) Export(ctx context.Context, size int, d time.Duration) {
+	i.size.Record(ctx, int64(size), i.attrs...)
+	i.duration.Record(ctx, float64(d)/float64(time.Millisecond), i.attrs...)
+}
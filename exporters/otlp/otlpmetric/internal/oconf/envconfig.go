@@ -16,13 +16,20 @@ package oconf // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/inte
 
 import (
 	"crypto/tls"
+	"crypto/x509"
+	"fmt"
 	"net/url"
 	"os"
 	"path"
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/envconfig"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/view"
 )
 
 // DefaultEnvOptionsReader is the default environments reader.
@@ -53,6 +60,7 @@ func ApplyHTTPEnvConfigs(cfg Config) Config {
 func getOptionsFromEnv() []GenericOption {
 	opts := []GenericOption{}
 
+	tlsConf := &tls.Config{}
 	DefaultEnvOptionsReader.Apply(
 		envconfig.WithURL("ENDPOINT", func(u *url.URL) {
 			opts = append(opts, withEndpointScheme(u))
@@ -81,14 +89,19 @@ func getOptionsFromEnv() []GenericOption {
 				return cfg
 			}, withEndpointForGRPC(u)))
 		}),
-		envconfig.WithTLSConfig("CERTIFICATE", func(c *tls.Config) { opts = append(opts, WithTLSClientConfig(c)) }),
-		envconfig.WithTLSConfig("METRICS_CERTIFICATE", func(c *tls.Config) { opts = append(opts, WithTLSClientConfig(c)) }),
+		envconfig.WithCertPool("CERTIFICATE", func(p *x509.CertPool) { tlsConf.RootCAs = p }),
+		envconfig.WithCertPool("METRICS_CERTIFICATE", func(p *x509.CertPool) { tlsConf.RootCAs = p }),
+		envconfig.WithClientCert("CLIENT_CERTIFICATE", "CLIENT_KEY", func(c tls.Certificate) { tlsConf.Certificates = []tls.Certificate{c} }),
+		envconfig.WithClientCert("METRICS_CLIENT_CERTIFICATE", "METRICS_CLIENT_KEY", func(c tls.Certificate) { tlsConf.Certificates = []tls.Certificate{c} }),
+		withTLSConfig(tlsConf, func(c *tls.Config) { opts = append(opts, WithTLSClientConfig(c)) }),
 		envconfig.WithHeaders("HEADERS", func(h map[string]string) { opts = append(opts, WithHeaders(h)) }),
 		envconfig.WithHeaders("METRICS_HEADERS", func(h map[string]string) { opts = append(opts, WithHeaders(h)) }),
 		WithEnvCompression("COMPRESSION", func(c Compression) { opts = append(opts, WithCompression(c)) }),
 		WithEnvCompression("METRICS_COMPRESSION", func(c Compression) { opts = append(opts, WithCompression(c)) }),
 		envconfig.WithDuration("TIMEOUT", func(d time.Duration) { opts = append(opts, WithTimeout(d)) }),
 		envconfig.WithDuration("METRICS_TIMEOUT", func(d time.Duration) { opts = append(opts, WithTimeout(d)) }),
+		withEnvTemporalityPreference("METRICS_TEMPORALITY_PREFERENCE", func(t metric.TemporalitySelector) { opts = append(opts, WithTemporalitySelector(t)) }),
+		withEnvAggPreference("METRICS_DEFAULT_HISTOGRAM_AGGREGATION", func(a metric.AggregationSelector) { opts = append(opts, WithAggregationSelector(a)) }),
 	)
 
 	return opts
@@ -98,18 +111,47 @@ func withEndpointForGRPC(u *url.URL) func(cfg Config) Config {
 	return func(cfg Config) Config {
 		// For OTLP/gRPC endpoints, this is the target to which the
 		// exporter is going to send telemetry.
-		cfg.Metrics.Endpoint = path.Join(u.Host, u.Path)
+		cfg.Metrics.Endpoint = grpcTarget(u)
 		return cfg
 	}
 }
 
+// grpcTarget returns the gRPC dial target for the endpoint u. Unix domain
+// socket endpoints retain their scheme so they are resolved by the gRPC unix
+// resolver instead of being dialed as a TCP address.
+func grpcTarget(u *url.URL) string {
+	if strings.EqualFold(u.Scheme, "unix") {
+		if u.Opaque != "" {
+			// Relative path (i.e. "unix:path/to/socket").
+			return "unix:" + u.Opaque
+		}
+		return "unix://" + path.Join(u.Host, u.Path)
+	}
+	return path.Join(u.Host, u.Path)
+}
+
+// withTLSConfig passes c to fn if it has been configured with a root CA or
+// client certificate.
+func withTLSConfig(c *tls.Config, fn func(*tls.Config)) func(e *envconfig.EnvOptionsReader) {
+	return func(e *envconfig.EnvOptionsReader) {
+		if c.RootCAs != nil || len(c.Certificates) > 0 {
+			fn(c)
+		}
+	}
+}
+
 // WithEnvCompression retrieves the specified config and passes it to ConfigFn as a Compression.
 func WithEnvCompression(n string, fn func(Compression)) func(e *envconfig.EnvOptionsReader) {
 	return func(e *envconfig.EnvOptionsReader) {
 		if v, ok := e.GetEnvValue(n); ok {
 			cp := NoCompression
-			if v == "gzip" {
+			switch v {
+			case "gzip":
 				cp = GzipCompression
+			case "zstd":
+				cp = ZstdCompression
+			case "snappy":
+				cp = SnappyCompression
 			}
 
 			fn(cp)
@@ -125,3 +167,69 @@ func withEndpointScheme(u *url.URL) GenericOption {
 		return WithSecure()
 	}
 }
+
+// withEnvTemporalityPreference retrieves the specified config and passes it
+// to fn as a TemporalitySelector.
+func withEnvTemporalityPreference(n string, fn func(metric.TemporalitySelector)) func(e *envconfig.EnvOptionsReader) {
+	return func(e *envconfig.EnvOptionsReader) {
+		if s, ok := e.GetEnvValue(n); ok {
+			switch strings.ToLower(s) {
+			case "cumulative":
+				fn(cumulativeTemporality)
+			case "delta":
+				fn(deltaTemporality)
+			case "lowmemory":
+				fn(lowMemory)
+			default:
+				otel.Handle(fmt.Errorf("invalid %s value %s, using cumulative temporality", n, s))
+			}
+		}
+	}
+}
+
+func cumulativeTemporality(view.InstrumentKind) metricdata.Temporality {
+	return metricdata.CumulativeTemporality
+}
+
+func deltaTemporality(ik view.InstrumentKind) metricdata.Temporality {
+	switch ik {
+	case view.SyncCounter, view.SyncHistogram, view.AsyncCounter:
+		return metricdata.DeltaTemporality
+	default:
+		return metricdata.CumulativeTemporality
+	}
+}
+
+func lowMemory(ik view.InstrumentKind) metricdata.Temporality {
+	switch ik {
+	case view.SyncCounter, view.SyncHistogram:
+		return metricdata.DeltaTemporality
+	default:
+		return metricdata.CumulativeTemporality
+	}
+}
+
+// withEnvAggPreference retrieves the specified config and passes it to fn as
+// an AggregationSelector.
+func withEnvAggPreference(n string, fn func(metric.AggregationSelector)) func(e *envconfig.EnvOptionsReader) {
+	return func(e *envconfig.EnvOptionsReader) {
+		if s, ok := e.GetEnvValue(n); ok {
+			switch strings.ToLower(s) {
+			case "explicit_bucket_histogram":
+				fn(metric.DefaultAggregationSelector)
+			case "base2_exponential_bucket_histogram":
+				fn(func(kind view.InstrumentKind) aggregation.Aggregation {
+					if kind == view.SyncHistogram {
+						return aggregation.ExponentialBucketHistogram{
+							MaxSize:  160,
+							MaxScale: aggregation.ExponentialMaxScale,
+						}
+					}
+					return metric.DefaultAggregationSelector(kind)
+				})
+			default:
+				otel.Handle(fmt.Errorf("invalid %s value %s, using explicit_bucket_histogram", n, s))
+			}
+		}
+	}
+}
@@ -15,8 +15,11 @@
 package oconf // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/oconf"
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
+	"net/http"
+	"net/url"
 	"time"
 
 	"google.golang.org/grpc"
@@ -26,7 +29,11 @@ import (
 	"google.golang.org/grpc/encoding/gzip"
 
 	"go.opentelemetry.io/otel/exporters/otlp/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/internal/diskqueue"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric"
 )
 
 const (
@@ -46,6 +53,10 @@ const (
 )
 
 type (
+	// HTTPTransportProxyFunc is a function that resolves which URL to use
+	// as proxy for a given request.
+	HTTPTransportProxyFunc func(*http.Request) (*url.URL, error)
+
 	SignalConfig struct {
 		Endpoint    string
 		Insecure    bool
@@ -55,8 +66,41 @@ type (
 		Timeout     time.Duration
 		URLPath     string
 
+		// HeadersProvider returns headers to send with each request in
+		// addition to Headers.
+		HeadersProvider func(context.Context) (map[string]string, error)
+
+		// MaxRequestSize is the maximum serialized size, in bytes, of an
+		// export request. Larger exports are split into multiple requests.
+		// No limit is applied if it is not positive.
+		MaxRequestSize int
+
+		// MeterProvider provides the Meter used to measure the operation of
+		// the exporter. No measurements are made if it is nil.
+		MeterProvider otelmetric.MeterProvider
+
+		// HTTP configurations
+		Proxy HTTPTransportProxyFunc
+
 		// gRPC configurations
 		GRPCCredentials credentials.TransportCredentials
+
+		TemporalitySelector metric.TemporalitySelector
+		AggregationSelector metric.AggregationSelector
+	}
+
+	// FailoverConfig defines the endpoints uploads fail over to when the
+	// configured endpoint is unavailable.
+	FailoverConfig struct {
+		// Endpoints are the endpoints to fail over to, in order of priority,
+		// after the configured endpoint.
+		Endpoints []string
+		// MaxFailures is the number of consecutive failed exports before
+		// failing over to the next endpoint.
+		MaxFailures int
+		// ProbeInterval is the minimum time between attempts to fall back
+		// to an endpoint with a higher priority than the active one.
+		ProbeInterval time.Duration
 	}
 
 	Config struct {
@@ -65,6 +109,16 @@ type (
 
 		RetryConfig retry.Config
 
+		// QueueConfig configures a file-backed queue that uploads are
+		// written to before they are sent.
+		QueueConfig diskqueue.Config
+
+		// Failover configures the endpoints to fail over to.
+		Failover FailoverConfig
+
+		// Middleware wraps all uploads of the client.
+		Middleware []otlpmetric.Middleware
+
 		// gRPC configurations
 		ReconnectionPeriod time.Duration
 		ServiceConfig      string
@@ -82,6 +136,9 @@ func NewHTTPConfig(opts ...HTTPOption) Config {
 			URLPath:     DefaultMetricsPath,
 			Compression: NoCompression,
 			Timeout:     DefaultTimeout,
+
+			TemporalitySelector: metric.DefaultTemporalitySelector,
+			AggregationSelector: metric.DefaultAggregationSelector,
 		},
 		RetryConfig: retry.DefaultConfig,
 	}
@@ -89,7 +146,6 @@ func NewHTTPConfig(opts ...HTTPOption) Config {
 	for _, opt := range opts {
 		cfg = opt.ApplyHTTPOption(cfg)
 	}
-	cfg.Metrics.URLPath = internal.CleanPath(cfg.Metrics.URLPath, DefaultMetricsPath)
 	return cfg
 }
 
@@ -102,6 +158,9 @@ func NewGRPCConfig(opts ...GRPCOption) Config {
 			URLPath:     DefaultMetricsPath,
 			Compression: NoCompression,
 			Timeout:     DefaultTimeout,
+
+			TemporalitySelector: metric.DefaultTemporalitySelector,
+			AggregationSelector: metric.DefaultAggregationSelector,
 		},
 		RetryConfig: retry.DefaultConfig,
 		DialOptions: []grpc.DialOption{grpc.WithUserAgent(internal.GetUserAgentHeader())},
@@ -125,8 +184,13 @@ func NewGRPCConfig(opts ...GRPCOption) Config {
 		cfg.Metrics.GRPCCredentials = creds
 		cfg.DialOptions = append(cfg.DialOptions, grpc.WithTransportCredentials(creds))
 	}
-	if cfg.Metrics.Compression == GzipCompression {
+	switch cfg.Metrics.Compression {
+	case GzipCompression:
 		cfg.DialOptions = append(cfg.DialOptions, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
+	case ZstdCompression:
+		cfg.DialOptions = append(cfg.DialOptions, grpc.WithDefaultCallOptions(grpc.UseCompressor(ZstdCompressorName)))
+	case SnappyCompression:
+		cfg.DialOptions = append(cfg.DialOptions, grpc.WithDefaultCallOptions(grpc.UseCompressor(SnappyCompressorName)))
 	}
 	if len(cfg.DialOptions) != 0 {
 		cfg.DialOptions = append(cfg.DialOptions, cfg.DialOptions...)
@@ -264,7 +328,7 @@ func WithCompression(compression Compression) GenericOption {
 
 func WithURLPath(urlPath string) GenericOption {
 	return newGenericOption(func(cfg Config) Config {
-		cfg.Metrics.URLPath = urlPath
+		cfg.Metrics.URLPath = internal.CleanPath(urlPath, DefaultMetricsPath)
 		return cfg
 	})
 }
@@ -276,6 +340,27 @@ func WithRetry(rc retry.Config) GenericOption {
 	})
 }
 
+func WithFailover(fc FailoverConfig) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Failover = fc
+		return cfg
+	})
+}
+
+func WithMiddleware(mw ...otlpmetric.Middleware) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Middleware = append(cfg.Middleware, mw...)
+		return cfg
+	})
+}
+
+func WithPersistentQueue(qc diskqueue.Config) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.QueueConfig = qc
+		return cfg
+	})
+}
+
 func WithTLSClientConfig(tlsCfg *tls.Config) GenericOption {
 	return newSplitOption(func(cfg Config) Config {
 		cfg.Metrics.TLSCfg = tlsCfg.Clone()
@@ -307,9 +392,51 @@ func WithHeaders(headers map[string]string) GenericOption {
 	})
 }
 
+func WithHeadersProvider(fn func(context.Context) (map[string]string, error)) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.HeadersProvider = fn
+		return cfg
+	})
+}
+
 func WithTimeout(duration time.Duration) GenericOption {
 	return newGenericOption(func(cfg Config) Config {
 		cfg.Metrics.Timeout = duration
 		return cfg
 	})
 }
+
+func WithMaxRequestSize(size int) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.MaxRequestSize = size
+		return cfg
+	})
+}
+
+func WithMeterProvider(mp otelmetric.MeterProvider) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.MeterProvider = mp
+		return cfg
+	})
+}
+
+func WithProxy(pf HTTPTransportProxyFunc) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.Proxy = pf
+		return cfg
+	})
+}
+
+func WithTemporalitySelector(selector metric.TemporalitySelector) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.TemporalitySelector = selector
+		return cfg
+	})
+}
+
+func WithAggregationSelector(selector metric.AggregationSelector) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.AggregationSelector = selector
+		return cfg
+	})
+}
@@ -23,6 +23,10 @@ import (
 
 	"go.opentelemetry.io/otel/exporters/otlp/internal/envconfig"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/oconf"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/view"
 )
 
 const (
@@ -127,6 +131,30 @@ func TestConfigs(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Test Environment Signal Specific Endpoint Path",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_METRICS_ENDPOINT": "https://env.metrics.endpoint/api/v1/otlp/v1/metrics/",
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				if !grpcOption {
+					assert.Equal(t, "env.metrics.endpoint", c.Metrics.Endpoint)
+					// Used as-is, without /v1/metrics appended or cleaning.
+					assert.Equal(t, "/api/v1/otlp/v1/metrics/", c.Metrics.URLPath)
+				}
+			},
+		},
+		{
+			name: "Test With URLPath",
+			opts: []oconf.GenericOption{
+				oconf.WithURLPath(" api//v1/otlp/v1/metrics/ "),
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				if !grpcOption {
+					assert.Equal(t, "/api/v1/otlp/v1/metrics", c.Metrics.URLPath)
+				}
+			},
+		},
 		{
 			name: "Test Mixed Environment and With Endpoint",
 			opts: []oconf.GenericOption{
@@ -149,6 +177,30 @@ func TestConfigs(t *testing.T) {
 				assert.Equal(t, true, c.Metrics.Insecure)
 			},
 		},
+		{
+			name: "Test Environment Endpoint with unix scheme",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT": "unix:///tmp/agent.sock",
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				if grpcOption {
+					assert.Equal(t, "unix:///tmp/agent.sock", c.Metrics.Endpoint)
+				}
+				assert.Equal(t, true, c.Metrics.Insecure)
+			},
+		},
+		{
+			name: "Test Environment Endpoint with relative unix scheme",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT": "unix:agent.sock",
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				if grpcOption {
+					assert.Equal(t, "unix:agent.sock", c.Metrics.Endpoint)
+				}
+				assert.Equal(t, true, c.Metrics.Insecure)
+			},
+		},
 		{
 			name: "Test Environment Endpoint with HTTP scheme and leading & trailingspaces",
 			env: map[string]string{
@@ -243,6 +295,66 @@ func TestConfigs(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Test Environment Client Certificate",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE": "cert_path",
+				"OTEL_EXPORTER_OTLP_CLIENT_KEY":         "key_path",
+			},
+			fileReader: fileReader{
+				"cert_path": []byte(WeakCertificate),
+				"key_path":  []byte(WeakPrivateKey),
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				if grpcOption {
+					assert.NotNil(t, c.Metrics.GRPCCredentials)
+				} else {
+					assert.Len(t, c.Metrics.TLSCfg.Certificates, 1)
+					assert.Nil(t, c.Metrics.TLSCfg.RootCAs)
+				}
+			},
+		},
+		{
+			name: "Test Environment Signal Specific Client Certificate",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_CERTIFICATE":                "cert_path",
+				"OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE":         "invalid_cert",
+				"OTEL_EXPORTER_OTLP_CLIENT_KEY":                 "invalid_key",
+				"OTEL_EXPORTER_OTLP_METRICS_CLIENT_CERTIFICATE": "cert_path",
+				"OTEL_EXPORTER_OTLP_METRICS_CLIENT_KEY":         "key_path",
+			},
+			fileReader: fileReader{
+				"cert_path":    []byte(WeakCertificate),
+				"key_path":     []byte(WeakPrivateKey),
+				"invalid_cert": []byte("invalid certificate file."),
+				"invalid_key":  []byte("invalid key file."),
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				if grpcOption {
+					assert.NotNil(t, c.Metrics.GRPCCredentials)
+				} else {
+					assert.Len(t, c.Metrics.TLSCfg.Certificates, 1)
+					// nolint:staticcheck // ignoring tlsCert.RootCAs.Subjects is deprecated ERR because cert does not come from SystemCertPool.
+					assert.Equal(t, tlsCert.RootCAs.Subjects(), c.Metrics.TLSCfg.RootCAs.Subjects())
+				}
+			},
+		},
+		{
+			name: "Test Environment Client Certificate Without Key",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE": "cert_path",
+			},
+			fileReader: fileReader{
+				"cert_path": []byte(WeakCertificate),
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				assert.Nil(t, c.Metrics.TLSCfg)
+				if grpcOption {
+					// Default TLS credentials are used.
+					assert.NotNil(t, c.Metrics.GRPCCredentials)
+				}
+			},
+		},
 		{
 			name: "Test Mixed Environment and With Certificate",
 			opts: []oconf.GenericOption{},
@@ -319,6 +431,24 @@ func TestConfigs(t *testing.T) {
 				assert.Equal(t, oconf.GzipCompression, c.Metrics.Compression)
 			},
 		},
+		{
+			name: "Test Environment Zstd Compression",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_COMPRESSION": "zstd",
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				assert.Equal(t, oconf.ZstdCompression, c.Metrics.Compression)
+			},
+		},
+		{
+			name: "Test Environment Snappy Compression",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_METRICS_COMPRESSION": "snappy",
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				assert.Equal(t, oconf.SnappyCompression, c.Metrics.Compression)
+			},
+		},
 		{
 			name: "Test Environment Signal Specific Compression",
 			env: map[string]string{
@@ -383,6 +513,127 @@ func TestConfigs(t *testing.T) {
 				assert.Equal(t, c.Metrics.Timeout, 5*time.Second)
 			},
 		},
+
+		// Temporality Tests
+		{
+			name: "Test With Temporality Selector",
+			opts: []oconf.GenericOption{
+				oconf.WithTemporalitySelector(deltaSelector),
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				// Function value comparisons are disallowed, test non-default
+				// behavior of a TemporalitySelector here to ensure our "catch
+				// all" was set.
+				var undefinedKind view.InstrumentKind
+				got := c.Metrics.TemporalitySelector
+				assert.Equal(t, metricdata.DeltaTemporality, got(undefinedKind))
+			},
+		},
+		{
+			name: "Test Environment Temporality Preference Delta",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE": "delta",
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				got := c.Metrics.TemporalitySelector
+				assert.Equal(t, metricdata.DeltaTemporality, got(view.SyncCounter))
+				assert.Equal(t, metricdata.DeltaTemporality, got(view.SyncHistogram))
+				assert.Equal(t, metricdata.DeltaTemporality, got(view.AsyncCounter))
+				assert.Equal(t, metricdata.CumulativeTemporality, got(view.SyncUpDownCounter))
+				assert.Equal(t, metricdata.CumulativeTemporality, got(view.AsyncUpDownCounter))
+			},
+		},
+		{
+			name: "Test Environment Temporality Preference LowMemory",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE": "LowMemory",
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				got := c.Metrics.TemporalitySelector
+				assert.Equal(t, metricdata.DeltaTemporality, got(view.SyncCounter))
+				assert.Equal(t, metricdata.DeltaTemporality, got(view.SyncHistogram))
+				assert.Equal(t, metricdata.CumulativeTemporality, got(view.AsyncCounter))
+				assert.Equal(t, metricdata.CumulativeTemporality, got(view.SyncUpDownCounter))
+				assert.Equal(t, metricdata.CumulativeTemporality, got(view.AsyncUpDownCounter))
+			},
+		},
+		{
+			name: "Test Environment Temporality Preference Cumulative",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE": "cumulative",
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				got := c.Metrics.TemporalitySelector
+				assert.Equal(t, metricdata.CumulativeTemporality, got(view.SyncCounter))
+				assert.Equal(t, metricdata.CumulativeTemporality, got(view.SyncHistogram))
+				assert.Equal(t, metricdata.CumulativeTemporality, got(view.AsyncCounter))
+			},
+		},
+		{
+			name: "Test Mixed Environment and With Temporality Selector",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE": "cumulative",
+			},
+			opts: []oconf.GenericOption{
+				oconf.WithTemporalitySelector(deltaSelector),
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				got := c.Metrics.TemporalitySelector
+				assert.Equal(t, metricdata.DeltaTemporality, got(view.SyncUpDownCounter))
+			},
+		},
+
+		// Aggregation Tests
+		{
+			name: "Test With Aggregation Selector",
+			opts: []oconf.GenericOption{
+				oconf.WithAggregationSelector(dropSelector),
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				var undefinedKind view.InstrumentKind
+				got := c.Metrics.AggregationSelector
+				assert.Equal(t, aggregation.Drop{}, got(undefinedKind))
+			},
+		},
+		{
+			name: "Test Environment Default Histogram Aggregation",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_METRICS_DEFAULT_HISTOGRAM_AGGREGATION": "explicit_bucket_histogram",
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				got := c.Metrics.AggregationSelector
+				want := metric.DefaultAggregationSelector(view.SyncHistogram)
+				assert.Equal(t, want, got(view.SyncHistogram))
+			},
+		},
+		{
+			name: "Test Environment Exponential Histogram Aggregation",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_METRICS_DEFAULT_HISTOGRAM_AGGREGATION": "base2_exponential_bucket_histogram",
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				got := c.Metrics.AggregationSelector
+				want := aggregation.ExponentialBucketHistogram{
+					MaxSize:  160,
+					MaxScale: aggregation.ExponentialMaxScale,
+				}
+				assert.Equal(t, want, got(view.SyncHistogram))
+				assert.Equal(t, aggregation.Sum{}, got(view.SyncCounter))
+			},
+		},
+		{
+			name: "Test Mixed Environment and With Aggregation Selector",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_METRICS_DEFAULT_HISTOGRAM_AGGREGATION": "explicit_bucket_histogram",
+			},
+			opts: []oconf.GenericOption{
+				oconf.WithAggregationSelector(dropSelector),
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				got := c.Metrics.AggregationSelector
+				assert.Equal(t, aggregation.Drop{}, got(view.SyncHistogram))
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -406,6 +657,14 @@ func TestConfigs(t *testing.T) {
 	}
 }
 
+func deltaSelector(view.InstrumentKind) metricdata.Temporality {
+	return metricdata.DeltaTemporality
+}
+
+func dropSelector(view.InstrumentKind) aggregation.Aggregation {
+	return aggregation.Drop{}
+}
+
 func asHTTPOptions(opts []oconf.GenericOption) []oconf.HTTPOption {
 	converted := make([]oconf.HTTPOption, len(opts))
 	for i, o := range opts {
@@ -37,6 +37,12 @@ const (
 	// GzipCompression tells the driver to send payloads after
 	// compressing them with gzip.
 	GzipCompression
+	// ZstdCompression tells the driver to send payloads after
+	// compressing them with zstd.
+	ZstdCompression
+	// SnappyCompression tells the driver to send payloads after
+	// compressing them with snappy.
+	SnappyCompression
 )
 
 // RetrySettings defines configuration for retrying batches in case of export failure
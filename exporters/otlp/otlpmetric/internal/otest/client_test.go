@@ -18,12 +18,19 @@ import (
 	"context"
 	"testing"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/internal"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/view"
 	cpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
 	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
 )
 
 type client struct {
+	rCh     <-chan ExportResult
 	storage *Storage
 }
 
@@ -31,10 +38,31 @@ func (c *client) Collect() *Storage {
 	return c.storage
 }
 
+func (c *client) Temporality(k view.InstrumentKind) metricdata.Temporality {
+	return metric.DefaultTemporalitySelector(k)
+}
+
+func (c *client) Aggregation(k view.InstrumentKind) aggregation.Aggregation {
+	return metric.DefaultAggregationSelector(k)
+}
+
 func (c *client) UploadMetrics(ctx context.Context, rm *mpb.ResourceMetrics) error {
 	c.storage.Add(&cpb.ExportMetricsServiceRequest{
 		ResourceMetrics: []*mpb.ResourceMetrics{rm},
 	})
+	if c.rCh != nil {
+		r := <-c.rCh
+		if r.Response != nil && r.Response.GetPartialSuccess() != nil {
+			msg := r.Response.GetPartialSuccess().GetErrorMessage()
+			n := r.Response.GetPartialSuccess().GetRejectedDataPoints()
+			if msg != "" || n != 0 {
+				otel.Handle(internal.PartialSuccessToError(
+					internal.MetricsPartialSuccess, n, msg,
+				))
+			}
+		}
+		return r.Err
+	}
 	return ctx.Err()
 }
 
@@ -42,8 +70,8 @@ func (c *client) ForceFlush(ctx context.Context) error { return ctx.Err() }
 func (c *client) Shutdown(ctx context.Context) error   { return ctx.Err() }
 
 func TestClientTests(t *testing.T) {
-	factory := func() (otlpmetric.Client, Collector) {
-		c := &client{storage: NewStorage()}
+	factory := func(rCh <-chan ExportResult) (otlpmetric.Client, Collector) {
+		c := &client{rCh: rCh, storage: NewStorage()}
 		return c, c
 	}
 
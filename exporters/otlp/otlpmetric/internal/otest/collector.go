@@ -33,9 +33,12 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
@@ -50,6 +53,12 @@ type Collector interface {
 	Collect() *Storage
 }
 
+// ExportResult is the response a Collector returns to an export request.
+type ExportResult struct {
+	Response *collpb.ExportMetricsServiceResponse
+	Err      error
+}
+
 // Storage stores uploaded OTLP metric data in their proto form.
 type Storage struct {
 	dataMu sync.Mutex
@@ -86,7 +95,7 @@ type GRPCCollector struct {
 	headers   metadata.MD
 	storage   *Storage
 
-	errCh    <-chan error
+	resultCh <-chan ExportResult
 	listener net.Listener
 	srv      *grpc.Server
 }
@@ -95,23 +104,29 @@ type GRPCCollector struct {
 // endpoint.
 //
 // If endpoint is an empty string, the returned collector will be listeing on
-// the localhost interface at an OS chosen port.
+// the localhost interface at an OS chosen port. If endpoint has a "unix://"
+// prefix, the collector will listen on a Unix domain socket at the path that
+// follows.
 //
-// If errCh is not nil, the collector will respond to Export calls with errors
-// sent on that channel. This means that if errCh is not nil Export calls will
-// block until an error is received.
-func NewGRPCCollector(endpoint string, errCh <-chan error) (*GRPCCollector, error) {
+// If resultCh is not nil, the collector will respond to Export calls with
+// results sent on that channel. This means that if resultCh is not nil Export
+// calls will block until a result is received.
+func NewGRPCCollector(endpoint string, resultCh <-chan ExportResult) (*GRPCCollector, error) {
 	if endpoint == "" {
 		endpoint = "localhost:0"
 	}
 
 	c := &GRPCCollector{
-		storage: NewStorage(),
-		errCh:   errCh,
+		storage:  NewStorage(),
+		resultCh: resultCh,
 	}
 
 	var err error
-	c.listener, err = net.Listen("tcp", endpoint)
+	if path := strings.TrimPrefix(endpoint, "unix://"); path != endpoint {
+		c.listener, err = net.Listen("unix", path)
+	} else {
+		c.listener, err = net.Listen("tcp", endpoint)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -155,22 +170,16 @@ func (c *GRPCCollector) Export(ctx context.Context, req *collpb.ExportMetricsSer
 		c.headersMu.Unlock()
 	}
 
-	var err error
-	if c.errCh != nil {
-		err = <-c.errCh
+	if c.resultCh != nil {
+		r := <-c.resultCh
+		if r.Response == nil {
+			return &collpb.ExportMetricsServiceResponse{}, r.Err
+		}
+		return r.Response, r.Err
 	}
-	return &collpb.ExportMetricsServiceResponse{}, err
+	return &collpb.ExportMetricsServiceResponse{}, nil
 }
 
-var emptyExportMetricsServiceResponse = func() []byte {
-	body := collpb.ExportMetricsServiceResponse{}
-	r, err := proto.Marshal(&body)
-	if err != nil {
-		panic(err)
-	}
-	return r
-}()
-
 type HTTPResponseError struct {
 	Err    error
 	Status int
@@ -189,7 +198,7 @@ type HTTPCollector struct {
 	headers   http.Header
 	storage   *Storage
 
-	errCh    <-chan error
+	resultCh <-chan ExportResult
 	listener net.Listener
 	srv      *http.Server
 }
@@ -204,10 +213,10 @@ type HTTPCollector struct {
 // certificates and use them to server data. If the endpoint contains a path,
 // that path will be used instead of the default OTLP metric endpoint path.
 //
-// If errCh is not nil, the collector will respond to HTTP requests with errors
-// sent on that channel. This means that if errCh is not nil Export calls will
-// block until an error is received.
-func NewHTTPCollector(endpoint string, errCh <-chan error) (*HTTPCollector, error) {
+// If resultCh is not nil, the collector will respond to HTTP requests with
+// results sent on that channel. This means that if resultCh is not nil Export
+// calls will block until a result is received.
+func NewHTTPCollector(endpoint string, resultCh <-chan ExportResult) (*HTTPCollector, error) {
 	u, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, err
@@ -220,9 +229,9 @@ func NewHTTPCollector(endpoint string, errCh <-chan error) (*HTTPCollector, erro
 	}
 
 	c := &HTTPCollector{
-		headers: http.Header{},
-		storage: NewStorage(),
-		errCh:   errCh,
+		headers:  http.Header{},
+		storage:  NewStorage(),
+		resultCh: resultCh,
 	}
 
 	c.listener, err = net.Listen("tcp", u.Host)
@@ -276,22 +285,25 @@ func (c *HTTPCollector) handler(w http.ResponseWriter, r *http.Request) {
 	c.respond(w, c.record(r))
 }
 
-func (c *HTTPCollector) record(r *http.Request) error {
+func (c *HTTPCollector) record(r *http.Request) ExportResult {
 	// Currently only supports protobuf.
 	if v := r.Header.Get("Content-Type"); v != "application/x-protobuf" {
-		return fmt.Errorf("content-type not supported: %s", v)
+		err := fmt.Errorf("content-type not supported: %s", v)
+		return ExportResult{Err: err}
 	}
 
 	body, err := c.readBody(r)
 	if err != nil {
-		return err
+		return ExportResult{Err: err}
 	}
 	pbRequest := &collpb.ExportMetricsServiceRequest{}
 	err = proto.Unmarshal(body, pbRequest)
 	if err != nil {
-		return &HTTPResponseError{
-			Err:    err,
-			Status: http.StatusInternalServerError,
+		return ExportResult{
+			Err: &HTTPResponseError{
+				Err:    err,
+				Status: http.StatusInternalServerError,
+			},
 		}
 	}
 	c.storage.Add(pbRequest)
@@ -304,10 +316,10 @@ func (c *HTTPCollector) record(r *http.Request) error {
 	}
 	c.headersMu.Unlock()
 
-	if c.errCh != nil {
-		err = <-c.errCh
+	if c.resultCh != nil {
+		return <-c.resultCh
 	}
-	return err
+	return ExportResult{Err: err}
 }
 
 func (c *HTTPCollector) readBody(r *http.Request) (body []byte, err error) {
@@ -322,6 +334,17 @@ func (c *HTTPCollector) readBody(r *http.Request) (body []byte, err error) {
 				Status: http.StatusInternalServerError,
 			}
 		}
+	case "zstd":
+		dec, err := zstd.NewReader(r.Body)
+		if err != nil {
+			return nil, &HTTPResponseError{
+				Err:    err,
+				Status: http.StatusInternalServerError,
+			}
+		}
+		reader = dec.IOReadCloser()
+	case "snappy":
+		reader = io.NopCloser(snappy.NewReader(r.Body))
 	default:
 		reader = r.Body
 	}
@@ -345,12 +368,12 @@ func (c *HTTPCollector) readBody(r *http.Request) (body []byte, err error) {
 	return body, err
 }
 
-func (c *HTTPCollector) respond(w http.ResponseWriter, err error) {
-	if err != nil {
+func (c *HTTPCollector) respond(w http.ResponseWriter, resp ExportResult) {
+	if resp.Err != nil {
 		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
 		w.Header().Set("X-Content-Type-Options", "nosniff")
 		var e *HTTPResponseError
-		if errors.As(err, &e) {
+		if errors.As(resp.Err, &e) {
 			for k, vals := range e.Header {
 				for _, v := range vals {
 					w.Header().Add(k, v)
@@ -360,16 +383,33 @@ func (c *HTTPCollector) respond(w http.ResponseWriter, err error) {
 			fmt.Fprintln(w, e.Error())
 		} else {
 			w.WriteHeader(http.StatusBadRequest)
-			fmt.Fprintln(w, err.Error())
+			fmt.Fprintln(w, resp.Err.Error())
 		}
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/x-protobuf")
 	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(emptyExportMetricsServiceResponse)
+	if resp.Response == nil {
+		_, _ = w.Write(emptyExportMetricsServiceResponse)
+	} else {
+		r, err := proto.Marshal(resp.Response)
+		if err != nil {
+			panic(err)
+		}
+		_, _ = w.Write(r)
+	}
 }
 
+var emptyExportMetricsServiceResponse = func() []byte {
+	body := collpb.ExportMetricsServiceResponse{}
+	r, err := proto.Marshal(&body)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}()
+
 type mathRandReader struct{}
 
 func (mathRandReader) Read(p []byte) (n int, err error) {
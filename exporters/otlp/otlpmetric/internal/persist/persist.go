@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package persist provides an otlpmetric.Client that writes uploads to a
+// file-backed queue before they are sent.
+package persist // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/persist"
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel/exporters/otlp/internal/diskqueue"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+type client struct {
+	otlpmetric.Client
+
+	queue     *diskqueue.Queue
+	permanent func(error) bool
+}
+
+// NewClient returns a Client that persists each upload to the queue
+// configured by cfg and sends it with c in the background. Uploads persisted
+// by a previous Client using the same directory are sent as well.
+//
+// Uploads that fail with an error permanent reports as true are dropped
+// instead of being sent again.
+func NewClient(c otlpmetric.Client, cfg diskqueue.Config, permanent func(error) bool) (otlpmetric.Client, error) {
+	pc := &client{Client: c, permanent: permanent}
+	pc.queue = diskqueue.New(cfg, pc.send)
+	if err := pc.queue.Start(); err != nil {
+		return nil, err
+	}
+	return pc, nil
+}
+
+// UploadMetrics persists protoMetrics to the queue. It returns once the data
+// is written to disk, not once it is sent.
+func (c *client) UploadMetrics(_ context.Context, protoMetrics *mpb.ResourceMetrics) error {
+	payload, err := proto.Marshal(protoMetrics)
+	if err != nil {
+		return err
+	}
+	return c.queue.Enqueue(payload)
+}
+
+// ForceFlush sends all queued data and then flushes the wrapped client.
+func (c *client) ForceFlush(ctx context.Context) error {
+	if err := c.queue.Flush(ctx); err != nil {
+		return err
+	}
+	return c.Client.ForceFlush(ctx)
+}
+
+// Shutdown makes a final attempt to send all queued data before shutting
+// down the wrapped client. Data that could not be sent remains on disk.
+func (c *client) Shutdown(ctx context.Context) error {
+	err := c.queue.Stop(ctx)
+	if sErr := c.Client.Shutdown(ctx); err == nil {
+		err = sErr
+	}
+	return err
+}
+
+func (c *client) send(ctx context.Context, payload []byte) error {
+	rm := new(mpb.ResourceMetrics)
+	if err := proto.Unmarshal(payload, rm); err != nil {
+		// Sending again will not fix this, drop the payload.
+		return diskqueue.Permanent(fmt.Errorf("invalid metric data: %w", err))
+	}
+	err := c.Client.UploadMetrics(ctx, rm)
+	if err != nil && c.permanent(err) {
+		return diskqueue.Permanent(err)
+	}
+	return err
+}
@@ -15,6 +15,8 @@
 package transform // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/transform"
 
 import (
+	"sort"
+
 	"go.opentelemetry.io/otel/attribute"
 	cpb "go.opentelemetry.io/proto/otlp/common/v1"
 )
@@ -95,6 +97,21 @@ func Value(v attribute.Value) *cpb.AnyValue {
 				Values: stringSliceValues(v.AsStringSlice()),
 			},
 		}
+	case attribute.BYTES:
+		av.Value = &cpb.AnyValue_BytesValue{
+			BytesValue: v.AsBytes(),
+		}
+	case attribute.TIMESTAMP:
+		// Timestamps are represented as nanoseconds since the Unix epoch.
+		av.Value = &cpb.AnyValue_IntValue{
+			IntValue: v.AsTimestamp().UnixNano(),
+		}
+	case attribute.MAP:
+		av.Value = &cpb.AnyValue_KvlistValue{
+			KvlistValue: &cpb.KeyValueList{
+				Values: mapValues(v.AsMap()),
+			},
+		}
 	default:
 		av.Value = &cpb.AnyValue_StringValue{
 			StringValue: "INVALID",
@@ -139,6 +156,21 @@ func float64SliceValues(vals []float64) []*cpb.AnyValue {
 	return converted
 }
 
+// mapValues transforms m into OTLP key-values sorted by key.
+func mapValues(m map[string]attribute.Value) []*cpb.KeyValue {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	converted := make([]*cpb.KeyValue, len(keys))
+	for i, k := range keys {
+		converted[i] = &cpb.KeyValue{Key: k, Value: Value(m[k])}
+	}
+	return converted
+}
+
 func stringSliceValues(vals []string) []*cpb.AnyValue {
 	converted := make([]*cpb.AnyValue, len(vals))
 	for i, v := range vals {
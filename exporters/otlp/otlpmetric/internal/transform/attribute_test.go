@@ -16,6 +16,7 @@ package transform // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -34,10 +35,16 @@ var (
 	attrFloat64Slice = attribute.Float64Slice("float64 slice", []float64{-1, 1})
 	attrString       = attribute.String("string", "o")
 	attrStringSlice  = attribute.StringSlice("string slice", []string{"o", "n"})
+	attrBytes        = attribute.Bytes("bytes", []byte{0x0, 0xff})
+	attrTimestamp    = attribute.Timestamp("timestamp", time.Unix(0, 1))
 	attrInvalid      = attribute.KeyValue{
 		Key:   attribute.Key("invalid"),
 		Value: attribute.Value{},
 	}
+	attrMap = attribute.Map("map", map[string]attribute.Value{
+		"string": attribute.StringValue("o"),
+		"bool":   attribute.BoolValue(true),
+	})
 
 	valBoolTrue  = &cpb.AnyValue{Value: &cpb.AnyValue_BoolValue{BoolValue: true}}
 	valBoolFalse = &cpb.AnyValue{Value: &cpb.AnyValue_BoolValue{BoolValue: false}}
@@ -68,6 +75,19 @@ var (
 		},
 	}}
 
+	valBytes = &cpb.AnyValue{Value: &cpb.AnyValue_BytesValue{
+		BytesValue: []byte{0x0, 0xff},
+	}}
+
+	valMap = &cpb.AnyValue{Value: &cpb.AnyValue_KvlistValue{
+		KvlistValue: &cpb.KeyValueList{
+			Values: []*cpb.KeyValue{
+				{Key: "bool", Value: valBoolTrue},
+				{Key: "string", Value: valStrO},
+			},
+		},
+	}}
+
 	kvBool         = &cpb.KeyValue{Key: "bool", Value: valBoolTrue}
 	kvBoolSlice    = &cpb.KeyValue{Key: "bool slice", Value: valBoolSlice}
 	kvInt          = &cpb.KeyValue{Key: "int", Value: valIntOne}
@@ -78,6 +98,9 @@ var (
 	kvFloat64Slice = &cpb.KeyValue{Key: "float64 slice", Value: valDblSlice}
 	kvString       = &cpb.KeyValue{Key: "string", Value: valStrO}
 	kvStringSlice  = &cpb.KeyValue{Key: "string slice", Value: valStrSlice}
+	kvBytes        = &cpb.KeyValue{Key: "bytes", Value: valBytes}
+	kvTimestamp    = &cpb.KeyValue{Key: "timestamp", Value: valIntOne}
+	kvMap          = &cpb.KeyValue{Key: "map", Value: valMap}
 	kvInvalid      = &cpb.KeyValue{
 		Key: "invalid",
 		Value: &cpb.AnyValue{
@@ -151,6 +174,21 @@ func TestAttributeTransforms(t *testing.T) {
 			[]attribute.KeyValue{attrStringSlice},
 			[]*cpb.KeyValue{kvStringSlice},
 		},
+		{
+			"bytes",
+			[]attribute.KeyValue{attrBytes},
+			[]*cpb.KeyValue{kvBytes},
+		},
+		{
+			"timestamp",
+			[]attribute.KeyValue{attrTimestamp},
+			[]*cpb.KeyValue{kvTimestamp},
+		},
+		{
+			"map",
+			[]attribute.KeyValue{attrMap},
+			[]*cpb.KeyValue{kvMap},
+		},
 		{
 			"all",
 			[]attribute.KeyValue{
@@ -164,6 +202,9 @@ func TestAttributeTransforms(t *testing.T) {
 				attrFloat64Slice,
 				attrString,
 				attrStringSlice,
+				attrBytes,
+				attrTimestamp,
+				attrMap,
 				attrInvalid,
 			},
 			[]*cpb.KeyValue{
@@ -177,6 +218,9 @@ func TestAttributeTransforms(t *testing.T) {
 				kvFloat64Slice,
 				kvString,
 				kvStringSlice,
+				kvBytes,
+				kvTimestamp,
+				kvMap,
 				kvInvalid,
 			},
 		},
@@ -51,6 +51,39 @@ var (
 		Value: &cpb.AnyValue_StringValue{StringValue: "bob"},
 	}}
 
+	spanID  = []byte{0, 0, 0, 0, 0, 0, 0, 1}
+	traceID = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}
+
+	otelExemplarInt64 = metricdata.Exemplar[int64]{
+		FilteredAttributes: []attribute.KeyValue{attribute.String("user", "alice")},
+		Time:               end,
+		Value:              1,
+		SpanID:             spanID,
+		TraceID:            traceID,
+	}
+	otelExemplarFloat64 = metricdata.Exemplar[float64]{
+		FilteredAttributes: []attribute.KeyValue{attribute.String("user", "alice")},
+		Time:               end,
+		Value:              1.0,
+		SpanID:             spanID,
+		TraceID:            traceID,
+	}
+
+	pbExemplarInt64 = &mpb.Exemplar{
+		FilteredAttributes: []*cpb.KeyValue{pbAlice},
+		TimeUnixNano:       uint64(end.UnixNano()),
+		Value:              &mpb.Exemplar_AsInt{AsInt: 1},
+		SpanId:             spanID,
+		TraceId:            traceID,
+	}
+	pbExemplarFloat64 = &mpb.Exemplar{
+		FilteredAttributes: []*cpb.KeyValue{pbAlice},
+		TimeUnixNano:       uint64(end.UnixNano()),
+		Value:              &mpb.Exemplar_AsDouble{AsDouble: 1.0},
+		SpanId:             spanID,
+		TraceId:            traceID,
+	}
+
 	minA, maxA, sumA = 2.0, 4.0, 90.0
 	minB, maxB, sumB = 4.0, 150.0, 234.0
 	otelHDP          = []metricdata.HistogramDataPoint{{
@@ -63,6 +96,7 @@ var (
 		Min:          &minA,
 		Max:          &maxA,
 		Sum:          sumA,
+		Exemplars:    []metricdata.Exemplar[float64]{otelExemplarFloat64},
 	}, {
 		Attributes:   bob,
 		StartTime:    start,
@@ -85,6 +119,7 @@ var (
 		BucketCounts:      []uint64{0, 30, 0},
 		Min:               &minA,
 		Max:               &maxA,
+		Exemplars:         []*mpb.Exemplar{pbExemplarFloat64},
 	}, {
 		Attributes:        []*cpb.KeyValue{pbBob},
 		StartTimeUnixNano: uint64(start.UnixNano()),
@@ -112,12 +147,129 @@ var (
 		DataPoints:             pbHDP,
 	}
 
+	otelExpoHDP = []metricdata.ExponentialHistogramDataPoint{{
+		Attributes:     alice,
+		StartTime:      start,
+		Time:           end,
+		Count:          30,
+		Min:            &minA,
+		Max:            &maxA,
+		Sum:            sumA,
+		Scale:          -1,
+		ZeroCount:      1,
+		PositiveBucket: metricdata.ExponentialBucket{Offset: -1, Counts: []uint64{1, 28}},
+		Exemplars:      []metricdata.Exemplar[float64]{otelExemplarFloat64},
+	}, {
+		Attributes:     bob,
+		StartTime:      start,
+		Time:           end,
+		Count:          3,
+		Min:            &minB,
+		Max:            &maxB,
+		Sum:            sumB,
+		Scale:          1,
+		PositiveBucket: metricdata.ExponentialBucket{Offset: 2, Counts: []uint64{1}},
+		NegativeBucket: metricdata.ExponentialBucket{Offset: 0, Counts: []uint64{1, 1}},
+	}}
+
+	pbExpoHDP = []*mpb.ExponentialHistogramDataPoint{{
+		Attributes:        []*cpb.KeyValue{pbAlice},
+		StartTimeUnixNano: uint64(start.UnixNano()),
+		TimeUnixNano:      uint64(end.UnixNano()),
+		Count:             30,
+		Sum:               &sumA,
+		Scale:             -1,
+		ZeroCount:         1,
+		Positive: &mpb.ExponentialHistogramDataPoint_Buckets{
+			Offset:       -1,
+			BucketCounts: []uint64{1, 28},
+		},
+		Negative:  &mpb.ExponentialHistogramDataPoint_Buckets{},
+		Min:       &minA,
+		Max:       &maxA,
+		Exemplars: []*mpb.Exemplar{pbExemplarFloat64},
+	}, {
+		Attributes:        []*cpb.KeyValue{pbBob},
+		StartTimeUnixNano: uint64(start.UnixNano()),
+		TimeUnixNano:      uint64(end.UnixNano()),
+		Count:             3,
+		Sum:               &sumB,
+		Scale:             1,
+		Positive: &mpb.ExponentialHistogramDataPoint_Buckets{
+			Offset:       2,
+			BucketCounts: []uint64{1},
+		},
+		Negative: &mpb.ExponentialHistogramDataPoint_Buckets{
+			Offset:       0,
+			BucketCounts: []uint64{1, 1},
+		},
+		Min: &minB,
+		Max: &maxB,
+	}}
+
+	otelExpoHist = metricdata.ExponentialHistogram{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints:  otelExpoHDP,
+	}
+	otelExpoHistInvalid = metricdata.ExponentialHistogram{
+		Temporality: invalidTemporality,
+		DataPoints:  otelExpoHDP,
+	}
+
+	pbExpoHist = &mpb.ExponentialHistogram{
+		AggregationTemporality: mpb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+		DataPoints:             pbExpoHDP,
+	}
+
+	otelSDP = []metricdata.SummaryDataPoint{{
+		Attributes: alice,
+		StartTime:  start,
+		Time:       end,
+		Count:      30,
+		Sum:        sumA,
+		QuantileValues: []metricdata.QuantileValue{
+			{Quantile: 0.5, Value: 1},
+			{Quantile: 1, Value: maxA},
+		},
+	}, {
+		Attributes: bob,
+		StartTime:  start,
+		Time:       end,
+		Count:      3,
+		Sum:        sumB,
+	}}
+
+	pbSDP = []*mpb.SummaryDataPoint{{
+		Attributes:        []*cpb.KeyValue{pbAlice},
+		StartTimeUnixNano: uint64(start.UnixNano()),
+		TimeUnixNano:      uint64(end.UnixNano()),
+		Count:             30,
+		Sum:               sumA,
+		QuantileValues: []*mpb.SummaryDataPoint_ValueAtQuantile{
+			{Quantile: 0.5, Value: 1},
+			{Quantile: 1, Value: maxA},
+		},
+	}, {
+		Attributes:        []*cpb.KeyValue{pbBob},
+		StartTimeUnixNano: uint64(start.UnixNano()),
+		TimeUnixNano:      uint64(end.UnixNano()),
+		Count:             3,
+		Sum:               sumB,
+	}}
+
+	otelSummary = metricdata.Summary{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints:  otelSDP,
+	}
+
+	pbSummary = &mpb.Summary{DataPoints: pbSDP}
+
 	otelDPtsInt64 = []metricdata.DataPoint[int64]{
-		{Attributes: alice, StartTime: start, Time: end, Value: 1},
+		{Attributes: alice, StartTime: start, Time: end, Value: 1, Exemplars: []metricdata.Exemplar[int64]{otelExemplarInt64}},
 		{Attributes: bob, StartTime: start, Time: end, Value: 2},
 	}
 	otelDPtsFloat64 = []metricdata.DataPoint[float64]{
-		{Attributes: alice, StartTime: start, Time: end, Value: 1.0},
+		{Attributes: alice, StartTime: start, Time: end, Value: 1.0, Exemplars: []metricdata.Exemplar[float64]{otelExemplarFloat64}},
 		{Attributes: bob, StartTime: start, Time: end, Value: 2.0},
 	}
 
@@ -127,6 +279,7 @@ var (
 			StartTimeUnixNano: uint64(start.UnixNano()),
 			TimeUnixNano:      uint64(end.UnixNano()),
 			Value:             &mpb.NumberDataPoint_AsInt{AsInt: 1},
+			Exemplars:         []*mpb.Exemplar{pbExemplarInt64},
 		},
 		{
 			Attributes:        []*cpb.KeyValue{pbBob},
@@ -141,6 +294,7 @@ var (
 			StartTimeUnixNano: uint64(start.UnixNano()),
 			TimeUnixNano:      uint64(end.UnixNano()),
 			Value:             &mpb.NumberDataPoint_AsDouble{AsDouble: 1.0},
+			Exemplars:         []*mpb.Exemplar{pbExemplarFloat64},
 		},
 		{
 			Attributes:        []*cpb.KeyValue{pbBob},
@@ -227,6 +381,24 @@ var (
 			Unit:        unit.Dimensionless,
 			Data:        otelHistInvalid,
 		},
+		{
+			Name:        "exponential-histogram",
+			Description: "Exponential histogram",
+			Unit:        unit.Dimensionless,
+			Data:        otelExpoHist,
+		},
+		{
+			Name:        "invalid-exponential-histogram",
+			Description: "Invalid exponential histogram",
+			Unit:        unit.Dimensionless,
+			Data:        otelExpoHistInvalid,
+		},
+		{
+			Name:        "summary",
+			Description: "Summary",
+			Unit:        unit.Dimensionless,
+			Data:        otelSummary,
+		},
 		{
 			Name:        "unknown",
 			Description: "Unknown aggregation",
@@ -266,21 +438,35 @@ var (
 			Unit:        string(unit.Dimensionless),
 			Data:        &mpb.Metric_Histogram{Histogram: pbHist},
 		},
+		{
+			Name:        "exponential-histogram",
+			Description: "Exponential histogram",
+			Unit:        string(unit.Dimensionless),
+			Data:        &mpb.Metric_ExponentialHistogram{ExponentialHistogram: pbExpoHist},
+		},
+		{
+			Name:        "summary",
+			Description: "Summary",
+			Unit:        string(unit.Dimensionless),
+			Data:        &mpb.Metric_Summary{Summary: pbSummary},
+		},
 	}
 
 	otelScopeMetrics = []metricdata.ScopeMetrics{{
 		Scope: instrumentation.Scope{
-			Name:      "test/code/path",
-			Version:   "v0.1.0",
-			SchemaURL: semconv.SchemaURL,
+			Name:       "test/code/path",
+			Version:    "v0.1.0",
+			SchemaURL:  semconv.SchemaURL,
+			Attributes: alice,
 		},
 		Metrics: otelMetrics,
 	}}
 
 	pbScopeMetrics = []*mpb.ScopeMetrics{{
 		Scope: &cpb.InstrumentationScope{
-			Name:    "test/code/path",
-			Version: "v0.1.0",
+			Name:       "test/code/path",
+			Version:    "v0.1.0",
+			Attributes: []*cpb.KeyValue{pbAlice},
 		},
 		Metrics:   pbMetrics,
 		SchemaUrl: semconv.SchemaURL,
@@ -321,6 +507,22 @@ var (
 	}
 )
 
+func TestHistogramDataPointsWithoutMinMax(t *testing.T) {
+	hdp := otelHDP[1]
+	hdp.Min, hdp.Max = nil, nil
+	pbH := HistogramDataPoints([]metricdata.HistogramDataPoint{hdp})
+	require.Len(t, pbH, 1)
+	assert.Nil(t, pbH[0].Min, "histogram min")
+	assert.Nil(t, pbH[0].Max, "histogram max")
+
+	ehdp := otelExpoHDP[1]
+	ehdp.Min, ehdp.Max = nil, nil
+	pbEH := ExponentialHistogramDataPoints([]metricdata.ExponentialHistogramDataPoint{ehdp})
+	require.Len(t, pbEH, 1)
+	assert.Nil(t, pbEH[0].Min, "exponential histogram min")
+	assert.Nil(t, pbEH[0].Max, "exponential histogram max")
+}
+
 func TestTransformations(t *testing.T) {
 	// Run tests from the "bottom-up" of the metricdata data-types and halt
 	// when a failure occurs to ensure the clearest failure message (as
@@ -329,6 +531,8 @@ func TestTransformations(t *testing.T) {
 
 	// DataPoint types.
 	assert.Equal(t, pbHDP, HistogramDataPoints(otelHDP))
+	assert.Equal(t, pbExpoHDP, ExponentialHistogramDataPoints(otelExpoHDP))
+	assert.Equal(t, pbSDP, SummaryDataPoints(otelSDP))
 	assert.Equal(t, pbDPtsInt64, DataPoints[int64](otelDPtsInt64))
 	require.Equal(t, pbDPtsFloat64, DataPoints[float64](otelDPtsFloat64))
 
@@ -340,6 +544,15 @@ func TestTransformations(t *testing.T) {
 	assert.ErrorIs(t, err, errUnknownTemporality)
 	assert.Nil(t, h)
 
+	eh, err := ExponentialHistogram(otelExpoHist)
+	assert.NoError(t, err)
+	assert.Equal(t, &mpb.Metric_ExponentialHistogram{ExponentialHistogram: pbExpoHist}, eh)
+	eh, err = ExponentialHistogram(otelExpoHistInvalid)
+	assert.ErrorIs(t, err, errUnknownTemporality)
+	assert.Nil(t, eh)
+
+	assert.Equal(t, &mpb.Metric_Summary{Summary: pbSummary}, Summary(otelSummary))
+
 	s, err := Sum[int64](otelSumInt64)
 	assert.NoError(t, err)
 	assert.Equal(t, &mpb.Metric_Sum{Sum: pbSumInt64}, s)
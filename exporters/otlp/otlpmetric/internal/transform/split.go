@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/transform"
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	cmpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+// Split splits rm into ResourceMetrics that are each no larger than limit
+// bytes when serialized as the sole member of an ExportMetricsServiceRequest.
+//
+// Splitting is done on data point boundaries. A single data point that is
+// larger than limit is returned in its own ResourceMetrics even though it
+// exceeds the limit. If limit is not positive or rm is already within the
+// limit, rm is returned unmodified.
+func Split(rm *mpb.ResourceMetrics, limit int) []*mpb.ResourceMetrics {
+	if limit <= 0 || requestSize(rm) <= limit {
+		return []*mpb.ResourceMetrics{rm}
+	}
+
+	n := resourceDataPoints(rm)
+	if n <= 1 {
+		return []*mpb.ResourceMetrics{rm}
+	}
+
+	head, tail := splitResourceMetrics(rm, n/2)
+	return append(Split(head, limit), Split(tail, limit)...)
+}
+
+// requestSize returns the serialized size of an export request containing
+// only rm.
+func requestSize(rm *mpb.ResourceMetrics) int {
+	return proto.Size(&cmpb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*mpb.ResourceMetrics{rm},
+	})
+}
+
+// resourceDataPoints returns the number of data points contained in rm.
+func resourceDataPoints(rm *mpb.ResourceMetrics) int {
+	var n int
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			n += metricDataPoints(m)
+		}
+	}
+	return n
+}
+
+// metricDataPoints returns the number of data points contained in m.
+func metricDataPoints(m *mpb.Metric) int {
+	switch d := m.Data.(type) {
+	case *mpb.Metric_Gauge:
+		return len(d.Gauge.DataPoints)
+	case *mpb.Metric_Sum:
+		return len(d.Sum.DataPoints)
+	case *mpb.Metric_Histogram:
+		return len(d.Histogram.DataPoints)
+	case *mpb.Metric_ExponentialHistogram:
+		return len(d.ExponentialHistogram.DataPoints)
+	case *mpb.Metric_Summary:
+		return len(d.Summary.DataPoints)
+	}
+	return 0
+}
+
+// splitResourceMetrics returns a ResourceMetrics containing the first n data
+// points of rm and a ResourceMetrics containing the remaining data points.
+// The scope and metric structure of rm is preserved in both.
+func splitResourceMetrics(rm *mpb.ResourceMetrics, n int) (head, tail *mpb.ResourceMetrics) {
+	head = &mpb.ResourceMetrics{Resource: rm.Resource, SchemaUrl: rm.SchemaUrl}
+	tail = &mpb.ResourceMetrics{Resource: rm.Resource, SchemaUrl: rm.SchemaUrl}
+	for _, sm := range rm.ScopeMetrics {
+		hSM := &mpb.ScopeMetrics{Scope: sm.Scope, SchemaUrl: sm.SchemaUrl}
+		tSM := &mpb.ScopeMetrics{Scope: sm.Scope, SchemaUrl: sm.SchemaUrl}
+		for _, m := range sm.Metrics {
+			count := metricDataPoints(m)
+			switch {
+			case n >= count:
+				hSM.Metrics = append(hSM.Metrics, m)
+				n -= count
+			case n == 0:
+				tSM.Metrics = append(tSM.Metrics, m)
+			default:
+				hM, tM := splitMetric(m, n)
+				hSM.Metrics = append(hSM.Metrics, hM)
+				tSM.Metrics = append(tSM.Metrics, tM)
+				n = 0
+			}
+		}
+		if len(hSM.Metrics) > 0 {
+			head.ScopeMetrics = append(head.ScopeMetrics, hSM)
+		}
+		if len(tSM.Metrics) > 0 {
+			tail.ScopeMetrics = append(tail.ScopeMetrics, tSM)
+		}
+	}
+	return head, tail
+}
+
+// splitMetric returns a Metric containing the first n data points of m and a
+// Metric containing the remaining data points.
+func splitMetric(m *mpb.Metric, n int) (head, tail *mpb.Metric) {
+	head = &mpb.Metric{Name: m.Name, Description: m.Description, Unit: m.Unit}
+	tail = &mpb.Metric{Name: m.Name, Description: m.Description, Unit: m.Unit}
+	switch d := m.Data.(type) {
+	case *mpb.Metric_Gauge:
+		head.Data = &mpb.Metric_Gauge{Gauge: &mpb.Gauge{
+			DataPoints: d.Gauge.DataPoints[:n],
+		}}
+		tail.Data = &mpb.Metric_Gauge{Gauge: &mpb.Gauge{
+			DataPoints: d.Gauge.DataPoints[n:],
+		}}
+	case *mpb.Metric_Sum:
+		head.Data = &mpb.Metric_Sum{Sum: &mpb.Sum{
+			DataPoints:             d.Sum.DataPoints[:n],
+			AggregationTemporality: d.Sum.AggregationTemporality,
+			IsMonotonic:            d.Sum.IsMonotonic,
+		}}
+		tail.Data = &mpb.Metric_Sum{Sum: &mpb.Sum{
+			DataPoints:             d.Sum.DataPoints[n:],
+			AggregationTemporality: d.Sum.AggregationTemporality,
+			IsMonotonic:            d.Sum.IsMonotonic,
+		}}
+	case *mpb.Metric_Histogram:
+		head.Data = &mpb.Metric_Histogram{Histogram: &mpb.Histogram{
+			DataPoints:             d.Histogram.DataPoints[:n],
+			AggregationTemporality: d.Histogram.AggregationTemporality,
+		}}
+		tail.Data = &mpb.Metric_Histogram{Histogram: &mpb.Histogram{
+			DataPoints:             d.Histogram.DataPoints[n:],
+			AggregationTemporality: d.Histogram.AggregationTemporality,
+		}}
+	case *mpb.Metric_ExponentialHistogram:
+		head.Data = &mpb.Metric_ExponentialHistogram{ExponentialHistogram: &mpb.ExponentialHistogram{
+			DataPoints:             d.ExponentialHistogram.DataPoints[:n],
+			AggregationTemporality: d.ExponentialHistogram.AggregationTemporality,
+		}}
+		tail.Data = &mpb.Metric_ExponentialHistogram{ExponentialHistogram: &mpb.ExponentialHistogram{
+			DataPoints:             d.ExponentialHistogram.DataPoints[n:],
+			AggregationTemporality: d.ExponentialHistogram.AggregationTemporality,
+		}}
+	case *mpb.Metric_Summary:
+		head.Data = &mpb.Metric_Summary{Summary: &mpb.Summary{
+			DataPoints: d.Summary.DataPoints[:n],
+		}}
+		tail.Data = &mpb.Metric_Summary{Summary: &mpb.Summary{
+			DataPoints: d.Summary.DataPoints[n:],
+		}}
+	}
+	return head, tail
+}
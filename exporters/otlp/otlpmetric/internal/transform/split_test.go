@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/transform"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+// dataPoints returns all data points contained in rm in order.
+func dataPoints(rm *mpb.ResourceMetrics) []interface{} {
+	var dps []interface{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch d := m.Data.(type) {
+			case *mpb.Metric_Gauge:
+				for _, dp := range d.Gauge.DataPoints {
+					dps = append(dps, dp)
+				}
+			case *mpb.Metric_Sum:
+				for _, dp := range d.Sum.DataPoints {
+					dps = append(dps, dp)
+				}
+			case *mpb.Metric_Histogram:
+				for _, dp := range d.Histogram.DataPoints {
+					dps = append(dps, dp)
+				}
+			case *mpb.Metric_ExponentialHistogram:
+				for _, dp := range d.ExponentialHistogram.DataPoints {
+					dps = append(dps, dp)
+				}
+			case *mpb.Metric_Summary:
+				for _, dp := range d.Summary.DataPoints {
+					dps = append(dps, dp)
+				}
+			}
+		}
+	}
+	return dps
+}
+
+func TestSplitNoLimit(t *testing.T) {
+	got := Split(pbResourceMetrics, 0)
+	require.Len(t, got, 1)
+	assert.Same(t, pbResourceMetrics, got[0])
+}
+
+func TestSplitWithinLimit(t *testing.T) {
+	got := Split(pbResourceMetrics, requestSize(pbResourceMetrics))
+	require.Len(t, got, 1)
+	assert.Same(t, pbResourceMetrics, got[0])
+}
+
+func TestSplitDataPoints(t *testing.T) {
+	want := dataPoints(pbResourceMetrics)
+
+	// A limit smaller than any single data point results in one data point
+	// per ResourceMetrics.
+	got := Split(pbResourceMetrics, 1)
+	require.Len(t, got, len(want))
+
+	var dps []interface{}
+	for _, rm := range got {
+		assert.Equal(t, pbResourceMetrics.Resource, rm.Resource)
+		assert.Equal(t, pbResourceMetrics.SchemaUrl, rm.SchemaUrl)
+		dps = append(dps, dataPoints(rm)...)
+	}
+	assert.Equal(t, want, dps)
+}
+
+func TestSplitSizeLimit(t *testing.T) {
+	want := dataPoints(pbResourceMetrics)
+
+	limit := requestSize(pbResourceMetrics) / 3
+	got := Split(pbResourceMetrics, limit)
+	assert.Greater(t, len(got), 1)
+
+	var dps []interface{}
+	for _, rm := range got {
+		assert.LessOrEqual(t, requestSize(rm), limit)
+		dps = append(dps, dataPoints(rm)...)
+	}
+	assert.Equal(t, want, dps)
+
+	// Splitting must preserve the metric definitions.
+	for _, rm := range got {
+		for _, sm := range rm.ScopeMetrics {
+			assert.Equal(t, pbScopeMetrics[0].Scope, sm.Scope)
+			for _, m := range sm.Metrics {
+				switch d := m.Data.(type) {
+				case *mpb.Metric_Sum:
+					if m.Name == "int64-sum" {
+						assert.Equal(t, pbSumInt64.AggregationTemporality, d.Sum.AggregationTemporality)
+						assert.Equal(t, pbSumInt64.IsMonotonic, d.Sum.IsMonotonic)
+					}
+				case *mpb.Metric_Histogram:
+					assert.Equal(t, pbHist.AggregationTemporality, d.Histogram.AggregationTemporality)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpmetric // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
+
+import (
+	"context"
+
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+// UploadFunc transmits metric data to an OTLP receiver.
+type UploadFunc func(ctx context.Context, protoMetrics *mpb.ResourceMetrics) error
+
+// Middleware wraps the upload of metric data by a Client. It can be used to
+// add behavior around each upload, such as logging, sampling, or signing
+// requests, without implementing a Client.
+//
+// The returned UploadFunc should call next to continue the upload. It may
+// modify the metric data or the context passed to next, or not call next at
+// all to drop the data.
+type Middleware func(next UploadFunc) UploadFunc
+
+// WrapClient returns a Client that passes all uploads through mw before they
+// are transmitted by c. The first Middleware is the outermost and is called
+// first. All other methods are handled by c.
+func WrapClient(c Client, mw ...Middleware) Client {
+	if len(mw) == 0 {
+		return c
+	}
+
+	upload := c.UploadMetrics
+	for i := len(mw) - 1; i >= 0; i-- {
+		upload = mw[i](upload)
+	}
+	return &middlewareClient{Client: c, upload: upload}
+}
+
+// middlewareClient is a Client whose uploads are passed through middleware.
+type middlewareClient struct {
+	Client
+
+	upload UploadFunc
+}
+
+// UploadMetrics transmits protoMetrics using the middleware chain.
+func (c *middlewareClient) UploadMetrics(ctx context.Context, protoMetrics *mpb.ResourceMetrics) error {
+	return c.upload(ctx, protoMetrics)
+}
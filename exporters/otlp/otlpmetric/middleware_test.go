@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpmetric // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+func TestWrapClient(t *testing.T) {
+	var calls []string
+	mw := func(name string) Middleware {
+		return func(next UploadFunc) UploadFunc {
+			return func(ctx context.Context, rm *mpb.ResourceMetrics) error {
+				calls = append(calls, name)
+				return next(ctx, rm)
+			}
+		}
+	}
+
+	c := &client{}
+	wrapped := WrapClient(c, mw("first"), mw("second"))
+	ctx := context.Background()
+
+	assert.NoError(t, wrapped.UploadMetrics(ctx, &mpb.ResourceMetrics{}))
+	assert.Equal(t, []string{"first", "second"}, calls)
+	assert.Equal(t, 1, c.n, "client not called")
+
+	// Other methods are passed to the wrapped client.
+	assert.NoError(t, wrapped.ForceFlush(ctx))
+	assert.NoError(t, wrapped.Shutdown(ctx))
+	assert.Equal(t, 3, c.n)
+}
+
+func TestWrapClientDrop(t *testing.T) {
+	errDropped := errors.New("dropped")
+	drop := func(UploadFunc) UploadFunc {
+		return func(context.Context, *mpb.ResourceMetrics) error {
+			return errDropped
+		}
+	}
+
+	c := &client{}
+	err := WrapClient(c, drop).UploadMetrics(context.Background(), &mpb.ResourceMetrics{})
+	assert.ErrorIs(t, err, errDropped)
+	assert.Equal(t, 0, c.n)
+}
+
+func TestWrapClientNoMiddleware(t *testing.T) {
+	c := &client{}
+	assert.Same(t, c, WrapClient(c))
+}
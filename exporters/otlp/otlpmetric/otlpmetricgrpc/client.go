@@ -16,6 +16,8 @@ package otlpmetricgrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlpme
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
@@ -23,11 +25,21 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/internal"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/retry"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/failover"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/observ"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/oconf"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/persist"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/transform"
 	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/view"
 	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
 	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
 )
@@ -48,10 +60,20 @@ func New(ctx context.Context, options ...Option) (metric.Exporter, error) {
 	return otlpmetric.New(c), nil
 }
 
+// instrumentationName is the name of the Meter used to measure the operation
+// of the client.
+const instrumentationName = "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+
 type client struct {
-	metadata      metadata.MD
-	exportTimeout time.Duration
-	requestFunc   retry.RequestFunc
+	temporalitySelector metric.TemporalitySelector
+	aggregationSelector metric.AggregationSelector
+
+	metadata        metadata.MD
+	headersProvider func(context.Context) (map[string]string, error)
+	maxRequestSize  int
+	exportTimeout   time.Duration
+	requestFunc     retry.RequestFunc
+	instr           *observ.Instrumentation
 
 	// ourConn keeps track of where conn was created: true if created here in
 	// NewClient, or false if passed with an option. This is important on
@@ -66,10 +88,53 @@ type client struct {
 func newClient(ctx context.Context, options ...Option) (otlpmetric.Client, error) {
 	cfg := oconf.NewGRPCConfig(asGRPCOptions(options)...)
 
+	var c otlpmetric.Client
+	c, err := newEndpointClient(ctx, cfg, cfg.Metrics.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fail over is not possible with a single ClientConn passed by the user.
+	if len(cfg.Failover.Endpoints) > 0 && cfg.GRPCConn == nil {
+		clients := []otlpmetric.Client{c}
+		for _, endpoint := range cfg.Failover.Endpoints {
+			ec, err := newEndpointClient(ctx, cfg, endpoint)
+			if err != nil {
+				for _, cl := range clients {
+					_ = cl.Shutdown(ctx)
+				}
+				return nil, err
+			}
+			clients = append(clients, ec)
+		}
+		c = failover.NewClient(clients, cfg.Failover.MaxFailures, cfg.Failover.ProbeInterval)
+	}
+	c = otlpmetric.WrapClient(c, cfg.Middleware...)
+
+	if cfg.QueueConfig.Enabled() {
+		pc, err := persist.NewClient(c, cfg.QueueConfig, permanent)
+		if err != nil {
+			// Do not leak the connections this client may have created.
+			_ = c.Shutdown(ctx)
+			return nil, err
+		}
+		return pc, nil
+	}
+	return c, nil
+}
+
+// newEndpointClient creates a new gRPC metric client for endpoint using cfg.
+func newEndpointClient(ctx context.Context, cfg oconf.Config, endpoint string) (*client, error) {
 	c := &client{
-		exportTimeout: cfg.Metrics.Timeout,
-		requestFunc:   cfg.RetryConfig.RequestFunc(retryable),
-		conn:          cfg.GRPCConn,
+		temporalitySelector: cfg.Metrics.TemporalitySelector,
+		aggregationSelector: cfg.Metrics.AggregationSelector,
+
+		headersProvider: cfg.Metrics.HeadersProvider,
+		maxRequestSize:  cfg.Metrics.MaxRequestSize,
+		exportTimeout:   cfg.Metrics.Timeout,
+		requestFunc:     cfg.RetryConfig.RequestFunc(retryable),
+		instr:           observ.New(cfg.Metrics.MeterProvider, instrumentationName, "metrics", "grpc"),
+		conn:            cfg.GRPCConn,
 	}
 
 	if len(cfg.Metrics.Headers) > 0 {
@@ -79,7 +144,7 @@ func newClient(ctx context.Context, options ...Option) (otlpmetric.Client, error
 	if c.conn == nil {
 		// If the caller did not provide a ClientConn when the client was
 		// created, create one using the configuration they did provide.
-		conn, err := grpc.DialContext(ctx, cfg.Metrics.Endpoint, cfg.DialOptions...)
+		conn, err := grpc.DialContext(ctx, endpoint, cfg.DialOptions...)
 		if err != nil {
 			return nil, err
 		}
@@ -90,10 +155,19 @@ func newClient(ctx context.Context, options ...Option) (otlpmetric.Client, error
 	}
 
 	c.msc = colmetricpb.NewMetricsServiceClient(c.conn)
-
 	return c, nil
 }
 
+// Temporality returns the Temporality to use for an instrument kind.
+func (c *client) Temporality(k view.InstrumentKind) metricdata.Temporality {
+	return c.temporalitySelector(k)
+}
+
+// Aggregation returns the Aggregation to use for an instrument kind.
+func (c *client) Aggregation(k view.InstrumentKind) aggregation.Aggregation {
+	return c.aggregationSelector(k)
+}
+
 // ForceFlush does nothing, the client holds no state.
 func (c *client) ForceFlush(ctx context.Context) error { return ctx.Err() }
 
@@ -143,17 +217,60 @@ func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.Resou
 	ctx, cancel := c.exportContext(ctx)
 	defer cancel()
 
-	return c.requestFunc(ctx, func(iCtx context.Context) error {
-		_, err := c.msc.Export(iCtx, &colmetricpb.ExportMetricsServiceRequest{
-			ResourceMetrics: []*metricpb.ResourceMetrics{protoMetrics},
-		})
-		// nil is converted to OK.
-		if status.Code(err) == codes.OK {
-			// Success.
-			return nil
+	for _, rm := range transform.Split(protoMetrics, c.maxRequestSize) {
+		if err := c.upload(ctx, rm); err != nil {
+			return err
 		}
+	}
+	return nil
+}
+
+// upload sends rm to the connected endpoint in a single export request.
+func (c *client) upload(ctx context.Context, rm *metricpb.ResourceMetrics) error {
+	req := &colmetricpb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricpb.ResourceMetrics{rm},
+	}
+
+	requestFunc := c.requestFunc
+	if failover.IsProbe(ctx) {
+		// Probes of a higher priority endpoint are not retried.
+		requestFunc = retry.Config{}.RequestFunc(nil)
+	}
+
+	start := time.Now()
+	err := requestFunc(ctx, func(iCtx context.Context) error {
+		err := c.send(iCtx, req)
+		c.instr.Attempt(iCtx, status.Code(err).String(), err)
 		return err
 	})
+	c.instr.Export(ctx, proto.Size(req), time.Since(start))
+	return err
+}
+
+// send makes a single attempt to export req.
+func (c *client) send(ctx context.Context, req *colmetricpb.ExportMetricsServiceRequest) error {
+	ctx, err := c.headersContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.msc.Export(ctx, req)
+	if resp != nil && resp.PartialSuccess != nil {
+		msg := resp.PartialSuccess.GetErrorMessage()
+		n := resp.PartialSuccess.GetRejectedDataPoints()
+		c.instr.Rejected(ctx, n)
+		if n != 0 || msg != "" {
+			otel.Handle(internal.PartialSuccessToError(
+				internal.MetricsPartialSuccess, n, msg,
+			))
+		}
+	}
+	// nil is converted to OK.
+	if status.Code(err) == codes.OK {
+		// Success.
+		return nil
+	}
+	return err
 }
 
 // exportContext returns a copy of parent with an appropriate deadline and
@@ -181,6 +298,26 @@ func (c *client) exportContext(parent context.Context) (context.Context, context
 	return ctx, cancel
 }
 
+// headersContext returns a copy of ctx with the headers returned from the
+// headers provider of the client merged into its outgoing metadata. If no
+// headers provider is configured, ctx is returned unmodified.
+func (c *client) headersContext(ctx context.Context) (context.Context, error) {
+	if c.headersProvider == nil {
+		return ctx, nil
+	}
+
+	h, err := c.headersProvider(ctx)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to get headers: %w", err)
+	}
+
+	md := c.metadata.Copy()
+	for k, v := range h {
+		md.Set(k, v)
+	}
+	return metadata.NewOutgoingContext(ctx, md), nil
+}
+
 // retryable returns if err identifies a request that can be retried and a
 // duration to wait for if an explicit throttle time is included in err.
 func retryable(err error) (bool, time.Duration) {
@@ -200,6 +337,18 @@ func retryable(err error) (bool, time.Duration) {
 	return false, 0
 }
 
+// permanent returns if err is a status returned by the collector that sending
+// the request again will not change.
+func permanent(err error) bool {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if _, ok := status.FromError(err); ok {
+			r, _ := retryable(err)
+			return !r
+		}
+	}
+	return false
+}
+
 // throttleDelay returns a duration to wait for if an explicit throttle time
 // is included in the response status.
 func throttleDelay(s *status.Status) time.Duration {
@@ -16,6 +16,9 @@ package otlpmetricgrpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -24,13 +27,18 @@ import (
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/failover"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/observ"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/otest"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
 )
 
 func TestThrottleDuration(t *testing.T) {
@@ -129,9 +137,18 @@ func TestRetryable(t *testing.T) {
 	}
 }
 
+func TestPermanent(t *testing.T) {
+	assert.True(t, permanent(status.Error(codes.InvalidArgument, "")))
+	assert.True(t, permanent(fmt.Errorf("wrapped: %w", status.Error(codes.PermissionDenied, ""))))
+	assert.False(t, permanent(status.Error(codes.Unavailable, "")))
+	assert.False(t, permanent(fmt.Errorf("max retry time elapsed: %w", status.Error(codes.Unavailable, ""))))
+	assert.False(t, permanent(errors.New("connection failure")))
+	assert.False(t, permanent(nil))
+}
+
 func TestClient(t *testing.T) {
-	factory := func() (otlpmetric.Client, otest.Collector) {
-		coll, err := otest.NewGRPCCollector("", nil)
+	factory := func(rCh <-chan otest.ExportResult) (otlpmetric.Client, otest.Collector) {
+		coll, err := otest.NewGRPCCollector("", rCh)
 		require.NoError(t, err)
 
 		ctx := context.Background()
@@ -144,9 +161,43 @@ func TestClient(t *testing.T) {
 	t.Run("Integration", otest.RunClientTests(factory))
 }
 
+func TestClientProbeNotRetried(t *testing.T) {
+	rCh := make(chan otest.ExportResult, 3)
+	rCh <- otest.ExportResult{Err: status.Error(codes.Unavailable, "unavailable")}
+	rCh <- otest.ExportResult{Err: status.Error(codes.Unavailable, "unavailable")}
+	rCh <- otest.ExportResult{}
+	coll, err := otest.NewGRPCCollector("", rCh)
+	require.NoError(t, err)
+	t.Cleanup(coll.Shutdown)
+	// Push this after Shutdown so the gRPC server doesn't hang.
+	t.Cleanup(func() { close(rCh) })
+
+	ctx := context.Background()
+	client, err := newClient(
+		ctx,
+		WithEndpoint(coll.Addr().String()),
+		WithInsecure(),
+		WithRetry(RetryConfig{
+			Enabled:         true,
+			InitialInterval: time.Nanosecond,
+			MaxInterval:     time.Millisecond,
+			MaxElapsedTime:  time.Minute,
+		}),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.Shutdown(ctx)) })
+
+	// A probe makes a single attempt.
+	assert.Error(t, client.UploadMetrics(failover.ProbeContext(ctx), &mpb.ResourceMetrics{}))
+	assert.Len(t, rCh, 2)
+	// Other uploads are retried.
+	assert.NoError(t, client.UploadMetrics(ctx, &mpb.ResourceMetrics{}))
+	assert.Len(t, rCh, 0)
+}
+
 func TestConfig(t *testing.T) {
-	factoryFunc := func(errCh <-chan error, o ...Option) (metric.Exporter, *otest.GRPCCollector) {
-		coll, err := otest.NewGRPCCollector("", errCh)
+	factoryFunc := func(rCh <-chan otest.ExportResult, o ...Option) (metric.Exporter, *otest.GRPCCollector) {
+		coll, err := otest.NewGRPCCollector("", rCh)
 		require.NoError(t, err)
 
 		ctx := context.Background()
@@ -175,12 +226,189 @@ func TestConfig(t *testing.T) {
 		assert.Equal(t, got[key], []string{headers[key]})
 	})
 
+	t.Run("WithHeadersProvider", func(t *testing.T) {
+		key := "my-custom-header"
+		var calls int
+		provider := func(context.Context) (map[string]string, error) {
+			calls++
+			return map[string]string{key: fmt.Sprintf("value-%d", calls)}, nil
+		}
+		exp, coll := factoryFunc(
+			nil,
+			WithHeaders(map[string]string{key: "static-value"}),
+			WithHeadersProvider(provider),
+		)
+		t.Cleanup(coll.Shutdown)
+		ctx := context.Background()
+		require.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}))
+		// Ensure everything is flushed.
+		require.NoError(t, exp.Shutdown(ctx))
+
+		got := coll.Headers()
+		require.Regexp(t, "OTel OTLP Exporter Go/1\\..*", got)
+		require.Contains(t, got, key)
+		assert.Equal(t, []string{"value-1"}, got[key])
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("WithHeadersProviderError", func(t *testing.T) {
+		errProvider := errors.New("provider failure")
+		provider := func(context.Context) (map[string]string, error) {
+			return nil, errProvider
+		}
+		exp, coll := factoryFunc(nil, WithHeadersProvider(provider))
+		t.Cleanup(coll.Shutdown)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		err := exp.Export(ctx, metricdata.ResourceMetrics{})
+		assert.ErrorIs(t, err, errProvider)
+		assert.Len(t, coll.Collect().Dump(), 0)
+	})
+
+	t.Run("WithCompressor", func(t *testing.T) {
+		for _, compressor := range []string{"zstd", "snappy"} {
+			t.Run(compressor, func(t *testing.T) {
+				exp, coll := factoryFunc(nil, WithCompressor(compressor))
+				t.Cleanup(coll.Shutdown)
+				ctx := context.Background()
+				t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+				assert.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}))
+				assert.Len(t, coll.Collect().Dump(), 1)
+			})
+		}
+	})
+
+	t.Run("WithMaxRequestSize", func(t *testing.T) {
+		exp, coll := factoryFunc(nil, WithMaxRequestSize(1))
+		t.Cleanup(coll.Shutdown)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		rm := metricdata.ResourceMetrics{
+			ScopeMetrics: []metricdata.ScopeMetrics{{
+				Metrics: []metricdata.Metrics{{
+					Name: "gauge",
+					Data: metricdata.Gauge[int64]{
+						DataPoints: []metricdata.DataPoint[int64]{
+							{Value: 1}, {Value: 2}, {Value: 3},
+						},
+					},
+				}},
+			}},
+		}
+		// A limit smaller than any data point sends each in its own request.
+		assert.NoError(t, exp.Export(ctx, rm))
+		assert.Len(t, coll.Collect().Dump(), 3)
+	})
+
+	t.Run("WithPersistentQueue", func(t *testing.T) {
+		dir := t.TempDir()
+		qc := QueueConfig{Directory: dir, InitialInterval: time.Minute}
+
+		rCh := make(chan otest.ExportResult, 2)
+		rCh <- otest.ExportResult{Err: status.Error(codes.Unavailable, "unavailable")}
+		rCh <- otest.ExportResult{Err: status.Error(codes.Unavailable, "unavailable")}
+		exp, coll := factoryFunc(rCh, WithPersistentQueue(qc), WithRetry(RetryConfig{Enabled: false}))
+		t.Cleanup(coll.Shutdown)
+		// Push this after Shutdown so the gRPC server doesn't hang.
+		t.Cleanup(func() { close(rCh) })
+		ctx := context.Background()
+		// Accepted once written to disk.
+		require.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}))
+		// The data cannot be sent and remains queued.
+		assert.Error(t, exp.Shutdown(ctx))
+
+		// A new exporter sends the data persisted by the previous one.
+		exp, coll = factoryFunc(nil, WithPersistentQueue(qc))
+		t.Cleanup(coll.Shutdown)
+		require.NoError(t, exp.Shutdown(ctx))
+		assert.Len(t, coll.Collect().Dump(), 1)
+	})
+
+	t.Run("WithFailover", func(t *testing.T) {
+		secondary, err := otest.NewGRPCCollector("", nil)
+		require.NoError(t, err)
+		t.Cleanup(secondary.Shutdown)
+
+		rCh := make(chan otest.ExportResult, 1)
+		rCh <- otest.ExportResult{Err: status.Error(codes.Unavailable, "unavailable")}
+		exp, coll := factoryFunc(
+			rCh,
+			WithRetry(RetryConfig{Enabled: false}),
+			WithFailover(FailoverConfig{
+				Endpoints:   []string{secondary.Addr().String()},
+				MaxFailures: 1,
+			}),
+		)
+		t.Cleanup(coll.Shutdown)
+		// Push this after Shutdown so the gRPC server doesn't hang.
+		t.Cleanup(func() { close(rCh) })
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+		// The failed export is sent to the secondary endpoint.
+		require.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}))
+		require.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}))
+		assert.Len(t, coll.Collect().Dump(), 1)
+		assert.Len(t, secondary.Collect().Dump(), 2)
+	})
+
+	t.Run("WithMiddleware", func(t *testing.T) {
+		var calls int
+		sign := func(next otlpmetric.UploadFunc) otlpmetric.UploadFunc {
+			return func(ctx context.Context, rm *mpb.ResourceMetrics) error {
+				calls++
+				ctx = metadata.AppendToOutgoingContext(ctx, "signature", "signed")
+				return next(ctx, rm)
+			}
+		}
+		exp, coll := factoryFunc(nil, WithMiddleware(sign))
+		t.Cleanup(coll.Shutdown)
+		ctx := context.Background()
+		require.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}))
+		require.NoError(t, exp.Shutdown(ctx))
+
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, []string{"signed"}, coll.Headers()["signature"])
+	})
+
+	t.Run("WithMeterProvider", func(t *testing.T) {
+		rCh := make(chan otest.ExportResult, 2)
+		rCh <- otest.ExportResult{Err: status.Error(codes.Unavailable, "unavailable")}
+		rCh <- otest.ExportResult{Response: &colmetricpb.ExportMetricsServiceResponse{
+			PartialSuccess: &colmetricpb.ExportMetricsPartialSuccess{RejectedDataPoints: 3},
+		}}
+		reader := metric.NewManualReader()
+		mp := metric.NewMeterProvider(metric.WithReader(reader))
+		exp, coll := factoryFunc(rCh, WithMeterProvider(mp), WithRetry(RetryConfig{
+			Enabled:         true,
+			InitialInterval: time.Nanosecond,
+			MaxInterval:     time.Millisecond,
+			MaxElapsedTime:  time.Minute,
+		}))
+		t.Cleanup(coll.Shutdown)
+		// Push this after Shutdown so the gRPC server doesn't hang.
+		t.Cleanup(func() { close(rCh) })
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		require.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}))
+
+		got, err := reader.Collect(ctx)
+		require.NoError(t, err)
+		require.Len(t, got.ScopeMetrics, 1)
+		assert.Equal(t, instrumentationName, got.ScopeMetrics[0].Scope.Name)
+		assert.Equal(t, map[string]int64{
+			observ.AttemptsName:                  2,
+			observ.FailuresName + "/Unavailable": 1,
+			observ.RejectedName:                  3,
+		}, sums(got.ScopeMetrics[0].Metrics))
+	})
+
 	t.Run("WithTimeout", func(t *testing.T) {
-		// Do not send on errCh so the Collector never responds to the client.
-		errCh := make(chan error)
-		t.Cleanup(func() { close(errCh) })
+		// Do not send on rCh so the Collector never responds to the client.
+		rCh := make(chan otest.ExportResult)
+		t.Cleanup(func() { close(rCh) })
 		exp, coll := factoryFunc(
-			errCh,
+			rCh,
 			WithTimeout(time.Millisecond),
 			WithRetry(RetryConfig{Enabled: false}),
 		)
@@ -191,6 +419,20 @@ func TestConfig(t *testing.T) {
 		assert.ErrorContains(t, err, context.DeadlineExceeded.Error())
 	})
 
+	t.Run("WithUnixSocketEndpoint", func(t *testing.T) {
+		endpoint := "unix://" + filepath.Join(t.TempDir(), "agent.sock")
+		coll, err := otest.NewGRPCCollector(endpoint, nil)
+		require.NoError(t, err)
+		t.Cleanup(coll.Shutdown)
+
+		ctx := context.Background()
+		exp, err := New(ctx, WithEndpoint(endpoint), WithInsecure())
+		require.NoError(t, err)
+		require.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}))
+		require.NoError(t, exp.Shutdown(ctx))
+		assert.Len(t, coll.Collect().Dump(), 1)
+	})
+
 	t.Run("WithCustomUserAgent", func(t *testing.T) {
 		key := "user-agent"
 		customerUserAgent := "custom-user-agent"
@@ -205,3 +447,23 @@ func TestConfig(t *testing.T) {
 		assert.Contains(t, got[key][0], customerUserAgent)
 	})
 }
+
+// sums returns the value of each int64 sum in metrics keyed by name. Failure
+// counts are keyed by name and error code.
+func sums(metrics []metricdata.Metrics) map[string]int64 {
+	out := make(map[string]int64)
+	for _, m := range metrics {
+		s, ok := m.Data.(metricdata.Sum[int64])
+		if !ok {
+			continue
+		}
+		for _, dp := range s.DataPoints {
+			key := m.Name
+			if v, ok := dp.Attributes.Value(observ.ErrorCodeKey); ok {
+				key += "/" + v.AsString()
+			}
+			out[key] += dp.Value
+		}
+	}
+	return out
+}
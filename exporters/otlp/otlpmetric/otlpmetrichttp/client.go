@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -27,13 +28,23 @@ import (
 	"sync"
 	"time"
 
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
 	"google.golang.org/protobuf/proto"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/internal"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/retry"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/failover"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/observ"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/oconf"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/persist"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/transform"
 	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/view"
 	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
 	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
 )
@@ -49,12 +60,22 @@ func New(_ context.Context, opts ...Option) (metric.Exporter, error) {
 	return otlpmetric.New(c), nil
 }
 
+// instrumentationName is the name of the Meter used to measure the operation
+// of the client.
+const instrumentationName = "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+
 type client struct {
+	temporalitySelector metric.TemporalitySelector
+	aggregationSelector metric.AggregationSelector
+
 	// req is cloned for every upload the client makes.
-	req         *http.Request
-	compression Compression
-	requestFunc retry.RequestFunc
-	httpClient  *http.Client
+	req             *http.Request
+	headersProvider func(context.Context) (map[string]string, error)
+	maxRequestSize  int
+	compression     Compression
+	requestFunc     retry.RequestFunc
+	instr           *observ.Instrumentation
+	httpClient      *http.Client
 }
 
 // Keep it in sync with golang's DefaultTransport from net/http! We
@@ -82,15 +103,48 @@ func newClient(opts ...Option) (otlpmetric.Client, error) {
 		Transport: ourTransport,
 		Timeout:   cfg.Metrics.Timeout,
 	}
-	if cfg.Metrics.TLSCfg != nil {
+	if cfg.Metrics.TLSCfg != nil || cfg.Metrics.Proxy != nil {
 		transport := ourTransport.Clone()
-		transport.TLSClientConfig = cfg.Metrics.TLSCfg
+		if cfg.Metrics.TLSCfg != nil {
+			transport.TLSClientConfig = cfg.Metrics.TLSCfg
+		}
+		if cfg.Metrics.Proxy != nil {
+			transport.Proxy = cfg.Metrics.Proxy
+		}
 		httpClient.Transport = transport
 	}
 
+	var c otlpmetric.Client
+	c, err := newEndpointClient(cfg, httpClient, cfg.Metrics.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cfg.Failover.Endpoints) > 0 {
+		clients := []otlpmetric.Client{c}
+		for _, endpoint := range cfg.Failover.Endpoints {
+			ec, err := newEndpointClient(cfg, httpClient, endpoint)
+			if err != nil {
+				return nil, err
+			}
+			clients = append(clients, ec)
+		}
+		c = failover.NewClient(clients, cfg.Failover.MaxFailures, cfg.Failover.ProbeInterval)
+	}
+	c = otlpmetric.WrapClient(c, cfg.Middleware...)
+
+	if cfg.QueueConfig.Enabled() {
+		return persist.NewClient(c, cfg.QueueConfig, permanent)
+	}
+	return c, nil
+}
+
+// newEndpointClient creates a new HTTP metric client for endpoint using cfg
+// that sends requests with httpClient.
+func newEndpointClient(cfg oconf.Config, httpClient *http.Client, endpoint string) (*client, error) {
 	u := &url.URL{
 		Scheme: "https",
-		Host:   cfg.Metrics.Endpoint,
+		Host:   endpoint,
 		Path:   cfg.Metrics.URLPath,
 	}
 	if cfg.Metrics.Insecure {
@@ -112,13 +166,29 @@ func newClient(opts ...Option) (otlpmetric.Client, error) {
 	req.Header.Set("Content-Type", "application/x-protobuf")
 
 	return &client{
-		compression: Compression(cfg.Metrics.Compression),
-		req:         req,
-		requestFunc: cfg.RetryConfig.RequestFunc(evaluate),
-		httpClient:  httpClient,
+		temporalitySelector: cfg.Metrics.TemporalitySelector,
+		aggregationSelector: cfg.Metrics.AggregationSelector,
+
+		compression:     Compression(cfg.Metrics.Compression),
+		req:             req,
+		headersProvider: cfg.Metrics.HeadersProvider,
+		maxRequestSize:  cfg.Metrics.MaxRequestSize,
+		requestFunc:     cfg.RetryConfig.RequestFunc(evaluate),
+		instr:           observ.New(cfg.Metrics.MeterProvider, instrumentationName, "metrics", "http"),
+		httpClient:      httpClient,
 	}, nil
 }
 
+// Temporality returns the Temporality to use for an instrument kind.
+func (c *client) Temporality(k view.InstrumentKind) metricdata.Temporality {
+	return c.temporalitySelector(k)
+}
+
+// Aggregation returns the Aggregation to use for an instrument kind.
+func (c *client) Aggregation(k view.InstrumentKind) aggregation.Aggregation {
+	return c.aggregationSelector(k)
+}
+
 // ForceFlush does nothing, the client holds no state.
 func (c *client) ForceFlush(ctx context.Context) error { return ctx.Err() }
 
@@ -142,8 +212,18 @@ func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.Resou
 	// ensures this is not called after the Exporter is shutdown. Only thing
 	// to do here is send data.
 
+	for _, rm := range transform.Split(protoMetrics, c.maxRequestSize) {
+		if err := c.upload(ctx, rm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upload sends rm to the connected endpoint in a single export request.
+func (c *client) upload(ctx context.Context, rm *metricpb.ResourceMetrics) error {
 	pbRequest := &colmetricpb.ExportMetricsServiceRequest{
-		ResourceMetrics: []*metricpb.ResourceMetrics{protoMetrics},
+		ResourceMetrics: []*metricpb.ResourceMetrics{rm},
 	}
 	body, err := proto.Marshal(pbRequest)
 	if err != nil {
@@ -154,42 +234,95 @@ func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.Resou
 		return err
 	}
 
-	return c.requestFunc(ctx, func(iCtx context.Context) error {
-		select {
-		case <-iCtx.Done():
-			return iCtx.Err()
-		default:
-		}
+	requestFunc := c.requestFunc
+	if failover.IsProbe(ctx) {
+		// Probes of a higher priority endpoint are not retried.
+		requestFunc = retry.Config{}.RequestFunc(nil)
+	}
+
+	start := time.Now()
+	err = requestFunc(ctx, func(iCtx context.Context) error {
+		code, err := c.send(iCtx, &request)
+		c.instr.Attempt(iCtx, code, err)
+		return err
+	})
+	c.instr.Export(ctx, len(body), time.Since(start))
+	return err
+}
 
-		request.reset(iCtx)
-		resp, err := c.httpClient.Do(request.Request)
+// send makes a single attempt to send request. The status code of the
+// response is returned, or an empty string if no response was received.
+func (c *client) send(ctx context.Context, request *request) (string, error) {
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	default:
+	}
+
+	request.reset(ctx)
+	if c.headersProvider != nil {
+		h, err := c.headersProvider(ctx)
 		if err != nil {
-			return err
+			return "", fmt.Errorf("failed to get headers: %w", err)
+		}
+		for k, v := range h {
+			request.Header.Set(k, v)
+		}
+	}
+	resp, err := c.httpClient.Do(request.Request)
+	if err != nil {
+		return "", err
+	}
+	code := strconv.Itoa(resp.StatusCode)
+
+	var rErr error
+	switch resp.StatusCode {
+	case http.StatusOK:
+		// Success, do not retry.
+
+		// Read the partial success message, if any.
+		var respData bytes.Buffer
+		if _, err := io.Copy(&respData, resp.Body); err != nil {
+			_ = resp.Body.Close()
+			return code, err
 		}
 
-		var rErr error
-		switch resp.StatusCode {
-		case http.StatusOK:
-			// Success, do not retry.
-		case http.StatusTooManyRequests,
-			http.StatusServiceUnavailable:
-			// Retry-able failure.
-			rErr = newResponseError(resp.Header)
-
-			// Going to retry, drain the body to reuse the connection.
-			if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+		if respData.Len() != 0 {
+			var respProto colmetricpb.ExportMetricsServiceResponse
+			if err := proto.Unmarshal(respData.Bytes(), &respProto); err != nil {
 				_ = resp.Body.Close()
-				return err
+				return code, err
 			}
-		default:
-			rErr = fmt.Errorf("failed to send metrics to %s: %s", request.URL, resp.Status)
-		}
 
-		if err := resp.Body.Close(); err != nil {
-			return err
+			if respProto.PartialSuccess != nil {
+				msg := respProto.PartialSuccess.GetErrorMessage()
+				n := respProto.PartialSuccess.GetRejectedDataPoints()
+				c.instr.Rejected(ctx, n)
+				if n != 0 || msg != "" {
+					otel.Handle(internal.PartialSuccessToError(
+						internal.MetricsPartialSuccess, n, msg,
+					))
+				}
+			}
 		}
-		return rErr
-	})
+	case http.StatusTooManyRequests,
+		http.StatusServiceUnavailable:
+		// Retry-able failure.
+		rErr = newResponseError(resp.Header)
+
+		// Going to retry, drain the body to reuse the connection.
+		if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+			_ = resp.Body.Close()
+			return code, err
+		}
+	default:
+		rErr = rejectedError{msg: fmt.Sprintf("failed to send metrics to %s: %s", request.URL, resp.Status)}
+	}
+
+	if err := resp.Body.Close(); err != nil {
+		return code, err
+	}
+	return code, rErr
 }
 
 var gzPool = sync.Pool{
@@ -199,6 +332,20 @@ var gzPool = sync.Pool{
 	},
 }
 
+var zstdPool = sync.Pool{
+	New: func() interface{} {
+		// This only returns an error for invalid options.
+		w, _ := zstd.NewWriter(io.Discard, zstd.WithEncoderConcurrency(1))
+		return w
+	},
+}
+
+var snappyPool = sync.Pool{
+	New: func() interface{} {
+		return snappy.NewBufferedWriter(io.Discard)
+	},
+}
+
 func (c *client) newRequest(ctx context.Context, body []byte) (request, error) {
 	r := c.req.Clone(ctx)
 	req := request{Request: r}
@@ -208,28 +355,46 @@ func (c *client) newRequest(ctx context.Context, body []byte) (request, error) {
 		r.ContentLength = (int64)(len(body))
 		req.bodyReader = bodyReader(body)
 	case GzipCompression:
-		// Ensure the content length is not used.
-		r.ContentLength = -1
-		r.Header.Set("Content-Encoding", "gzip")
+		return req, compressBody(&req, "gzip", &gzPool, body)
+	case ZstdCompression:
+		return req, compressBody(&req, "zstd", &zstdPool, body)
+	case SnappyCompression:
+		return req, compressBody(&req, "snappy", &snappyPool, body)
+	}
 
-		gz := gzPool.Get().(*gzip.Writer)
-		defer gzPool.Put(gz)
+	return req, nil
+}
 
-		var b bytes.Buffer
-		gz.Reset(&b)
+// resetWriteCloser is a compressing writer that can be reused.
+type resetWriteCloser interface {
+	io.WriteCloser
+	Reset(io.Writer)
+}
 
-		if _, err := gz.Write(body); err != nil {
-			return req, err
-		}
-		// Close needs to be called to ensure body if fully written.
-		if err := gz.Close(); err != nil {
-			return req, err
-		}
+// compressBody sets the body of req to body compressed with a
+// resetWriteCloser from pool and sets the Content-Encoding header of req to
+// encoding.
+func compressBody(req *request, encoding string, pool *sync.Pool, body []byte) error {
+	// Ensure the content length is not used.
+	req.ContentLength = -1
+	req.Header.Set("Content-Encoding", encoding)
+
+	w := pool.Get().(resetWriteCloser)
+	defer pool.Put(w)
 
-		req.bodyReader = bodyReader(b.Bytes())
+	var b bytes.Buffer
+	w.Reset(&b)
+
+	if _, err := w.Write(body); err != nil {
+		return err
+	}
+	// Close needs to be called to ensure body if fully written.
+	if err := w.Close(); err != nil {
+		return err
 	}
 
-	return req, nil
+	req.bodyReader = bodyReader(b.Bytes())
+	return nil
 }
 
 // bodyReader returns a closure returning a new reader for buf.
@@ -288,3 +453,20 @@ func evaluate(err error) (bool, time.Duration) {
 
 	return true, time.Duration(rErr.throttle)
 }
+
+// rejectedError is returned when the collector responds to a request with a
+// status that is not retry-able.
+type rejectedError struct {
+	msg string
+}
+
+func (e rejectedError) Error() string {
+	return e.msg
+}
+
+// permanent returns if err is a rejection of a request by the collector that
+// sending the request again will not change.
+func permanent(err error) bool {
+	var rErr rejectedError
+	return errors.As(err, &rErr)
+}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
@@ -28,14 +29,18 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/failover"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/observ"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/otest"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
 )
 
 func TestClient(t *testing.T) {
-	factory := func() (otlpmetric.Client, otest.Collector) {
-		coll, err := otest.NewHTTPCollector("", nil)
+	factory := func(rCh <-chan otest.ExportResult) (otlpmetric.Client, otest.Collector) {
+		coll, err := otest.NewHTTPCollector("", rCh)
 		require.NoError(t, err)
 
 		addr := coll.Addr().String()
@@ -47,9 +52,56 @@ func TestClient(t *testing.T) {
 	t.Run("Integration", otest.RunClientTests(factory))
 }
 
+func TestClientProbeNotRetried(t *testing.T) {
+	unavailable := otest.ExportResult{Err: &otest.HTTPResponseError{
+		Status: http.StatusServiceUnavailable,
+		Err:    errors.New("unavailable"),
+	}}
+	rCh := make(chan otest.ExportResult, 3)
+	rCh <- unavailable
+	rCh <- unavailable
+	rCh <- otest.ExportResult{}
+	coll, err := otest.NewHTTPCollector("", rCh)
+	require.NoError(t, err)
+	ctx := context.Background()
+	t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+	// Push this after Shutdown so the HTTP server doesn't hang.
+	t.Cleanup(func() { close(rCh) })
+
+	client, err := newClient(
+		WithEndpoint(coll.Addr().String()),
+		WithInsecure(),
+		WithRetry(RetryConfig{
+			Enabled:         true,
+			InitialInterval: time.Nanosecond,
+			MaxInterval:     time.Millisecond,
+			MaxElapsedTime:  time.Minute,
+		}),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.Shutdown(ctx)) })
+
+	// A probe makes a single attempt.
+	assert.Error(t, client.UploadMetrics(failover.ProbeContext(ctx), &mpb.ResourceMetrics{}))
+	assert.Len(t, rCh, 2)
+	// Other uploads are retried.
+	assert.NoError(t, client.UploadMetrics(ctx, &mpb.ResourceMetrics{}))
+	assert.Len(t, rCh, 0)
+}
+
+func TestPermanent(t *testing.T) {
+	rejected := rejectedError{msg: "failed to send metrics: 400 Bad Request"}
+	assert.True(t, permanent(rejected))
+	assert.True(t, permanent(fmt.Errorf("wrapped: %w", rejected)))
+	assert.False(t, permanent(retryableError{}))
+	assert.False(t, permanent(fmt.Errorf("max retry time elapsed: %w", retryableError{})))
+	assert.False(t, permanent(errors.New("connection failure")))
+	assert.False(t, permanent(nil))
+}
+
 func TestConfig(t *testing.T) {
-	factoryFunc := func(ePt string, errCh <-chan error, o ...Option) (metric.Exporter, *otest.HTTPCollector) {
-		coll, err := otest.NewHTTPCollector(ePt, errCh)
+	factoryFunc := func(ePt string, rCh <-chan otest.ExportResult, o ...Option) (metric.Exporter, *otest.HTTPCollector) {
+		coll, err := otest.NewHTTPCollector(ePt, rCh)
 		require.NoError(t, err)
 
 		opts := []Option{WithEndpoint(coll.Addr().String())}
@@ -80,19 +132,59 @@ func TestConfig(t *testing.T) {
 		assert.Equal(t, got[key], []string{headers[key]})
 	})
 
+	t.Run("WithHeadersProvider", func(t *testing.T) {
+		key := http.CanonicalHeaderKey("my-custom-header")
+		var calls int
+		provider := func(context.Context) (map[string]string, error) {
+			calls++
+			return map[string]string{key: fmt.Sprintf("value-%d", calls)}, nil
+		}
+		exp, coll := factoryFunc(
+			"",
+			nil,
+			WithHeaders(map[string]string{key: "static-value"}),
+			WithHeadersProvider(provider),
+		)
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		require.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}))
+		// Ensure everything is flushed.
+		require.NoError(t, exp.Shutdown(ctx))
+
+		got := coll.Headers()
+		require.Regexp(t, "OTel OTLP Exporter Go/1\\..*", got)
+		require.Contains(t, got, key)
+		assert.Equal(t, []string{"value-1"}, got[key])
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("WithHeadersProviderError", func(t *testing.T) {
+		errProvider := errors.New("provider failure")
+		provider := func(context.Context) (map[string]string, error) {
+			return nil, errProvider
+		}
+		exp, coll := factoryFunc("", nil, WithHeadersProvider(provider))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		err := exp.Export(ctx, metricdata.ResourceMetrics{})
+		assert.ErrorIs(t, err, errProvider)
+		assert.Len(t, coll.Collect().Dump(), 0)
+	})
+
 	t.Run("WithTimeout", func(t *testing.T) {
-		// Do not send on errCh so the Collector never responds to the client.
-		errCh := make(chan error)
+		// Do not send on rCh so the Collector never responds to the client.
+		rCh := make(chan otest.ExportResult)
 		exp, coll := factoryFunc(
 			"",
-			errCh,
+			rCh,
 			WithTimeout(time.Millisecond),
 			WithRetry(RetryConfig{Enabled: false}),
 		)
 		ctx := context.Background()
 		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
 		// Push this after Shutdown so the HTTP server doesn't hang.
-		t.Cleanup(func() { close(errCh) })
+		t.Cleanup(func() { close(rCh) })
 		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
 		err := exp.Export(ctx, metricdata.ResourceMetrics{})
 		assert.ErrorContains(t, err, context.DeadlineExceeded.Error())
@@ -107,15 +199,198 @@ func TestConfig(t *testing.T) {
 		assert.Len(t, coll.Collect().Dump(), 1)
 	})
 
+	t.Run("WithCompressionZstd", func(t *testing.T) {
+		exp, coll := factoryFunc("", nil, WithCompression(ZstdCompression))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		assert.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}))
+		assert.Len(t, coll.Collect().Dump(), 1)
+	})
+
+	t.Run("WithCompressionSnappy", func(t *testing.T) {
+		exp, coll := factoryFunc("", nil, WithCompression(SnappyCompression))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		assert.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}))
+		assert.Len(t, coll.Collect().Dump(), 1)
+	})
+
+	t.Run("WithMaxRequestSize", func(t *testing.T) {
+		exp, coll := factoryFunc("", nil, WithMaxRequestSize(1))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		rm := metricdata.ResourceMetrics{
+			ScopeMetrics: []metricdata.ScopeMetrics{{
+				Metrics: []metricdata.Metrics{{
+					Name: "gauge",
+					Data: metricdata.Gauge[int64]{
+						DataPoints: []metricdata.DataPoint[int64]{
+							{Value: 1}, {Value: 2}, {Value: 3},
+						},
+					},
+				}},
+			}},
+		}
+		// A limit smaller than any data point sends each in its own request.
+		assert.NoError(t, exp.Export(ctx, rm))
+		assert.Len(t, coll.Collect().Dump(), 3)
+	})
+
+	t.Run("WithProxy", func(t *testing.T) {
+		var proxied []string
+		// The collector acts as the proxy for an unresolvable endpoint.
+		var collURL *url.URL
+		proxy := func(r *http.Request) (*url.URL, error) {
+			proxied = append(proxied, r.URL.Host)
+			return collURL, nil
+		}
+		exp, coll := factoryFunc(
+			"",
+			nil,
+			WithEndpoint("proxied.invalid:4318"),
+			WithProxy(proxy),
+		)
+		collURL = &url.URL{Scheme: "http", Host: coll.Addr().String()}
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		assert.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}))
+		assert.Len(t, coll.Collect().Dump(), 1)
+		assert.Equal(t, []string{"proxied.invalid:4318"}, proxied)
+	})
+
+	t.Run("WithPersistentQueue", func(t *testing.T) {
+		dir := t.TempDir()
+		qc := QueueConfig{Directory: dir, InitialInterval: time.Minute}
+
+		rCh := make(chan otest.ExportResult, 2)
+		for i := 0; i < 2; i++ {
+			rCh <- otest.ExportResult{Err: &otest.HTTPResponseError{
+				Status: http.StatusServiceUnavailable,
+				Err:    errors.New("unavailable"),
+			}}
+		}
+		exp, coll := factoryFunc("", rCh, WithPersistentQueue(qc), WithRetry(RetryConfig{Enabled: false}))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		// Push this after Shutdown so the HTTP server doesn't hang.
+		t.Cleanup(func() { close(rCh) })
+		// Accepted once written to disk.
+		require.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}))
+		// The data cannot be sent and remains queued.
+		assert.Error(t, exp.Shutdown(ctx))
+
+		// A new exporter sends the data persisted by the previous one.
+		exp, coll = factoryFunc("", nil, WithPersistentQueue(qc))
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		require.NoError(t, exp.Shutdown(ctx))
+		assert.Len(t, coll.Collect().Dump(), 1)
+	})
+
+	t.Run("WithFailover", func(t *testing.T) {
+		ctx := context.Background()
+		secondary, err := otest.NewHTTPCollector("", nil)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, secondary.Shutdown(ctx)) })
+
+		rCh := make(chan otest.ExportResult, 1)
+		rCh <- otest.ExportResult{Err: &otest.HTTPResponseError{
+			Status: http.StatusServiceUnavailable,
+			Err:    errors.New("unavailable"),
+		}}
+		exp, coll := factoryFunc(
+			"",
+			rCh,
+			WithRetry(RetryConfig{Enabled: false}),
+			WithFailover(FailoverConfig{
+				Endpoints:   []string{secondary.Addr().String()},
+				MaxFailures: 1,
+			}),
+		)
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		// Push this after Shutdown so the HTTP server doesn't hang.
+		t.Cleanup(func() { close(rCh) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+		// The failed export is sent to the secondary endpoint.
+		require.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}))
+		require.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}))
+		assert.Len(t, coll.Collect().Dump(), 1)
+		assert.Len(t, secondary.Collect().Dump(), 2)
+	})
+
+	t.Run("WithMiddleware", func(t *testing.T) {
+		var calls int
+		count := func(next otlpmetric.UploadFunc) otlpmetric.UploadFunc {
+			return func(ctx context.Context, rm *mpb.ResourceMetrics) error {
+				calls++
+				return next(ctx, rm)
+			}
+		}
+		exp, coll := factoryFunc("", nil, WithMiddleware(count))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		require.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}))
+
+		assert.Equal(t, 1, calls)
+		assert.Len(t, coll.Collect().Dump(), 1)
+	})
+
+	t.Run("WithMeterProvider", func(t *testing.T) {
+		rCh := make(chan otest.ExportResult, 2)
+		rCh <- otest.ExportResult{Err: &otest.HTTPResponseError{
+			Status: http.StatusServiceUnavailable,
+			Err:    errors.New("unavailable"),
+		}}
+		rCh <- otest.ExportResult{Response: &colmetricpb.ExportMetricsServiceResponse{
+			PartialSuccess: &colmetricpb.ExportMetricsPartialSuccess{RejectedDataPoints: 3},
+		}}
+		reader := metric.NewManualReader()
+		mp := metric.NewMeterProvider(metric.WithReader(reader))
+		exp, coll := factoryFunc("", rCh, WithMeterProvider(mp), WithRetry(RetryConfig{
+			Enabled:         true,
+			InitialInterval: time.Nanosecond,
+			MaxInterval:     time.Millisecond,
+			MaxElapsedTime:  time.Minute,
+		}))
+		ctx := context.Background()
+		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+		// Push this after Shutdown so the HTTP server doesn't hang.
+		t.Cleanup(func() { close(rCh) })
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		require.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}))
+
+		got, err := reader.Collect(ctx)
+		require.NoError(t, err)
+		require.Len(t, got.ScopeMetrics, 1)
+		assert.Equal(t, instrumentationName, got.ScopeMetrics[0].Scope.Name)
+		assert.Equal(t, map[string]int64{
+			observ.AttemptsName:          2,
+			observ.FailuresName + "/503": 1,
+			observ.RejectedName:          3,
+		}, sums(got.ScopeMetrics[0].Metrics))
+	})
+
 	t.Run("WithRetry", func(t *testing.T) {
 		emptyErr := errors.New("")
-		errCh := make(chan error, 3)
+		rCh := make(chan otest.ExportResult, 3)
 		header := http.Header{http.CanonicalHeaderKey("Retry-After"): {"10"}}
 		// Both retryable errors.
-		errCh <- &otest.HTTPResponseError{Status: http.StatusServiceUnavailable, Err: emptyErr, Header: header}
-		errCh <- &otest.HTTPResponseError{Status: http.StatusTooManyRequests, Err: emptyErr}
-		errCh <- nil
-		exp, coll := factoryFunc("", errCh, WithRetry(RetryConfig{
+		rCh <- otest.ExportResult{Err: &otest.HTTPResponseError{
+			Status: http.StatusServiceUnavailable,
+			Err:    emptyErr,
+			Header: header,
+		}}
+		rCh <- otest.ExportResult{Err: &otest.HTTPResponseError{
+			Status: http.StatusTooManyRequests,
+			Err:    emptyErr,
+		}}
+		rCh <- otest.ExportResult{}
+		exp, coll := factoryFunc("", rCh, WithRetry(RetryConfig{
 			Enabled:         true,
 			InitialInterval: time.Nanosecond,
 			MaxInterval:     time.Millisecond,
@@ -124,10 +399,10 @@ func TestConfig(t *testing.T) {
 		ctx := context.Background()
 		t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
 		// Push this after Shutdown so the HTTP server doesn't hang.
-		t.Cleanup(func() { close(errCh) })
+		t.Cleanup(func() { close(rCh) })
 		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
 		assert.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}), "failed retry")
-		assert.Len(t, errCh, 0, "failed HTTP responses did not occur")
+		assert.Len(t, rCh, 0, "failed HTTP responses did not occur")
 	})
 
 	t.Run("WithURLPath", func(t *testing.T) {
@@ -178,3 +453,23 @@ func TestConfig(t *testing.T) {
 		assert.Equal(t, got[key], []string{headers[key]})
 	})
 }
+
+// sums returns the value of each int64 sum in metrics keyed by name. Failure
+// counts are keyed by name and error code.
+func sums(metrics []metricdata.Metrics) map[string]int64 {
+	out := make(map[string]int64)
+	for _, m := range metrics {
+		s, ok := m.Data.(metricdata.Sum[int64])
+		if !ok {
+			continue
+		}
+		for _, dp := range s.DataPoints {
+			key := m.Name
+			if v, ok := dp.Attributes.Value(observ.ErrorCodeKey); ok {
+				key += "/" + v.AsString()
+			}
+			out[key] += dp.Value
+		}
+	}
+	return out
+}
@@ -15,11 +15,18 @@
 package otlpmetrichttp // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
 
 import (
+	"context"
 	"crypto/tls"
+	"net/http"
+	"net/url"
 	"time"
 
+	"go.opentelemetry.io/otel/exporters/otlp/internal/diskqueue"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/oconf"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric"
 )
 
 // Compression describes the compression used for payloads sent to the
@@ -33,6 +40,12 @@ const (
 	// GzipCompression tells the driver to send payloads after
 	// compressing them with gzip.
 	GzipCompression = Compression(oconf.GzipCompression)
+	// ZstdCompression tells the driver to send payloads after
+	// compressing them with zstd.
+	ZstdCompression = Compression(oconf.ZstdCompression)
+	// SnappyCompression tells the driver to send payloads after
+	// compressing them with snappy.
+	SnappyCompression = Compression(oconf.SnappyCompression)
 )
 
 // Option applies an option to the Exporter.
@@ -52,6 +65,19 @@ func asHTTPOptions(opts []Option) []oconf.HTTPOption {
 // that failed.
 type RetryConfig retry.Config
 
+// QueueConfig defines configuration for the file-backed queue metric data
+// is written to before it is sent.
+type QueueConfig diskqueue.Config
+
+// FailoverConfig defines the endpoints metric data is sent to when the
+// endpoint of the exporter is unavailable.
+type FailoverConfig oconf.FailoverConfig
+
+// HTTPTransportProxyFunc is a function that resolves which URL to use as proxy
+// for a given request. This type is compatible with http.Transport.Proxy and
+// can be used to set a custom proxy function with WithProxy.
+type HTTPTransportProxyFunc func(*http.Request) (*url.URL, error)
+
 type wrappedOption struct {
 	oconf.HTTPOption
 }
@@ -81,7 +107,7 @@ func WithEndpoint(endpoint string) Option {
 // If the OTEL_EXPORTER_OTLP_COMPRESSION or
 // OTEL_EXPORTER_OTLP_METRICS_COMPRESSION environment variable is set, and
 // this option is not passed, that variable value will be used. That value can
-// be either "none" or "gzip". If both are set,
+// be either "none", "gzip", "zstd", or "snappy". If both are set,
 // OTEL_EXPORTER_OTLP_METRICS_COMPRESSION will take precedence.
 //
 // By default, if an environment variable is not set, and this option is not
@@ -95,7 +121,9 @@ func WithCompression(compression Compression) Option {
 // If the OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_METRICS_ENDPOINT
 // environment variable is set, and this option is not passed, the path
 // contained in that variable value will be used. If both are set,
-// OTEL_EXPORTER_OTLP_METRICS_ENDPOINT will take precedence.
+// OTEL_EXPORTER_OTLP_METRICS_ENDPOINT will take precedence. The path of
+// OTEL_EXPORTER_OTLP_METRICS_ENDPOINT is used exactly as it is set, while
+// "/v1/metrics" is appended to the path of OTEL_EXPORTER_OTLP_ENDPOINT.
 //
 // By default, if an environment variable is not set, and this option is not
 // passed, "/v1/metrics" will be used.
@@ -112,6 +140,14 @@ func WithURLPath(urlPath string) Option {
 // be parsed the filepath of the TLS certificate chain to use. If both are
 // set, OTEL_EXPORTER_OTLP_METRICS_CERTIFICATE will take precedence.
 //
+// If the OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE and
+// OTEL_EXPORTER_OTLP_CLIENT_KEY, or the
+// OTEL_EXPORTER_OTLP_METRICS_CLIENT_CERTIFICATE and
+// OTEL_EXPORTER_OTLP_METRICS_CLIENT_KEY, environment variables are set, and
+// this option is not passed, those variable values will be parsed as the
+// filepaths of the PEM encoded client certificate and key used for mutual
+// TLS. The METRICS variables take precedence if both pairs are set.
+//
 // By default, if an environment variable is not set, and this option is not
 // passed, the system default configuration is used.
 func WithTLSClientConfig(tlsCfg *tls.Config) Option {
@@ -166,6 +202,33 @@ func WithTimeout(duration time.Duration) Option {
 	return wrappedOption{oconf.WithTimeout(duration)}
 }
 
+// WithMaxRequestSize sets the maximum serialized size, in bytes, of an export
+// request. Exports with a larger payload are split into multiple requests
+// that are each within this limit. The limit applies to the payload before
+// any compression. A single data point larger than the limit is still sent
+// in its own request.
+//
+// By default, if this option is not passed or size is not positive, exports
+// are not split.
+func WithMaxRequestSize(size int) Option {
+	return wrappedOption{oconf.WithMaxRequestSize(size)}
+}
+
+// WithMeterProvider sets the MeterProvider the exporter uses to measure its
+// own operation. The exporter records the number of export request attempts,
+// the number of failed attempts by error code, the size of export request
+// payloads, the duration of exports including any retries, and the number of
+// data points rejected by the server in partially successful exports. These
+// can be used to alert on a failing telemetry pipeline.
+//
+// The MeterProvider may itself use this exporter. Measurements made during
+// an export are included in a subsequent export.
+//
+// By default, if this option is not passed, no measurements are recorded.
+func WithMeterProvider(mp otelmetric.MeterProvider) Option {
+	return wrappedOption{oconf.WithMeterProvider(mp)}
+}
+
 // WithRetry sets the retry policy for transient retryable errors that are
 // returned by the target endpoint.
 //
@@ -179,3 +242,114 @@ func WithTimeout(duration time.Duration) Option {
 func WithRetry(rc RetryConfig) Option {
 	return wrappedOption{oconf.WithRetry(retry.Config(rc))}
 }
+
+// WithPersistentQueue sets the exporter to write all metric data to a
+// file-backed queue in the Directory of cfg before it is sent. Queued data is
+// sent in the background, in order, and sending is retried with an
+// exponential backoff while the target endpoint is unreachable. Data that has
+// not been sent when the exporter is shut down remains on disk and is sent
+// by the next exporter created with the same Directory.
+//
+// When the queue is full, the oldest data is dropped. If MaxSize is not set,
+// the queue holds up to 64 MiB. Data the endpoint rejects with an error that
+// is not retry-able is dropped instead of being sent again.
+//
+// By default, if this option is not passed or the Directory is empty, metric
+// data is sent directly and dropped if it cannot be delivered.
+func WithPersistentQueue(cfg QueueConfig) Option {
+	return wrappedOption{oconf.WithPersistentQueue(diskqueue.Config(cfg))}
+}
+
+// WithFailover sets the endpoints the exporter fails over to, in order of
+// priority, when the endpoint it is configured with is unavailable. Once
+// MaxFailures consecutive exports have failed, the exporter sends metric data
+// to the next endpoint instead, starting with the failed export. While not
+// sending to its configured endpoint, the exporter attempts to fall back to
+// an endpoint with a higher priority at most once every ProbeInterval. These
+// probes make a single attempt, that is not retried, and time out after 5
+// seconds.
+//
+// All endpoints use the same configuration other than their address.
+//
+// By default, if MaxFailures or ProbeInterval are not positive, failing over
+// happens after 3 consecutive failed exports and endpoints with a higher
+// priority are probed at most once a minute. If this option is not passed,
+// the exporter does not fail over.
+func WithFailover(cfg FailoverConfig) Option {
+	return wrappedOption{oconf.WithFailover(oconf.FailoverConfig(cfg))}
+}
+
+// WithMiddleware adds mw to the Middleware all exports of metric data are
+// passed through before they are sent. This can be used to add behavior such
+// as logging, sampling, or signing requests to the exporter. The first
+// Middleware passed is called first. If this option is passed multiple
+// times, the Middleware of later options are called after those of earlier
+// ones.
+//
+// If a persistent queue is used, Middleware is called when data is sent from
+// the queue.
+//
+// By default, if this option is not passed, exports are sent directly.
+func WithMiddleware(mw ...otlpmetric.Middleware) Option {
+	return wrappedOption{oconf.WithMiddleware(mw...)}
+}
+
+// WithTemporalitySelector sets the TemporalitySelector the client will use to
+// determine the Temporality of an instrument based on its kind. A
+// PeriodicReader using the Exporter will produce metric data with this
+// Temporality.
+//
+// If the OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE environment
+// variable is set, and this option is not passed, that variable value will be
+// used. The value is case insensitive and can be one of the following:
+//
+//   - "cumulative": cumulative temporality is used for all instrument kinds.
+//   - "delta": delta temporality is used for Counter, Asynchronous Counter,
+//     and Histogram instruments, and cumulative temporality is used for
+//     UpDownCounter and Asynchronous UpDownCounter instruments.
+//   - "lowmemory": delta temporality is used for Counter and Histogram
+//     instruments, and cumulative temporality is used for all others.
+//
+// By default, if an environment variable is not set, and this option is not
+// passed, the DefaultTemporalitySelector from the
+// go.opentelemetry.io/otel/sdk/metric package will be used.
+func WithTemporalitySelector(selector metric.TemporalitySelector) Option {
+	return wrappedOption{oconf.WithTemporalitySelector(selector)}
+}
+
+// WithAggregationSelector sets the AggregationSelector the client will use to
+// determine the aggregation to use for an instrument based on its kind. A
+// PeriodicReader using the Exporter will use this aggregation for all
+// instruments not matched by a view with an explicit aggregation.
+//
+// If the OTEL_EXPORTER_OTLP_METRICS_DEFAULT_HISTOGRAM_AGGREGATION environment
+// variable is set, and this option is not passed, that variable value will be
+// used to determine the aggregation of Histogram instruments. The only
+// currently supported value is "explicit_bucket_histogram".
+//
+// By default, if an environment variable is not set, and this option is not
+// passed, the DefaultAggregationSelector from the
+// go.opentelemetry.io/otel/sdk/metric package will be used.
+func WithAggregationSelector(selector metric.AggregationSelector) Option {
+	return wrappedOption{oconf.WithAggregationSelector(selector)}
+}
+
+// WithHeadersProvider sets fn to be called before every export request to
+// provide additional headers to send with that request. This allows
+// short-lived credentials (e.g. OAuth tokens) to be refreshed without
+// recreating the Exporter.
+//
+// Headers returned by fn take precedence over any headers with the same key
+// set using WithHeaders. If fn returns an error, the export request is not
+// sent and that error is returned.
+func WithHeadersProvider(fn func(ctx context.Context) (map[string]string, error)) Option {
+	return wrappedOption{oconf.WithHeadersProvider(fn)}
+}
+
+// WithProxy sets the Proxy function the client will use to determine the
+// proxy to use for an HTTP request. If this option is not used, the client
+// will use http.ProxyFromEnvironment, which respects the HTTPS_PROXY,
+// HTTP_PROXY, and NO_PROXY environment variables.
+func WithProxy(pf HTTPTransportProxyFunc) Option {
+	return wrappedOption{oconf.WithProxy(oconf.HTTPTransportProxyFunc(pf))}
+}
@@ -5,6 +5,7 @@ go 1.18
 retract v0.32.2 // Contains unresolvable dependencies.
 
 require (
+	github.com/klauspost/compress v1.15.12
 	github.com/stretchr/testify v1.8.0
 	go.opentelemetry.io/otel v1.11.1
 	go.opentelemetry.io/otel/exporters/otlp/internal/retry v1.11.1
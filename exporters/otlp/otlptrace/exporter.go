@@ -48,6 +48,15 @@ func (e *Exporter) ExportSpans(ctx context.Context, ss []tracesdk.ReadOnlySpan)
 	return e.client.UploadTraces(ctx, protoSpans)
 }
 
+// ForceFlush waits for any exports the client sends in the background to
+// complete. It does nothing if the client sends exports synchronously.
+func (e *Exporter) ForceFlush(ctx context.Context) error {
+	if f, ok := e.client.(interface{ ForceFlush(context.Context) error }); ok {
+		return f.ForceFlush(ctx)
+	}
+	return nil
+}
+
 // Start establishes a connection to the receiving endpoint.
 func (e *Exporter) Start(ctx context.Context) error {
 	var err = errAlreadyStarted
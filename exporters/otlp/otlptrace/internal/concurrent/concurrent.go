@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package concurrent provides an otlptrace.Client that sends multiple
+// uploads concurrently.
+package concurrent // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/concurrent"
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+var errStopped = errors.New("client stopped")
+
+type client struct {
+	client otlptrace.Client
+
+	// sem bounds the number of uploads in flight.
+	sem chan struct{}
+
+	// stopCtx is the parent context of all uploads. It is canceled if Stop
+	// is not able to wait for all uploads to complete.
+	stopCtx  context.Context
+	stopFunc context.CancelFunc
+
+	mu      sync.RWMutex
+	stopped bool
+
+	// uploadMu guards the fields below it.
+	uploadMu sync.Mutex
+	// inFlight is the number of uploads that have not returned.
+	inFlight int
+	// idle is closed when inFlight returns to zero.
+	idle chan struct{}
+}
+
+// Compile time check *client implements otlptrace.Client.
+var _ otlptrace.Client = (*client)(nil)
+
+// NewClient returns a Client that sends up to n uploads with c
+// concurrently. Uploads are sent in the background and the error of each one
+// that fails is sent to the global ErrorHandler as it completes. The returned
+// Client has a ForceFlush method that waits for the uploads in flight to
+// complete, as does Stop.
+func NewClient(c otlptrace.Client, n int) otlptrace.Client {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &client{
+		client:   c,
+		sem:      make(chan struct{}, n),
+		stopCtx:  ctx,
+		stopFunc: cancel,
+	}
+}
+
+// Start starts the wrapped client.
+func (c *client) Start(ctx context.Context) error {
+	return c.client.Start(ctx)
+}
+
+// Stop waits for all uploads in flight to complete before stopping the
+// wrapped client. If ctx is done first, the uploads still in flight are
+// canceled.
+func (c *client) Stop(ctx context.Context) error {
+	c.mu.Lock()
+	c.stopped = true
+	c.mu.Unlock()
+
+	err := c.wait(ctx)
+	if err != nil {
+		c.stopFunc()
+		// The uploads return promptly once canceled.
+		_ = c.wait(context.Background())
+	}
+	c.stopFunc()
+
+	if sErr := c.client.Stop(ctx); err == nil {
+		err = sErr
+	}
+	return err
+}
+
+// ForceFlush waits for all uploads in flight to complete, or ctx to be done.
+func (c *client) ForceFlush(ctx context.Context) error {
+	return c.wait(ctx)
+}
+
+// UploadTraces starts sending protoSpans in the background. It blocks while
+// the maximum number of uploads are in flight.
+//
+// The upload is not bound to ctx as it is expected to complete after this
+// returns. Timeouts of the wrapped client still apply. An error returned by
+// the upload is sent to the global ErrorHandler.
+func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	select {
+	case c.sem <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.stopped {
+		<-c.sem
+		return errStopped
+	}
+
+	c.add()
+	go func() {
+		defer func() { <-c.sem }()
+		c.done(c.client.UploadTraces(c.stopCtx, protoSpans))
+	}()
+	return nil
+}
+
+// add records an upload is in flight.
+func (c *client) add() {
+	c.uploadMu.Lock()
+	defer c.uploadMu.Unlock()
+	if c.inFlight == 0 {
+		c.idle = make(chan struct{})
+	}
+	c.inFlight++
+}
+
+// done records an upload returned err, and reports err if it is not nil.
+func (c *client) done(err error) {
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	c.uploadMu.Lock()
+	defer c.uploadMu.Unlock()
+	c.inFlight--
+	if c.inFlight == 0 {
+		close(c.idle)
+	}
+}
+
+// wait waits for all uploads in flight to complete or ctx to be done.
+func (c *client) wait(ctx context.Context) error {
+	c.uploadMu.Lock()
+	if c.inFlight == 0 {
+		c.uploadMu.Unlock()
+		return nil
+	}
+	idle := c.idle
+	c.uploadMu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
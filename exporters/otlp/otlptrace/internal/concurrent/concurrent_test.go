@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+// blockingClient is an otlptrace.Client whose uploads block until released.
+type blockingClient struct {
+	release chan struct{}
+	// err is returned by uploads once released.
+	err error
+
+	mu       sync.Mutex
+	inFlight int
+	max      int
+	uploads  int
+	stopped  bool
+}
+
+func newBlockingClient() *blockingClient {
+	return &blockingClient{release: make(chan struct{})}
+}
+
+func (c *blockingClient) Start(context.Context) error { return nil }
+
+func (c *blockingClient) Stop(context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stopped = true
+	return nil
+}
+
+func (c *blockingClient) UploadTraces(ctx context.Context, _ []*tracepb.ResourceSpans) error {
+	c.mu.Lock()
+	c.inFlight++
+	if c.inFlight > c.max {
+		c.max = c.inFlight
+	}
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		c.inFlight--
+		c.uploads++
+		c.mu.Unlock()
+	}()
+
+	select {
+	case <-c.release:
+		return c.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *blockingClient) counts() (inFlight, max, uploads int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.inFlight, c.max, c.uploads
+}
+
+func TestClientBoundsConcurrency(t *testing.T) {
+	bc := newBlockingClient()
+	c := NewClient(bc, 2)
+	ctx := context.Background()
+	require.NoError(t, c.Start(ctx))
+
+	require.NoError(t, c.UploadTraces(ctx, nil))
+	require.NoError(t, c.UploadTraces(ctx, nil))
+	assert.Eventually(t, func() bool {
+		n, _, _ := bc.counts()
+		return n == 2
+	}, time.Second, time.Millisecond)
+
+	// A third upload blocks until one in flight completes.
+	short, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, c.UploadTraces(short, nil), context.DeadlineExceeded)
+
+	close(bc.release)
+	require.NoError(t, c.UploadTraces(ctx, nil))
+	require.NoError(t, c.Stop(ctx))
+
+	_, max, uploads := bc.counts()
+	assert.Equal(t, 2, max)
+	assert.Equal(t, 3, uploads)
+	assert.True(t, bc.stopped)
+	assert.ErrorIs(t, c.UploadTraces(ctx, nil), errStopped)
+}
+
+func TestClientStopCancelsInFlight(t *testing.T) {
+	bc := newBlockingClient()
+	c := NewClient(bc, 2)
+	ctx := context.Background()
+	require.NoError(t, c.Start(ctx))
+	require.NoError(t, c.UploadTraces(ctx, nil))
+
+	stopCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, c.Stop(stopCtx), context.DeadlineExceeded)
+
+	inFlight, _, uploads := bc.counts()
+	assert.Equal(t, 0, inFlight)
+	assert.Equal(t, 1, uploads)
+	assert.True(t, bc.stopped)
+}
+
+func TestClientForceFlush(t *testing.T) {
+	orig := otel.GetErrorHandler()
+	t.Cleanup(func() { otel.SetErrorHandler(orig) })
+	var (
+		mu   sync.Mutex
+		errs []error
+	)
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, err)
+	}))
+	handled := func() []error {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]error(nil), errs...)
+	}
+
+	bc := newBlockingClient()
+	bc.err = errors.New("upload failed")
+	c := NewClient(bc, 2).(*client)
+	ctx := context.Background()
+	require.NoError(t, c.Start(ctx))
+	assert.NoError(t, c.ForceFlush(ctx), "no uploads")
+
+	require.NoError(t, c.UploadTraces(ctx, nil))
+	require.NoError(t, c.UploadTraces(ctx, nil))
+
+	short, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, c.ForceFlush(short), context.DeadlineExceeded)
+
+	close(bc.release)
+	assert.NoError(t, c.ForceFlush(ctx))
+	_, _, uploads := bc.counts()
+	assert.Equal(t, 2, uploads)
+	// Each failed upload is reported as it completes.
+	assert.Equal(t, []error{bc.err, bc.err}, handled())
+
+	require.NoError(t, c.UploadTraces(ctx, nil))
+	assert.NoError(t, c.Stop(ctx))
+	assert.Equal(t, []error{bc.err, bc.err, bc.err}, handled())
+}
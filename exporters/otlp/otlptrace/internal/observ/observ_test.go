@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observ
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func collect(t *testing.T, r sdkmetric.Reader) map[string]metricdata.Aggregation {
+	t.Helper()
+
+	rm, err := r.Collect(context.Background())
+	require.NoError(t, err)
+
+	got := make(map[string]metricdata.Aggregation)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			got[m.Name] = m.Data
+		}
+	}
+	return got
+}
+
+func TestInstrumentation(t *testing.T) {
+	r := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(r))
+	inst := New(mp, "test", "traces", "grpc")
+
+	ctx := context.Background()
+	errFail := errors.New("failed")
+	inst.Attempt(ctx, "", nil)
+	inst.Attempt(ctx, "Unavailable", errFail)
+	inst.Attempt(ctx, "Unavailable", errFail)
+	inst.Attempt(ctx, "", errFail)
+	inst.Export(ctx, 100, 2*time.Millisecond)
+
+	got := collect(t, r)
+	attrs := []attribute.KeyValue{
+		SignalKey.String("traces"),
+		TransportKey.String("grpc"),
+	}
+
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints: []metricdata.DataPoint[int64]{
+			{Attributes: attribute.NewSet(attrs...), Value: 4},
+		},
+	}, got[AttemptsName], metricdatatest.IgnoreTimestamp())
+
+	failures, ok := got[FailuresName].(metricdata.Sum[int64])
+	require.True(t, ok)
+	codes := make(map[string]int64)
+	for _, dp := range failures.DataPoints {
+		v, _ := dp.Attributes.Value(ErrorCodeKey)
+		codes[v.AsString()] = dp.Value
+		assert.True(t, dp.Attributes.HasValue(SignalKey))
+		assert.True(t, dp.Attributes.HasValue(TransportKey))
+	}
+	assert.Equal(t, map[string]int64{"Unavailable": 2, "unknown": 1}, codes)
+
+	size, ok := got[SizeName].(metricdata.Histogram)
+	require.True(t, ok)
+	require.Len(t, size.DataPoints, 1)
+	assert.Equal(t, uint64(1), size.DataPoints[0].Count)
+	assert.Equal(t, float64(100), size.DataPoints[0].Sum)
+
+	duration, ok := got[DurationName].(metricdata.Histogram)
+	require.True(t, ok)
+	require.Len(t, duration.DataPoints, 1)
+	assert.Equal(t, uint64(1), duration.DataPoints[0].Count)
+	assert.Equal(t, float64(2), duration.DataPoints[0].Sum)
+}
+
+func TestInstrumentationNilMeterProvider(t *testing.T) {
+	inst := New(nil, "test", "traces", "http")
+	assert.NotPanics(t, func() {
+		inst.Attempt(context.Background(), "503", errors.New("failed"))
+		inst.Export(context.Background(), 10, time.Second)
+	})
+}
@@ -15,8 +15,11 @@
 package otlpconfig // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/otlpconfig"
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
+	"net/http"
+	"net/url"
 	"time"
 
 	"google.golang.org/grpc"
@@ -26,7 +29,9 @@ import (
 	"google.golang.org/grpc/encoding/gzip"
 
 	"go.opentelemetry.io/otel/exporters/otlp/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/internal/diskqueue"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/retry"
+	"go.opentelemetry.io/otel/metric"
 )
 
 const (
@@ -39,6 +44,10 @@ const (
 )
 
 type (
+	// HTTPTransportProxyFunc is a function that resolves which URL to use
+	// as proxy for a given request.
+	HTTPTransportProxyFunc func(*http.Request) (*url.URL, error)
+
 	SignalConfig struct {
 		Endpoint    string
 		Insecure    bool
@@ -48,6 +57,27 @@ type (
 		Timeout     time.Duration
 		URLPath     string
 
+		// HeadersProvider returns headers to send with each request in
+		// addition to Headers.
+		HeadersProvider func(context.Context) (map[string]string, error)
+
+		// MaxRequestSize is the maximum serialized size, in bytes, of an
+		// export request. Larger exports are split into multiple requests.
+		// No limit is applied if it is not positive.
+		MaxRequestSize int
+
+		// MeterProvider provides the Meter used to measure the operation of
+		// the exporter. No measurements are made if it is nil.
+		MeterProvider metric.MeterProvider
+
+		// MaxConcurrentExports is the maximum number of export requests sent
+		// concurrently. Exports are sent serially if this is not greater than
+		// one.
+		MaxConcurrentExports int
+
+		// HTTP configurations
+		Proxy HTTPTransportProxyFunc
+
 		// gRPC configurations
 		GRPCCredentials credentials.TransportCredentials
 	}
@@ -58,6 +88,10 @@ type (
 
 		RetryConfig retry.Config
 
+		// QueueConfig configures a file-backed queue that uploads are
+		// written to before they are sent.
+		QueueConfig diskqueue.Config
+
 		// gRPC configurations
 		ReconnectionPeriod time.Duration
 		ServiceConfig      string
@@ -82,7 +116,6 @@ func NewHTTPConfig(opts ...HTTPOption) Config {
 	for _, opt := range opts {
 		cfg = opt.ApplyHTTPOption(cfg)
 	}
-	cfg.Traces.URLPath = internal.CleanPath(cfg.Traces.URLPath, DefaultTracesPath)
 	return cfg
 }
 
@@ -118,8 +151,13 @@ func NewGRPCConfig(opts ...GRPCOption) Config {
 		cfg.Traces.GRPCCredentials = creds
 		cfg.DialOptions = append(cfg.DialOptions, grpc.WithTransportCredentials(creds))
 	}
-	if cfg.Traces.Compression == GzipCompression {
+	switch cfg.Traces.Compression {
+	case GzipCompression:
 		cfg.DialOptions = append(cfg.DialOptions, grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
+	case ZstdCompression:
+		cfg.DialOptions = append(cfg.DialOptions, grpc.WithDefaultCallOptions(grpc.UseCompressor(ZstdCompressorName)))
+	case SnappyCompression:
+		cfg.DialOptions = append(cfg.DialOptions, grpc.WithDefaultCallOptions(grpc.UseCompressor(SnappyCompressorName)))
 	}
 	if len(cfg.DialOptions) != 0 {
 		cfg.DialOptions = append(cfg.DialOptions, cfg.DialOptions...)
@@ -257,7 +295,7 @@ func WithCompression(compression Compression) GenericOption {
 
 func WithURLPath(urlPath string) GenericOption {
 	return newGenericOption(func(cfg Config) Config {
-		cfg.Traces.URLPath = urlPath
+		cfg.Traces.URLPath = internal.CleanPath(urlPath, DefaultTracesPath)
 		return cfg
 	})
 }
@@ -269,6 +307,13 @@ func WithRetry(rc retry.Config) GenericOption {
 	})
 }
 
+func WithPersistentQueue(qc diskqueue.Config) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.QueueConfig = qc
+		return cfg
+	})
+}
+
 func WithTLSClientConfig(tlsCfg *tls.Config) GenericOption {
 	return newSplitOption(func(cfg Config) Config {
 		cfg.Traces.TLSCfg = tlsCfg.Clone()
@@ -300,9 +345,44 @@ func WithHeaders(headers map[string]string) GenericOption {
 	})
 }
 
+func WithHeadersProvider(fn func(context.Context) (map[string]string, error)) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.HeadersProvider = fn
+		return cfg
+	})
+}
+
 func WithTimeout(duration time.Duration) GenericOption {
 	return newGenericOption(func(cfg Config) Config {
 		cfg.Traces.Timeout = duration
 		return cfg
 	})
 }
+
+func WithMaxRequestSize(size int) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.MaxRequestSize = size
+		return cfg
+	})
+}
+
+func WithMeterProvider(mp metric.MeterProvider) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.MeterProvider = mp
+		return cfg
+	})
+}
+
+func WithMaxConcurrentExports(n int) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.MaxConcurrentExports = n
+		return cfg
+	})
+}
+
+func WithProxy(pf HTTPTransportProxyFunc) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.Proxy = pf
+		return cfg
+	})
+}
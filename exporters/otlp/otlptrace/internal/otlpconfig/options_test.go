@@ -127,6 +127,30 @@ func TestConfigs(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Test Environment Signal Specific Endpoint Path",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "https://env.traces.endpoint/api/v1/otlp/v1/traces/",
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				if !grpcOption {
+					assert.Equal(t, "env.traces.endpoint", c.Traces.Endpoint)
+					// Used as-is, without /v1/traces appended or cleaning.
+					assert.Equal(t, "/api/v1/otlp/v1/traces/", c.Traces.URLPath)
+				}
+			},
+		},
+		{
+			name: "Test With URLPath",
+			opts: []otlpconfig.GenericOption{
+				otlpconfig.WithURLPath(" api//v1/otlp/v1/traces/ "),
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				if !grpcOption {
+					assert.Equal(t, "/api/v1/otlp/v1/traces", c.Traces.URLPath)
+				}
+			},
+		},
 		{
 			name: "Test Mixed Environment and With Endpoint",
 			opts: []otlpconfig.GenericOption{
@@ -149,6 +173,30 @@ func TestConfigs(t *testing.T) {
 				assert.Equal(t, true, c.Traces.Insecure)
 			},
 		},
+		{
+			name: "Test Environment Endpoint with unix scheme",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT": "unix:///tmp/agent.sock",
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				if grpcOption {
+					assert.Equal(t, "unix:///tmp/agent.sock", c.Traces.Endpoint)
+				}
+				assert.Equal(t, true, c.Traces.Insecure)
+			},
+		},
+		{
+			name: "Test Environment Endpoint with relative unix scheme",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT": "unix:agent.sock",
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				if grpcOption {
+					assert.Equal(t, "unix:agent.sock", c.Traces.Endpoint)
+				}
+				assert.Equal(t, true, c.Traces.Insecure)
+			},
+		},
 		{
 			name: "Test Environment Endpoint with HTTP scheme and leading & trailingspaces",
 			env: map[string]string{
@@ -243,6 +291,66 @@ func TestConfigs(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Test Environment Client Certificate",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE": "cert_path",
+				"OTEL_EXPORTER_OTLP_CLIENT_KEY":         "key_path",
+			},
+			fileReader: fileReader{
+				"cert_path": []byte(WeakCertificate),
+				"key_path":  []byte(WeakPrivateKey),
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				if grpcOption {
+					assert.NotNil(t, c.Traces.GRPCCredentials)
+				} else {
+					assert.Len(t, c.Traces.TLSCfg.Certificates, 1)
+					assert.Nil(t, c.Traces.TLSCfg.RootCAs)
+				}
+			},
+		},
+		{
+			name: "Test Environment Signal Specific Client Certificate",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_CERTIFICATE":               "cert_path",
+				"OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE":        "invalid_cert",
+				"OTEL_EXPORTER_OTLP_CLIENT_KEY":                "invalid_key",
+				"OTEL_EXPORTER_OTLP_TRACES_CLIENT_CERTIFICATE": "cert_path",
+				"OTEL_EXPORTER_OTLP_TRACES_CLIENT_KEY":         "key_path",
+			},
+			fileReader: fileReader{
+				"cert_path":    []byte(WeakCertificate),
+				"key_path":     []byte(WeakPrivateKey),
+				"invalid_cert": []byte("invalid certificate file."),
+				"invalid_key":  []byte("invalid key file."),
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				if grpcOption {
+					assert.NotNil(t, c.Traces.GRPCCredentials)
+				} else {
+					assert.Len(t, c.Traces.TLSCfg.Certificates, 1)
+					// nolint:staticcheck // ignoring tlsCert.RootCAs.Subjects is deprecated ERR because cert does not come from SystemCertPool.
+					assert.Equal(t, tlsCert.RootCAs.Subjects(), c.Traces.TLSCfg.RootCAs.Subjects())
+				}
+			},
+		},
+		{
+			name: "Test Environment Client Certificate Without Key",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE": "cert_path",
+			},
+			fileReader: fileReader{
+				"cert_path": []byte(WeakCertificate),
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				assert.Nil(t, c.Traces.TLSCfg)
+				if grpcOption {
+					// Default TLS credentials are used.
+					assert.NotNil(t, c.Traces.GRPCCredentials)
+				}
+			},
+		},
 		{
 			name: "Test Mixed Environment and With Certificate",
 			opts: []otlpconfig.GenericOption{},
@@ -317,6 +425,24 @@ func TestConfigs(t *testing.T) {
 				assert.Equal(t, otlpconfig.GzipCompression, c.Traces.Compression)
 			},
 		},
+		{
+			name: "Test Environment Zstd Compression",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_COMPRESSION": "zstd",
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				assert.Equal(t, otlpconfig.ZstdCompression, c.Traces.Compression)
+			},
+		},
+		{
+			name: "Test Environment Snappy Compression",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_TRACES_COMPRESSION": "snappy",
+			},
+			asserts: func(t *testing.T, c *otlpconfig.Config, grpcOption bool) {
+				assert.Equal(t, otlpconfig.SnappyCompression, c.Traces.Compression)
+			},
+		},
 		{
 			name: "Test Environment Signal Specific Compression",
 			env: map[string]string{
@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package persist provides an otlptrace.Client that writes uploads to a
+// file-backed queue before they are sent.
+package persist // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/persist"
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel/exporters/otlp/internal/diskqueue"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+type client struct {
+	client    otlptrace.Client
+	queue     *diskqueue.Queue
+	permanent func(error) bool
+}
+
+// Compile time check *client implements otlptrace.Client.
+var _ otlptrace.Client = (*client)(nil)
+
+// NewClient returns a Client that persists each upload to the queue
+// configured by cfg and sends it with c in the background.
+//
+// Uploads that fail with an error permanent reports as true are dropped
+// instead of being sent again.
+func NewClient(c otlptrace.Client, cfg diskqueue.Config, permanent func(error) bool) otlptrace.Client {
+	pc := &client{client: c, permanent: permanent}
+	pc.queue = diskqueue.New(cfg, pc.send)
+	return pc
+}
+
+// Start starts the wrapped client and then starts sending queued spans,
+// including any persisted by a previous Client using the same directory.
+func (c *client) Start(ctx context.Context) error {
+	if err := c.client.Start(ctx); err != nil {
+		return err
+	}
+	return c.queue.Start()
+}
+
+// Stop makes a final attempt to send all queued spans before stopping the
+// wrapped client. Spans that could not be sent remain on disk.
+func (c *client) Stop(ctx context.Context) error {
+	err := c.queue.Stop(ctx)
+	if sErr := c.client.Stop(ctx); err == nil {
+		err = sErr
+	}
+	return err
+}
+
+// UploadTraces persists protoSpans to the queue. It returns once the spans
+// are written to disk, not once they are sent.
+func (c *client) UploadTraces(_ context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	payload, err := proto.Marshal(&tracepb.TracesData{ResourceSpans: protoSpans})
+	if err != nil {
+		return err
+	}
+	return c.queue.Enqueue(payload)
+}
+
+func (c *client) send(ctx context.Context, payload []byte) error {
+	td := new(tracepb.TracesData)
+	if err := proto.Unmarshal(payload, td); err != nil {
+		// Sending again will not fix this, drop the payload.
+		return diskqueue.Permanent(fmt.Errorf("invalid span data: %w", err))
+	}
+	err := c.client.UploadTraces(ctx, td.ResourceSpans)
+	if err != nil && c.permanent(err) {
+		return diskqueue.Permanent(err)
+	}
+	return err
+}
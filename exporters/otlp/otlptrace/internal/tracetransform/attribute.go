@@ -15,6 +15,8 @@
 package tracetransform // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/tracetransform"
 
 import (
+	"sort"
+
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/resource"
 	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
@@ -101,6 +103,21 @@ func Value(v attribute.Value) *commonpb.AnyValue {
 				Values: stringSliceValues(v.AsStringSlice()),
 			},
 		}
+	case attribute.BYTES:
+		av.Value = &commonpb.AnyValue_BytesValue{
+			BytesValue: v.AsBytes(),
+		}
+	case attribute.TIMESTAMP:
+		// Timestamps are represented as nanoseconds since the Unix epoch.
+		av.Value = &commonpb.AnyValue_IntValue{
+			IntValue: v.AsTimestamp().UnixNano(),
+		}
+	case attribute.MAP:
+		av.Value = &commonpb.AnyValue_KvlistValue{
+			KvlistValue: &commonpb.KeyValueList{
+				Values: mapValues(v.AsMap()),
+			},
+		}
 	default:
 		av.Value = &commonpb.AnyValue_StringValue{
 			StringValue: "INVALID",
@@ -145,6 +162,21 @@ func float64SliceValues(vals []float64) []*commonpb.AnyValue {
 	return converted
 }
 
+// mapValues transforms m into OTLP key-values sorted by key.
+func mapValues(m map[string]attribute.Value) []*commonpb.KeyValue {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	converted := make([]*commonpb.KeyValue, len(keys))
+	for i, k := range keys {
+		converted[i] = &commonpb.KeyValue{Key: k, Value: Value(m[k])}
+	}
+	return converted
+}
+
 func stringSliceValues(vals []string) []*commonpb.AnyValue {
 	converted := make([]*commonpb.AnyValue, len(vals))
 	for i, v := range vals {
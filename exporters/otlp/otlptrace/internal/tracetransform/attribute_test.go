@@ -16,6 +16,7 @@ package tracetransform
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -38,6 +39,12 @@ func TestAttributes(t *testing.T) {
 				attribute.Float64("float64 to double", 1.61),
 				attribute.String("string to string", "string"),
 				attribute.Bool("bool to bool", true),
+				attribute.Bytes("bytes to bytes", []byte("bytes")),
+				attribute.Timestamp("timestamp to int64", time.Unix(1, 5)),
+				attribute.Map("map to kvlist", map[string]attribute.Value{
+					"string": attribute.StringValue("string"),
+					"int":    attribute.Int64Value(1),
+				}),
 			},
 			[]*commonpb.KeyValue{
 				{
@@ -80,6 +87,45 @@ func TestAttributes(t *testing.T) {
 						},
 					},
 				},
+				{
+					Key: "bytes to bytes",
+					Value: &commonpb.AnyValue{
+						Value: &commonpb.AnyValue_BytesValue{
+							BytesValue: []byte("bytes"),
+						},
+					},
+				},
+				{
+					Key: "timestamp to int64",
+					Value: &commonpb.AnyValue{
+						Value: &commonpb.AnyValue_IntValue{
+							IntValue: 1000000005,
+						},
+					},
+				},
+				{
+					Key: "map to kvlist",
+					Value: &commonpb.AnyValue{
+						Value: &commonpb.AnyValue_KvlistValue{
+							KvlistValue: &commonpb.KeyValueList{
+								Values: []*commonpb.KeyValue{
+									{
+										Key: "int",
+										Value: &commonpb.AnyValue{
+											Value: &commonpb.AnyValue_IntValue{IntValue: 1},
+										},
+									},
+									{
+										Key: "string",
+										Value: &commonpb.AnyValue{
+											Value: &commonpb.AnyValue_StringValue{StringValue: "string"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 	} {
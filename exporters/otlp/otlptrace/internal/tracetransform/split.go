@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracetransform // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/tracetransform"
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+// Split splits rss into groups of ResourceSpans that are each no larger than
+// limit bytes when serialized as an ExportTraceServiceRequest.
+//
+// Splitting is done on span boundaries. A single span that is larger than
+// limit is returned in its own group even though it exceeds the limit. If
+// limit is not positive or rss is already within the limit, rss is returned
+// as the only group.
+func Split(rss []*tracepb.ResourceSpans, limit int) [][]*tracepb.ResourceSpans {
+	if limit <= 0 || requestSize(rss) <= limit {
+		return [][]*tracepb.ResourceSpans{rss}
+	}
+
+	n := spanCount(rss)
+	if n <= 1 {
+		return [][]*tracepb.ResourceSpans{rss}
+	}
+
+	head, tail := splitResourceSpans(rss, n/2)
+	return append(Split(head, limit), Split(tail, limit)...)
+}
+
+// requestSize returns the serialized size of an export request containing
+// rss.
+func requestSize(rss []*tracepb.ResourceSpans) int {
+	return proto.Size(&coltracepb.ExportTraceServiceRequest{ResourceSpans: rss})
+}
+
+// spanCount returns the number of spans contained in rss.
+func spanCount(rss []*tracepb.ResourceSpans) int {
+	var n int
+	for _, rs := range rss {
+		for _, ss := range rs.ScopeSpans {
+			n += len(ss.Spans)
+		}
+	}
+	return n
+}
+
+// splitResourceSpans returns ResourceSpans containing the first n spans of
+// rss and ResourceSpans containing the remaining spans. The resource and
+// scope structure of rss is preserved in both.
+func splitResourceSpans(rss []*tracepb.ResourceSpans, n int) (head, tail []*tracepb.ResourceSpans) {
+	for _, rs := range rss {
+		hRS := &tracepb.ResourceSpans{Resource: rs.Resource, SchemaUrl: rs.SchemaUrl}
+		tRS := &tracepb.ResourceSpans{Resource: rs.Resource, SchemaUrl: rs.SchemaUrl}
+		for _, ss := range rs.ScopeSpans {
+			switch {
+			case n >= len(ss.Spans):
+				hRS.ScopeSpans = append(hRS.ScopeSpans, ss)
+				n -= len(ss.Spans)
+			case n == 0:
+				tRS.ScopeSpans = append(tRS.ScopeSpans, ss)
+			default:
+				hRS.ScopeSpans = append(hRS.ScopeSpans, &tracepb.ScopeSpans{
+					Scope:     ss.Scope,
+					Spans:     ss.Spans[:n],
+					SchemaUrl: ss.SchemaUrl,
+				})
+				tRS.ScopeSpans = append(tRS.ScopeSpans, &tracepb.ScopeSpans{
+					Scope:     ss.Scope,
+					Spans:     ss.Spans[n:],
+					SchemaUrl: ss.SchemaUrl,
+				})
+				n = 0
+			}
+		}
+		if len(hRS.ScopeSpans) > 0 {
+			head = append(head, hRS)
+		}
+		if len(tRS.ScopeSpans) > 0 {
+			tail = append(tail, tRS)
+		}
+	}
+	return head, tail
+}
@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracetransform
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+// testResourceSpans returns ResourceSpans for two resources, each with two
+// scopes containing n spans.
+func testResourceSpans(n int) []*tracepb.ResourceSpans {
+	var rss []*tracepb.ResourceSpans
+	for r := 0; r < 2; r++ {
+		rs := &tracepb.ResourceSpans{
+			Resource: &resourcepb.Resource{
+				Attributes: []*commonpb.KeyValue{{
+					Key: "service.name",
+					Value: &commonpb.AnyValue{
+						Value: &commonpb.AnyValue_StringValue{
+							StringValue: fmt.Sprintf("service-%d", r),
+						},
+					},
+				}},
+			},
+			SchemaUrl: "https://opentelemetry.io/schemas/1.12.0",
+		}
+		for s := 0; s < 2; s++ {
+			ss := &tracepb.ScopeSpans{
+				Scope: &commonpb.InstrumentationScope{
+					Name: fmt.Sprintf("scope-%d", s),
+				},
+			}
+			for i := 0; i < n; i++ {
+				ss.Spans = append(ss.Spans, &tracepb.Span{
+					Name: fmt.Sprintf("span-%d-%d-%d", r, s, i),
+				})
+			}
+			rs.ScopeSpans = append(rs.ScopeSpans, ss)
+		}
+		rss = append(rss, rs)
+	}
+	return rss
+}
+
+// spanNames returns the names of all spans in rss in order.
+func spanNames(rss []*tracepb.ResourceSpans) []string {
+	var names []string
+	for _, rs := range rss {
+		for _, ss := range rs.ScopeSpans {
+			for _, s := range ss.Spans {
+				names = append(names, s.Name)
+			}
+		}
+	}
+	return names
+}
+
+func TestSplitNoLimit(t *testing.T) {
+	rss := testResourceSpans(3)
+	got := Split(rss, 0)
+	require.Len(t, got, 1)
+	assert.Equal(t, rss, got[0])
+}
+
+func TestSplitWithinLimit(t *testing.T) {
+	rss := testResourceSpans(3)
+	got := Split(rss, requestSize(rss))
+	require.Len(t, got, 1)
+	assert.Equal(t, rss, got[0])
+}
+
+func TestSplitSpans(t *testing.T) {
+	rss := testResourceSpans(3)
+	want := spanNames(rss)
+
+	// A limit smaller than any single span results in one span per request.
+	got := Split(rss, 1)
+	require.Len(t, got, len(want))
+
+	var names []string
+	for _, g := range got {
+		require.Len(t, g, 1)
+		require.Len(t, g[0].ScopeSpans, 1)
+		names = append(names, spanNames(g)...)
+	}
+	assert.Equal(t, want, names)
+}
+
+func TestSplitSizeLimit(t *testing.T) {
+	rss := testResourceSpans(10)
+	want := spanNames(rss)
+
+	limit := requestSize(rss) / 3
+	got := Split(rss, limit)
+	assert.Greater(t, len(got), 1)
+
+	var names []string
+	for _, g := range got {
+		assert.LessOrEqual(t, requestSize(g), limit)
+		names = append(names, spanNames(g)...)
+
+		// Resources and scopes must be preserved.
+		for _, rs := range g {
+			name := rs.ScopeSpans[0].Spans[0].Name
+			var r int
+			_, err := fmt.Sscanf(name, "span-%d-", &r)
+			require.NoError(t, err)
+			assert.Equal(t, rss[r].Resource, rs.Resource)
+			assert.Equal(t, rss[r].SchemaUrl, rs.SchemaUrl)
+		}
+	}
+	assert.Equal(t, want, names)
+}
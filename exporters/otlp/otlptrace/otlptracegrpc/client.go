@@ -17,6 +17,7 @@ package otlptracegrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlptra
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -25,22 +26,35 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/internal/diskqueue"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/retry"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/concurrent"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/observ"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/otlpconfig"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/persist"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/tracetransform"
 	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
 	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
 )
 
+// instrumentationName is the name of the Meter used to measure the operation
+// of the client.
+const instrumentationName = "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+
 type client struct {
-	endpoint      string
-	dialOpts      []grpc.DialOption
-	metadata      metadata.MD
-	exportTimeout time.Duration
-	requestFunc   retry.RequestFunc
+	endpoint        string
+	dialOpts        []grpc.DialOption
+	metadata        metadata.MD
+	headersProvider func(context.Context) (map[string]string, error)
+	maxRequestSize  int
+	exportTimeout   time.Duration
+	requestFunc     retry.RequestFunc
+	instr           *observ.Instrumentation
 
 	// stopCtx is used as a parent context for all exports. Therefore, when it
 	// is canceled with the stopFunc all exports are canceled.
@@ -56,6 +70,11 @@ type client struct {
 	conn    *grpc.ClientConn
 	tscMu   sync.RWMutex
 	tsc     coltracepb.TraceServiceClient
+
+	// queueConfig and maxConcurrentExports configure the clients NewClient
+	// wraps this client with.
+	queueConfig          diskqueue.Config
+	maxConcurrentExports int
 }
 
 // Compile time check *client implements otlptrace.Client.
@@ -63,7 +82,15 @@ var _ otlptrace.Client = (*client)(nil)
 
 // NewClient creates a new gRPC trace client.
 func NewClient(opts ...Option) otlptrace.Client {
-	return newClient(opts...)
+	c := newClient(opts...)
+	var tc otlptrace.Client = c
+	if c.queueConfig.Enabled() {
+		tc = persist.NewClient(tc, c.queueConfig, permanent)
+	}
+	if c.maxConcurrentExports > 1 {
+		tc = concurrent.NewClient(tc, c.maxConcurrentExports)
+	}
+	return tc
 }
 
 func newClient(opts ...Option) *client {
@@ -72,13 +99,19 @@ func newClient(opts ...Option) *client {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	c := &client{
-		endpoint:      cfg.Traces.Endpoint,
-		exportTimeout: cfg.Traces.Timeout,
-		requestFunc:   cfg.RetryConfig.RequestFunc(retryable),
-		dialOpts:      cfg.DialOptions,
-		stopCtx:       ctx,
-		stopFunc:      cancel,
-		conn:          cfg.GRPCConn,
+		endpoint:        cfg.Traces.Endpoint,
+		headersProvider: cfg.Traces.HeadersProvider,
+		maxRequestSize:  cfg.Traces.MaxRequestSize,
+		exportTimeout:   cfg.Traces.Timeout,
+		requestFunc:     cfg.RetryConfig.RequestFunc(retryable),
+		instr:           observ.New(cfg.Traces.MeterProvider, instrumentationName, "traces", "grpc"),
+		dialOpts:        cfg.DialOptions,
+		stopCtx:         ctx,
+		stopFunc:        cancel,
+		conn:            cfg.GRPCConn,
+
+		queueConfig:          cfg.QueueConfig,
+		maxConcurrentExports: cfg.Traces.MaxConcurrentExports,
 	}
 
 	if len(cfg.Traces.Headers) > 0 {
@@ -197,24 +230,51 @@ func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 	ctx, cancel := c.exportContext(ctx)
 	defer cancel()
 
-	return c.requestFunc(ctx, func(iCtx context.Context) error {
-		resp, err := c.tsc.Export(iCtx, &coltracepb.ExportTraceServiceRequest{
-			ResourceSpans: protoSpans,
-		})
-		if resp != nil && resp.PartialSuccess != nil {
-			otel.Handle(internal.PartialSuccessToError(
-				internal.TracingPartialSuccess,
-				resp.PartialSuccess.RejectedSpans,
-				resp.PartialSuccess.ErrorMessage,
-			))
-		}
-		// nil is converted to OK.
-		if status.Code(err) == codes.OK {
-			// Success.
-			return nil
+	for _, rss := range tracetransform.Split(protoSpans, c.maxRequestSize) {
+		if err := c.upload(ctx, rss); err != nil {
+			return err
 		}
+	}
+	return nil
+}
+
+// upload sends rss to the collector in a single export request.
+func (c *client) upload(ctx context.Context, rss []*tracepb.ResourceSpans) error {
+	req := &coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: rss,
+	}
+
+	start := time.Now()
+	err := c.requestFunc(ctx, func(iCtx context.Context) error {
+		err := c.send(iCtx, req)
+		c.instr.Attempt(iCtx, status.Code(err).String(), err)
 		return err
 	})
+	c.instr.Export(ctx, proto.Size(req), time.Since(start))
+	return err
+}
+
+// send makes a single attempt to export req.
+func (c *client) send(ctx context.Context, req *coltracepb.ExportTraceServiceRequest) error {
+	ctx, err := c.headersContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.tsc.Export(ctx, req)
+	if resp != nil && resp.PartialSuccess != nil {
+		otel.Handle(internal.PartialSuccessToError(
+			internal.TracingPartialSuccess,
+			resp.PartialSuccess.RejectedSpans,
+			resp.PartialSuccess.ErrorMessage,
+		))
+	}
+	// nil is converted to OK.
+	if status.Code(err) == codes.OK {
+		// Success.
+		return nil
+	}
+	return err
 }
 
 // exportContext returns a copy of parent with an appropriate deadline and
@@ -252,6 +312,26 @@ func (c *client) exportContext(parent context.Context) (context.Context, context
 	return ctx, cancel
 }
 
+// headersContext returns a copy of ctx with the headers returned from the
+// headers provider of the client merged into its outgoing metadata. If no
+// headers provider is configured, ctx is returned unmodified.
+func (c *client) headersContext(ctx context.Context) (context.Context, error) {
+	if c.headersProvider == nil {
+		return ctx, nil
+	}
+
+	h, err := c.headersProvider(ctx)
+	if err != nil {
+		return ctx, fmt.Errorf("failed to get headers: %w", err)
+	}
+
+	md := c.metadata.Copy()
+	for k, v := range h {
+		md.Set(k, v)
+	}
+	return metadata.NewOutgoingContext(ctx, md), nil
+}
+
 // retryable returns if err identifies a request that can be retried and a
 // duration to wait for if an explicit throttle time is included in err.
 func retryable(err error) (bool, time.Duration) {
@@ -272,6 +352,18 @@ func retryable(err error) (bool, time.Duration) {
 	return false, 0
 }
 
+// permanent returns if err is a status returned by the collector that sending
+// the request again will not change.
+func permanent(err error) bool {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if _, ok := status.FromError(err); ok {
+			r, _ := retryable(err)
+			return !r
+		}
+	}
+	return false
+}
+
 // throttleDelay returns a duration to wait for if an explicit throttle time
 // is included in the response status.
 func throttleDelay(s *status.Status) time.Duration {
@@ -16,8 +16,11 @@ package otlptracegrpc_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -33,8 +36,11 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/observ"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/otlptracetest"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
@@ -75,6 +81,18 @@ func TestNewEndToEnd(t *testing.T) {
 				otlptracegrpc.WithCompressor(gzip.Name),
 			},
 		},
+		{
+			name: "WithZstdCompressor",
+			additionalOpts: []otlptracegrpc.Option{
+				otlptracegrpc.WithCompressor("zstd"),
+			},
+		},
+		{
+			name: "WithSnappyCompressor",
+			additionalOpts: []otlptracegrpc.Option{
+				otlptracegrpc.WithCompressor("snappy"),
+			},
+		},
 		{
 			name: "WithServiceConfig",
 			additionalOpts: []otlptracegrpc.Option{
@@ -217,6 +235,124 @@ func TestNewWithHeaders(t *testing.T) {
 	assert.Equal(t, "value1", headers.Get("header1")[0])
 }
 
+func TestNewWithHeadersProvider(t *testing.T) {
+	mc := runMockCollector(t)
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint,
+		otlptracegrpc.WithHeaders(map[string]string{"header1": "static"}),
+		otlptracegrpc.WithHeadersProvider(func(context.Context) (map[string]string, error) {
+			return map[string]string{"header1": "value1", "header2": "value2"}, nil
+		}))
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+
+	headers := mc.getHeaders()
+	require.Regexp(t, "OTel OTLP Exporter Go/1\\..*", headers.Get("user-agent"))
+	assert.Equal(t, []string{"value1"}, headers.Get("header1"))
+	assert.Equal(t, []string{"value2"}, headers.Get("header2"))
+}
+
+func TestNewWithHeadersProviderError(t *testing.T) {
+	mc := runMockCollector(t)
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	errProvider := errors.New("provider failure")
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint,
+		otlptracegrpc.WithHeadersProvider(func(context.Context) (map[string]string, error) {
+			return nil, errProvider
+		}))
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+	assert.ErrorIs(t, exp.ExportSpans(ctx, roSpans), errProvider)
+	assert.Len(t, mc.getSpans(), 0)
+}
+
+func TestMaxRequestSize(t *testing.T) {
+	mc := runMockCollector(t)
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint, otlptracegrpc.WithMaxRequestSize(1))
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+	spans := tracetest.SpanStubs{{Name: "A"}, {Name: "B"}, {Name: "C"}}.Snapshots()
+	require.NoError(t, exp.ExportSpans(ctx, spans))
+	// A limit smaller than any span sends each in its own request.
+	assert.Equal(t, 3, mc.getRequests())
+	assert.Len(t, mc.getSpans(), 3)
+}
+
+func TestPersistentQueue(t *testing.T) {
+	mc := runMockCollector(t)
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	exp := newGRPCExporter(t, ctx, mc.endpoint, otlptracegrpc.WithPersistentQueue(otlptracegrpc.QueueConfig{
+		Directory: dir,
+	}))
+
+	spans := tracetest.SpanStubs{{Name: "A"}, {Name: "B"}}.Snapshots()
+	require.NoError(t, exp.ExportSpans(ctx, spans))
+	// Shutdown sends any spans still queued.
+	require.NoError(t, exp.Shutdown(ctx))
+	assert.Len(t, mc.getSpans(), 2)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 0)
+}
+
+func TestMaxConcurrentExports(t *testing.T) {
+	mc := runMockCollector(t)
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint, otlptracegrpc.WithMaxConcurrentExports(4))
+
+	for i := 0; i < 10; i++ {
+		spans := tracetest.SpanStubs{{Name: fmt.Sprintf("span-%d", i)}}.Snapshots()
+		require.NoError(t, exp.ExportSpans(ctx, spans))
+	}
+	// Shutdown waits for all exports in flight.
+	require.NoError(t, exp.Shutdown(ctx))
+	assert.Equal(t, 10, mc.getRequests())
+	assert.Len(t, mc.getSpans(), 10)
+}
+
+func TestMeterProvider(t *testing.T) {
+	mc := runMockCollectorWithConfig(t, &mockConfig{
+		errors: []error{status.Error(codes.Unavailable, "unavailable")},
+	})
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint,
+		otlptracegrpc.WithMeterProvider(mp),
+		otlptracegrpc.WithRetry(otlptracegrpc.RetryConfig{
+			Enabled:         true,
+			InitialInterval: time.Nanosecond,
+			MaxInterval:     time.Millisecond,
+			MaxElapsedTime:  time.Minute,
+		}),
+	)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+
+	got, err := reader.Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	assert.Equal(t, "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc", got.ScopeMetrics[0].Scope.Name)
+	assert.Equal(t, map[string]int64{
+		observ.AttemptsName:                  2,
+		observ.FailuresName + "/Unavailable": 1,
+	}, sums(got.ScopeMetrics[0].Metrics))
+}
+
 func TestExportSpansTimeoutHonored(t *testing.T) {
 	ctx, cancel := contextWithTimeout(context.Background(), t, 1*time.Minute)
 	t.Cleanup(cancel)
@@ -390,6 +526,19 @@ func TestEmptyData(t *testing.T) {
 	assert.NoError(t, exp.ExportSpans(ctx, nil))
 }
 
+func TestUnixSocketEndpoint(t *testing.T) {
+	endpoint := "unix://" + filepath.Join(t.TempDir(), "agent.sock")
+	mc := runMockCollectorAtEndpoint(t, endpoint)
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint)
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+	require.NoError(t, exp.Shutdown(ctx))
+
+	assert.Len(t, mc.getSpans(), len(roSpans))
+}
+
 func TestPartialSuccess(t *testing.T) {
 	mc := runMockCollectorWithConfig(t, &mockConfig{
 		partial: &coltracepb.ExportTracePartialSuccess{
@@ -427,3 +576,23 @@ func TestCustomUserAgent(t *testing.T) {
 	headers := mc.getHeaders()
 	require.Contains(t, headers.Get("user-agent")[0], customUserAgent)
 }
+
+// sums returns the value of each int64 sum in metrics keyed by name. Failure
+// counts are keyed by name and error code.
+func sums(metrics []metricdata.Metrics) map[string]int64 {
+	out := make(map[string]int64)
+	for _, m := range metrics {
+		s, ok := m.Data.(metricdata.Sum[int64])
+		if !ok {
+			continue
+		}
+		for _, dp := range s.DataPoints {
+			key := m.Name
+			if v, ok := dp.Attributes.Value(observ.ErrorCodeKey); ok {
+				key += "/" + v.AsString()
+			}
+			out[key] += dp.Value
+		}
+	}
+	return out
+}
@@ -16,6 +16,8 @@ package otlptracegrpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -123,6 +125,15 @@ func TestRetryable(t *testing.T) {
 	}
 }
 
+func TestPermanent(t *testing.T) {
+	assert.True(t, permanent(status.Error(codes.InvalidArgument, "")))
+	assert.True(t, permanent(fmt.Errorf("wrapped: %w", status.Error(codes.PermissionDenied, ""))))
+	assert.False(t, permanent(status.Error(codes.Unavailable, "")))
+	assert.False(t, permanent(fmt.Errorf("max retry time elapsed: %w", status.Error(codes.Unavailable, ""))))
+	assert.False(t, permanent(errors.New("connection failure")))
+	assert.False(t, permanent(nil))
+}
+
 func TestUnstartedStop(t *testing.T) {
 	client := NewClient()
 	assert.ErrorIs(t, client.Stop(context.Background()), errAlreadyStopped)
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -71,6 +72,12 @@ func (mts *mockTraceService) getResourceSpans() []*tracepb.ResourceSpans {
 	return mts.storage.GetResourceSpans()
 }
 
+func (mts *mockTraceService) getRequests() int {
+	mts.mu.RLock()
+	defer mts.mu.RUnlock()
+	return mts.requests
+}
+
 func (mts *mockTraceService) Export(ctx context.Context, exp *collectortracepb.ExportTraceServiceRequest) (*collectortracepb.ExportTraceServiceResponse, error) {
 	mts.mu.Lock()
 	defer func() {
@@ -151,6 +158,10 @@ func (mc *mockCollector) GetResourceSpans() []*tracepb.ResourceSpans {
 	return mc.getResourceSpans()
 }
 
+func (mc *mockCollector) getRequests() int {
+	return mc.traceSvc.getRequests()
+}
+
 func (mc *mockCollector) getHeaders() metadata.MD {
 	return mc.traceSvc.getHeaders()
 }
@@ -165,7 +176,11 @@ func runMockCollectorAtEndpoint(t *testing.T, endpoint string) *mockCollector {
 }
 
 func runMockCollectorWithConfig(t *testing.T, mockConfig *mockConfig) *mockCollector {
-	ln, err := net.Listen("tcp", mockConfig.endpoint)
+	network, address := "tcp", mockConfig.endpoint
+	if path := strings.TrimPrefix(address, "unix://"); path != address {
+		network, address = "unix", path
+	}
+	ln, err := net.Listen(network, address)
 	if err != nil {
 		t.Fatalf("Failed to get an endpoint: %v", err)
 	}
@@ -178,6 +193,9 @@ func runMockCollectorWithConfig(t *testing.T, mockConfig *mockConfig) *mockColle
 	}()
 
 	mc.endpoint = ln.Addr().String()
+	if network == "unix" {
+		mc.endpoint = mockConfig.endpoint
+	}
 	mc.stopFunc = srv.Stop
 
 	return mc
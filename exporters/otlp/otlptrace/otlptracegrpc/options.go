@@ -15,6 +15,7 @@
 package otlptracegrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -22,8 +23,10 @@ import (
 	"google.golang.org/grpc/credentials"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/internal/diskqueue"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/retry"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/otlpconfig"
+	"go.opentelemetry.io/otel/metric"
 )
 
 // Option applies an option to the gRPC driver.
@@ -46,6 +49,10 @@ func asGRPCOptions(opts []Option) []otlpconfig.GRPCOption {
 // entirely handled by the gRPC ClientConn.
 type RetryConfig retry.Config
 
+// QueueConfig defines configuration for the file-backed queue span batches
+// are written to before they are sent.
+type QueueConfig diskqueue.Config
+
 type wrappedOption struct {
 	otlpconfig.GRPCOption
 }
@@ -67,6 +74,10 @@ func WithInsecure() Option {
 // WithEndpoint sets the target endpoint the exporter will connect to. If
 // unset, localhost:4317 will be used as a default.
 //
+// A Unix domain socket can be used as the endpoint by passing the socket
+// path with a "unix" scheme (e.g. "unix:///path/to/agent.sock"). Transport
+// security is still used for these endpoints unless WithInsecure is passed.
+//
 // This option has no effect if WithGRPCConn is used.
 func WithEndpoint(endpoint string) Option {
 	return wrappedOption{otlpconfig.WithEndpoint(endpoint)}
@@ -84,8 +95,13 @@ func WithReconnectionPeriod(rp time.Duration) Option {
 }
 
 func compressorToCompression(compressor string) otlpconfig.Compression {
-	if compressor == "gzip" {
+	switch compressor {
+	case "gzip":
 		return otlpconfig.GzipCompression
+	case otlpconfig.ZstdCompressorName:
+		return otlpconfig.ZstdCompression
+	case otlpconfig.SnappyCompressorName:
+		return otlpconfig.SnappyCompression
 	}
 
 	otel.Handle(fmt.Errorf("invalid compression type: '%s', using no compression as default", compressor))
@@ -97,7 +113,9 @@ func compressorToCompression(compressor string) otlpconfig.Compression {
 // compressor set has been registered with google.golang.org/grpc/encoding.
 // This can be done by encoding.RegisterCompressor. Some compressors
 // auto-register on import, such as gzip, which can be registered by calling
-// `import _ "google.golang.org/grpc/encoding/gzip"`.
+// `import _ "google.golang.org/grpc/encoding/gzip"`. The "zstd" and
+// "snappy" compressors are registered by this package unless a compressor
+// with the same name has already been registered.
 //
 // This option has no effect if WithGRPCConn is used.
 func WithCompressor(compressor string) Option {
@@ -171,6 +189,22 @@ func WithTimeout(duration time.Duration) Option {
 	return wrappedOption{otlpconfig.WithTimeout(duration)}
 }
 
+// WithMaxRequestSize sets the maximum serialized size, in bytes, of an export
+// request. Batches of spans with a larger payload are split into multiple
+// requests that are each within this limit, before any compression is
+// applied. If unset or not positive, batches are not split.
+func WithMaxRequestSize(size int) Option {
+	return wrappedOption{otlpconfig.WithMaxRequestSize(size)}
+}
+
+// WithMeterProvider sets the MeterProvider used to measure the operation of
+// the exporter: export request attempts, failed attempts by error code,
+// request payload sizes, and export durations including retries. If unset,
+// no measurements are recorded.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return wrappedOption{otlpconfig.WithMeterProvider(mp)}
+}
+
 // WithRetry sets the retry policy for transient retryable errors that may be
 // returned by the target endpoint when exporting a batch of spans.
 //
@@ -187,3 +221,34 @@ func WithTimeout(duration time.Duration) Option {
 func WithRetry(settings RetryConfig) Option {
 	return wrappedOption{otlpconfig.WithRetry(retry.Config(settings))}
 }
+
+// WithPersistentQueue configures the client to write span batches to a
+// file-backed queue in the Directory of cfg before they are sent. Queued
+// batches are sent in the background with an exponential backoff while the
+// endpoint is unreachable, and any not sent on Stop are kept on disk and sent
+// by the next client started with the same Directory. Batches the endpoint
+// rejects with an error that is not retry-able are dropped instead of being
+// sent again. When the queue is full, the oldest batches are dropped. If
+// MaxSize is not set, the queue holds up to 64 MiB. If unset or the Directory
+// is empty, batches are sent directly.
+func WithPersistentQueue(cfg QueueConfig) Option {
+	return wrappedOption{otlpconfig.WithPersistentQueue(diskqueue.Config(cfg))}
+}
+
+// WithMaxConcurrentExports sets the maximum number of span batches exported
+// concurrently. When greater than one, exports are sent in the background so
+// the span processor can export the next batch without waiting, and the
+// error of each export that fails is sent to the global ErrorHandler.
+// ForceFlush of the span processor and Shutdown wait for all exports in
+// flight. If unset or not greater than one, batches are exported serially.
+func WithMaxConcurrentExports(n int) Option {
+	return wrappedOption{otlpconfig.WithMaxConcurrentExports(n)}
+}
+
+// WithHeadersProvider sets fn to be called before every export request to
+// provide additional headers to send with that request. Headers returned by
+// fn take precedence over those set with WithHeaders. If fn returns an error
+// the request is not sent and the error is returned.
+func WithHeadersProvider(fn func(ctx context.Context) (map[string]string, error)) Option {
+	return wrappedOption{otlpconfig.WithHeadersProvider(fn)}
+}
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -27,13 +28,19 @@ import (
 	"sync"
 	"time"
 
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
 	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/internal"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/retry"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/concurrent"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/observ"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/otlpconfig"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/persist"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/tracetransform"
 	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
 	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
 )
@@ -47,6 +54,20 @@ var gzPool = sync.Pool{
 	},
 }
 
+var zstdPool = sync.Pool{
+	New: func() interface{} {
+		// This only returns an error for invalid options.
+		w, _ := zstd.NewWriter(io.Discard, zstd.WithEncoderConcurrency(1))
+		return w
+	},
+}
+
+var snappyPool = sync.Pool{
+	New: func() interface{} {
+		return snappy.NewBufferedWriter(io.Discard)
+	},
+}
+
 // Keep it in sync with golang's DefaultTransport from net/http! We
 // have our own copy to avoid handling a situation where the
 // DefaultTransport is overwritten with some different implementation
@@ -64,11 +85,16 @@ var ourTransport = &http.Transport{
 	ExpectContinueTimeout: 1 * time.Second,
 }
 
+// instrumentationName is the name of the Meter used to measure the operation
+// of the client.
+const instrumentationName = "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+
 type client struct {
 	name        string
 	cfg         otlpconfig.SignalConfig
 	generalCfg  otlpconfig.Config
 	requestFunc retry.RequestFunc
+	instr       *observ.Instrumentation
 	client      *http.Client
 	stopCh      chan struct{}
 	stopOnce    sync.Once
@@ -84,21 +110,35 @@ func NewClient(opts ...Option) otlptrace.Client {
 		Transport: ourTransport,
 		Timeout:   cfg.Traces.Timeout,
 	}
-	if cfg.Traces.TLSCfg != nil {
+	if cfg.Traces.TLSCfg != nil || cfg.Traces.Proxy != nil {
 		transport := ourTransport.Clone()
-		transport.TLSClientConfig = cfg.Traces.TLSCfg
+		if cfg.Traces.TLSCfg != nil {
+			transport.TLSClientConfig = cfg.Traces.TLSCfg
+		}
+		if cfg.Traces.Proxy != nil {
+			transport.Proxy = cfg.Traces.Proxy
+		}
 		httpClient.Transport = transport
 	}
 
 	stopCh := make(chan struct{})
-	return &client{
+	c := &client{
 		name:        "traces",
 		cfg:         cfg.Traces,
 		generalCfg:  cfg,
 		requestFunc: cfg.RetryConfig.RequestFunc(evaluate),
+		instr:       observ.New(cfg.Traces.MeterProvider, instrumentationName, "traces", "http"),
 		stopCh:      stopCh,
 		client:      httpClient,
 	}
+	var tc otlptrace.Client = c
+	if cfg.QueueConfig.Enabled() {
+		tc = persist.NewClient(tc, cfg.QueueConfig, permanent)
+	}
+	if cfg.Traces.MaxConcurrentExports > 1 {
+		tc = concurrent.NewClient(tc, cfg.Traces.MaxConcurrentExports)
+	}
+	return tc
 }
 
 // Start does nothing in a HTTP client.
@@ -127,78 +167,109 @@ func (d *client) Stop(ctx context.Context) error {
 
 // UploadTraces sends a batch of spans to the collector.
 func (d *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	ctx, cancel := d.contextWithStop(ctx)
+	defer cancel()
+
+	for _, rss := range tracetransform.Split(protoSpans, d.cfg.MaxRequestSize) {
+		if err := d.upload(ctx, rss); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upload sends rss to the collector in a single export request.
+func (d *client) upload(ctx context.Context, rss []*tracepb.ResourceSpans) error {
 	pbRequest := &coltracepb.ExportTraceServiceRequest{
-		ResourceSpans: protoSpans,
+		ResourceSpans: rss,
 	}
 	rawRequest, err := proto.Marshal(pbRequest)
 	if err != nil {
 		return err
 	}
 
-	ctx, cancel := d.contextWithStop(ctx)
-	defer cancel()
-
 	request, err := d.newRequest(rawRequest)
 	if err != nil {
 		return err
 	}
 
-	return d.requestFunc(ctx, func(ctx context.Context) error {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
-		}
+	start := time.Now()
+	err = d.requestFunc(ctx, func(ctx context.Context) error {
+		code, err := d.send(ctx, &request)
+		d.instr.Attempt(ctx, code, err)
+		return err
+	})
+	d.instr.Export(ctx, len(rawRequest), time.Since(start))
+	return err
+}
 
-		request.reset(ctx)
-		resp, err := d.client.Do(request.Request)
+// send makes a single attempt to send request. The status code of the
+// response is returned, or an empty string if no response was received.
+func (d *client) send(ctx context.Context, request *request) (string, error) {
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	default:
+	}
+
+	request.reset(ctx)
+	if d.cfg.HeadersProvider != nil {
+		h, err := d.cfg.HeadersProvider(ctx)
 		if err != nil {
-			return err
+			return "", fmt.Errorf("failed to get headers: %w", err)
 		}
-
-		if resp != nil && resp.Body != nil {
-			defer func() {
-				if err := resp.Body.Close(); err != nil {
-					otel.Handle(err)
-				}
-			}()
+		for k, v := range h {
+			request.Header.Set(k, v)
 		}
+	}
+	resp, err := d.client.Do(request.Request)
+	if err != nil {
+		return "", err
+	}
+	code := strconv.Itoa(resp.StatusCode)
 
-		switch resp.StatusCode {
-		case http.StatusOK:
-			// Success, do not retry.
-			// Read the partial success message, if any.
-			var respData bytes.Buffer
-			if _, err := io.Copy(&respData, resp.Body); err != nil {
-				return err
+	if resp != nil && resp.Body != nil {
+		defer func() {
+			if err := resp.Body.Close(); err != nil {
+				otel.Handle(err)
 			}
+		}()
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		// Success, do not retry.
+		// Read the partial success message, if any.
+		var respData bytes.Buffer
+		if _, err := io.Copy(&respData, resp.Body); err != nil {
+			return code, err
+		}
 
-			if respData.Len() != 0 {
-				var respProto coltracepb.ExportTraceServiceResponse
-				if err := proto.Unmarshal(respData.Bytes(), &respProto); err != nil {
-					return err
-				}
-
-				if respProto.PartialSuccess != nil {
-					otel.Handle(internal.PartialSuccessToError(
-						internal.TracingPartialSuccess,
-						respProto.PartialSuccess.RejectedSpans,
-						respProto.PartialSuccess.ErrorMessage,
-					))
-				}
+		if respData.Len() != 0 {
+			var respProto coltracepb.ExportTraceServiceResponse
+			if err := proto.Unmarshal(respData.Bytes(), &respProto); err != nil {
+				return code, err
 			}
-			return nil
 
-		case http.StatusTooManyRequests, http.StatusServiceUnavailable:
-			// Retry-able failures.  Drain the body to reuse the connection.
-			if _, err := io.Copy(io.Discard, resp.Body); err != nil {
-				otel.Handle(err)
+			if respProto.PartialSuccess != nil {
+				otel.Handle(internal.PartialSuccessToError(
+					internal.TracingPartialSuccess,
+					respProto.PartialSuccess.RejectedSpans,
+					respProto.PartialSuccess.ErrorMessage,
+				))
 			}
-			return newResponseError(resp.Header)
-		default:
-			return fmt.Errorf("failed to send %s to %s: %s", d.name, request.URL, resp.Status)
 		}
-	})
+		return code, nil
+
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		// Retry-able failures.  Drain the body to reuse the connection.
+		if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+			otel.Handle(err)
+		}
+		return code, newResponseError(resp.Header)
+	default:
+		return code, rejectedError{msg: fmt.Sprintf("failed to send %s to %s: %s", d.name, request.URL, resp.Status)}
+	}
 }
 
 func (d *client) newRequest(body []byte) (request, error) {
@@ -221,28 +292,46 @@ func (d *client) newRequest(body []byte) (request, error) {
 		r.ContentLength = (int64)(len(body))
 		req.bodyReader = bodyReader(body)
 	case GzipCompression:
-		// Ensure the content length is not used.
-		r.ContentLength = -1
-		r.Header.Set("Content-Encoding", "gzip")
+		return req, compressBody(&req, "gzip", &gzPool, body)
+	case ZstdCompression:
+		return req, compressBody(&req, "zstd", &zstdPool, body)
+	case SnappyCompression:
+		return req, compressBody(&req, "snappy", &snappyPool, body)
+	}
 
-		gz := gzPool.Get().(*gzip.Writer)
-		defer gzPool.Put(gz)
+	return req, nil
+}
 
-		var b bytes.Buffer
-		gz.Reset(&b)
+// resetWriteCloser is a compressing writer that can be reused.
+type resetWriteCloser interface {
+	io.WriteCloser
+	Reset(io.Writer)
+}
 
-		if _, err := gz.Write(body); err != nil {
-			return req, err
-		}
-		// Close needs to be called to ensure body if fully written.
-		if err := gz.Close(); err != nil {
-			return req, err
-		}
+// compressBody sets the body of req to body compressed with a
+// resetWriteCloser from pool and sets the Content-Encoding header of req to
+// encoding.
+func compressBody(req *request, encoding string, pool *sync.Pool, body []byte) error {
+	// Ensure the content length is not used.
+	req.ContentLength = -1
+	req.Header.Set("Content-Encoding", encoding)
+
+	w := pool.Get().(resetWriteCloser)
+	defer pool.Put(w)
 
-		req.bodyReader = bodyReader(b.Bytes())
+	var b bytes.Buffer
+	w.Reset(&b)
+
+	if _, err := w.Write(body); err != nil {
+		return err
+	}
+	// Close needs to be called to ensure body if fully written.
+	if err := w.Close(); err != nil {
+		return err
 	}
 
-	return req, nil
+	req.bodyReader = bodyReader(b.Bytes())
+	return nil
 }
 
 // MarshalLog is the marshaling function used by the logging system to represent this Client.
@@ -315,6 +404,23 @@ func evaluate(err error) (bool, time.Duration) {
 	return true, time.Duration(rErr.throttle)
 }
 
+// rejectedError is returned when the collector responds to a request with a
+// status that is not retry-able.
+type rejectedError struct {
+	msg string
+}
+
+func (e rejectedError) Error() string {
+	return e.msg
+}
+
+// permanent returns if err is a rejection of a request by the collector that
+// sending the request again will not change.
+func permanent(err error) bool {
+	var rErr rejectedError
+	return errors.As(err, &rErr)
+}
+
 func (d *client) getScheme() string {
 	if d.cfg.Insecure {
 		return "http"
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"testing"
 	"time"
@@ -27,8 +28,12 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/observ"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/otlptracetest"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
 )
 
@@ -65,6 +70,18 @@ func TestEndToEnd(t *testing.T) {
 				otlptracehttp.WithCompression(otlptracehttp.GzipCompression),
 			},
 		},
+		{
+			name: "with zstd compression",
+			opts: []otlptracehttp.Option{
+				otlptracehttp.WithCompression(otlptracehttp.ZstdCompression),
+			},
+		},
+		{
+			name: "with snappy compression",
+			opts: []otlptracehttp.Option{
+				otlptracehttp.WithCompression(otlptracehttp.SnappyCompression),
+			},
+		},
 		{
 			name: "retry",
 			opts: []otlptracehttp.Option{
@@ -146,6 +163,18 @@ func TestEndToEnd(t *testing.T) {
 				ExpectedHeaders: testHeaders,
 			},
 		},
+		{
+			name: "with headers provider",
+			opts: []otlptracehttp.Option{
+				otlptracehttp.WithHeaders(map[string]string{"Otel-Go-Key-1": "static"}),
+				otlptracehttp.WithHeadersProvider(func(context.Context) (map[string]string, error) {
+					return testHeaders, nil
+				}),
+			},
+			mcCfg: mockCollectorConfig{
+				ExpectedHeaders: testHeaders,
+			},
+		},
 		{
 			name: "with custom user agent",
 			opts: []otlptracehttp.Option{
@@ -270,6 +299,163 @@ func TestEmptyData(t *testing.T) {
 	assert.Empty(t, mc.GetSpans())
 }
 
+func TestMaxRequestSize(t *testing.T) {
+	mc := runMockCollector(t, mockCollectorConfig{})
+	defer mc.MustStop(t)
+	driver := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpoint(mc.Endpoint()),
+		otlptracehttp.WithInsecure(),
+		otlptracehttp.WithMaxRequestSize(1),
+	)
+	ctx := context.Background()
+	exporter, err := otlptrace.New(ctx, driver)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, exporter.Shutdown(ctx))
+	}()
+
+	spans := tracetest.SpanStubs{{Name: "A"}, {Name: "B"}, {Name: "C"}}.Snapshots()
+	assert.NoError(t, exporter.ExportSpans(ctx, spans))
+	// A limit smaller than any span sends each in its own request.
+	assert.Equal(t, 3, mc.GetRequests())
+	assert.Len(t, mc.GetSpans(), 3)
+}
+
+func TestPersistentQueue(t *testing.T) {
+	mc := runMockCollector(t, mockCollectorConfig{})
+	defer mc.MustStop(t)
+	dir := t.TempDir()
+	driver := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpoint(mc.Endpoint()),
+		otlptracehttp.WithInsecure(),
+		otlptracehttp.WithPersistentQueue(otlptracehttp.QueueConfig{Directory: dir}),
+	)
+	ctx := context.Background()
+	exporter, err := otlptrace.New(ctx, driver)
+	require.NoError(t, err)
+
+	spans := tracetest.SpanStubs{{Name: "A"}, {Name: "B"}}.Snapshots()
+	assert.NoError(t, exporter.ExportSpans(ctx, spans))
+	// Shutdown sends any spans still queued.
+	assert.NoError(t, exporter.Shutdown(ctx))
+	assert.Len(t, mc.GetSpans(), 2)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 0)
+}
+
+func TestPersistentQueueDropsRejected(t *testing.T) {
+	mc := runMockCollector(t, mockCollectorConfig{
+		InjectHTTPStatus: []int{http.StatusBadRequest},
+	})
+	defer mc.MustStop(t)
+	dir := t.TempDir()
+	driver := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpoint(mc.Endpoint()),
+		otlptracehttp.WithInsecure(),
+		otlptracehttp.WithPersistentQueue(otlptracehttp.QueueConfig{Directory: dir}),
+	)
+	ctx := context.Background()
+	exporter, err := otlptrace.New(ctx, driver)
+	require.NoError(t, err)
+
+	assert.NoError(t, exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+	// The rejected spans are dropped, not kept to be sent again.
+	assert.NoError(t, exporter.Shutdown(ctx))
+	assert.Empty(t, mc.GetSpans())
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 0)
+}
+
+func TestMaxConcurrentExports(t *testing.T) {
+	mc := runMockCollector(t, mockCollectorConfig{})
+	defer mc.MustStop(t)
+	driver := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpoint(mc.Endpoint()),
+		otlptracehttp.WithInsecure(),
+		otlptracehttp.WithMaxConcurrentExports(4),
+	)
+	ctx := context.Background()
+	exporter, err := otlptrace.New(ctx, driver)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		spans := tracetest.SpanStubs{{Name: fmt.Sprintf("span-%d", i)}}.Snapshots()
+		assert.NoError(t, exporter.ExportSpans(ctx, spans))
+	}
+	// Shutdown waits for all exports in flight.
+	assert.NoError(t, exporter.Shutdown(ctx))
+	assert.Equal(t, 10, mc.GetRequests())
+	assert.Len(t, mc.GetSpans(), 10)
+}
+
+func TestMeterProvider(t *testing.T) {
+	mc := runMockCollector(t, mockCollectorConfig{
+		InjectHTTPStatus: []int{http.StatusServiceUnavailable},
+	})
+	defer mc.MustStop(t)
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	driver := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpoint(mc.Endpoint()),
+		otlptracehttp.WithInsecure(),
+		otlptracehttp.WithMeterProvider(mp),
+		otlptracehttp.WithRetry(otlptracehttp.RetryConfig{
+			Enabled:         true,
+			InitialInterval: time.Nanosecond,
+			MaxInterval:     time.Millisecond,
+			MaxElapsedTime:  time.Minute,
+		}),
+	)
+	ctx := context.Background()
+	exporter, err := otlptrace.New(ctx, driver)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, exporter.Shutdown(ctx))
+	}()
+	assert.NoError(t, exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+
+	got, err := reader.Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	assert.Equal(t, "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp", got.ScopeMetrics[0].Scope.Name)
+	assert.Equal(t, map[string]int64{
+		observ.AttemptsName:          2,
+		observ.FailuresName + "/503": 1,
+	}, sums(got.ScopeMetrics[0].Metrics))
+}
+
+func TestProxy(t *testing.T) {
+	mc := runMockCollector(t, mockCollectorConfig{})
+	defer mc.MustStop(t)
+
+	var proxied []string
+	// The collector acts as the proxy for an unresolvable endpoint.
+	proxy := func(r *http.Request) (*url.URL, error) {
+		proxied = append(proxied, r.URL.Host)
+		return &url.URL{Scheme: "http", Host: mc.Endpoint()}, nil
+	}
+	driver := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpoint("proxied.invalid:4318"),
+		otlptracehttp.WithInsecure(),
+		otlptracehttp.WithProxy(proxy),
+	)
+	ctx := context.Background()
+	exporter, err := otlptrace.New(ctx, driver)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, exporter.Shutdown(ctx))
+	}()
+
+	spans := tracetest.SpanStubs{{Name: "A"}}.Snapshots()
+	assert.NoError(t, exporter.ExportSpans(ctx, spans))
+	assert.Len(t, mc.GetSpans(), 1)
+	assert.Equal(t, []string{"proxied.invalid:4318"}, proxied)
+}
+
 func TestCancelledContext(t *testing.T) {
 	mcCfg := mockCollectorConfig{}
 	mc := runMockCollector(t, mcCfg)
@@ -395,3 +581,23 @@ func TestPartialSuccess(t *testing.T) {
 	require.Contains(t, errors[0].Error(), "partially successful")
 	require.Contains(t, errors[0].Error(), "2 spans rejected")
 }
+
+// sums returns the value of each int64 sum in metrics keyed by name. Failure
+// counts are keyed by name and error code.
+func sums(metrics []metricdata.Metrics) map[string]int64 {
+	out := make(map[string]int64)
+	for _, m := range metrics {
+		s, ok := m.Data.(metricdata.Sum[int64])
+		if !ok {
+			continue
+		}
+		for _, dp := range s.DataPoints {
+			key := m.Name
+			if v, ok := dp.Attributes.Value(observ.ErrorCodeKey); ok {
+				key += "/" + v.AsString()
+			}
+			out[key] += dp.Value
+		}
+	}
+	return out
+}
@@ -26,6 +26,8 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
@@ -42,6 +44,7 @@ type mockCollector struct {
 
 	spanLock     sync.Mutex
 	spansStorage otlptracetest.SpansStorage
+	requests     int
 
 	injectHTTPStatus     []int
 	injectResponseHeader []map[string]string
@@ -73,6 +76,12 @@ func (c *mockCollector) GetResourceSpans() []*tracepb.ResourceSpans {
 	return c.spansStorage.GetResourceSpans()
 }
 
+func (c *mockCollector) GetRequests() int {
+	c.spanLock.Lock()
+	defer c.spanLock.Unlock()
+	return c.requests
+}
+
 func (c *mockCollector) Endpoint() string {
 	return c.endpoint
 }
@@ -121,6 +130,7 @@ func (c *mockCollector) serveTraces(w http.ResponseWriter, r *http.Request) {
 	writeReply(w, rawResponse, 0, c.injectContentType, h)
 	c.spanLock.Lock()
 	defer c.spanLock.Unlock()
+	c.requests++
 	c.spansStorage.AddSpans(request)
 }
 
@@ -167,8 +177,18 @@ func (c *mockCollector) getInjectResponseHeader() (h map[string]string) {
 }
 
 func readRequest(r *http.Request) ([]byte, error) {
-	if r.Header.Get("Content-Encoding") == "gzip" {
+	switch r.Header.Get("Content-Encoding") {
+	case "gzip":
 		return readGzipBody(r.Body)
+	case "zstd":
+		dec, err := zstd.NewReader(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer dec.Close()
+		return io.ReadAll(dec)
+	case "snappy":
+		return io.ReadAll(snappy.NewReader(r.Body))
 	}
 	return io.ReadAll(r.Body)
 }
@@ -15,11 +15,16 @@
 package otlptracehttp // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 
 import (
+	"context"
 	"crypto/tls"
+	"net/http"
+	"net/url"
 	"time"
 
+	"go.opentelemetry.io/otel/exporters/otlp/internal/diskqueue"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/retry"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/otlpconfig"
+	"go.opentelemetry.io/otel/metric"
 )
 
 // Compression describes the compression used for payloads sent to the
@@ -33,6 +38,12 @@ const (
 	// GzipCompression tells the driver to send payloads after
 	// compressing them with gzip.
 	GzipCompression = Compression(otlpconfig.GzipCompression)
+	// ZstdCompression tells the driver to send payloads after
+	// compressing them with zstd.
+	ZstdCompression = Compression(otlpconfig.ZstdCompression)
+	// SnappyCompression tells the driver to send payloads after
+	// compressing them with snappy.
+	SnappyCompression = Compression(otlpconfig.SnappyCompression)
 )
 
 // Option applies an option to the HTTP client.
@@ -52,6 +63,15 @@ func asHTTPOptions(opts []Option) []otlpconfig.HTTPOption {
 // failure using an exponential backoff.
 type RetryConfig retry.Config
 
+// QueueConfig defines configuration for the file-backed queue span batches
+// are written to before they are sent.
+type QueueConfig diskqueue.Config
+
+// HTTPTransportProxyFunc is a function that resolves which URL to use as proxy
+// for a given request. This type is compatible with http.Transport.Proxy and
+// can be used to set a custom proxy function with WithProxy.
+type HTTPTransportProxyFunc func(*http.Request) (*url.URL, error)
+
 type wrappedOption struct {
 	otlpconfig.HTTPOption
 }
@@ -75,7 +95,11 @@ func WithCompression(compression Compression) Option {
 }
 
 // WithURLPath allows one to override the default URL path used
-// for sending traces. If unset, default ("/v1/traces") will be used.
+// for sending traces. If unset, the path of the
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT environment variable is used exactly as
+// it is set, or "/v1/traces" is appended to the path of the
+// OTEL_EXPORTER_OTLP_ENDPOINT environment variable. If neither is set,
+// default ("/v1/traces") will be used.
 func WithURLPath(urlPath string) Option {
 	return wrappedOption{otlpconfig.WithURLPath(urlPath)}
 }
@@ -106,6 +130,22 @@ func WithTimeout(duration time.Duration) Option {
 	return wrappedOption{otlpconfig.WithTimeout(duration)}
 }
 
+// WithMaxRequestSize sets the maximum serialized size, in bytes, of an export
+// request. Batches of spans with a larger payload are split into multiple
+// requests that are each within this limit, before any compression is
+// applied. If unset or not positive, batches are not split.
+func WithMaxRequestSize(size int) Option {
+	return wrappedOption{otlpconfig.WithMaxRequestSize(size)}
+}
+
+// WithMeterProvider sets the MeterProvider used to measure the operation of
+// the exporter: export request attempts, failed attempts by error code,
+// request payload sizes, and export durations including retries. If unset,
+// no measurements are recorded.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return wrappedOption{otlpconfig.WithMeterProvider(mp)}
+}
+
 // WithRetry configures the retry policy for transient errors that may occurs
 // when exporting traces. An exponential back-off algorithm is used to ensure
 // endpoints are not overwhelmed with retries. If unset, the default retry
@@ -114,3 +154,42 @@ func WithTimeout(duration time.Duration) Option {
 func WithRetry(rc RetryConfig) Option {
 	return wrappedOption{otlpconfig.WithRetry(retry.Config(rc))}
 }
+
+// WithPersistentQueue configures the client to write span batches to a
+// file-backed queue in the Directory of cfg before they are sent. Queued
+// batches are sent in the background with an exponential backoff while the
+// endpoint is unreachable, and any not sent on Stop are kept on disk and sent
+// by the next client started with the same Directory. Batches the endpoint
+// rejects with an error that is not retry-able are dropped instead of being
+// sent again. When the queue is full, the oldest batches are dropped. If
+// MaxSize is not set, the queue holds up to 64 MiB. If unset or the Directory
+// is empty, batches are sent directly.
+func WithPersistentQueue(cfg QueueConfig) Option {
+	return wrappedOption{otlpconfig.WithPersistentQueue(diskqueue.Config(cfg))}
+}
+
+// WithMaxConcurrentExports sets the maximum number of span batches exported
+// concurrently. When greater than one, exports are sent in the background so
+// the span processor can export the next batch without waiting, and the
+// error of each export that fails is sent to the global ErrorHandler.
+// ForceFlush of the span processor and Shutdown wait for all exports in
+// flight. If unset or not greater than one, batches are exported serially.
+func WithMaxConcurrentExports(n int) Option {
+	return wrappedOption{otlpconfig.WithMaxConcurrentExports(n)}
+}
+
+// WithHeadersProvider sets fn to be called before every export request to
+// provide additional headers to send with that request. Headers returned by
+// fn take precedence over those set with WithHeaders. If fn returns an error
+// the request is not sent and the error is returned.
+func WithHeadersProvider(fn func(ctx context.Context) (map[string]string, error)) Option {
+	return wrappedOption{otlpconfig.WithHeadersProvider(fn)}
+}
+
+// WithProxy sets the Proxy function the client will use to determine the
+// proxy to use for an HTTP request. If this option is not used, the client
+// will use http.ProxyFromEnvironment, which respects the HTTPS_PROXY,
+// HTTP_PROXY, and NO_PROXY environment variables.
+func WithProxy(pf HTTPTransportProxyFunc) Option {
+	return wrappedOption{otlpconfig.WithProxy(otlpconfig.HTTPTransportProxyFunc(pf))}
+}
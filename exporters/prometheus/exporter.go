@@ -123,6 +123,8 @@ func (c *collector) Collect(ch chan<- prometheus.Metric) {
 			switch v := m.Data.(type) {
 			case metricdata.Histogram:
 				addHistogramMetric(ch, v, m, c.getName(m))
+			case metricdata.Summary:
+				addSummaryMetric(ch, v, m, c.getName(m))
 			case metricdata.Sum[int64]:
 				addSumMetric(ch, v, m, c.getName(m))
 			case metricdata.Sum[float64]:
@@ -157,6 +159,23 @@ func addHistogramMetric(ch chan<- prometheus.Metric, histogram metricdata.Histog
 	}
 }
 
+func addSummaryMetric(ch chan<- prometheus.Metric, summary metricdata.Summary, m metricdata.Metrics, name string) {
+	for _, dp := range summary.DataPoints {
+		keys, values := getAttrs(dp.Attributes)
+		desc := prometheus.NewDesc(name, m.Description, keys, nil)
+		quantiles := make(map[float64]float64, len(dp.QuantileValues))
+		for _, qv := range dp.QuantileValues {
+			quantiles[qv.Quantile] = qv.Value
+		}
+		m, err := prometheus.NewConstSummary(desc, dp.Count, dp.Sum, quantiles, values...)
+		if err != nil {
+			otel.Handle(err)
+			continue
+		}
+		ch <- m
+	}
+}
+
 func addSumMetric[N int64 | float64](ch chan<- prometheus.Metric, sum metricdata.Sum[N], m metricdata.Metrics, name string) {
 	valueType := prometheus.CounterValue
 	if !sum.IsMonotonic {
@@ -21,6 +21,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -41,6 +42,7 @@ func TestPrometheusExporter(t *testing.T) {
 		customResouceAttrs []attribute.KeyValue
 		recordMetrics      func(ctx context.Context, meter otelmetric.Meter)
 		options            []Option
+		views              []view.View
 		expectedFile       string
 	}{
 		{
@@ -110,6 +112,28 @@ func TestPrometheusExporter(t *testing.T) {
 				histogram.Record(ctx, 105, attrs...)
 			},
 		},
+		{
+			name:         "summary",
+			expectedFile: "testdata/summary.txt",
+			views:        []view.View{summaryView(t)},
+			recordMetrics: func(ctx context.Context, meter otelmetric.Meter) {
+				attrs := []attribute.KeyValue{
+					attribute.Key("A").String("B"),
+					attribute.Key("C").String("D"),
+				}
+				summary, err := meter.SyncFloat64().Histogram(
+					"summary_baz",
+					instrument.WithDescription("a very nice summary"),
+					instrument.WithUnit(unit.Bytes),
+				)
+				require.NoError(t, err)
+				summary.Record(ctx, 23, attrs...)
+				summary.Record(ctx, 7, attrs...)
+				summary.Record(ctx, 101, attrs...)
+				summary.Record(ctx, 105, attrs...)
+				summary.Record(ctx, 57, attrs...)
+			},
+		},
 		{
 			name:         "sanitized attributes to labels",
 			expectedFile: "testdata/sanitized_labels.txt",
@@ -156,7 +180,7 @@ func TestPrometheusExporter(t *testing.T) {
 				gauge.Add(ctx, 100, attrs...)
 
 				counter, err := meter.SyncFloat64().Counter("0invalid.counter.name", instrument.WithDescription("a counter with an invalid name"))
-				require.NoError(t, err)
+				assert.ErrorIs(t, err, metric.ErrInstrumentName)
 				counter.Add(ctx, 100, attrs...)
 
 				histogram, err := meter.SyncFloat64().Histogram("invalid.hist.name", instrument.WithDescription("a histogram with an invalid name"))
@@ -239,6 +263,10 @@ func TestPrometheusExporter(t *testing.T) {
 			require.NoError(t, err)
 			defaultView, err := view.New(view.MatchInstrumentName("*"))
 			require.NoError(t, err)
+			views := tc.views
+			if views == nil {
+				views = []view.View{customBucketsView, defaultView}
+			}
 
 			var res *resource.Resource
 
@@ -260,7 +288,7 @@ func TestPrometheusExporter(t *testing.T) {
 
 			provider := metric.NewMeterProvider(
 				metric.WithResource(res),
-				metric.WithReader(exporter, customBucketsView, defaultView),
+				metric.WithReader(exporter, views...),
 			)
 			meter := provider.Meter("testmeter")
 
@@ -276,6 +304,17 @@ func TestPrometheusExporter(t *testing.T) {
 	}
 }
 
+func summaryView(t *testing.T) view.View {
+	v, err := view.New(
+		view.MatchInstrumentName("summary_*"),
+		view.WithSetAggregation(aggregation.Summary{
+			Quantiles: []float64{0, 0.5, 0.9, 1},
+		}),
+	)
+	require.NoError(t, err)
+	return v
+}
+
 func TestSantitizeName(t *testing.T) {
 	tests := []struct {
 		input string
@@ -0,0 +1,268 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package filewriter provides an io.Writer that writes to a file and
+// rotates it based on its size and age.
+package filewriter // import "go.opentelemetry.io/otel/exporters/stdout/internal/filewriter"
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// backupTimeFormat is the layout of the timestamp suffix appended to the
+// name of rotated files. It sorts lexically in chronological order.
+const backupTimeFormat = "20060102T150405.000000000"
+
+// Config defines the rotation of a file. The zero value disables rotation.
+type Config struct {
+	// MaxSize is the maximum size in bytes of the file. The file is rotated
+	// before a write that would make it exceed this size. If MaxSize is not
+	// positive, the file is not rotated based on size.
+	MaxSize int64
+	// MaxAge is the maximum time data is written to the same file. The file
+	// is rotated on the first write after it has been written to for longer
+	// than this. If MaxAge is not positive, the file is not rotated based on
+	// age.
+	MaxAge time.Duration
+	// MaxBackups is the maximum number of rotated files to keep. The oldest
+	// rotated files are removed when this is exceeded. If MaxBackups is not
+	// positive, all rotated files are kept.
+	MaxBackups int
+}
+
+var errClosed = errors.New("file writer closed")
+
+// Writer is an io.Writer that writes to a file. When configured, the file is
+// rotated by renaming it with a timestamp suffix and opening a new one in its
+// place.
+//
+// If a rotation fails, the Write that triggered it returns the error and the
+// Writer continues to write to the file at its path. The rotation is retried
+// once the file has grown by another MaxSize or MaxAge has elapsed again.
+type Writer struct {
+	path string
+	cfg  Config
+
+	mu     sync.Mutex
+	file   *os.File
+	closed bool
+	size   int64
+	opened time.Time
+	// maxSize is the size the file is rotated at. It is larger than
+	// cfg.MaxSize after a rotation failed.
+	maxSize int64
+
+	// now returns the current time. It is overridden in tests.
+	now func() time.Time
+}
+
+var _ io.WriteCloser = (*Writer)(nil)
+
+// New returns a Writer appending to the file at path. The file and its
+// parent directories are created if they do not exist.
+func New(path string, cfg Config) (*Writer, error) {
+	w := &Writer{path: path, cfg: cfg, maxSize: cfg.MaxSize, now: time.Now}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// open opens the file at the Writer path for appending.
+func (w *Writer) open() error {
+	f, err := os.OpenFile(w.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	w.file = f
+	w.size = info.Size()
+	w.opened = w.now()
+	return nil
+}
+
+// Write writes p to the file, rotating the file first if needed.
+func (w *Writer) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return 0, errClosed
+	}
+	if w.file == nil {
+		// A failed rotation could not reopen the file.
+		if err := w.open(); err != nil {
+			return 0, err
+		}
+	}
+	if w.shouldRotate(int64(len(p))) {
+		if err := w.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+// shouldRotate returns if the file needs to be rotated before n bytes are
+// written to it.
+func (w *Writer) shouldRotate(n int64) bool {
+	if w.size == 0 {
+		// Never rotate an empty file, even if n exceeds MaxSize.
+		return false
+	}
+	if w.cfg.MaxSize > 0 && w.size+n > w.maxSize {
+		return true
+	}
+	return w.cfg.MaxAge > 0 && w.now().Sub(w.opened) >= w.cfg.MaxAge
+}
+
+// rotate renames the current file with a timestamp suffix, opens a new file
+// in its place, and removes rotated files exceeding MaxBackups. If the file
+// cannot be renamed, it is reopened and the rotation postponed.
+func (w *Writer) rotate() error {
+	if err := w.file.Sync(); err != nil {
+		w.postponeRotation()
+		return err
+	}
+	err := w.file.Close()
+	w.file = nil
+	if err == nil {
+		backup := w.path + "." + w.now().UTC().Format(backupTimeFormat)
+		err = os.Rename(w.path, backup)
+	}
+	if err != nil {
+		// If the file cannot be reopened either, the next Write retries.
+		_ = w.open()
+		w.postponeRotation()
+		return err
+	}
+
+	w.maxSize = w.cfg.MaxSize
+	if err := w.open(); err != nil {
+		return err
+	}
+	return w.removeBackups()
+}
+
+// postponeRotation delays the next rotation until the file has grown by
+// another MaxSize or MaxAge has elapsed again.
+func (w *Writer) postponeRotation() {
+	w.maxSize = w.size + w.cfg.MaxSize
+	w.opened = w.now()
+}
+
+// removeBackups removes the oldest rotated files exceeding MaxBackups.
+func (w *Writer) removeBackups() error {
+	if w.cfg.MaxBackups <= 0 {
+		return nil
+	}
+
+	matches, err := filepath.Glob(w.path + ".*")
+	if err != nil {
+		return err
+	}
+	var backups []string
+	for _, m := range matches {
+		suffix := strings.TrimPrefix(m, w.path+".")
+		if _, err := time.Parse(backupTimeFormat, suffix); err == nil {
+			backups = append(backups, m)
+		}
+	}
+	if len(backups) <= w.cfg.MaxBackups {
+		return nil
+	}
+
+	sort.Strings(backups)
+	var errs []string
+	for _, b := range backups[:len(backups)-w.cfg.MaxBackups] {
+		if err := os.Remove(b); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// Flush commits the written data to stable storage.
+func (w *Writer) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return errClosed
+	}
+	if w.file == nil {
+		return nil
+	}
+	return w.file.Sync()
+}
+
+// Close flushes and closes the file. Writes after Close return an error.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Sync()
+	if cErr := w.file.Close(); err == nil {
+		err = cErr
+	}
+	w.file = nil
+	return err
+}
+
+// Flush flushes w if it buffers data or can commit written data to stable
+// storage. Writers that are not a *Writer are flushed if they implement a
+// Flush method (e.g. *bufio.Writer), they are never synced as they may not
+// support it (e.g. os.Stdout).
+func Flush(w io.Writer) error {
+	if f, ok := w.(interface{ Flush() error }); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
+// Close flushes w and closes it if it is a *Writer. Other writers are owned
+// by the user and are only flushed.
+func Close(w io.Writer) error {
+	if fw, ok := w.(*Writer); ok {
+		return fw.Close()
+	}
+	return Flush(w)
+}
@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filewriter
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWriter(t *testing.T, cfg Config) (*Writer, *time.Time) {
+	t.Helper()
+	now := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "sub", "out.json")
+	w, err := New(path, cfg)
+	require.NoError(t, err)
+	w.now = func() time.Time { return now }
+	w.opened = now
+	t.Cleanup(func() { _ = w.Close() })
+	return w, &now
+}
+
+func backups(t *testing.T, w *Writer) []string {
+	t.Helper()
+	matches, err := filepath.Glob(w.path + ".*")
+	require.NoError(t, err)
+	return matches
+}
+
+func read(t *testing.T, path string) string {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestWriterAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.json")
+	require.NoError(t, os.WriteFile(path, []byte("a\n"), 0o644))
+
+	w, err := New(path, Config{})
+	require.NoError(t, err)
+	_, err = w.Write([]byte("b\n"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	assert.Equal(t, "a\nb\n", read(t, path))
+	_, err = w.Write([]byte("c\n"))
+	assert.ErrorIs(t, err, errClosed)
+	assert.NoError(t, w.Close(), "Close is idempotent")
+}
+
+func TestWriterRotateSize(t *testing.T) {
+	w, now := newTestWriter(t, Config{MaxSize: 4})
+
+	for _, s := range []string{"ab", "cd", "ef", "toolarge"} {
+		*now = now.Add(time.Second)
+		_, err := w.Write([]byte(s))
+		require.NoError(t, err)
+	}
+
+	b := backups(t, w)
+	require.Len(t, b, 2)
+	assert.Equal(t, "abcd", read(t, b[0]))
+	assert.Equal(t, "ef", read(t, b[1]))
+	assert.Equal(t, "toolarge", read(t, w.path))
+}
+
+func TestWriterRotateAge(t *testing.T) {
+	w, now := newTestWriter(t, Config{MaxAge: time.Minute})
+
+	_, err := w.Write([]byte("a"))
+	require.NoError(t, err)
+	*now = now.Add(30 * time.Second)
+	_, err = w.Write([]byte("b"))
+	require.NoError(t, err)
+	assert.Len(t, backups(t, w), 0)
+
+	*now = now.Add(30 * time.Second)
+	_, err = w.Write([]byte("c"))
+	require.NoError(t, err)
+
+	b := backups(t, w)
+	require.Len(t, b, 1)
+	assert.Equal(t, "ab", read(t, b[0]))
+	assert.Equal(t, "c", read(t, w.path))
+}
+
+func TestWriterRotateFailure(t *testing.T) {
+	w, now := newTestWriter(t, Config{MaxSize: 4})
+	_, err := w.Write([]byte("abcd"))
+	require.NoError(t, err)
+
+	// A non-empty directory in place of the backup makes the rename fail.
+	*now = now.Add(time.Second)
+	blocked := w.path + "." + now.UTC().Format(backupTimeFormat)
+	require.NoError(t, os.MkdirAll(filepath.Join(blocked, "dir"), 0o755))
+	_, err = w.Write([]byte("ef"))
+	assert.Error(t, err)
+
+	// Writes continue to the file and the rotation is postponed.
+	_, err = w.Write([]byte("ef"))
+	require.NoError(t, err)
+	assert.Equal(t, "abcdef", read(t, w.path))
+
+	*now = now.Add(time.Second)
+	_, err = w.Write([]byte("ghi"))
+	require.NoError(t, err)
+
+	b := backups(t, w)
+	require.Len(t, b, 2)
+	assert.Equal(t, blocked, b[0])
+	assert.Equal(t, "abcdef", read(t, b[1]))
+	assert.Equal(t, "ghi", read(t, w.path))
+}
+
+func TestWriterReopen(t *testing.T) {
+	w, _ := newTestWriter(t, Config{})
+	// Simulate a rotation that failed to reopen the file.
+	require.NoError(t, w.file.Close())
+	w.file = nil
+
+	_, err := w.Write([]byte("a"))
+	require.NoError(t, err)
+	assert.Equal(t, "a", read(t, w.path))
+}
+
+func TestWriterMaxBackups(t *testing.T) {
+	w, now := newTestWriter(t, Config{MaxSize: 1, MaxBackups: 2})
+	// Files not created by rotation are kept.
+	other := w.path + ".lock"
+	require.NoError(t, os.WriteFile(other, nil, 0o644))
+
+	for _, s := range []string{"a", "b", "c", "d"} {
+		*now = now.Add(time.Second)
+		_, err := w.Write([]byte(s))
+		require.NoError(t, err)
+	}
+
+	b := backups(t, w)
+	require.Len(t, b, 3)
+	assert.Equal(t, "b", read(t, b[0]))
+	assert.Equal(t, "c", read(t, b[1]))
+	assert.Equal(t, other, b[2])
+	assert.Equal(t, "d", read(t, w.path))
+}
+
+func TestFlushClose(t *testing.T) {
+	var buf bytes.Buffer
+	bw := bufio.NewWriter(&buf)
+	_, err := bw.WriteString("a")
+	require.NoError(t, err)
+	require.NoError(t, Flush(bw))
+	assert.Equal(t, "a", buf.String())
+
+	_, err = bw.WriteString("b")
+	require.NoError(t, err)
+	require.NoError(t, Close(bw))
+	assert.Equal(t, "ab", buf.String())
+
+	assert.NoError(t, Flush(&buf), "writers without Flush are ignored")
+
+	w, _ := newTestWriter(t, Config{})
+	require.NoError(t, Flush(w))
+	require.NoError(t, Close(w))
+	assert.ErrorIs(t, Flush(w), errClosed)
+}
@@ -15,28 +15,74 @@ package stdoutmetric // import "go.opentelemetry.io/otel/exporters/stdout/stdout
 
 import (
 	"encoding/json"
+	"io"
 	"os"
+
+	"go.opentelemetry.io/otel/exporters/stdout/internal/filewriter"
+	"go.opentelemetry.io/otel/sdk/metric"
+)
+
+// format is the output format of the exporter.
+type format int
+
+const (
+	// formatJSON encodes the metricdata data-types with the exporter
+	// Encoder.
+	formatJSON format = iota
+	// formatConsole writes metric data as human-readable text.
+	formatConsole
 )
 
 // config contains options for the exporter.
 type config struct {
-	encoder *encoderHolder
+	encoder             *encoderHolder
+	writer              io.Writer
+	filePath            string
+	rotation            filewriter.Config
+	temporalitySelector metric.TemporalitySelector
+	aggregationSelector metric.AggregationSelector
+	format              format
+	color               bool
 }
 
 // newConfig creates a validated config configured with options.
-func newConfig(options ...Option) config {
+func newConfig(options ...Option) (config, error) {
 	cfg := config{}
 	for _, opt := range options {
 		cfg = opt.apply(cfg)
 	}
 
+	if cfg.temporalitySelector == nil {
+		cfg.temporalitySelector = metric.DefaultTemporalitySelector
+	}
+
+	if cfg.aggregationSelector == nil {
+		cfg.aggregationSelector = metric.DefaultAggregationSelector
+	}
+
+	if cfg.filePath != "" {
+		w, err := filewriter.New(cfg.filePath, cfg.rotation)
+		if err != nil {
+			return cfg, err
+		}
+		cfg.writer = w
+	}
+
+	if cfg.writer == nil {
+		cfg.writer = os.Stdout
+	}
+
+	if cfg.format == formatConsole {
+		cfg.encoder = &encoderHolder{encoder: newConsoleEncoder(cfg.writer, cfg.color)}
+	}
+
 	if cfg.encoder == nil {
-		enc := json.NewEncoder(os.Stdout)
+		enc := json.NewEncoder(cfg.writer)
 		enc.SetIndent("", "\t")
 		cfg.encoder = &encoderHolder{encoder: enc}
 	}
 
-	return cfg
+	return cfg, nil
 }
 
 // Option sets exporter option values.
@@ -60,3 +106,98 @@ func WithEncoder(encoder Encoder) Option {
 		return c
 	})
 }
+
+// WithWriter sets the exporter to write to w. If this option is not used,
+// the exporter writes to STDOUT. If w has a Flush method returning an error
+// (e.g. *bufio.Writer), it is flushed when the exporter is flushed or
+// shutdown.
+//
+// The writer is not used by an Encoder passed with the WithEncoder option.
+func WithWriter(w io.Writer) Option {
+	return optionFunc(func(c config) config {
+		if w != nil {
+			c.writer = w
+		}
+		return c
+	})
+}
+
+// WithFile sets the exporter to write to the file at path. The file is
+// appended to and it, along with its parent directories, is created if it
+// does not exist. The file is synced to stable storage when the exporter is
+// flushed and it is closed when the exporter is shutdown.
+//
+// This option overrides any writer set with WithWriter. Like that writer, the
+// file is not used by an Encoder passed with the WithEncoder option.
+func WithFile(path string) Option {
+	return optionFunc(func(c config) config {
+		c.filePath = path
+		return c
+	})
+}
+
+// RotationConfig defines the rotation of the file set with WithFile. A
+// rotated file is renamed with a timestamp suffix and a new file is created
+// in its place.
+type RotationConfig filewriter.Config
+
+// WithRotation sets the rotation of the file set with WithFile. If this
+// option is not used, the file is never rotated.
+func WithRotation(rc RotationConfig) Option {
+	return optionFunc(func(c config) config {
+		c.rotation = filewriter.Config(rc)
+		return c
+	})
+}
+
+// WithTemporalitySelector sets the TemporalitySelector the exporter will use
+// to determine the Temporality of an instrument based on its kind. If this
+// option is not used, the exporter will use the DefaultTemporalitySelector
+// from the go.opentelemetry.io/otel/sdk/metric package.
+func WithTemporalitySelector(selector metric.TemporalitySelector) Option {
+	return optionFunc(func(c config) config {
+		c.temporalitySelector = selector
+		return c
+	})
+}
+
+// WithAggregationSelector sets the AggregationSelector the exporter will use
+// to determine the aggregation to use for an instrument based on its kind. If
+// this option is not used, the exporter will use the
+// DefaultAggregationSelector from the go.opentelemetry.io/otel/sdk/metric
+// package or the aggregation explicitly passed for a view matching an
+// instrument.
+func WithAggregationSelector(selector metric.AggregationSelector) Option {
+	return optionFunc(func(c config) config {
+		c.aggregationSelector = selector
+		return c
+	})
+}
+
+// WithConsoleFormat sets the exporter to write metric data as aligned,
+// human-readable text meant for local development instead of JSON. Each
+// metric is written with its name, kind, unit, and description followed by
+// its data points with their attributes, values, and timestamps.
+//
+// The output is not colorized unless the WithColor option is used. The output
+// is written to the writer set with WithWriter and any Encoder passed with
+// WithEncoder is ignored.
+func WithConsoleFormat() Option {
+	return optionFunc(func(c config) config {
+		c.format = formatConsole
+		return c
+	})
+}
+
+// WithColor sets the console format to be colorized using ANSI escape
+// sequences. The output is still not colorized if the NO_COLOR environment
+// variable is set. Colors are meant for a terminal, this option should not be
+// used when writing to a file or other non-terminal writer.
+//
+// This option has no effect unless the WithConsoleFormat option is used.
+func WithColor() Option {
+	return optionFunc(func(c config) config {
+		c.color = true
+		return c
+	})
+}
@@ -0,0 +1,303 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stdoutmetric // import "go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// ANSI escape sequences used to colorize the console format.
+const (
+	colorReset = "\x1b[0m"
+	colorBold  = "\x1b[1m"
+	colorDim   = "\x1b[2m"
+	colorCyan  = "\x1b[36m"
+)
+
+// consoleTimeFormat is the layout used to render timestamps in the console
+// format.
+const consoleTimeFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// consoleEncoder is an Encoder that writes metric data as aligned,
+// human-readable text.
+type consoleEncoder struct {
+	w     io.Writer
+	color bool
+}
+
+// newConsoleEncoder returns a consoleEncoder writing to w. Output is
+// colorized if color is true and the NO_COLOR environment variable is not
+// set.
+func newConsoleEncoder(w io.Writer, color bool) consoleEncoder {
+	_, noColor := os.LookupEnv("NO_COLOR")
+	return consoleEncoder{w: w, color: color && !noColor}
+}
+
+// Encode writes v, which needs to be a metricdata.ResourceMetrics, as text.
+func (e consoleEncoder) Encode(v any) error {
+	rm, ok := v.(metricdata.ResourceMetrics)
+	if !ok {
+		return fmt.Errorf("console format: unsupported type %T", v)
+	}
+
+	w := bufio.NewWriter(e.w)
+	resIter := rm.Resource.Iter()
+	fmt.Fprintf(w, "%s %s\n", e.paint(colorBold, "Resource:"), formatAttrs(&resIter))
+	for _, sm := range rm.ScopeMetrics {
+		fmt.Fprintf(w, "%s %s %s\n", e.paint(colorBold, "Scope:"), sm.Scope.Name, sm.Scope.Version)
+		for _, m := range sm.Metrics {
+			e.metric(w, m)
+		}
+	}
+	return w.Flush()
+}
+
+func (e consoleEncoder) metric(w io.Writer, m metricdata.Metrics) {
+	header := func(kind string) {
+		fmt.Fprintf(w, "  %s %s", e.paint(colorBold, m.Name), kind)
+		if m.Unit != "" {
+			fmt.Fprintf(w, " (%s)", m.Unit)
+		}
+		if m.Description != "" {
+			fmt.Fprintf(w, " %s", e.paint(colorDim, m.Description))
+		}
+		fmt.Fprintln(w)
+	}
+
+	var rows [][]consoleCell
+	switch data := m.Data.(type) {
+	case metricdata.Gauge[int64]:
+		header("Gauge[int64]")
+		rows = dataPointRows(data.DataPoints)
+	case metricdata.Gauge[float64]:
+		header("Gauge[float64]")
+		rows = dataPointRows(data.DataPoints)
+	case metricdata.Sum[int64]:
+		header(sumKind("Sum[int64]", data.Temporality, data.IsMonotonic))
+		rows = dataPointRows(data.DataPoints)
+	case metricdata.Sum[float64]:
+		header(sumKind("Sum[float64]", data.Temporality, data.IsMonotonic))
+		rows = dataPointRows(data.DataPoints)
+	case metricdata.Histogram:
+		header("Histogram " + temporality(data.Temporality))
+		for _, dp := range data.DataPoints {
+			rows = append(rows, []consoleCell{
+				attrsCell(dp.Attributes),
+				{text: histogramSummary(dp)},
+				{text: histogramBuckets(dp)},
+				timesCell(dp.StartTime, dp.Time),
+			})
+		}
+	case metricdata.ExponentialHistogram:
+		header("ExponentialHistogram " + temporality(data.Temporality))
+		for _, dp := range data.DataPoints {
+			rows = append(rows, []consoleCell{
+				attrsCell(dp.Attributes),
+				{text: exponentialHistogramSummary(dp)},
+				{text: exponentialHistogramBuckets(dp)},
+				timesCell(dp.StartTime, dp.Time),
+			})
+		}
+	case metricdata.Summary:
+		header("Summary " + temporality(data.Temporality))
+		for _, dp := range data.DataPoints {
+			rows = append(rows, []consoleCell{
+				attrsCell(dp.Attributes),
+				{text: fmt.Sprintf("count=%d sum=%v", dp.Count, dp.Sum)},
+				{text: summaryQuantiles(dp)},
+				timesCell(dp.StartTime, dp.Time),
+			})
+		}
+	default:
+		header(fmt.Sprintf("%T", m.Data))
+	}
+	e.table(w, "    ", rows)
+}
+
+func dataPointRows[N int64 | float64](dps []metricdata.DataPoint[N]) [][]consoleCell {
+	rows := make([][]consoleCell, 0, len(dps))
+	for _, dp := range dps {
+		rows = append(rows, []consoleCell{
+			attrsCell(dp.Attributes),
+			{text: fmt.Sprint(dp.Value)},
+			timesCell(dp.StartTime, dp.Time),
+		})
+	}
+	return rows
+}
+
+func sumKind(kind string, t metricdata.Temporality, monotonic bool) string {
+	kind += " " + temporality(t)
+	if monotonic {
+		kind += " monotonic"
+	}
+	return kind
+}
+
+// temporality returns the lower-case name of t (e.g. "delta").
+func temporality(t metricdata.Temporality) string {
+	return strings.ToLower(strings.TrimSuffix(t.String(), "Temporality"))
+}
+
+func histogramSummary(dp metricdata.HistogramDataPoint) string {
+	s := fmt.Sprintf("count=%d sum=%v", dp.Count, dp.Sum)
+	if dp.Min != nil {
+		s += fmt.Sprintf(" min=%v", *dp.Min)
+	}
+	if dp.Max != nil {
+		s += fmt.Sprintf(" max=%v", *dp.Max)
+	}
+	return s
+}
+
+// histogramBuckets returns the bucket counts of dp labeled by their bounds
+// (e.g. "(-inf,1]:1 (1,+inf):0").
+func histogramBuckets(dp metricdata.HistogramDataPoint) string {
+	var b strings.Builder
+	lower := "-inf"
+	for i, count := range dp.BucketCounts {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		if i < len(dp.Bounds) {
+			upper := strconv.FormatFloat(dp.Bounds[i], 'g', -1, 64)
+			fmt.Fprintf(&b, "(%s,%s]:%d", lower, upper, count)
+			lower = upper
+		} else {
+			fmt.Fprintf(&b, "(%s,+inf):%d", lower, count)
+		}
+	}
+	return b.String()
+}
+
+func exponentialHistogramSummary(dp metricdata.ExponentialHistogramDataPoint) string {
+	s := fmt.Sprintf("count=%d sum=%v", dp.Count, dp.Sum)
+	if dp.Min != nil {
+		s += fmt.Sprintf(" min=%v", *dp.Min)
+	}
+	if dp.Max != nil {
+		s += fmt.Sprintf(" max=%v", *dp.Max)
+	}
+	return s + fmt.Sprintf(" scale=%d zero=%d", dp.Scale, dp.ZeroCount)
+}
+
+// exponentialHistogramBuckets returns the offset and counts of the populated
+// buckets of dp (e.g. "positive=2:[1 3] negative=0:[1]").
+func exponentialHistogramBuckets(dp metricdata.ExponentialHistogramDataPoint) string {
+	var parts []string
+	if len(dp.PositiveBucket.Counts) > 0 {
+		parts = append(parts, fmt.Sprintf("positive=%d:%v", dp.PositiveBucket.Offset, dp.PositiveBucket.Counts))
+	}
+	if len(dp.NegativeBucket.Counts) > 0 {
+		parts = append(parts, fmt.Sprintf("negative=%d:%v", dp.NegativeBucket.Offset, dp.NegativeBucket.Counts))
+	}
+	return strings.Join(parts, " ")
+}
+
+// summaryQuantiles returns the quantile values of dp labeled by their
+// quantile (e.g. "0.5:4 0.99:16").
+func summaryQuantiles(dp metricdata.SummaryDataPoint) string {
+	parts := make([]string, 0, len(dp.QuantileValues))
+	for _, qv := range dp.QuantileValues {
+		parts = append(parts, fmt.Sprintf("%v:%v", qv.Quantile, qv.Value))
+	}
+	return strings.Join(parts, " ")
+}
+
+// consoleCell is a single column value of a console format table.
+type consoleCell struct {
+	text  string
+	color string
+}
+
+func attrsCell(s attribute.Set) consoleCell {
+	if s.Len() == 0 {
+		return consoleCell{text: "{}", color: colorDim}
+	}
+	iter := s.Iter()
+	return consoleCell{text: formatAttrs(&iter), color: colorCyan}
+}
+
+func timesCell(start, end time.Time) consoleCell {
+	text := end.Format(consoleTimeFormat)
+	if !start.IsZero() {
+		text = start.Format(consoleTimeFormat) + " - " + text
+	}
+	return consoleCell{text: text, color: colorDim}
+}
+
+// table writes rows to w with the cells of each column aligned. Colors are
+// applied after alignment so they do not affect the column widths.
+func (e consoleEncoder) table(w io.Writer, indent string, rows [][]consoleCell) {
+	var widths []int
+	for _, row := range rows {
+		for i, c := range row {
+			if i == len(widths) {
+				widths = append(widths, 0)
+			}
+			if n := utf8.RuneCountInString(c.text); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+
+	var line strings.Builder
+	for _, row := range rows {
+		line.Reset()
+		line.WriteString(indent)
+		for i, c := range row {
+			line.WriteString(e.paint(c.color, c.text))
+			if i < len(row)-1 {
+				pad := widths[i] - utf8.RuneCountInString(c.text) + 2
+				line.WriteString(strings.Repeat(" ", pad))
+			}
+		}
+		// Trailing empty cells are not padded.
+		_, _ = io.WriteString(w, strings.TrimRight(line.String(), " ")+"\n")
+	}
+}
+
+// formatAttrs returns the attributes of iter as space separated key=value
+// pairs.
+func formatAttrs(iter *attribute.Iterator) string {
+	var b strings.Builder
+	for iter.Next() {
+		kv := iter.Attribute()
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(string(kv.Key))
+		b.WriteByte('=')
+		b.WriteString(kv.Value.Emit())
+	}
+	return b.String()
+}
+
+func (e consoleEncoder) paint(color, s string) string {
+	if !e.color || color == "" || s == "" {
+		return s
+	}
+	return color + s + colorReset
+}
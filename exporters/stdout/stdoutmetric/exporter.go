@@ -16,16 +16,24 @@ package stdoutmetric // import "go.opentelemetry.io/otel/exporters/stdout/stdout
 
 import (
 	"context"
+	"io"
 	"sync"
 	"sync/atomic"
 
+	"go.opentelemetry.io/otel/exporters/stdout/internal/filewriter"
 	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/view"
 )
 
 // exporter is an OpenTelemetry metric exporter.
 type exporter struct {
 	encVal atomic.Value // encoderHolder
+	writer io.Writer
+
+	temporalitySelector metric.TemporalitySelector
+	aggregationSelector metric.AggregationSelector
 
 	shutdownOnce sync.Once
 }
@@ -35,12 +43,28 @@ type exporter struct {
 // If no options are passed, the default exporter returned will use a JSON
 // encoder with tab indentations that output to STDOUT.
 func New(options ...Option) (metric.Exporter, error) {
-	cfg := newConfig(options...)
-	exp := &exporter{}
+	cfg, err := newConfig(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	exp := &exporter{
+		writer:              cfg.writer,
+		temporalitySelector: cfg.temporalitySelector,
+		aggregationSelector: cfg.aggregationSelector,
+	}
 	exp.encVal.Store(*cfg.encoder)
 	return exp, nil
 }
 
+func (e *exporter) Temporality(k view.InstrumentKind) metricdata.Temporality {
+	return e.temporalitySelector(k)
+}
+
+func (e *exporter) Aggregation(k view.InstrumentKind) aggregation.Aggregation {
+	return e.aggregationSelector(k)
+}
+
 func (e *exporter) Export(ctx context.Context, data metricdata.ResourceMetrics) error {
 	select {
 	case <-ctx.Done():
@@ -54,15 +78,23 @@ func (e *exporter) Export(ctx context.Context, data metricdata.ResourceMetrics)
 }
 
 func (e *exporter) ForceFlush(ctx context.Context) error {
-	// exporter holds no state, nothing to flush.
-	return ctx.Err()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	// exporter holds no state, only the writer may need to be flushed.
+	return filewriter.Flush(e.writer)
 }
 
 func (e *exporter) Shutdown(ctx context.Context) error {
+	var err error
 	e.shutdownOnce.Do(func() {
 		e.encVal.Store(encoderHolder{
 			encoder: shutdownEncoder{},
 		})
+		err = filewriter.Close(e.writer)
 	})
+	if err != nil {
+		return err
+	}
 	return ctx.Err()
 }
@@ -15,17 +15,26 @@
 package stdoutmetric_test // import "go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
 	"io"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/metric/unit"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/view"
 )
 
 func testEncoderOption() stdoutmetric.Option {
@@ -97,3 +106,162 @@ func TestShutdownExporterReturnsShutdownErrorOnExport(t *testing.T) {
 	require.NoError(t, exp.Shutdown(ctx))
 	assert.EqualError(t, exp.Export(ctx, data), "exporter shutdown")
 }
+
+func deltaSelector(view.InstrumentKind) metricdata.Temporality {
+	return metricdata.DeltaTemporality
+}
+
+func TestTemporalitySelector(t *testing.T) {
+	exp, err := stdoutmetric.New(
+		testEncoderOption(),
+		stdoutmetric.WithTemporalitySelector(deltaSelector),
+	)
+	require.NoError(t, err)
+
+	var unknownKind view.InstrumentKind
+	assert.Equal(t, metricdata.DeltaTemporality, exp.Temporality(unknownKind))
+}
+
+func dropSelector(view.InstrumentKind) aggregation.Aggregation {
+	return aggregation.Drop{}
+}
+
+func TestAggregationSelector(t *testing.T) {
+	exp, err := stdoutmetric.New(
+		testEncoderOption(),
+		stdoutmetric.WithAggregationSelector(dropSelector),
+	)
+	require.NoError(t, err)
+
+	var unknownKind view.InstrumentKind
+	assert.Equal(t, aggregation.Drop{}, exp.Aggregation(unknownKind))
+}
+
+func TestConsoleFormat(t *testing.T) {
+	var buf bytes.Buffer
+	exp, err := stdoutmetric.New(
+		stdoutmetric.WithConsoleFormat(),
+		stdoutmetric.WithWriter(&buf),
+	)
+	require.NoError(t, err)
+
+	data := metricdata.ResourceMetrics{
+		Resource: res,
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope: instrumentation.Scope{Name: "example", Version: "v0.0.1"},
+			Metrics: []metricdata.Metrics{{
+				Name:        "requests",
+				Description: "Number of requests received",
+				Unit:        unit.Dimensionless,
+				Data: metricdata.Sum[int64]{
+					IsMonotonic: true,
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints: []metricdata.DataPoint[int64]{
+						{
+							Attributes: attribute.NewSet(attribute.String("server", "central")),
+							StartTime:  now,
+							Time:       now.Add(1 * time.Second),
+							Value:      5,
+						},
+						{
+							Attributes: attribute.NewSet(attribute.String("server", "west")),
+							StartTime:  now,
+							Time:       now.Add(1 * time.Second),
+							Value:      100,
+						},
+					},
+				},
+			}},
+		}},
+	}
+	ctx := context.Background()
+	require.NoError(t, exp.Export(ctx, data))
+
+	want := `Resource: service.name=stdoutmetric-example
+Scope: example v0.0.1
+  requests Sum[int64] cumulative monotonic (1) Number of requests received
+    server=central  5    2000-01-01T00:00:00.000Z - 2000-01-01T00:00:01.000Z
+    server=west     100  2000-01-01T00:00:00.000Z - 2000-01-01T00:00:01.000Z
+`
+	assert.Equal(t, want, buf.String())
+
+	require.NoError(t, exp.Shutdown(ctx))
+	assert.EqualError(t, exp.Export(ctx, data), "exporter shutdown")
+}
+
+func TestConsoleFormatColor(t *testing.T) {
+	data := metricdata.ResourceMetrics{
+		Resource: res,
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope: instrumentation.Scope{Name: "example"},
+			Metrics: []metricdata.Metrics{{
+				Name: "requests",
+				Data: metricdata.Gauge[int64]{
+					DataPoints: []metricdata.DataPoint[int64]{{Value: 1}},
+				},
+			}},
+		}},
+	}
+
+	export := func(t *testing.T) string {
+		var buf bytes.Buffer
+		exp, err := stdoutmetric.New(
+			stdoutmetric.WithConsoleFormat(),
+			stdoutmetric.WithColor(),
+			stdoutmetric.WithWriter(&buf),
+		)
+		require.NoError(t, err)
+		require.NoError(t, exp.Export(context.Background(), data))
+		return buf.String()
+	}
+
+	t.Run("WithColor", func(t *testing.T) {
+		assert.Contains(t, export(t), "\x1b[1mrequests\x1b[0m")
+	})
+
+	t.Run("NO_COLOR", func(t *testing.T) {
+		t.Setenv("NO_COLOR", "")
+		assert.NotContains(t, export(t), "\x1b[")
+	})
+}
+
+func TestWithFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	exp, err := stdoutmetric.New(
+		stdoutmetric.WithFile(path),
+		stdoutmetric.WithRotation(stdoutmetric.RotationConfig{MaxSize: 1, MaxBackups: 1}),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, exp.Export(ctx, mockData))
+	}
+	require.NoError(t, exp.ForceFlush(ctx))
+	require.NoError(t, exp.Shutdown(ctx))
+
+	got, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(got), `"Name": "requests"`)
+
+	backups, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	assert.Len(t, backups, 1)
+}
+
+func TestFlushWriter(t *testing.T) {
+	var buf bytes.Buffer
+	exp, err := stdoutmetric.New(stdoutmetric.WithWriter(bufio.NewWriter(&buf)))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, exp.Export(ctx, mockData))
+	assert.Zero(t, buf.Len())
+	require.NoError(t, exp.ForceFlush(ctx))
+	n := buf.Len()
+	assert.NotZero(t, n)
+
+	require.NoError(t, exp.Export(ctx, mockData))
+	require.NoError(t, exp.Shutdown(ctx))
+	assert.Greater(t, buf.Len(), n)
+}
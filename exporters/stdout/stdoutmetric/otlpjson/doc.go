@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otlpjson provides an exporter that writes metric data using the
+// OTLP JSON encoding of an ExportMetricsServiceRequest. The output can be
+// replayed to an OpenTelemetry Collector file receiver or compared with other
+// OTLP captures.
+//
+// This package is kept separate from the stdoutmetric package so the latter
+// does not depend on the OTLP exporter and its transitive dependencies.
+//
+// This package is currently in a pre-GA phase. Backwards incompatible changes
+// may be introduced in subsequent minor version releases as we work to track
+// the evolving OpenTelemetry specification and user feedback.
+package otlpjson // import "go.opentelemetry.io/otel/exporters/stdout/stdoutmetric/otlpjson"
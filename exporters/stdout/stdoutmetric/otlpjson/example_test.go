@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpjson_test
+
+import (
+	"context"
+	"log"
+
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric/otlpjson"
+	"go.opentelemetry.io/otel/metric/global"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+func Example() {
+	exp, err := otlpjson.New(otlpjson.WithPrettyPrint())
+	if err != nil {
+		log.Fatalf("failed to create exporter: %v", err)
+	}
+
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exp)))
+	defer func() {
+		if err := mp.Shutdown(context.Background()); err != nil {
+			log.Fatalf("failed to shutdown MeterProvider: %v", err)
+		}
+	}()
+	global.SetMeterProvider(mp)
+}
@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpjson // import "go.opentelemetry.io/otel/exporters/stdout/stdoutmetric/otlpjson"
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
+	"go.opentelemetry.io/otel/exporters/stdout/internal/filewriter"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/view"
+	cmpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+// New returns an exporter that writes metric data using the OTLP JSON
+// encoding.
+func New(options ...Option) (metric.Exporter, error) {
+	cfg := newConfig(options...)
+
+	enc := json.NewEncoder(cfg.writer)
+	enc.SetEscapeHTML(false)
+	if cfg.prettyPrint {
+		enc.SetIndent("", "\t")
+	}
+	return otlpmetric.New(&client{
+		writer:              cfg.writer,
+		encoder:             enc,
+		temporalitySelector: cfg.temporalitySelector,
+		aggregationSelector: cfg.aggregationSelector,
+	}), nil
+}
+
+// client is an otlpmetric.Client that writes the OTLP JSON encoding of
+// uploaded metric data.
+type client struct {
+	writer              io.Writer
+	temporalitySelector metric.TemporalitySelector
+	aggregationSelector metric.AggregationSelector
+
+	encoderMu sync.Mutex
+	encoder   *json.Encoder
+}
+
+var _ otlpmetric.Client = (*client)(nil)
+
+func (c *client) Temporality(k view.InstrumentKind) metricdata.Temporality {
+	return c.temporalitySelector(k)
+}
+
+func (c *client) Aggregation(k view.InstrumentKind) aggregation.Aggregation {
+	return c.aggregationSelector(k)
+}
+
+// UploadMetrics writes rm as the OTLP JSON encoding of an
+// ExportMetricsServiceRequest.
+func (c *client) UploadMetrics(ctx context.Context, rm *mpb.ResourceMetrics) error {
+	if err := ctx.Err(); err != nil {
+		// Don't do anything if the context has already timed out.
+		return err
+	}
+
+	v, err := marshal(&cmpb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*mpb.ResourceMetrics{rm},
+	})
+	if err != nil {
+		return err
+	}
+
+	c.encoderMu.Lock()
+	defer c.encoderMu.Unlock()
+	return c.encoder.Encode(v)
+}
+
+func (c *client) ForceFlush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	// client holds no state, only the writer may need to be flushed.
+	c.encoderMu.Lock()
+	defer c.encoderMu.Unlock()
+	return filewriter.Flush(c.writer)
+}
+
+func (c *client) Shutdown(ctx context.Context) error {
+	c.encoderMu.Lock()
+	err := filewriter.Close(c.writer)
+	c.encoderMu.Unlock()
+	if err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+// marshal returns the OTLP JSON encoding of req as a generic JSON value.
+// Contrary to the default protojson encoding, OTLP JSON encodes enums as
+// integers and trace and span IDs as hex strings.
+func marshal(req *cmpb.ExportMetricsServiceRequest) (interface{}, error) {
+	b, err := protojson.MarshalOptions{UseEnumNumbers: true}.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	// Keep the exact integer values protojson encodes as numbers.
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	hexIDs(v)
+	return v, nil
+}
+
+// idFields are the JSON names of the bytes fields of OTLP messages holding
+// trace and span IDs.
+var idFields = map[string]bool{
+	"traceId": true,
+	"spanId":  true,
+}
+
+// hexIDs re-encodes, in place, the base64 encoded ID fields of the decoded
+// protojson value v as hex strings. Only object keys are matched, which are
+// always message field names, so attribute keys and values are unaffected.
+func hexIDs(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			if s, ok := val.(string); ok && idFields[k] {
+				if id, err := base64.StdEncoding.DecodeString(s); err == nil {
+					v[k] = hex.EncodeToString(id)
+				}
+				continue
+			}
+			hexIDs(val)
+		}
+	case []interface{}:
+		for _, val := range v {
+			hexIDs(val)
+		}
+	}
+}
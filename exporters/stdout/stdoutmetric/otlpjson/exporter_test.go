@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpjson_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric/otlpjson"
+	"go.opentelemetry.io/otel/metric/unit"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/view"
+	"go.opentelemetry.io/otel/sdk/resource"
+	cmpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+)
+
+var (
+	now = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	data = metricdata.ResourceMetrics{
+		Resource: resource.NewSchemaless(attribute.String("service.name", "otlpjson-test")),
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope: instrumentation.Scope{Name: "example", Version: "v0.0.1"},
+			Metrics: []metricdata.Metrics{{
+				Name:        "requests",
+				Description: "Number of requests received",
+				Unit:        unit.Dimensionless,
+				Data: metricdata.Sum[int64]{
+					IsMonotonic: true,
+					Temporality: metricdata.DeltaTemporality,
+					DataPoints: []metricdata.DataPoint[int64]{{
+						Attributes: attribute.NewSet(attribute.String("server", "central")),
+						StartTime:  now,
+						Time:       now.Add(1 * time.Second),
+						Value:      5,
+						Exemplars: []metricdata.Exemplar[int64]{{
+							Time:    now,
+							Value:   5,
+							TraceID: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+							SpanID:  []byte{1, 2, 3, 4, 5, 6, 7, 8},
+						}},
+					}},
+				},
+			}},
+		}},
+	}
+)
+
+func deltaSelector(view.InstrumentKind) metricdata.Temporality {
+	return metricdata.DeltaTemporality
+}
+
+func dropSelector(view.InstrumentKind) aggregation.Aggregation {
+	return aggregation.Drop{}
+}
+
+func TestSelectors(t *testing.T) {
+	exp, err := otlpjson.New(
+		otlpjson.WithTemporalitySelector(deltaSelector),
+		otlpjson.WithAggregationSelector(dropSelector),
+	)
+	require.NoError(t, err)
+
+	var unknownKind view.InstrumentKind
+	assert.Equal(t, metricdata.DeltaTemporality, exp.Temporality(unknownKind))
+	assert.Equal(t, aggregation.Drop{}, exp.Aggregation(unknownKind))
+}
+
+func TestExport(t *testing.T) {
+	var buf bytes.Buffer
+	exp, err := otlpjson.New(otlpjson.WithWriter(&buf))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, exp.Export(ctx, data))
+
+	assert.Contains(t, buf.String(), `"traceId":"0102030405060708090a0b0c0d0e0f10"`)
+	assert.Contains(t, buf.String(), `"spanId":"0102030405060708"`)
+	assert.Contains(t, buf.String(), `"aggregationTemporality":1`)
+
+	var req cmpb.ExportMetricsServiceRequest
+	require.NoError(t, protojson.Unmarshal(buf.Bytes(), &req))
+	require.Len(t, req.ResourceMetrics, 1)
+	require.Len(t, req.ResourceMetrics[0].ScopeMetrics, 1)
+	sm := req.ResourceMetrics[0].ScopeMetrics[0]
+	assert.Equal(t, "example", sm.Scope.Name)
+	require.Len(t, sm.Metrics, 1)
+	assert.Equal(t, "requests", sm.Metrics[0].Name)
+	assert.Equal(t, int64(5), sm.Metrics[0].GetSum().DataPoints[0].GetAsInt())
+
+	require.NoError(t, exp.Shutdown(ctx))
+	assert.Error(t, exp.Export(ctx, data))
+}
+
+func TestFlushWriter(t *testing.T) {
+	var buf bytes.Buffer
+	exp, err := otlpjson.New(otlpjson.WithWriter(bufio.NewWriter(&buf)))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, exp.Export(ctx, data))
+	assert.Zero(t, buf.Len())
+	require.NoError(t, exp.ForceFlush(ctx))
+	n := buf.Len()
+	assert.NotZero(t, n)
+
+	require.NoError(t, exp.Export(ctx, data))
+	require.NoError(t, exp.Shutdown(ctx))
+	assert.Greater(t, buf.Len(), n)
+}
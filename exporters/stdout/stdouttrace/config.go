@@ -17,12 +17,25 @@ package stdouttrace // import "go.opentelemetry.io/otel/exporters/stdout/stdoutt
 import (
 	"io"
 	"os"
+
+	"go.opentelemetry.io/otel/exporters/stdout/internal/filewriter"
 )
 
 var (
 	defaultWriter      = os.Stdout
 	defaultPrettyPrint = false
 	defaultTimestamps  = true
+	defaultFormat      = formatJSON
+)
+
+// format is the export stream format.
+type format int
+
+const (
+	// formatJSON encodes span stubs as JSON.
+	formatJSON format = iota
+	// formatConsole writes spans as human-readable text.
+	formatConsole
 )
 
 // config contains options for the STDOUT exporter.
@@ -37,6 +50,20 @@ type config struct {
 	// Timestamps specifies if timestamps should be printed. Default is
 	// true.
 	Timestamps bool
+
+	// Format is the export stream format. Default is JSON.
+	Format format
+
+	// Color specifies if the console format is colorized. Default is false.
+	Color bool
+
+	// FilePath is the path of a file used as the destination instead of
+	// Writer. Default is empty.
+	FilePath string
+
+	// Rotation is the rotation of the file at FilePath. Default is no
+	// rotation.
+	Rotation filewriter.Config
 }
 
 // newConfig creates a validated Config configured with options.
@@ -45,10 +72,19 @@ func newConfig(options ...Option) (config, error) {
 		Writer:      defaultWriter,
 		PrettyPrint: defaultPrettyPrint,
 		Timestamps:  defaultTimestamps,
+		Format:      defaultFormat,
 	}
 	for _, opt := range options {
 		cfg = opt.apply(cfg)
 	}
+
+	if cfg.FilePath != "" {
+		w, err := filewriter.New(cfg.FilePath, cfg.Rotation)
+		if err != nil {
+			return cfg, err
+		}
+		cfg.Writer = w
+	}
 	return cfg, nil
 }
 
@@ -57,7 +93,9 @@ type Option interface {
 	apply(config) config
 }
 
-// WithWriter sets the export stream destination.
+// WithWriter sets the export stream destination. If w has a Flush method
+// returning an error (e.g. *bufio.Writer), it is flushed when the exporter is
+// shutdown.
 func WithWriter(w io.Writer) Option {
 	return writerOption{w}
 }
@@ -71,6 +109,41 @@ func (o writerOption) apply(cfg config) config {
 	return cfg
 }
 
+// WithFile sets the export stream destination to the file at path. The file
+// is appended to and it, along with its parent directories, is created if it
+// does not exist. The file is synced to stable storage and closed when the
+// exporter is shutdown.
+//
+// This option overrides any writer set with WithWriter.
+func WithFile(path string) Option {
+	return fileOption(path)
+}
+
+type fileOption string
+
+func (o fileOption) apply(cfg config) config {
+	cfg.FilePath = string(o)
+	return cfg
+}
+
+// RotationConfig defines the rotation of the file set with WithFile. A
+// rotated file is renamed with a timestamp suffix and a new file is created
+// in its place.
+type RotationConfig filewriter.Config
+
+// WithRotation sets the rotation of the file set with WithFile. If this
+// option is not used, the file is never rotated.
+func WithRotation(rc RotationConfig) Option {
+	return rotationOption(rc)
+}
+
+type rotationOption RotationConfig
+
+func (o rotationOption) apply(cfg config) config {
+	cfg.Rotation = filewriter.Config(o)
+	return cfg
+}
+
 // WithPrettyPrint sets the export stream format to use JSON.
 func WithPrettyPrint() Option {
 	return prettyPrintOption(true)
@@ -94,3 +167,37 @@ func (o timestampsOption) apply(cfg config) config {
 	cfg.Timestamps = bool(o)
 	return cfg
 }
+
+// WithConsoleFormat sets the export stream format to aligned, human-readable
+// text meant for local development. Each span is written on a single line
+// with its start time, name, kind, duration, status, IDs, attributes, and
+// events.
+//
+// The output is not colorized unless the WithColor option is used.
+func WithConsoleFormat() Option {
+	return formatOption(formatConsole)
+}
+
+type formatOption format
+
+func (o formatOption) apply(cfg config) config {
+	cfg.Format = format(o)
+	return cfg
+}
+
+// WithColor sets the console format to be colorized using ANSI escape
+// sequences. The output is still not colorized if the NO_COLOR environment
+// variable is set. Colors are meant for a terminal, this option should not be
+// used when writing to a file or other non-terminal writer.
+//
+// This option has no effect unless the WithConsoleFormat option is used.
+func WithColor() Option {
+	return colorOption(true)
+}
+
+type colorOption bool
+
+func (o colorOption) apply(cfg config) config {
+	cfg.Color = bool(o)
+	return cfg
+}
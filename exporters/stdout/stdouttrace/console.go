@@ -0,0 +1,169 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stdouttrace // import "go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+// ANSI escape sequences used to colorize the console format.
+const (
+	colorReset = "\x1b[0m"
+	colorBold  = "\x1b[1m"
+	colorDim   = "\x1b[2m"
+	colorRed   = "\x1b[31m"
+	colorGreen = "\x1b[32m"
+	colorCyan  = "\x1b[36m"
+)
+
+// consoleTimeFormat is the layout used to render timestamps in the console
+// format.
+const consoleTimeFormat = "2006-01-02T15:04:05.000Z07:00"
+
+// consoleWriter writes spans as aligned, human-readable text.
+type consoleWriter struct {
+	w     io.Writer
+	color bool
+}
+
+// newConsoleWriter returns a consoleWriter writing to w. Output is colorized
+// if color is true and the NO_COLOR environment variable is not set.
+func newConsoleWriter(w io.Writer, color bool) *consoleWriter {
+	_, noColor := os.LookupEnv("NO_COLOR")
+	return &consoleWriter{w: w, color: color && !noColor}
+}
+
+// consoleCell is a single column value of a console format line.
+type consoleCell struct {
+	text  string
+	color string
+}
+
+// write writes spans with one line per span. The columns of all lines are
+// aligned. If timestamps is false, the start time and duration of spans are
+// not written.
+func (c *consoleWriter) write(spans []trace.ReadOnlySpan, timestamps bool) error {
+	rows := make([][]consoleCell, 0, len(spans))
+	for _, s := range spans {
+		var row []consoleCell
+		if timestamps {
+			row = append(row, consoleCell{text: s.StartTime().Format(consoleTimeFormat), color: colorDim})
+		}
+		row = append(row,
+			consoleCell{text: s.Name(), color: colorBold},
+			consoleCell{text: s.SpanKind().String()},
+		)
+		if timestamps {
+			row = append(row, consoleCell{text: s.EndTime().Sub(s.StartTime()).String()})
+		}
+		row = append(row, statusCell(s.Status()))
+
+		ids := "trace_id=" + s.SpanContext().TraceID().String() + " span_id=" + s.SpanContext().SpanID().String()
+		if s.Parent().IsValid() {
+			ids += " parent_id=" + s.Parent().SpanID().String()
+		}
+		row = append(row,
+			consoleCell{text: ids, color: colorDim},
+			consoleCell{text: formatAttrs(s.Attributes()), color: colorCyan},
+		)
+
+		if events := s.Events(); len(events) > 0 {
+			names := make([]string, len(events))
+			for i, ev := range events {
+				names[i] = ev.Name
+			}
+			row = append(row, consoleCell{text: "events=[" + strings.Join(names, " ") + "]"})
+		}
+		rows = append(rows, row)
+	}
+
+	w := bufio.NewWriter(c.w)
+	c.table(w, rows)
+	return w.Flush()
+}
+
+func statusCell(s trace.Status) consoleCell {
+	switch s.Code {
+	case codes.Error:
+		text := s.Code.String()
+		if s.Description != "" {
+			text += ": " + s.Description
+		}
+		return consoleCell{text: text, color: colorRed}
+	case codes.Ok:
+		return consoleCell{text: s.Code.String(), color: colorGreen}
+	default:
+		return consoleCell{text: s.Code.String(), color: colorDim}
+	}
+}
+
+// table writes rows to w with the cells of each column aligned. Colors are
+// applied after alignment so they do not affect the column widths.
+func (c *consoleWriter) table(w io.Writer, rows [][]consoleCell) {
+	var widths []int
+	for _, row := range rows {
+		for i, cell := range row {
+			if i == len(widths) {
+				widths = append(widths, 0)
+			}
+			if n := utf8.RuneCountInString(cell.text); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+
+	var line strings.Builder
+	for _, row := range rows {
+		line.Reset()
+		for i, cell := range row {
+			line.WriteString(c.paint(cell.color, cell.text))
+			if i < len(row)-1 {
+				pad := widths[i] - utf8.RuneCountInString(cell.text) + 2
+				line.WriteString(strings.Repeat(" ", pad))
+			}
+		}
+		// Trailing empty cells are not padded.
+		_, _ = io.WriteString(w, strings.TrimRight(line.String(), " ")+"\n")
+	}
+}
+
+// formatAttrs returns attrs as space separated key=value pairs.
+func formatAttrs(attrs []attribute.KeyValue) string {
+	var b strings.Builder
+	for _, kv := range attrs {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(string(kv.Key))
+		b.WriteByte('=')
+		b.WriteString(kv.Value.Emit())
+	}
+	return b.String()
+}
+
+func (c *consoleWriter) paint(color, s string) string {
+	if !c.color || color == "" || s == "" {
+		return s
+	}
+	return color + s + colorReset
+}
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpjson // import "go.opentelemetry.io/otel/exporters/stdout/stdouttrace/otlpjson"
+
+import (
+	"io"
+	"os"
+)
+
+// config contains options for the exporter.
+type config struct {
+	writer      io.Writer
+	prettyPrint bool
+	timestamps  bool
+}
+
+// newConfig creates a config configured with options.
+func newConfig(options ...Option) config {
+	cfg := config{
+		writer:     os.Stdout,
+		timestamps: true,
+	}
+	for _, opt := range options {
+		cfg = opt.apply(cfg)
+	}
+	return cfg
+}
+
+// Option sets exporter option values.
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (o optionFunc) apply(c config) config {
+	return o(c)
+}
+
+// WithWriter sets the export stream destination. If this option is not used,
+// os.Stdout is used. If w has a Flush method returning an error (e.g.
+// *bufio.Writer), it is flushed when the exporter is shutdown.
+func WithWriter(w io.Writer) Option {
+	return optionFunc(func(c config) config {
+		c.writer = w
+		return c
+	})
+}
+
+// WithPrettyPrint sets the export stream to be indented. Each request is
+// otherwise written on a single line.
+func WithPrettyPrint() Option {
+	return optionFunc(func(c config) config {
+		c.prettyPrint = true
+		return c
+	})
+}
+
+// WithoutTimestamps sets the export stream to not include span and event
+// timestamps.
+func WithoutTimestamps() Option {
+	return optionFunc(func(c config) config {
+		c.timestamps = false
+		return c
+	})
+}
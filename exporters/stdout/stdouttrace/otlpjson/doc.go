@@ -0,0 +1,21 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otlpjson provides an exporter that writes spans using the OTLP JSON
+// encoding of an ExportTraceServiceRequest. The output can be replayed to an
+// OpenTelemetry Collector file receiver or compared with other OTLP captures.
+//
+// This package is kept separate from the stdouttrace package so the latter
+// does not depend on the OTLP exporter and its transitive dependencies.
+package otlpjson // import "go.opentelemetry.io/otel/exporters/stdout/stdouttrace/otlpjson"
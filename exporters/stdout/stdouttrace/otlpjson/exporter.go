@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpjson // import "go.opentelemetry.io/otel/exporters/stdout/stdouttrace/otlpjson"
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/stdout/internal/filewriter"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+// New returns a started exporter that writes spans using the OTLP JSON
+// encoding.
+func New(ctx context.Context, options ...Option) (*otlptrace.Exporter, error) {
+	return otlptrace.New(ctx, newClient(options...))
+}
+
+// NewUnstarted returns an exporter that writes spans using the OTLP JSON
+// encoding. The exporter needs to be started before use.
+func NewUnstarted(options ...Option) *otlptrace.Exporter {
+	return otlptrace.NewUnstarted(newClient(options...))
+}
+
+// client is an otlptrace.Client that writes the OTLP JSON encoding of
+// uploaded spans.
+type client struct {
+	writer     io.Writer
+	timestamps bool
+
+	encoderMu sync.Mutex
+	encoder   *json.Encoder
+}
+
+var _ otlptrace.Client = (*client)(nil)
+
+func newClient(options ...Option) *client {
+	cfg := newConfig(options...)
+
+	enc := json.NewEncoder(cfg.writer)
+	enc.SetEscapeHTML(false)
+	if cfg.prettyPrint {
+		enc.SetIndent("", "\t")
+	}
+	return &client{
+		writer:     cfg.writer,
+		timestamps: cfg.timestamps,
+		encoder:    enc,
+	}
+}
+
+func (c *client) Start(context.Context) error { return nil }
+
+// Stop flushes the writer of the client.
+func (c *client) Stop(ctx context.Context) error {
+	c.encoderMu.Lock()
+	err := filewriter.Close(c.writer)
+	c.encoderMu.Unlock()
+	if err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+// UploadTraces writes protoSpans as the OTLP JSON encoding of an
+// ExportTraceServiceRequest.
+func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if !c.timestamps {
+		removeTimestamps(protoSpans)
+	}
+
+	v, err := marshal(&coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: protoSpans,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.encoderMu.Lock()
+	defer c.encoderMu.Unlock()
+	return c.encoder.Encode(v)
+}
+
+// removeTimestamps clears all span and event timestamps in rss.
+func removeTimestamps(rss []*tracepb.ResourceSpans) {
+	for _, rs := range rss {
+		for _, ss := range rs.ScopeSpans {
+			for _, s := range ss.Spans {
+				s.StartTimeUnixNano = 0
+				s.EndTimeUnixNano = 0
+				for _, e := range s.Events {
+					e.TimeUnixNano = 0
+				}
+			}
+		}
+	}
+}
+
+// marshal returns the OTLP JSON encoding of req as a generic JSON value.
+// Contrary to the default protojson encoding, OTLP JSON encodes enums as
+// integers and trace and span IDs as hex strings.
+func marshal(req *coltracepb.ExportTraceServiceRequest) (interface{}, error) {
+	b, err := protojson.MarshalOptions{UseEnumNumbers: true}.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	// Keep the exact integer values protojson encodes as numbers.
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	hexIDs(v)
+	return v, nil
+}
+
+// idFields are the JSON names of the bytes fields of OTLP messages holding
+// trace and span IDs.
+var idFields = map[string]bool{
+	"traceId":      true,
+	"spanId":       true,
+	"parentSpanId": true,
+}
+
+// hexIDs re-encodes, in place, the base64 encoded ID fields of the decoded
+// protojson value v as hex strings. Only object keys are matched, which are
+// always message field names, so attribute keys and values are unaffected.
+func hexIDs(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, val := range v {
+			if s, ok := val.(string); ok && idFields[k] {
+				if id, err := base64.StdEncoding.DecodeString(s); err == nil {
+					v[k] = hex.EncodeToString(id)
+				}
+				continue
+			}
+			hexIDs(val)
+		}
+	case []interface{}:
+		for _, val := range v {
+			hexIDs(val)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpjson_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace/otlpjson"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestExportSpans(t *testing.T) {
+	now := time.Now()
+	traceID, _ := trace.TraceIDFromHex("0102030405060708090a0b0c0d0e0f10")
+	spanID, _ := trace.SpanIDFromHex("0102030405060708")
+	parentID, _ := trace.SpanIDFromHex("0807060504030201")
+	ss := tracetest.SpanStub{
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: traceID,
+			SpanID:  spanID,
+		}),
+		Parent: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: traceID,
+			SpanID:  parentID,
+		}),
+		Name:      "/foo",
+		StartTime: now,
+		EndTime:   now,
+		Attributes: []attribute.KeyValue{
+			// Attribute keys matching ID field names are not re-encoded.
+			attribute.String("spanId", "AQIDBAUGBwg="),
+		},
+		Events:   []tracesdk.Event{{Name: "foo", Time: now}},
+		SpanKind: trace.SpanKindServer,
+		Resource: resource.NewSchemaless(attribute.String("rk1", "rv11")),
+	}
+
+	tests := []struct {
+		name      string
+		opts      []otlpjson.Option
+		expectNow uint64
+	}{
+		{
+			name:      "WithTimestamps",
+			expectNow: uint64(now.UnixNano()),
+		},
+		{
+			name: "WithoutTimestamps",
+			opts: []otlpjson.Option{otlpjson.WithoutTimestamps()},
+		},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			ex, err := otlpjson.New(ctx, append(tt.opts, otlpjson.WithWriter(&b))...)
+			require.NoError(t, err)
+			require.NoError(t, ex.ExportSpans(ctx, tracetest.SpanStubs{ss, ss}.Snapshots()))
+
+			// All spans are written as a single request on one line.
+			assert.Equal(t, 1, strings.Count(b.String(), "\n"))
+			assert.Contains(t, b.String(), `"traceId":"0102030405060708090a0b0c0d0e0f10"`)
+			assert.Contains(t, b.String(), `"spanId":"0102030405060708"`)
+			assert.Contains(t, b.String(), `"parentSpanId":"0807060504030201"`)
+			assert.Contains(t, b.String(), `"stringValue":"AQIDBAUGBwg="`)
+			assert.Contains(t, b.String(), `"kind":2`)
+
+			var got map[string][]struct {
+				ScopeSpans []struct {
+					Spans []struct {
+						Name              string `json:"name"`
+						StartTimeUnixNano uint64 `json:"startTimeUnixNano,string"`
+						Events            []struct {
+							TimeUnixNano uint64 `json:"timeUnixNano,string"`
+						} `json:"events"`
+					} `json:"spans"`
+				} `json:"scopeSpans"`
+			}
+			require.NoError(t, json.Unmarshal(b.Bytes(), &got))
+			require.Len(t, got["resourceSpans"], 1)
+			require.Len(t, got["resourceSpans"][0].ScopeSpans, 1)
+			spans := got["resourceSpans"][0].ScopeSpans[0].Spans
+			require.Len(t, spans, 2)
+			assert.Equal(t, "/foo", spans[0].Name)
+			assert.Equal(t, tt.expectNow, spans[0].StartTimeUnixNano)
+			require.Len(t, spans[0].Events, 1)
+			assert.Equal(t, tt.expectNow, spans[0].Events[0].TimeUnixNano)
+
+			require.NoError(t, ex.Shutdown(ctx))
+		})
+	}
+}
+
+func TestShutdownFlushesWriter(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := context.Background()
+	ex, err := otlpjson.New(ctx, otlpjson.WithWriter(bufio.NewWriter(&buf)))
+	require.NoError(t, err)
+
+	require.NoError(t, ex.ExportSpans(ctx, tracetest.SpanStubs{{Name: "span"}}.Snapshots()))
+	assert.Zero(t, buf.Len())
+	require.NoError(t, ex.Shutdown(ctx))
+	assert.Contains(t, buf.String(), `"name":"span"`)
+}
@@ -0,0 +1,35 @@
+module go.opentelemetry.io/otel/exporters/stdout/stdouttrace/otlpjson
+
+go 1.18
+
+replace (
+	go.opentelemetry.io/otel => ../../../..
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace => ../../../otlp/otlptrace
+	go.opentelemetry.io/otel/sdk => ../../../../sdk
+	go.opentelemetry.io/otel/trace => ../../../../trace
+)
+
+require (
+	github.com/stretchr/testify v1.8.0
+	go.opentelemetry.io/otel v1.11.1
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace v1.11.1
+	go.opentelemetry.io/otel/sdk v1.11.1
+	go.opentelemetry.io/otel/trace v1.11.1
+	go.opentelemetry.io/proto/otlp v0.19.0
+	google.golang.org/protobuf v1.28.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/go-logr/logr v1.2.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/golang/protobuf v1.5.2 // indirect
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.7.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/net v0.0.0-20210405180319-a5a99cb37ef4 // indirect
+	golang.org/x/sys v0.0.0-20220919091848-fb04ddd9f9c8 // indirect
+	golang.org/x/text v0.3.5 // indirect
+	google.golang.org/genproto v0.0.0-20211118181313-81c1377c94b1 // indirect
+	google.golang.org/grpc v1.50.1 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
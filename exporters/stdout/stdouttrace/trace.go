@@ -17,9 +17,11 @@ package stdouttrace // import "go.opentelemetry.io/otel/exporters/stdout/stdoutt
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/exporters/stdout/internal/filewriter"
 	"go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
@@ -40,23 +42,32 @@ func New(options ...Option) (*Exporter, error) {
 		enc.SetIndent("", "\t")
 	}
 
-	return &Exporter{
+	exp := &Exporter{
+		writer:     cfg.Writer,
 		encoder:    enc,
 		timestamps: cfg.Timestamps,
-	}, nil
+	}
+	if cfg.Format == formatConsole {
+		exp.console = newConsoleWriter(cfg.Writer, cfg.Color)
+	}
+	return exp, nil
 }
 
 // Exporter is an implementation of trace.SpanSyncer that writes spans to stdout.
 type Exporter struct {
+	writer     io.Writer
 	encoder    *json.Encoder
 	encoderMu  sync.Mutex
 	timestamps bool
 
+	// console writes spans when the console format is used.
+	console *consoleWriter
+
 	stoppedMu sync.RWMutex
 	stopped   bool
 }
 
-// ExportSpans writes spans in json format to stdout.
+// ExportSpans writes spans in json format to the configured writer.
 func (e *Exporter) ExportSpans(ctx context.Context, spans []trace.ReadOnlySpan) error {
 	e.stoppedMu.RLock()
 	stopped := e.stopped
@@ -69,6 +80,12 @@ func (e *Exporter) ExportSpans(ctx context.Context, spans []trace.ReadOnlySpan)
 		return nil
 	}
 
+	if e.console != nil {
+		e.encoderMu.Lock()
+		defer e.encoderMu.Unlock()
+		return e.console.write(spans, e.timestamps)
+	}
+
 	stubs := tracetest.SpanStubsFromReadOnlySpans(spans)
 
 	e.encoderMu.Lock()
@@ -93,12 +110,23 @@ func (e *Exporter) ExportSpans(ctx context.Context, spans []trace.ReadOnlySpan)
 	return nil
 }
 
-// Shutdown is called to stop the exporter, it preforms no action.
+// Shutdown is called to stop the exporter. It flushes the writer of the
+// exporter and, if it was opened with WithFile, closes it.
 func (e *Exporter) Shutdown(ctx context.Context) error {
 	e.stoppedMu.Lock()
+	stopped := e.stopped
 	e.stopped = true
 	e.stoppedMu.Unlock()
 
+	if !stopped {
+		e.encoderMu.Lock()
+		err := filewriter.Close(e.writer)
+		e.encoderMu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
@@ -15,9 +15,12 @@
 package stdouttrace_test
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -186,7 +189,8 @@ func expectedJSON(now time.Time) string {
 	"InstrumentationLibrary": {
 		"Name": "",
 		"Version": "",
-		"SchemaURL": ""
+		"SchemaURL": "",
+		"Attributes": null
 	}
 }
 `
@@ -233,3 +237,126 @@ func TestExporterShutdownNoError(t *testing.T) {
 		t.Errorf("shutdown errored: expected nil, got %v", err)
 	}
 }
+
+func TestExporterExportSpanConsoleFormat(t *testing.T) {
+	now := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	traceID, _ := trace.TraceIDFromHex("0102030405060708090a0b0c0d0e0f10")
+	spanID, _ := trace.SpanIDFromHex("0102030405060708")
+	parentID, _ := trace.SpanIDFromHex("0807060504030201")
+	sc := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID})
+
+	spans := tracetest.SpanStubs{
+		{
+			SpanContext: sc,
+			Parent:      sc.WithSpanID(parentID),
+			Name:        "/foo",
+			StartTime:   now,
+			EndTime:     now.Add(1500 * time.Millisecond),
+			Attributes:  []attribute.KeyValue{attribute.String("key", "value")},
+			Events:      []tracesdk.Event{{Name: "foo", Time: now}},
+			SpanKind:    trace.SpanKindInternal,
+			Status:      tracesdk.Status{Code: codes.Error, Description: "interesting"},
+		},
+		{
+			SpanContext: sc,
+			Name:        "/bar/baz",
+			StartTime:   now,
+			EndTime:     now.Add(20 * time.Millisecond),
+			SpanKind:    trace.SpanKindServer,
+			Status:      tracesdk.Status{Code: codes.Ok},
+		},
+	}.Snapshots()
+
+	tests := []struct {
+		name string
+		opts []stdouttrace.Option
+		want string
+	}{
+		{
+			name: "WithTimestamps",
+			opts: []stdouttrace.Option{stdouttrace.WithConsoleFormat()},
+			want: "2000-01-01T00:00:00.000Z  /foo      internal  1.5s  Error: interesting  trace_id=0102030405060708090a0b0c0d0e0f10 span_id=0102030405060708 parent_id=0807060504030201  key=value  events=[foo]\n" +
+				"2000-01-01T00:00:00.000Z  /bar/baz  server    20ms  Ok                  trace_id=0102030405060708090a0b0c0d0e0f10 span_id=0102030405060708\n",
+		},
+		{
+			name: "WithoutTimestamps",
+			opts: []stdouttrace.Option{stdouttrace.WithConsoleFormat(), stdouttrace.WithoutTimestamps()},
+			want: "/foo      internal  Error: interesting  trace_id=0102030405060708090a0b0c0d0e0f10 span_id=0102030405060708 parent_id=0807060504030201  key=value  events=[foo]\n" +
+				"/bar/baz  server    Ok                  trace_id=0102030405060708090a0b0c0d0e0f10 span_id=0102030405060708\n",
+		},
+	}
+
+	ctx := context.Background()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			ex, err := stdouttrace.New(append(tt.opts, stdouttrace.WithWriter(&b))...)
+			require.NoError(t, err)
+			require.NoError(t, ex.ExportSpans(ctx, spans))
+			assert.Equal(t, tt.want, b.String())
+		})
+	}
+}
+
+func TestExporterExportSpanConsoleFormatColor(t *testing.T) {
+	spans := tracetest.SpanStubs{{Name: "/foo"}}.Snapshots()
+	export := func(t *testing.T) string {
+		var b bytes.Buffer
+		ex, err := stdouttrace.New(
+			stdouttrace.WithConsoleFormat(),
+			stdouttrace.WithColor(),
+			stdouttrace.WithWriter(&b),
+		)
+		require.NoError(t, err)
+		require.NoError(t, ex.ExportSpans(context.Background(), spans))
+		return b.String()
+	}
+
+	t.Run("WithColor", func(t *testing.T) {
+		assert.Contains(t, export(t), "\x1b[1m/foo\x1b[0m")
+	})
+
+	t.Run("NO_COLOR", func(t *testing.T) {
+		t.Setenv("NO_COLOR", "")
+		assert.NotContains(t, export(t), "\x1b[")
+	})
+}
+
+func TestExporterWithFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.json")
+	ex, err := stdouttrace.New(
+		stdouttrace.WithFile(path),
+		stdouttrace.WithRotation(stdouttrace.RotationConfig{MaxSize: 1, MaxBackups: 1}),
+	)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	spans := tracetest.SpanStubs{{Name: "/foo"}}.Snapshots()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, ex.ExportSpans(ctx, spans))
+	}
+	require.NoError(t, ex.Shutdown(ctx))
+	require.NoError(t, ex.Shutdown(ctx), "Shutdown is idempotent")
+
+	got, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(got), `"Name":"/foo"`)
+
+	backups, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	assert.Len(t, backups, 1)
+}
+
+func TestExporterShutdownFlushesWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	ex, err := stdouttrace.New(stdouttrace.WithWriter(w))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, ex.ExportSpans(ctx, tracetest.SpanStubs{{Name: "/foo"}}.Snapshots()))
+	assert.Zero(t, buf.Len())
+
+	require.NoError(t, ex.Shutdown(ctx))
+	assert.Contains(t, buf.String(), `"Name":"/foo"`)
+}
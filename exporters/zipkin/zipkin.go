@@ -16,6 +16,7 @@ package zipkin // import "go.opentelemetry.io/otel/exporters/zipkin"
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -23,7 +24,12 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"strconv"
 	"sync"
+	"time"
+
+	zkmodel "github.com/openzipkin/zipkin-go/model"
+	"github.com/openzipkin/zipkin-go/proto/zipkin_proto3"
 
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
@@ -34,9 +40,12 @@ const (
 
 // Exporter exports spans to the zipkin collector.
 type Exporter struct {
-	url    string
-	client *http.Client
-	logger *log.Logger
+	url         string
+	client      *http.Client
+	logger      *log.Logger
+	encoding    Encoding
+	compression Compression
+	retry       RetryConfig
 
 	stoppedMu sync.RWMutex
 	stopped   bool
@@ -48,8 +57,64 @@ var (
 
 // Options contains configuration for the exporter.
 type config struct {
-	client *http.Client
-	logger *log.Logger
+	client      *http.Client
+	logger      *log.Logger
+	encoding    Encoding
+	compression Compression
+	retry       RetryConfig
+}
+
+// Encoding is the encoding of the spans sent to the Zipkin collector.
+type Encoding int
+
+const (
+	// JSONEncoding encodes spans using the Zipkin JSON v2 format.
+	JSONEncoding Encoding = iota
+	// Proto3Encoding encodes spans using the Zipkin proto3 format. It
+	// produces smaller payloads than JSONEncoding.
+	Proto3Encoding
+)
+
+// Compression is the compression of the requests sent to the Zipkin
+// collector.
+type Compression int
+
+const (
+	// NoCompression sends requests uncompressed.
+	NoCompression Compression = iota
+	// GzipCompression sends requests compressed with gzip.
+	GzipCompression
+)
+
+// RetryConfig defines configuration for retrying the export of spans that
+// failed to be received by the Zipkin collector.
+//
+// An export is retried if the collector responds with a 429 (Too Many
+// Requests) or 5xx status code. The wait between attempts starts at
+// InitialInterval and is doubled after each attempt up to MaxInterval. If a
+// Retry-After header with a delay in seconds is received, that delay is used
+// instead. No further attempts are made once MaxElapsedTime has elapsed since
+// the first attempt.
+type RetryConfig struct {
+	// Enabled indicates whether to retry failed exports.
+	Enabled bool
+	// InitialInterval is the time to wait after the first failure before
+	// retrying.
+	InitialInterval time.Duration
+	// MaxInterval is the upper bound of the wait between attempts.
+	MaxInterval time.Duration
+	// MaxElapsedTime is the maximum amount of time (including retries)
+	// spent trying to export spans.
+	MaxElapsedTime time.Duration
+}
+
+// DefaultRetryConfig is the RetryConfig used by WithRetry if its fields
+// are not set.
+var DefaultRetryConfig = RetryConfig{
+	Enabled:         true,
+	InitialInterval: 5 * time.Second,
+	MaxInterval:     30 * time.Second,
+	MaxElapsedTime:  time.Minute,
 }
 
 // Option defines a function that configures the exporter.
@@ -79,6 +144,43 @@ func WithClient(client *http.Client) Option {
 	})
 }
 
+// WithEncoding configures the encoding of the spans sent to the Zipkin
+// collector. By default JSONEncoding is used.
+func WithEncoding(encoding Encoding) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.encoding = encoding
+		return cfg
+	})
+}
+
+// WithCompression configures the compression of the requests sent to the
+// Zipkin collector. By default NoCompression is used.
+func WithCompression(compression Compression) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.compression = compression
+		return cfg
+	})
+}
+
+// WithRetry configures the retry policy for spans that failed to be
+// received by the Zipkin collector. By default failed exports are not
+// retried. Unset intervals of rc are set to the ones of DefaultRetryConfig.
+func WithRetry(rc RetryConfig) Option {
+	return optionFunc(func(cfg config) config {
+		if rc.InitialInterval <= 0 {
+			rc.InitialInterval = DefaultRetryConfig.InitialInterval
+		}
+		if rc.MaxInterval <= 0 {
+			rc.MaxInterval = DefaultRetryConfig.MaxInterval
+		}
+		if rc.MaxElapsedTime <= 0 {
+			rc.MaxElapsedTime = DefaultRetryConfig.MaxElapsedTime
+		}
+		cfg.retry = rc
+		return cfg
+	})
+}
+
 // New creates a new Zipkin exporter.
 func New(collectorURL string, opts ...Option) (*Exporter, error) {
 	if collectorURL == "" {
@@ -102,9 +204,12 @@ func New(collectorURL string, opts ...Option) (*Exporter, error) {
 		cfg.client = http.DefaultClient
 	}
 	return &Exporter{
-		url:    collectorURL,
-		client: cfg.client,
-		logger: cfg.logger,
+		url:         collectorURL,
+		client:      cfg.client,
+		logger:      cfg.logger,
+		encoding:    cfg.encoding,
+		compression: cfg.compression,
+		retry:       cfg.retry,
 	}, nil
 }
 
@@ -123,19 +228,103 @@ func (e *Exporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpa
 		return nil
 	}
 	models := SpanModels(spans)
+	body, contentType, err := e.encode(models)
+	if err != nil {
+		return err
+	}
+	contentEncoding := ""
+	if e.compression == GzipCompression {
+		if body, err = gzipBody(body); err != nil {
+			return e.errf("failed to compress request body: %v", err)
+		}
+		contentEncoding = "gzip"
+	}
+
+	start := time.Now()
+	interval := e.retry.InitialInterval
+	for {
+		retryAfter, err := e.send(ctx, body, contentType, contentEncoding)
+		if err == nil || retryAfter < 0 || !e.retry.Enabled {
+			return err
+		}
+
+		wait := interval
+		if retryAfter > 0 {
+			wait = retryAfter
+		}
+		if time.Since(start)+wait > e.retry.MaxElapsedTime {
+			return e.errf("max retry time elapsed: %v", err)
+		}
+		e.logf("retrying export in %s: %v", wait, err)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		if interval *= 2; interval > e.retry.MaxInterval {
+			interval = e.retry.MaxInterval
+		}
+	}
+}
+
+// encode returns models encoded with the Encoding of e and the content type
+// of the encoding.
+func (e *Exporter) encode(models []zkmodel.SpanModel) ([]byte, string, error) {
+	if e.encoding == Proto3Encoding {
+		ptrs := make([]*zkmodel.SpanModel, len(models))
+		for i := range models {
+			ptrs[i] = &models[i]
+		}
+		var serializer zipkin_proto3.SpanSerializer
+		body, err := serializer.Serialize(ptrs)
+		if err != nil {
+			return nil, "", e.errf("failed to serialize zipkin models to protobuf: %v", err)
+		}
+		e.logf("about to send a POST request to %s with a %d byte protobuf body", e.url, len(body))
+		return body, serializer.ContentType(), nil
+	}
+
 	body, err := json.Marshal(models)
 	if err != nil {
-		return e.errf("failed to serialize zipkin models to JSON: %v", err)
+		return nil, "", e.errf("failed to serialize zipkin models to JSON: %v", err)
 	}
 	e.logf("about to send a POST request to %s with body %s", e.url, body)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewBuffer(body))
+	return body, "application/json", nil
+}
+
+// gzipBody returns body compressed with gzip.
+func gzipBody(body []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(body); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// send makes a single attempt to send body to the Zipkin collector. If the
+// attempt failed and can be retried, the returned duration is the delay
+// requested by the collector, or zero if none was requested. It is negative
+// if the attempt cannot be retried.
+func (e *Exporter) send(ctx context.Context, body []byte, contentType, contentEncoding string) (time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
 	if err != nil {
-		return e.errf("failed to create request to %s: %v", e.url, err)
+		return -1, e.errf("failed to create request to %s: %v", e.url, err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	if contentEncoding != "" {
+		req.Header.Set("Content-Encoding", contentEncoding)
 	}
-	req.Header.Set("Content-Type", "application/json")
 	resp, err := e.client.Do(req)
 	if err != nil {
-		return e.errf("request to %s failed: %v", e.url, err)
+		return -1, e.errf("request to %s failed: %v", e.url, err)
 	}
 	defer resp.Body.Close()
 
@@ -145,14 +334,24 @@ func (e *Exporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpa
 	// > if the Body is not read to completion and closed.
 	_, err = io.Copy(io.Discard, resp.Body)
 	if err != nil {
-		return e.errf("failed to read response body: %v", err)
+		return -1, e.errf("failed to read response body: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusAccepted {
-		return e.errf("failed to send spans to zipkin server with status %d", resp.StatusCode)
+	if resp.StatusCode == http.StatusAccepted {
+		return 0, nil
 	}
 
-	return nil
+	err = e.errf("failed to send spans to zipkin server with status %d", resp.StatusCode)
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < http.StatusInternalServerError {
+		return -1, err
+	}
+	var retryAfter time.Duration
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if secs, perr := strconv.Atoi(v); perr == nil && secs > 0 {
+			retryAfter = time.Duration(secs) * time.Second
+		}
+	}
+	return retryAfter, err
 }
 
 // Shutdown stops the exporter flushing any pending exports.
@@ -15,6 +15,7 @@
 package zipkin
 
 import (
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -22,6 +23,7 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
 	"time"
@@ -29,6 +31,7 @@ import (
 	ottest "go.opentelemetry.io/otel/internal/internaltest"
 
 	zkmodel "github.com/openzipkin/zipkin-go/model"
+	"github.com/openzipkin/zipkin-go/proto/zipkin_proto3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -135,11 +138,25 @@ func startMockZipkinCollector(t *testing.T) *mockZipkinCollector {
 }
 
 func (c *mockZipkinCollector) handler(w http.ResponseWriter, r *http.Request) {
-	jsonBytes, err := io.ReadAll(r.Body)
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(r.Body)
+		require.NoError(c.t, err)
+		body = gz
+	}
+	b, err := io.ReadAll(body)
 	require.NoError(c.t, err)
 	var models []zkmodel.SpanModel
-	err = json.Unmarshal(jsonBytes, &models)
-	require.NoError(c.t, err)
+	if r.Header.Get("Content-Type") == "application/x-protobuf" {
+		spans, err := zipkin_proto3.ParseSpans(b, false)
+		require.NoError(c.t, err)
+		for _, s := range spans {
+			models = append(models, *s)
+		}
+	} else {
+		err = json.Unmarshal(b, &models)
+		require.NoError(c.t, err)
+	}
 	// for some reason we may get the nonUTC timestamps in models,
 	// fix that
 	for midx := range models {
@@ -364,3 +381,122 @@ func TestErrorOnExportShutdownExporter(t *testing.T) {
 	assert.NoError(t, exp.Shutdown(context.Background()))
 	assert.NoError(t, exp.ExportSpans(context.Background(), nil))
 }
+
+func testSpans() []sdktrace.ReadOnlySpan {
+	return tracetest.SpanStubs{{
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10},
+			SpanID:  trace.SpanID{0xFF, 0xFE, 0xFD, 0xFC, 0xFB, 0xFA, 0xF9, 0xF8},
+		}),
+		SpanKind:  trace.SpanKindServer,
+		Name:      "foo",
+		StartTime: time.Date(2020, time.March, 11, 19, 24, 0, 0, time.UTC),
+		EndTime:   time.Date(2020, time.March, 11, 19, 25, 0, 0, time.UTC),
+	}}.Snapshots()
+}
+
+func TestExportSpansProto3Gzip(t *testing.T) {
+	collector := startMockZipkinCollector(t)
+	defer collector.Close()
+
+	exp, err := New(collector.url,
+		WithEncoding(Proto3Encoding),
+		WithCompression(GzipCompression),
+	)
+	require.NoError(t, err)
+	require.NoError(t, exp.ExportSpans(context.Background(), testSpans()))
+
+	models := collector.StealModels()
+	require.Len(t, models, 1)
+	want := SpanModels(testSpans())[0]
+	assert.Equal(t, want.Name, models[0].Name)
+	assert.Equal(t, want.TraceID, models[0].TraceID)
+	assert.Equal(t, want.ID, models[0].ID)
+	assert.Equal(t, want.Kind, models[0].Kind)
+	assert.Equal(t, want.Duration, models[0].Duration)
+}
+
+// statusServer responds with the statuses in order, and then with 202.
+func statusServer(t *testing.T, statuses ...int) (*httptest.Server, *int) {
+	var (
+		mu       sync.Mutex
+		attempts int
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		if len(statuses) == 0 {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		w.WriteHeader(statuses[0])
+		statuses = statuses[1:]
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &attempts
+}
+
+func TestExportSpansRetry(t *testing.T) {
+	rc := RetryConfig{
+		Enabled:         true,
+		InitialInterval: time.Millisecond,
+		MaxInterval:     2 * time.Millisecond,
+		MaxElapsedTime:  time.Second,
+	}
+
+	t.Run("RetryableStatus", func(t *testing.T) {
+		srv, attempts := statusServer(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+		exp, err := New(srv.URL, WithRetry(rc))
+		require.NoError(t, err)
+		assert.NoError(t, exp.ExportSpans(context.Background(), testSpans()))
+		assert.Equal(t, 3, *attempts)
+	})
+
+	t.Run("NonRetryableStatus", func(t *testing.T) {
+		srv, attempts := statusServer(t, http.StatusBadRequest)
+		exp, err := New(srv.URL, WithRetry(rc))
+		require.NoError(t, err)
+		assert.EqualError(t, exp.ExportSpans(context.Background(), testSpans()), "failed to send spans to zipkin server with status 400")
+		assert.Equal(t, 1, *attempts)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		srv, attempts := statusServer(t, http.StatusServiceUnavailable)
+		exp, err := New(srv.URL)
+		require.NoError(t, err)
+		assert.EqualError(t, exp.ExportSpans(context.Background(), testSpans()), "failed to send spans to zipkin server with status 503")
+		assert.Equal(t, 1, *attempts)
+	})
+
+	t.Run("MaxElapsedTime", func(t *testing.T) {
+		srv, attempts := statusServer(t, 500, 500, 500, 500, 500, 500)
+		exp, err := New(srv.URL, WithRetry(RetryConfig{
+			Enabled:         true,
+			InitialInterval: 10 * time.Millisecond,
+			MaxInterval:     10 * time.Millisecond,
+			MaxElapsedTime:  15 * time.Millisecond,
+		}))
+		require.NoError(t, err)
+		assert.ErrorContains(t, exp.ExportSpans(context.Background(), testSpans()), "max retry time elapsed")
+		assert.Equal(t, 2, *attempts)
+	})
+
+	t.Run("ContextCanceled", func(t *testing.T) {
+		srv, _ := statusServer(t, http.StatusServiceUnavailable)
+		exp, err := New(srv.URL, WithRetry(RetryConfig{
+			Enabled:         true,
+			InitialInterval: time.Minute,
+			MaxElapsedTime:  time.Hour,
+		}))
+		require.NoError(t, err)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, exp.ExportSpans(ctx, testSpans()), context.DeadlineExceeded)
+	})
+}
+
+func TestWithRetryDefaults(t *testing.T) {
+	cfg := WithRetry(RetryConfig{Enabled: true}).apply(config{})
+	assert.Equal(t, DefaultRetryConfig, cfg.retry)
+}
@@ -18,6 +18,8 @@ import (
 	"log"
 	"os"
 	"sync"
+
+	"go.opentelemetry.io/otel/internal/handler"
 )
 
 var (
@@ -36,6 +38,8 @@ var (
 type delegator struct {
 	lock *sync.RWMutex
 	eh   ErrorHandler
+	// def is the ErrorHandler delegated to if no other is set.
+	def ErrorHandler
 }
 
 func (d *delegator) Handle(err error) {
@@ -44,17 +48,31 @@ func (d *delegator) Handle(err error) {
 	d.eh.Handle(err)
 }
 
-// setDelegate sets the ErrorHandler delegate.
+// setDelegate sets the ErrorHandler delegate. If eh is d, which happens when
+// the ErrorHandler returned from GetErrorHandler is set to restore it, the
+// default delegate is restored instead of d delegating to itself.
 func (d *delegator) setDelegate(eh ErrorHandler) {
+	if eh == ErrorHandler(d) {
+		eh = d.def
+	}
+
 	d.lock.Lock()
 	defer d.lock.Unlock()
 	d.eh = eh
 }
 
+func init() {
+	// Send errors from packages that cannot import this package to the
+	// global ErrorHandler.
+	handler.SetHandleFunc(Handle)
+}
+
 func defaultErrorHandler() *delegator {
+	def := &errLogger{l: log.New(os.Stderr, "", log.LstdFlags)}
 	return &delegator{
 		lock: &sync.RWMutex{},
-		eh:   &errLogger{l: log.New(os.Stderr, "", log.LstdFlags)},
+		eh:   def,
+		def:  def,
 	}
 }
 
@@ -119,6 +119,16 @@ func (s *HandlerTestSuite) TestAllowMultipleSets() {
 	s.Assert().Same(globalErrorHandler.eh, tertiary, "user Handler not overridden")
 }
 
+func (s *HandlerTestSuite) TestRestoreGlobalHandler() {
+	orig := GetErrorHandler()
+	SetErrorHandler(ErrorHandlerFunc(func(error) {}))
+	SetErrorHandler(orig)
+
+	// Setting the global ErrorHandler to itself restores the default one
+	// instead of having it delegate to itself.
+	s.Assert().Same(globalErrorHandler.def, globalErrorHandler.eh)
+}
+
 func TestHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package golden compares test output against golden files. It is shared by
+// the golden file helpers of the SDK testing packages so they all use the
+// same -otel.update flag.
+//
+// Importing this package registers the -otel.update flag with the flag package.
+// The flag is namespaced so it does not conflict with an -update flag defined
+// by the tests importing it.
+package golden // import "go.opentelemetry.io/otel/internal/golden"
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("otel.update", false, "update golden files instead of comparing with them")
+
+// Assert asserts that got equals the content of the golden file at path. If
+// the test binary is run with the -otel.update flag, the golden file is
+// written with got instead, creating any missing parent directories.
+func Assert(t *testing.T, path string, got []byte) bool {
+	t.Helper()
+
+	if *update {
+		if err := write(path, got); err != nil {
+			t.Errorf("failed to update golden file: %v", err)
+			return false
+		}
+		return true
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Errorf("failed to read golden file (run with -otel.update to create it): %v", err)
+		return false
+	}
+	if bytes.Equal(want, got) {
+		return true
+	}
+	t.Errorf(
+		"output differs from golden file %s (run with -otel.update to update it):\n%s",
+		path, Diff(string(want), string(got)),
+	)
+	return false
+}
+
+func write(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644) // nolint: gosec  // Golden files are not sensitive.
+}
+
+// Diff returns a line based diff of want and got. Lines only in want are
+// prefixed with "-", lines only in got are prefixed with "+", and common
+// lines are prefixed with a space.
+func Diff(want, got string) string {
+	a, b := strings.Split(want, "\n"), strings.Split(got, "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var out strings.Builder
+	out.WriteString("--- golden\n+++ actual\n")
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			fmt.Fprintf(&out, "  %s\n", a[i])
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			fmt.Fprintf(&out, "- %s\n", a[i])
+			i++
+		default:
+			fmt.Fprintf(&out, "+ %s\n", b[j])
+			j++
+		}
+	}
+	return out.String()
+}
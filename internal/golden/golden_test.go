@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golden
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	want := "a\nb\nc"
+	got := "a\nx\nc\nd"
+	assert.Equal(t, "--- golden\n+++ actual\n  a\n- b\n+ x\n  c\n+ d\n", Diff(want, got))
+}
+
+func TestAssert(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata", "out.golden")
+
+	orig := *update
+	t.Cleanup(func() { *update = orig })
+
+	*update = true
+	require.True(t, Assert(t, path, []byte("data\n")))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "data\n", string(data))
+
+	*update = false
+	assert.True(t, Assert(t, path, []byte("data\n")))
+}
+
+func TestUpdateFlag(t *testing.T) {
+	assert.NotNil(t, flag.Lookup("otel.update"))
+	// The generic name is left to the tests importing the package.
+	assert.Nil(t, flag.Lookup("update"))
+}
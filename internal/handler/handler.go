@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package handler provides access to the global ErrorHandler for packages the
+otel package depends on, and therefore cannot import it (i.e. baggage and
+propagation).
+*/
+package handler // import "go.opentelemetry.io/otel/internal/handler"
+
+import (
+	"log"
+	"os"
+	"sync"
+)
+
+var (
+	mu     sync.RWMutex
+	handle func(error)
+
+	// fallback is used if no handle func is set.
+	fallback = log.New(os.Stderr, "", log.LstdFlags)
+)
+
+// SetHandleFunc sets the function errors passed to Handle are sent to. The
+// otel package sets this to its Handle function when it is initialized.
+func SetHandleFunc(f func(error)) {
+	mu.Lock()
+	defer mu.Unlock()
+	handle = f
+}
+
+// Handle sends err to the function set with SetHandleFunc. If none is set,
+// err is logged to STDERR.
+func Handle(err error) {
+	mu.RLock()
+	f := handle
+	mu.RUnlock()
+
+	if f == nil {
+		fallback.Print(err)
+		return
+	}
+	f(err)
+}
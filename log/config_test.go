@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestNewLoggerConfig(t *testing.T) {
+	attrs := attribute.NewSet(attribute.String("key", "value"))
+	cfg := NewLoggerConfig(
+		WithInstrumentationVersion("v1.0.0"),
+		WithSchemaURL("https://opentelemetry.io/schemas/1.12.0"),
+		WithInstrumentationAttributes(attribute.String("key", "value")),
+	)
+
+	assert.Equal(t, "v1.0.0", cfg.InstrumentationVersion())
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.12.0", cfg.SchemaURL())
+	assert.True(t, attrs.Equals(&cfg.attrs))
+}
@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package global // import "go.opentelemetry.io/otel/log/global"
+
+import (
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/internal/global"
+)
+
+// Logger returns a Logger from the global LoggerProvider. The
+// instrumentationName must be the name of the logging bridge, or the library
+// providing instrumentation. If the instrumentationName is empty, then a
+// implementation defined default name will be used instead.
+//
+// This is short for GetLoggerProvider().Logger(name).
+func Logger(instrumentationName string, opts ...log.LoggerOption) log.Logger {
+	return GetLoggerProvider().Logger(instrumentationName, opts...)
+}
+
+// GetLoggerProvider returns the registered global logger provider.
+//
+// If no global LoggerProvider is registered, a delegating LoggerProvider is
+// returned. The Loggers it provides drop all records until a LoggerProvider
+// is registered with SetLoggerProvider, after which they forward records to
+// Loggers of the registered LoggerProvider.
+func GetLoggerProvider() log.LoggerProvider {
+	return global.GetLoggerProvider()
+}
+
+// SetLoggerProvider registers `lp` as the global logger provider.
+func SetLoggerProvider(lp log.LoggerProvider) {
+	global.SetLoggerProvider(lp)
+}
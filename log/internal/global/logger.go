@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package global // import "go.opentelemetry.io/otel/log/internal/global"
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+)
+
+// loggerProvider is a placeholder for a configured SDK LoggerProvider.
+//
+// All LoggerProvider functionality is forwarded to a delegate once
+// configured.
+type loggerProvider struct {
+	mtx     sync.Mutex
+	loggers map[il]*logger
+
+	delegate log.LoggerProvider
+}
+
+// Compile-time guarantee that loggerProvider implements the LoggerProvider
+// interface.
+var _ log.LoggerProvider = &loggerProvider{}
+
+type il struct {
+	name      string
+	version   string
+	schemaURL string
+	attrs     attribute.Distinct
+}
+
+// setDelegate configures p to delegate all LoggerProvider functionality to
+// provider.
+//
+// All Loggers provided prior to this function call are switched out to be
+// Loggers provided by provider.
+//
+// It is guaranteed by the caller that this happens only once.
+func (p *loggerProvider) setDelegate(provider log.LoggerProvider) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.delegate = provider
+
+	if len(p.loggers) == 0 {
+		return
+	}
+
+	for _, l := range p.loggers {
+		l.setDelegate(provider)
+	}
+
+	p.loggers = nil
+}
+
+// Logger implements LoggerProvider.
+func (p *loggerProvider) Logger(name string, opts ...log.LoggerOption) log.Logger {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.delegate != nil {
+		return p.delegate.Logger(name, opts...)
+	}
+
+	// At this moment it is guaranteed that no sdk is installed, save the logger in the loggers map.
+
+	c := log.NewLoggerConfig(opts...)
+	attrs := c.InstrumentationAttributes()
+	key := il{
+		name:      name,
+		version:   c.InstrumentationVersion(),
+		schemaURL: c.SchemaURL(),
+		attrs:     attrs.Equivalent(),
+	}
+
+	if p.loggers == nil {
+		p.loggers = make(map[il]*logger)
+	}
+
+	if val, ok := p.loggers[key]; ok {
+		return val
+	}
+
+	l := &logger{name: name, opts: opts}
+	p.loggers[key] = l
+	return l
+}
+
+// logger is a placeholder for a log.Logger.
+//
+// All Logger functionality is forwarded to a delegate once configured.
+// Otherwise, all records are dropped.
+type logger struct {
+	name string
+	opts []log.LoggerOption
+
+	delegate atomic.Value // log.Logger
+}
+
+// Compile-time guarantee that logger implements the log.Logger interface.
+var _ log.Logger = &logger{}
+
+// setDelegate configures l to delegate all Logger functionality to Loggers
+// created by provider.
+//
+// It is guaranteed by the caller that this happens only once.
+func (l *logger) setDelegate(provider log.LoggerProvider) {
+	l.delegate.Store(provider.Logger(l.name, l.opts...))
+}
+
+// Emit forwards record to the delegate Logger, if one is configured.
+// Otherwise, record is dropped.
+func (l *logger) Emit(ctx context.Context, record log.Record) {
+	if del, ok := l.delegate.Load().(log.Logger); ok {
+		del.Emit(ctx, record)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package global // import "go.opentelemetry.io/otel/log/internal/global"
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+)
+
+type testLoggerProvider struct {
+	loggers map[string]*testLogger
+}
+
+func (p *testLoggerProvider) Logger(name string, opts ...log.LoggerOption) log.Logger {
+	if p.loggers == nil {
+		p.loggers = make(map[string]*testLogger)
+	}
+	l, ok := p.loggers[name]
+	if !ok {
+		l = &testLogger{version: log.NewLoggerConfig(opts...).InstrumentationVersion()}
+		p.loggers[name] = l
+	}
+	return l
+}
+
+type testLogger struct {
+	version string
+	records []log.Record
+}
+
+func (l *testLogger) Emit(_ context.Context, r log.Record) {
+	l.records = append(l.records, r)
+}
+
+func TestLoggerProviderDelegation(t *testing.T) {
+	p := &loggerProvider{}
+
+	// Loggers created before the delegate is set drop records.
+	l := p.Logger("before", log.WithInstrumentationVersion("v1.0.0"))
+	assert.Same(t, l, p.Logger("before", log.WithInstrumentationVersion("v1.0.0")))
+	l.Emit(context.Background(), log.Record{Body: attribute.StringValue("dropped")})
+
+	delegate := &testLoggerProvider{}
+	p.setDelegate(delegate)
+
+	l.Emit(context.Background(), log.Record{Body: attribute.StringValue("forwarded")})
+	require.Contains(t, delegate.loggers, "before")
+	before := delegate.loggers["before"]
+	assert.Equal(t, "v1.0.0", before.version)
+	require.Len(t, before.records, 1)
+	assert.Equal(t, "forwarded", before.records[0].Body.AsString())
+
+	// Loggers created after the delegate is set are created by the delegate.
+	assert.Same(t, delegate.Logger("after"), p.Logger("after"))
+}
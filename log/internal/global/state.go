@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package global // import "go.opentelemetry.io/otel/log/internal/global"
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/log"
+)
+
+var (
+	globalLoggerProvider = defaultLoggerProvider()
+
+	delegateLoggerOnce sync.Once
+)
+
+type loggerProviderHolder struct {
+	lp log.LoggerProvider
+}
+
+// GetLoggerProvider is the internal implementation for
+// global.GetLoggerProvider.
+func GetLoggerProvider() log.LoggerProvider {
+	return globalLoggerProvider.Load().(loggerProviderHolder).lp
+}
+
+// SetLoggerProvider is the internal implementation for
+// global.SetLoggerProvider.
+func SetLoggerProvider(lp log.LoggerProvider) {
+	current := GetLoggerProvider()
+	if _, cOk := current.(*loggerProvider); cOk {
+		if _, lpOk := lp.(*loggerProvider); lpOk && current == lp {
+			// Do not assign the default delegating LoggerProvider to delegate
+			// to itself.
+			global.Error(
+				errors.New("no delegate configured in logger provider"),
+				"Setting logger provider to it's current value. No delegate will be configured",
+			)
+			return
+		}
+	}
+
+	delegateLoggerOnce.Do(func() {
+		if def, ok := current.(*loggerProvider); ok {
+			def.setDelegate(lp)
+		}
+	})
+	globalLoggerProvider.Store(loggerProviderHolder{lp: lp})
+}
+
+func defaultLoggerProvider() *atomic.Value {
+	v := &atomic.Value{}
+	v.Store(loggerProviderHolder{lp: &loggerProvider{}})
+	return v
+}
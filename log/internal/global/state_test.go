@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package global // import "go.opentelemetry.io/otel/log/internal/global"
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+func resetGlobalLoggerProvider() {
+	globalLoggerProvider = defaultLoggerProvider()
+	delegateLoggerOnce = sync.Once{}
+}
+
+type nonComparableLoggerProvider struct {
+	log.LoggerProvider
+
+	nonComparable func() //nolint:structcheck,unused  // This is not called.
+}
+
+func TestSetLoggerProvider(t *testing.T) {
+	t.Cleanup(resetGlobalLoggerProvider)
+
+	t.Run("Set With default is a noop", func(t *testing.T) {
+		resetGlobalLoggerProvider()
+		SetLoggerProvider(GetLoggerProvider())
+
+		lp, ok := GetLoggerProvider().(*loggerProvider)
+		if !ok {
+			t.Fatal("Global LoggerProvider should be the default logger provider")
+		}
+
+		if lp.delegate != nil {
+			t.Fatal("logger provider should not delegate when setting itself")
+		}
+	})
+
+	t.Run("First Set() should replace the delegate", func(t *testing.T) {
+		resetGlobalLoggerProvider()
+
+		SetLoggerProvider(log.NewNoopLoggerProvider())
+
+		_, ok := GetLoggerProvider().(*loggerProvider)
+		if ok {
+			t.Fatal("Global LoggerProvider was not changed")
+		}
+	})
+
+	t.Run("Set() should delegate existing Logger Providers", func(t *testing.T) {
+		resetGlobalLoggerProvider()
+
+		lp := GetLoggerProvider()
+
+		SetLoggerProvider(log.NewNoopLoggerProvider())
+
+		dlp := lp.(*loggerProvider)
+
+		if dlp.delegate == nil {
+			t.Fatal("The delegated logger providers should have a delegate")
+		}
+	})
+
+	t.Run("non-comparable types should not panic", func(t *testing.T) {
+		resetGlobalLoggerProvider()
+
+		lp := nonComparableLoggerProvider{}
+		SetLoggerProvider(lp)
+		assert.NotPanics(t, func() { SetLoggerProvider(lp) })
+	})
+}
@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/log"
+
+import "context"
+
+// LoggerProvider provides access to named Logger instances.
+type LoggerProvider interface {
+	// Logger creates an instance of a `Logger` interface. The
+	// instrumentationName must be the name of the logging bridge, or the
+	// library providing instrumentation. If the instrumentationName is empty,
+	// then a implementation defined default name will be used instead.
+	Logger(instrumentationName string, opts ...LoggerOption) Logger
+}
+
+// Logger emits log records.
+type Logger interface {
+	// Emit emits a log record.
+	//
+	// The ctx is used to determine the trace context of the record if the
+	// SpanContext of record is not valid. Implementations must not retain
+	// the Attributes slice of record after Emit returns.
+	Emit(ctx context.Context, record Record)
+}
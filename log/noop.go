@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/log"
+
+import "context"
+
+// NewNoopLoggerProvider creates a LoggerProvider that does not emit any logs.
+func NewNoopLoggerProvider() LoggerProvider {
+	return noopLoggerProvider{}
+}
+
+type noopLoggerProvider struct{}
+
+func (noopLoggerProvider) Logger(string, ...LoggerOption) Logger {
+	return noopLogger{}
+}
+
+// NewNoopLogger creates a Logger that does not emit any logs.
+func NewNoopLogger() Logger {
+	return noopLogger{}
+}
+
+type noopLogger struct{}
+
+// Emit does nothing.
+func (noopLogger) Emit(context.Context, Record) {}
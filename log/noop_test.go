@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestNewNoopLoggerProvider(t *testing.T) {
+	lp := NewNoopLoggerProvider()
+	assert.Equal(t, lp, noopLoggerProvider{})
+	logger := lp.Logger("")
+	assert.Equal(t, logger, noopLogger{})
+}
+
+func TestNoopLoggerEmit(t *testing.T) {
+	logger := NewNoopLoggerProvider().Logger("test instrumentation")
+	assert.NotPanics(t, func() {
+		logger.Emit(context.Background(), Record{
+			Timestamp:  time.Now(),
+			Severity:   SeverityInfo,
+			Body:       attribute.StringValue("message"),
+			Attributes: []attribute.KeyValue{attribute.String("key", "value")},
+		})
+	})
+	assert.NotPanics(t, func() {
+		NewNoopLogger().Emit(context.Background(), Record{})
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/log"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Record is a log record emitted by a Logger.
+type Record struct {
+	// Timestamp is the time the event the record describes occurred. It may
+	// be zero if the time is unknown.
+	Timestamp time.Time
+
+	// ObservedTimestamp is the time the event the record describes was
+	// observed by the logging bridge. If zero, Loggers should use the time
+	// the record is emitted.
+	ObservedTimestamp time.Time
+
+	// Severity is the normalized severity of the record.
+	Severity Severity
+
+	// SeverityText is the severity of the record as it is known by the
+	// logging library, also known as log level.
+	SeverityText string
+
+	// Body is the body of the record. This is commonly the human-readable
+	// message of the log.
+	Body attribute.Value
+
+	// Attributes describe the aspects of the record.
+	Attributes []attribute.KeyValue
+
+	// SpanContext is the trace context of the record. If it is not valid,
+	// Loggers use the trace context of the context passed to Emit.
+	SpanContext trace.SpanContext
+}
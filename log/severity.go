@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/log"
+
+import "strconv"
+
+// Severity is the normalized level of severity of a Record. Smaller numerical
+// values correspond to less severe events, larger numerical values to more
+// severe events.
+//
+// The severities are grouped in ranges of four: TRACE, DEBUG, INFO, WARN,
+// ERROR, and FATAL. Logging bridges should map the levels of their logging
+// library to the range that has the same meaning, using the other values of
+// the range to distinguish finer levels.
+type Severity int
+
+// Severity values defined by the OpenTelemetry log data model.
+const (
+	// SeverityUndefined represents an unset Severity.
+	SeverityUndefined Severity = 0
+
+	SeverityTrace1 Severity = 1
+	SeverityTrace2 Severity = 2
+	SeverityTrace3 Severity = 3
+	SeverityTrace4 Severity = 4
+
+	SeverityDebug1 Severity = 5
+	SeverityDebug2 Severity = 6
+	SeverityDebug3 Severity = 7
+	SeverityDebug4 Severity = 8
+
+	SeverityInfo1 Severity = 9
+	SeverityInfo2 Severity = 10
+	SeverityInfo3 Severity = 11
+	SeverityInfo4 Severity = 12
+
+	SeverityWarn1 Severity = 13
+	SeverityWarn2 Severity = 14
+	SeverityWarn3 Severity = 15
+	SeverityWarn4 Severity = 16
+
+	SeverityError1 Severity = 17
+	SeverityError2 Severity = 18
+	SeverityError3 Severity = 19
+	SeverityError4 Severity = 20
+
+	SeverityFatal1 Severity = 21
+	SeverityFatal2 Severity = 22
+	SeverityFatal3 Severity = 23
+	SeverityFatal4 Severity = 24
+
+	// SeverityTrace is the default TRACE severity.
+	SeverityTrace = SeverityTrace1
+	// SeverityDebug is the default DEBUG severity.
+	SeverityDebug = SeverityDebug1
+	// SeverityInfo is the default INFO severity.
+	SeverityInfo = SeverityInfo1
+	// SeverityWarn is the default WARN severity.
+	SeverityWarn = SeverityWarn1
+	// SeverityError is the default ERROR severity.
+	SeverityError = SeverityError1
+	// SeverityFatal is the default FATAL severity.
+	SeverityFatal = SeverityFatal1
+)
+
+var severityRanges = [...]string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR", "FATAL"}
+
+// String returns the short name of s defined by the OpenTelemetry log data
+// model, e.g. "INFO" or "WARN3". An unknown Severity is returned as its
+// numerical value.
+func (s Severity) String() string {
+	switch {
+	case s == SeverityUndefined:
+		return "UNDEFINED"
+	case s < SeverityTrace1 || s > SeverityFatal4:
+		return "Severity(" + strconv.Itoa(int(s)) + ")"
+	}
+
+	name := severityRanges[(s-1)/4]
+	if n := (s-1)%4 + 1; n > 1 {
+		name += strconv.Itoa(int(n))
+	}
+	return name
+}
@@ -20,6 +20,8 @@ import "go.opentelemetry.io/otel/metric/unit"
 type Config struct {
 	description string
 	unit        unit.Unit
+
+	explicitBucketBoundaries []float64
 }
 
 // Description describes the instrument in human-readable terms.
@@ -32,6 +34,12 @@ func (cfg Config) Unit() unit.Unit {
 	return cfg.unit
 }
 
+// ExplicitBucketBoundaries returns the bucket boundaries advised for a
+// histogram instrument. If no boundaries were advised, nil is returned.
+func (cfg Config) ExplicitBucketBoundaries() []float64 {
+	return cfg.explicitBucketBoundaries
+}
+
 // Option is an interface for applying metric instrument options.
 type Option interface {
 	applyInstrument(Config) Config
@@ -67,3 +75,15 @@ func WithUnit(u unit.Unit) Option {
 		return cfg
 	})
 }
+
+// WithExplicitBucketBoundaries advises the SDK to use bounds as the bucket
+// boundaries of a histogram instrument. The SDK uses these boundaries when an
+// explicit bucket histogram aggregation is used for the instrument, unless a
+// view overrides the aggregation. The advice is ignored by instruments that
+// are not histograms.
+func WithExplicitBucketBoundaries(bounds ...float64) Option {
+	return optionFunc(func(cfg Config) Config {
+		cfg.explicitBucketBoundaries = append([]float64{}, bounds...)
+		return cfg
+	})
+}
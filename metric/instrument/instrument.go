@@ -14,6 +14,11 @@
 
 package instrument // import "go.opentelemetry.io/otel/metric/instrument"
 
+import (
+	"context"
+	"time"
+)
+
 // Asynchronous instruments are instruments that are updated within a Callback.
 // If an instrument is observed outside of it's callback it should be an error.
 //
@@ -28,3 +33,23 @@ type Asynchronous interface {
 type Synchronous interface {
 	synchronous()
 }
+
+type observationTimeKey struct{}
+
+// ContextWithObservationTime returns a copy of parent that carries t as the
+// time an observation was made. Asynchronous instruments observed with the
+// returned context report t as the time of the observation instead of the
+// time the observation was collected. This is useful when reporting values
+// read from an external system that records its own sample time.
+//
+// Synchronous instruments ignore the observation time.
+func ContextWithObservationTime(parent context.Context, t time.Time) context.Context {
+	return context.WithValue(parent, observationTimeKey{}, t)
+}
+
+// ObservationTime returns the observation time carried by ctx and true, or
+// the zero time and false if ctx does not carry an observation time.
+func ObservationTime(ctx context.Context) (time.Time, bool) {
+	t, ok := ctx.Value(observationTimeKey{}).(time.Time)
+	return t, ok
+}
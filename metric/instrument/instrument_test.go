@@ -0,0 +1,34 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instrument
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObservationTime(t *testing.T) {
+	ctx := context.Background()
+	_, ok := ObservationTime(ctx)
+	assert.False(t, ok, "background context carries an observation time")
+
+	want := time.Unix(1, 2)
+	got, ok := ObservationTime(ContextWithObservationTime(ctx, want))
+	assert.True(t, ok, "observation time not carried")
+	assert.Equal(t, want, got)
+}
@@ -29,6 +29,8 @@ type InstrumentProvider interface {
 	UpDownCounter(name string, opts ...instrument.Option) (UpDownCounter, error)
 	// Histogram creates an instrument for recording a distribution of values.
 	Histogram(name string, opts ...instrument.Option) (Histogram, error)
+	// Gauge creates an instrument for recording the current value.
+	Gauge(name string, opts ...instrument.Option) (Gauge, error)
 }
 
 // Counter is an instrument that records increasing values.
@@ -36,14 +38,36 @@ type Counter interface {
 	// Add records a change to the counter.
 	Add(ctx context.Context, incr int64, attrs ...attribute.KeyValue)
 
+	// Bind returns a BoundCounter that records changes to the counter with
+	// attrs. The attributes are resolved once, when Bind is called, instead
+	// of for every change recorded.
+	Bind(attrs ...attribute.KeyValue) BoundCounter
+
+	// Enabled reports whether the counter records measurements made with
+	// ctx. It can be used to skip computing the values and attributes of
+	// measurements that would be dropped.
+	Enabled(ctx context.Context) bool
+
 	instrument.Synchronous
 }
 
+// BoundCounter is a Counter bound to a fixed set of attributes. It is
+// intended to be reused for repeated changes made with the same attributes.
+type BoundCounter interface {
+	// Add records a change to the counter with the bound attributes.
+	Add(ctx context.Context, incr int64)
+}
+
 // UpDownCounter is an instrument that records increasing or decreasing values.
 type UpDownCounter interface {
 	// Add records a change to the counter.
 	Add(ctx context.Context, incr int64, attrs ...attribute.KeyValue)
 
+	// Enabled reports whether the counter records measurements made with
+	// ctx. It can be used to skip computing the values and attributes of
+	// measurements that would be dropped.
+	Enabled(ctx context.Context) bool
+
 	instrument.Synchronous
 }
 
@@ -52,5 +76,23 @@ type Histogram interface {
 	// Record adds an additional value to the distribution.
 	Record(ctx context.Context, incr int64, attrs ...attribute.KeyValue)
 
+	// Enabled reports whether the histogram records measurements made with
+	// ctx. It can be used to skip computing the values and attributes of
+	// measurements that would be dropped.
+	Enabled(ctx context.Context) bool
+
+	instrument.Synchronous
+}
+
+// Gauge is an instrument that records the current value when it is set.
+type Gauge interface {
+	// Record sets the current value of the gauge.
+	Record(ctx context.Context, value int64, attrs ...attribute.KeyValue)
+
+	// Enabled reports whether the gauge records measurements made with
+	// ctx. It can be used to skip computing the values and attributes of
+	// measurements that would be dropped.
+	Enabled(ctx context.Context) bool
+
 	instrument.Synchronous
 }
@@ -239,6 +239,45 @@ func (i *sfCounter) Add(ctx context.Context, incr float64, attrs ...attribute.Ke
 	}
 }
 
+func (i *sfCounter) Enabled(ctx context.Context) bool {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(syncfloat64.Counter).Enabled(ctx)
+	}
+	return false
+}
+
+func (i *sfCounter) Bind(attrs ...attribute.KeyValue) syncfloat64.BoundCounter {
+	b := &sfBoundCounter{
+		inst:  i,
+		attrs: append([]attribute.KeyValue(nil), attrs...),
+	}
+	if ctr := i.delegate.Load(); ctr != nil {
+		b.delegate.Store(ctr.(syncfloat64.Counter).Bind(b.attrs...))
+	}
+	return b
+}
+
+// sfBoundCounter is a bound sfCounter. Once the sfCounter has a delegate,
+// the bound counter lazily binds to that delegate.
+type sfBoundCounter struct {
+	inst  *sfCounter
+	attrs []attribute.KeyValue
+
+	delegate atomic.Value //syncfloat64.BoundCounter
+}
+
+func (b *sfBoundCounter) Add(ctx context.Context, incr float64) {
+	if bound := b.delegate.Load(); bound != nil {
+		bound.(syncfloat64.BoundCounter).Add(ctx, incr)
+		return
+	}
+	if ctr := b.inst.delegate.Load(); ctr != nil {
+		bound := ctr.(syncfloat64.Counter).Bind(b.attrs...)
+		b.delegate.Store(bound)
+		bound.Add(ctx, incr)
+	}
+}
+
 type sfUpDownCounter struct {
 	name string
 	opts []instrument.Option
@@ -263,6 +302,13 @@ func (i *sfUpDownCounter) Add(ctx context.Context, incr float64, attrs ...attrib
 	}
 }
 
+func (i *sfUpDownCounter) Enabled(ctx context.Context) bool {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(syncfloat64.UpDownCounter).Enabled(ctx)
+	}
+	return false
+}
+
 type sfHistogram struct {
 	name string
 	opts []instrument.Option
@@ -287,6 +333,44 @@ func (i *sfHistogram) Record(ctx context.Context, x float64, attrs ...attribute.
 	}
 }
 
+func (i *sfHistogram) Enabled(ctx context.Context) bool {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(syncfloat64.Histogram).Enabled(ctx)
+	}
+	return false
+}
+
+type sfGauge struct {
+	name string
+	opts []instrument.Option
+
+	delegate atomic.Value //syncfloat64.Gauge
+
+	instrument.Synchronous
+}
+
+func (i *sfGauge) setDelegate(m metric.Meter) {
+	ctr, err := m.SyncFloat64().Gauge(i.name, i.opts...)
+	if err != nil {
+		otel.Handle(err)
+		return
+	}
+	i.delegate.Store(ctr)
+}
+
+func (i *sfGauge) Record(ctx context.Context, x float64, attrs ...attribute.KeyValue) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		ctr.(syncfloat64.Gauge).Record(ctx, x, attrs...)
+	}
+}
+
+func (i *sfGauge) Enabled(ctx context.Context) bool {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(syncfloat64.Gauge).Enabled(ctx)
+	}
+	return false
+}
+
 type siCounter struct {
 	name string
 	opts []instrument.Option
@@ -311,6 +395,45 @@ func (i *siCounter) Add(ctx context.Context, x int64, attrs ...attribute.KeyValu
 	}
 }
 
+func (i *siCounter) Enabled(ctx context.Context) bool {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(syncint64.Counter).Enabled(ctx)
+	}
+	return false
+}
+
+func (i *siCounter) Bind(attrs ...attribute.KeyValue) syncint64.BoundCounter {
+	b := &siBoundCounter{
+		inst:  i,
+		attrs: append([]attribute.KeyValue(nil), attrs...),
+	}
+	if ctr := i.delegate.Load(); ctr != nil {
+		b.delegate.Store(ctr.(syncint64.Counter).Bind(b.attrs...))
+	}
+	return b
+}
+
+// siBoundCounter is a bound siCounter. Once the siCounter has a delegate,
+// the bound counter lazily binds to that delegate.
+type siBoundCounter struct {
+	inst  *siCounter
+	attrs []attribute.KeyValue
+
+	delegate atomic.Value //syncint64.BoundCounter
+}
+
+func (b *siBoundCounter) Add(ctx context.Context, x int64) {
+	if bound := b.delegate.Load(); bound != nil {
+		bound.(syncint64.BoundCounter).Add(ctx, x)
+		return
+	}
+	if ctr := b.inst.delegate.Load(); ctr != nil {
+		bound := ctr.(syncint64.Counter).Bind(b.attrs...)
+		b.delegate.Store(bound)
+		bound.Add(ctx, x)
+	}
+}
+
 type siUpDownCounter struct {
 	name string
 	opts []instrument.Option
@@ -335,6 +458,13 @@ func (i *siUpDownCounter) Add(ctx context.Context, x int64, attrs ...attribute.K
 	}
 }
 
+func (i *siUpDownCounter) Enabled(ctx context.Context) bool {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(syncint64.UpDownCounter).Enabled(ctx)
+	}
+	return false
+}
+
 type siHistogram struct {
 	name string
 	opts []instrument.Option
@@ -358,3 +488,41 @@ func (i *siHistogram) Record(ctx context.Context, x int64, attrs ...attribute.Ke
 		ctr.(syncint64.Histogram).Record(ctx, x, attrs...)
 	}
 }
+
+func (i *siHistogram) Enabled(ctx context.Context) bool {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(syncint64.Histogram).Enabled(ctx)
+	}
+	return false
+}
+
+type siGauge struct {
+	name string
+	opts []instrument.Option
+
+	delegate atomic.Value //syncint64.Gauge
+
+	instrument.Synchronous
+}
+
+func (i *siGauge) setDelegate(m metric.Meter) {
+	ctr, err := m.SyncInt64().Gauge(i.name, i.opts...)
+	if err != nil {
+		otel.Handle(err)
+		return
+	}
+	i.delegate.Store(ctr)
+}
+
+func (i *siGauge) Record(ctx context.Context, x int64, attrs ...attribute.KeyValue) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		ctr.(syncint64.Gauge).Record(ctx, x, attrs...)
+	}
+}
+
+func (i *siGauge) Enabled(ctx context.Context) bool {
+	if ctr := i.delegate.Load(); ctr != nil {
+		return ctr.(syncint64.Gauge).Enabled(ctx)
+	}
+	return false
+}
@@ -21,6 +21,8 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncfloat64"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
 )
 
 func testFloat64Race(interact func(context.Context, float64, ...attribute.KeyValue), setDelegate func(metric.Meter)) {
@@ -104,6 +106,15 @@ func TestSyncInstrumentSetDelegateRace(t *testing.T) {
 			testFloat64Race(delegate.Add, delegate.setDelegate)
 		})
 
+		t.Run("BoundCounter", func(t *testing.T) {
+			delegate := &sfCounter{}
+			bound := delegate.Bind(attribute.String("key", "value"))
+			add := func(ctx context.Context, incr float64, _ ...attribute.KeyValue) {
+				bound.Add(ctx, incr)
+			}
+			testFloat64Race(add, delegate.setDelegate)
+		})
+
 		t.Run("UpDownCounter", func(t *testing.T) {
 			delegate := &sfUpDownCounter{}
 			testFloat64Race(delegate.Add, delegate.setDelegate)
@@ -113,6 +124,11 @@ func TestSyncInstrumentSetDelegateRace(t *testing.T) {
 			delegate := &sfHistogram{}
 			testFloat64Race(delegate.Record, delegate.setDelegate)
 		})
+
+		t.Run("Gauge", func(t *testing.T) {
+			delegate := &sfGauge{}
+			testFloat64Race(delegate.Record, delegate.setDelegate)
+		})
 	})
 
 	// Int64 Instruments
@@ -123,6 +139,15 @@ func TestSyncInstrumentSetDelegateRace(t *testing.T) {
 			testInt64Race(delegate.Add, delegate.setDelegate)
 		})
 
+		t.Run("BoundCounter", func(t *testing.T) {
+			delegate := &siCounter{}
+			bound := delegate.Bind(attribute.String("key", "value"))
+			add := func(ctx context.Context, x int64, _ ...attribute.KeyValue) {
+				bound.Add(ctx, x)
+			}
+			testInt64Race(add, delegate.setDelegate)
+		})
+
 		t.Run("UpDownCounter", func(t *testing.T) {
 			delegate := &siUpDownCounter{}
 			testInt64Race(delegate.Add, delegate.setDelegate)
@@ -132,6 +157,11 @@ func TestSyncInstrumentSetDelegateRace(t *testing.T) {
 			delegate := &siHistogram{}
 			testInt64Race(delegate.Record, delegate.setDelegate)
 		})
+
+		t.Run("Gauge", func(t *testing.T) {
+			delegate := &siGauge{}
+			testInt64Race(delegate.Record, delegate.setDelegate)
+		})
 	})
 }
 
@@ -151,6 +181,20 @@ func (i *testCountingFloatInstrument) Add(context.Context, float64, ...attribute
 func (i *testCountingFloatInstrument) Record(context.Context, float64, ...attribute.KeyValue) {
 	i.count++
 }
+func (i *testCountingFloatInstrument) Enabled(context.Context) bool {
+	return true
+}
+func (i *testCountingFloatInstrument) Bind(...attribute.KeyValue) syncfloat64.BoundCounter {
+	return testCountingBoundFloat{i}
+}
+
+type testCountingBoundFloat struct {
+	inst *testCountingFloatInstrument
+}
+
+func (b testCountingBoundFloat) Add(context.Context, float64) {
+	b.inst.count++
+}
 
 type testCountingIntInstrument struct {
 	count int
@@ -168,3 +212,62 @@ func (i *testCountingIntInstrument) Add(context.Context, int64, ...attribute.Key
 func (i *testCountingIntInstrument) Record(context.Context, int64, ...attribute.KeyValue) {
 	i.count++
 }
+func (i *testCountingIntInstrument) Enabled(context.Context) bool {
+	return true
+}
+func (i *testCountingIntInstrument) Bind(...attribute.KeyValue) syncint64.BoundCounter {
+	return testCountingBoundInt{i}
+}
+
+type testCountingBoundInt struct {
+	inst *testCountingIntInstrument
+}
+
+func (b testCountingBoundInt) Add(context.Context, int64) {
+	b.inst.count++
+}
+
+func TestBoundCounterDelegates(t *testing.T) {
+	meter := &testMeter{}
+
+	fCtr := &sfCounter{name: "float64"}
+	fBound := fCtr.Bind(attribute.String("key", "value"))
+	iCtr := &siCounter{name: "int64"}
+	iBound := iCtr.Bind(attribute.String("key", "value"))
+
+	// Values recorded before a delegate is set are dropped.
+	fBound.Add(context.Background(), 1)
+	iBound.Add(context.Background(), 1)
+
+	fCtr.setDelegate(meter)
+	iCtr.setDelegate(meter)
+
+	fBound.Add(context.Background(), 1)
+	iBound.Add(context.Background(), 1)
+
+	fDelegate := fCtr.delegate.Load().(*testCountingFloatInstrument)
+	if fDelegate.count != 1 {
+		t.Errorf("float64 bound counter delegated %d calls, want 1", fDelegate.count)
+	}
+	iDelegate := iCtr.delegate.Load().(*testCountingIntInstrument)
+	if iDelegate.count != 1 {
+		t.Errorf("int64 bound counter delegated %d calls, want 1", iDelegate.count)
+	}
+}
+
+func TestSyncInstrumentEnabledDelegates(t *testing.T) {
+	ctx := context.Background()
+	meter := &testMeter{}
+
+	fCtr := &sfCounter{name: "float64"}
+	iHist := &siHistogram{name: "int64"}
+	if fCtr.Enabled(ctx) || iHist.Enabled(ctx) {
+		t.Error("instrument without a delegate is enabled")
+	}
+
+	fCtr.setDelegate(meter)
+	iHist.setDelegate(meter)
+	if !fCtr.Enabled(ctx) || !iHist.Enabled(ctx) {
+		t.Error("instrument enabled state not delegated")
+	}
+}
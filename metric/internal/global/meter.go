@@ -20,6 +20,7 @@ import (
 	"sync/atomic"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/instrument"
 	"go.opentelemetry.io/otel/metric/instrument/asyncfloat64"
@@ -42,6 +43,7 @@ type meterProvider struct {
 type il struct {
 	name    string
 	version string
+	attrs   attribute.Distinct
 }
 
 // setDelegate configures p to delegate all MeterProvider functionality to
@@ -81,9 +83,11 @@ func (p *meterProvider) Meter(name string, opts ...metric.MeterOption) metric.Me
 	// At this moment it is guaranteed that no sdk is installed, save the meter in the meters map.
 
 	c := metric.NewMeterConfig(opts...)
+	attrs := c.InstrumentationAttributes()
 	key := il{
 		name:    name,
 		version: c.InstrumentationVersion(),
+		attrs:   attrs.Equivalent(),
 	}
 
 	if p.meters == nil {
@@ -317,6 +321,15 @@ func (ip *sfInstProvider) Histogram(name string, opts ...instrument.Option) (syn
 	return ctr, nil
 }
 
+// Gauge creates an instrument for recording the current value.
+func (ip *sfInstProvider) Gauge(name string, opts ...instrument.Option) (syncfloat64.Gauge, error) {
+	ip.mtx.Lock()
+	defer ip.mtx.Unlock()
+	ctr := &sfGauge{name: name, opts: opts}
+	ip.instruments = append(ip.instruments, ctr)
+	return ctr, nil
+}
+
 type siInstProvider meter
 
 // Counter creates an instrument for recording increasing values.
@@ -345,3 +358,12 @@ func (ip *siInstProvider) Histogram(name string, opts ...instrument.Option) (syn
 	ip.instruments = append(ip.instruments, ctr)
 	return ctr, nil
 }
+
+// Gauge creates an instrument for recording the current value.
+func (ip *siInstProvider) Gauge(name string, opts ...instrument.Option) (syncint64.Gauge, error) {
+	ip.mtx.Lock()
+	defer ip.mtx.Unlock()
+	ctr := &siGauge{name: name, opts: opts}
+	ip.instruments = append(ip.instruments, ctr)
+	return ctr, nil
+}
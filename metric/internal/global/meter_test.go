@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/instrument"
 	"go.opentelemetry.io/otel/metric/instrument/asyncfloat64"
@@ -65,9 +66,11 @@ func TestMeterRace(t *testing.T) {
 			_, _ = mtr.SyncFloat64().Counter(name)
 			_, _ = mtr.SyncFloat64().UpDownCounter(name)
 			_, _ = mtr.SyncFloat64().Histogram(name)
+			_, _ = mtr.SyncFloat64().Gauge(name)
 			_, _ = mtr.SyncInt64().Counter(name)
 			_, _ = mtr.SyncInt64().UpDownCounter(name)
 			_, _ = mtr.SyncInt64().Histogram(name)
+			_, _ = mtr.SyncInt64().Gauge(name)
 			_ = mtr.RegisterCallback(nil, func(ctx context.Context) {})
 			if !once {
 				wg.Done()
@@ -257,3 +260,17 @@ func TestMeterDefersDelegations(t *testing.T) {
 	assert.IsType(t, &afCounter{}, actr)
 	assert.Equal(t, 1, mp.count)
 }
+
+func TestMeterIdentity(t *testing.T) {
+	attrs := []attribute.KeyValue{attribute.String("user", "alice")}
+
+	globalMeterProvider := &meterProvider{}
+	m0 := globalMeterProvider.Meter("name")
+	m1 := globalMeterProvider.Meter("name", metric.WithInstrumentationVersion("v1"))
+	m2 := globalMeterProvider.Meter("name", metric.WithInstrumentationAttributes(attrs...))
+	m3 := globalMeterProvider.Meter("name", metric.WithInstrumentationAttributes(attrs...))
+
+	assert.NotSame(t, m0, m1, "different versions should be different meters")
+	assert.NotSame(t, m0, m2, "different attributes should be different meters")
+	assert.Same(t, m2, m3, "equivalent attributes should be the same meter")
+}
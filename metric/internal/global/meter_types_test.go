@@ -140,6 +140,11 @@ func (ip testSFInstrumentProvider) Histogram(name string, opts ...instrument.Opt
 	return &testCountingFloatInstrument{}, nil
 }
 
+// Gauge creates an instrument for recording the current value.
+func (ip testSFInstrumentProvider) Gauge(name string, opts ...instrument.Option) (syncfloat64.Gauge, error) {
+	return &testCountingFloatInstrument{}, nil
+}
+
 type testSIInstrumentProvider struct{}
 
 // Counter creates an instrument for recording increasing values.
@@ -156,3 +161,8 @@ func (ip testSIInstrumentProvider) UpDownCounter(name string, opts ...instrument
 func (ip testSIInstrumentProvider) Histogram(name string, opts ...instrument.Option) (syncint64.Histogram, error) {
 	return &testCountingIntInstrument{}, nil
 }
+
+// Gauge creates an instrument for recording the current value.
+func (ip testSIInstrumentProvider) Gauge(name string, opts ...instrument.Option) (syncint64.Gauge, error) {
+	return &testCountingIntInstrument{}, nil
+}
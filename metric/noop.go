@@ -130,6 +130,7 @@ var (
 	_ syncfloat64.Counter            = nonrecordingSyncFloat64Instrument{}
 	_ syncfloat64.UpDownCounter      = nonrecordingSyncFloat64Instrument{}
 	_ syncfloat64.Histogram          = nonrecordingSyncFloat64Instrument{}
+	_ syncfloat64.Gauge              = nonrecordingSyncFloat64Instrument{}
 )
 
 func (n nonrecordingSyncFloat64Instrument) Counter(string, ...instrument.Option) (syncfloat64.Counter, error) {
@@ -144,14 +145,26 @@ func (n nonrecordingSyncFloat64Instrument) Histogram(string, ...instrument.Optio
 	return n, nil
 }
 
+func (n nonrecordingSyncFloat64Instrument) Gauge(string, ...instrument.Option) (syncfloat64.Gauge, error) {
+	return n, nil
+}
+
 func (nonrecordingSyncFloat64Instrument) Add(context.Context, float64, ...attribute.KeyValue) {
 
 }
 
+func (nonrecordingSyncFloat64Instrument) Bind(...attribute.KeyValue) syncfloat64.BoundCounter {
+	return nonrecordingBoundFloat64Counter{}
+}
+
 func (nonrecordingSyncFloat64Instrument) Record(context.Context, float64, ...attribute.KeyValue) {
 
 }
 
+func (nonrecordingSyncFloat64Instrument) Enabled(context.Context) bool {
+	return false
+}
+
 type nonrecordingSyncInt64Instrument struct {
 	instrument.Synchronous
 }
@@ -161,6 +174,7 @@ var (
 	_ syncint64.Counter            = nonrecordingSyncInt64Instrument{}
 	_ syncint64.UpDownCounter      = nonrecordingSyncInt64Instrument{}
 	_ syncint64.Histogram          = nonrecordingSyncInt64Instrument{}
+	_ syncint64.Gauge              = nonrecordingSyncInt64Instrument{}
 )
 
 func (n nonrecordingSyncInt64Instrument) Counter(string, ...instrument.Option) (syncint64.Counter, error) {
@@ -175,7 +189,30 @@ func (n nonrecordingSyncInt64Instrument) Histogram(string, ...instrument.Option)
 	return n, nil
 }
 
+func (n nonrecordingSyncInt64Instrument) Gauge(string, ...instrument.Option) (syncint64.Gauge, error) {
+	return n, nil
+}
+
 func (nonrecordingSyncInt64Instrument) Add(context.Context, int64, ...attribute.KeyValue) {
 }
+func (nonrecordingSyncInt64Instrument) Bind(...attribute.KeyValue) syncint64.BoundCounter {
+	return nonrecordingBoundInt64Counter{}
+}
 func (nonrecordingSyncInt64Instrument) Record(context.Context, int64, ...attribute.KeyValue) {
 }
+
+func (nonrecordingSyncInt64Instrument) Enabled(context.Context) bool {
+	return false
+}
+
+type nonrecordingBoundFloat64Counter struct{}
+
+var _ syncfloat64.BoundCounter = nonrecordingBoundFloat64Counter{}
+
+func (nonrecordingBoundFloat64Counter) Add(context.Context, float64) {}
+
+type nonrecordingBoundInt64Counter struct{}
+
+var _ syncint64.BoundCounter = nonrecordingBoundInt64Counter{}
+
+func (nonrecordingBoundInt64Counter) Add(context.Context, int64) {}
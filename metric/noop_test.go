@@ -39,6 +39,18 @@ func TestSyncFloat64(t *testing.T) {
 		inst.Add(context.Background(), 1.0, attribute.String("key", "value"))
 	})
 
+	assert.NotPanics(t, func() {
+		inst, err := meter.SyncFloat64().Counter("test instrument")
+		require.NoError(t, err)
+		inst.Bind(attribute.String("key", "value")).Add(context.Background(), 1.0)
+	})
+
+	assert.NotPanics(t, func() {
+		inst, err := meter.SyncFloat64().Counter("test instrument")
+		require.NoError(t, err)
+		assert.False(t, inst.Enabled(context.Background()))
+	})
+
 	assert.NotPanics(t, func() {
 		inst, err := meter.SyncFloat64().UpDownCounter("test instrument")
 		require.NoError(t, err)
@@ -50,6 +62,12 @@ func TestSyncFloat64(t *testing.T) {
 		require.NoError(t, err)
 		inst.Record(context.Background(), 1.0, attribute.String("key", "value"))
 	})
+
+	assert.NotPanics(t, func() {
+		inst, err := meter.SyncFloat64().Gauge("test instrument")
+		require.NoError(t, err)
+		inst.Record(context.Background(), 1.0, attribute.String("key", "value"))
+	})
 }
 
 func TestSyncInt64(t *testing.T) {
@@ -60,6 +78,18 @@ func TestSyncInt64(t *testing.T) {
 		inst.Add(context.Background(), 1, attribute.String("key", "value"))
 	})
 
+	assert.NotPanics(t, func() {
+		inst, err := meter.SyncInt64().Counter("test instrument")
+		require.NoError(t, err)
+		inst.Bind(attribute.String("key", "value")).Add(context.Background(), 1)
+	})
+
+	assert.NotPanics(t, func() {
+		inst, err := meter.SyncInt64().Counter("test instrument")
+		require.NoError(t, err)
+		assert.False(t, inst.Enabled(context.Background()))
+	})
+
 	assert.NotPanics(t, func() {
 		inst, err := meter.SyncInt64().UpDownCounter("test instrument")
 		require.NoError(t, err)
@@ -71,6 +101,12 @@ func TestSyncInt64(t *testing.T) {
 		require.NoError(t, err)
 		inst.Record(context.Background(), 1, attribute.String("key", "value"))
 	})
+
+	assert.NotPanics(t, func() {
+		inst, err := meter.SyncInt64().Gauge("test instrument")
+		require.NoError(t, err)
+		inst.Record(context.Background(), 1, attribute.String("key", "value"))
+	})
 }
 
 func TestAsyncFloat64(t *testing.T) {
@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package autoprop provides an OpenTelemetry TextMapPropagator creation
+function. The OpenTelemetry specification states that the default
+TextMapPropagator needs to be a no-operation implementation. The
+opentelemetry-go project adheres to this requirement. However, for systems
+that perform propagation this default is not ideal. This package provides a
+TextMapPropagator with useful defaults (a combined TraceContext and Baggage
+TextMapPropagator), and supports environment overrides using the
+OTEL_PROPAGATORS environment variable.
+
+The OTEL_PROPAGATORS environment variable is a comma-separated list of
+propagator names. The following names are supported:
+
+  - "tracecontext": W3C Trace Context
+  - "baggage": W3C Baggage
+  - "b3": B3 Single
+  - "b3multi": B3 Multi
+  - "none": None, explicitly do not set any TextMapPropagator
+
+Additional propagators can be added with RegisterTextMapPropagator.
+*/
+package autoprop // import "go.opentelemetry.io/otel/propagation/autoprop"
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoprop // import "go.opentelemetry.io/otel/propagation/autoprop"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// otelPropagatorsEnvKey is the environment variable name identifying
+// propagators to use.
+const otelPropagatorsEnvKey = "OTEL_PROPAGATORS"
+
+// NewTextMapPropagator returns a new TextMapPropagator composited by props or,
+// if props is empty, the default of a TraceContext and Baggage TextMapPropagator.
+//
+// If the OTEL_PROPAGATORS environment variable is set, the TextMapPropagator
+// it identifies is returned instead of props or the default. Any names
+// OTEL_PROPAGATORS contains that are not registered are reported to the
+// global ErrorHandler and ignored.
+func NewTextMapPropagator(props ...propagation.TextMapPropagator) propagation.TextMapPropagator {
+	if v, ok := os.LookupEnv(otelPropagatorsEnvKey); ok && strings.TrimSpace(v) != "" {
+		names := strings.Split(v, ",")
+		p, err := TextMapPropagator(names...)
+		if err != nil {
+			otel.Handle(err)
+		}
+		return p
+	}
+
+	if len(props) == 0 {
+		return propagation.NewCompositeTextMapPropagator(
+			propagation.TraceContext{},
+			propagation.Baggage{},
+		)
+	}
+	return propagation.NewCompositeTextMapPropagator(props...)
+}
+
+// SetTextMapPropagator sets the global TextMapPropagator to the
+// TextMapPropagator returned by NewTextMapPropagator(props...), and returns
+// it.
+func SetTextMapPropagator(props ...propagation.TextMapPropagator) propagation.TextMapPropagator {
+	p := NewTextMapPropagator(props...)
+	otel.SetTextMapPropagator(p)
+	return p
+}
+
+var errUnknownPropagator = errors.New("unknown propagator")
+
+// TextMapPropagator returns a TextMapPropagator composed from the registered
+// TextMapPropagators identified by names. Names are trimmed of whitespace
+// and are case-insensitive. If names contains "none", a no-operation
+// TextMapPropagator is returned.
+//
+// An error is returned for any names that are not registered. The returned
+// TextMapPropagator is composed of the names that are registered.
+func TextMapPropagator(names ...string) (propagation.TextMapPropagator, error) {
+	var (
+		props   []propagation.TextMapPropagator
+		unknown []string
+	)
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if name == none {
+			return propagation.NewCompositeTextMapPropagator(), nil
+		}
+		p, ok := envRegistry.load(name)
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		props = append(props, p)
+	}
+
+	var err error
+	if len(unknown) > 0 {
+		err = fmt.Errorf("%w: %s", errUnknownPropagator, strings.Join(unknown, ", "))
+	}
+	return propagation.NewCompositeTextMapPropagator(props...), err
+}
@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoprop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+type handlerFunc func(error)
+
+func (f handlerFunc) Handle(err error) { f(err) }
+
+func TestNewTextMapPropagatorDefault(t *testing.T) {
+	t.Setenv(otelPropagatorsEnvKey, "")
+
+	want := propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	)
+	assert.Equal(t, want, NewTextMapPropagator())
+}
+
+func TestNewTextMapPropagatorProps(t *testing.T) {
+	t.Setenv(otelPropagatorsEnvKey, "")
+
+	b3 := propagation.B3{}
+	want := propagation.NewCompositeTextMapPropagator(b3)
+	assert.Equal(t, want, NewTextMapPropagator(b3))
+}
+
+func TestNewTextMapPropagatorEnv(t *testing.T) {
+	t.Setenv(otelPropagatorsEnvKey, "b3multi, TraceContext")
+
+	want := propagation.NewCompositeTextMapPropagator(
+		propagation.NewB3(propagation.WithB3InjectEncoding(propagation.B3MultipleHeader)),
+		propagation.TraceContext{},
+	)
+	assert.Equal(t, want, NewTextMapPropagator(propagation.Baggage{}))
+}
+
+func TestNewTextMapPropagatorEnvNone(t *testing.T) {
+	t.Setenv(otelPropagatorsEnvKey, "tracecontext,none")
+
+	p := NewTextMapPropagator()
+	assert.Empty(t, p.Fields())
+}
+
+func TestNewTextMapPropagatorEnvUnknown(t *testing.T) {
+	var handled []error
+	otel.SetErrorHandler(handlerFunc(func(err error) { handled = append(handled, err) }))
+	t.Setenv(otelPropagatorsEnvKey, "tracecontext,unknown")
+
+	p := NewTextMapPropagator()
+	assert.Equal(t, propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}), p)
+	require.Len(t, handled, 1)
+	assert.ErrorIs(t, handled[0], errUnknownPropagator)
+}
+
+func TestTextMapPropagator(t *testing.T) {
+	p, err := TextMapPropagator("baggage", " b3 ")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"baggage", "b3"}, p.Fields())
+
+	_, err = TextMapPropagator("invalid")
+	assert.ErrorIs(t, err, errUnknownPropagator)
+}
+
+func TestSetTextMapPropagator(t *testing.T) {
+	t.Setenv(otelPropagatorsEnvKey, "b3")
+	orig := otel.GetTextMapPropagator()
+	t.Cleanup(func() { otel.SetTextMapPropagator(orig) })
+
+	p := SetTextMapPropagator()
+	assert.ElementsMatch(t, p.Fields(), otel.GetTextMapPropagator().Fields())
+	assert.Equal(t, []string{"b3"}, otel.GetTextMapPropagator().Fields())
+}
+
+type testPropagator struct{}
+
+func (testPropagator) Inject(context.Context, propagation.TextMapCarrier) {}
+
+func (testPropagator) Extract(ctx context.Context, _ propagation.TextMapCarrier) context.Context {
+	return ctx
+}
+
+func (testPropagator) Fields() []string { return []string{"test"} }
+
+func TestRegisterTextMapPropagator(t *testing.T) {
+	t.Cleanup(func() {
+		envRegistry.mu.Lock()
+		delete(envRegistry.names, "custom")
+		envRegistry.mu.Unlock()
+	})
+
+	RegisterTextMapPropagator("Custom", testPropagator{})
+	p, err := TextMapPropagator("custom")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"test"}, p.Fields())
+
+	assert.PanicsWithError(t, `duplicate registration: "custom"`, func() {
+		RegisterTextMapPropagator("custom", testPropagator{})
+	})
+	assert.PanicsWithError(t, `reserved propagator name: "none"`, func() {
+		RegisterTextMapPropagator("none", testPropagator{})
+	})
+	assert.PanicsWithError(t, errEmptyName.Error(), func() {
+		RegisterTextMapPropagator("", testPropagator{})
+	})
+}
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoprop // import "go.opentelemetry.io/otel/propagation/autoprop"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// none is the special "propagator" name that means no propagator shall be
+// configured.
+const none = "none"
+
+// envRegistry is the registry of TextMapPropagators registered with this
+// package. It includes all the OpenTelemetry defaults at startup.
+var envRegistry = &registry{
+	names: map[string]propagation.TextMapPropagator{
+		// W3C Trace Context.
+		"tracecontext": propagation.TraceContext{},
+		// W3C Baggage.
+		"baggage": propagation.Baggage{},
+		// B3 single-header format.
+		"b3": propagation.NewB3(propagation.WithB3InjectEncoding(propagation.B3SingleHeader)),
+		// B3 multi-header format.
+		"b3multi": propagation.NewB3(propagation.WithB3InjectEncoding(propagation.B3MultipleHeader)),
+	},
+}
+
+var (
+	errDupReg    = errors.New("duplicate registration")
+	errReserved  = errors.New("reserved propagator name")
+	errEmptyName = errors.New("empty propagator name")
+)
+
+type registry struct {
+	mu    sync.Mutex
+	names map[string]propagation.TextMapPropagator
+}
+
+// load returns the value stored in the registry index for a key, or nil if
+// no value is present. The ok result indicates whether value was found in
+// the index.
+func (r *registry) load(key string) (p propagation.TextMapPropagator, ok bool) {
+	r.mu.Lock()
+	p, ok = r.names[key]
+	r.mu.Unlock()
+	return p, ok
+}
+
+// store sets the value for a key if is not already in the registry. An error
+// is returned if the key is already registered.
+func (r *registry) store(key string, value propagation.TextMapPropagator) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.names[key]; ok {
+		return fmt.Errorf("%w: %q", errDupReg, key)
+	}
+	r.names[key] = value
+	return nil
+}
+
+// RegisterTextMapPropagator sets the TextMapPropagator p to be used when the
+// OTEL_PROPAGATORS environment variable contains the propagator name. This
+// will panic if name has already been registered, is the reserved "none"
+// name, or is empty.
+//
+// Names are case-insensitive, name is registered in lower-case.
+func RegisterTextMapPropagator(name string, p propagation.TextMapPropagator) {
+	name = strings.ToLower(name)
+	switch name {
+	case "":
+		panic(errEmptyName)
+	case none:
+		panic(fmt.Errorf("%w: %q", errReserved, name))
+	}
+	if err := envRegistry.store(name, p); err != nil {
+		// envRegistry.store will return errDupReg if name is already
+		// registered. Panic here so the user is made aware of the duplicate
+		// registration, which could be done by malicious code trying to
+		// intercept cross-cutting concerns.
+		//
+		// Panic for all other errors as well. At this point there should not
+		// be any other errors returned from the store operation. If there
+		// are, alert the developer that they need to be handled here.
+		panic(err)
+	}
+}
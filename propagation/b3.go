@@ -0,0 +1,414 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation // import "go.opentelemetry.io/otel/propagation"
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	b3ContextHeader      = "b3"
+	b3DebugFlagHeader    = "x-b3-flags"
+	b3TraceIDHeader      = "x-b3-traceid"
+	b3SpanIDHeader       = "x-b3-spanid"
+	b3SampledHeader      = "x-b3-sampled"
+	b3ParentSpanIDHeader = "x-b3-parentspanid"
+
+	b3TraceIDPadding = "0000000000000000"
+
+	// B3 single header encoding widths.
+	b3SeparatorWidth      = 1       // Single "-" character.
+	b3SamplingWidth       = 1       // Single hex character.
+	b3TraceID64BitsWidth  = 64 / 4  // 16 hex character Trace ID.
+	b3TraceID128BitsWidth = 128 / 4 // 32 hex character Trace ID.
+	b3SpanIDWidth         = 16      // 16 hex character ID.
+	b3ParentSpanIDWidth   = 16      // 16 hex character ID.
+)
+
+var (
+	errB3InvalidSampledByte        = errors.New("invalid B3 Sampled found")
+	errB3InvalidSampledHeader      = errors.New("invalid B3 Sampled header found")
+	errB3InvalidTraceIDHeader      = errors.New("invalid B3 traceID header found")
+	errB3InvalidSpanIDHeader       = errors.New("invalid B3 spanID header found")
+	errB3InvalidParentSpanIDHeader = errors.New("invalid B3 ParentSpanID header found")
+	errB3InvalidScope              = errors.New("require either both traceID and spanID or none")
+	errB3InvalidScopeParent        = errors.New("ParentSpanID requires both traceID and spanID to be available")
+	errB3InvalidScopeParentSingle  = errors.New("ParentSpanID requires traceID, spanID and Sampled to be available")
+	errB3EmptyContext              = errors.New("empty request context")
+	errB3InvalidTraceIDValue       = errors.New("invalid B3 traceID value found")
+	errB3InvalidSpanIDValue        = errors.New("invalid B3 spanID value found")
+	errB3InvalidParentSpanIDValue  = errors.New("invalid B3 ParentSpanID value found")
+)
+
+// B3Encoding is a bitmask representation of the B3 encoding type.
+type B3Encoding uint8
+
+// supports returns if e has o bit(s) set.
+func (e B3Encoding) supports(o B3Encoding) bool {
+	return e&o == o
+}
+
+const (
+	// B3MultipleHeader is a B3 encoding that uses multiple headers to
+	// transmit tracing information all prefixed with `x-b3-`.
+	//    x-b3-traceid: {TraceId}
+	//    x-b3-parentspanid: {ParentSpanId}
+	//    x-b3-spanid: {SpanId}
+	//    x-b3-sampled: {SamplingState}
+	//    x-b3-flags: {DebugFlag}
+	B3MultipleHeader B3Encoding = 1 << iota
+	// B3SingleHeader is a B3 encoding that uses a single header named `b3`
+	// to transmit tracing information.
+	//    b3: {TraceId}-{SpanId}-{SamplingState}-{ParentSpanId}
+	B3SingleHeader
+	// B3Unspecified is an unspecified B3 encoding. The B3 propagator uses
+	// B3SingleHeader when it is used.
+	B3Unspecified B3Encoding = 0
+)
+
+// B3 is a propagator that supports the B3 single and multiple header formats
+// (https://github.com/openzipkin/b3-propagation).
+//
+// Both formats are extracted, with the single header taking precedence if it
+// is valid. The zero value injects the single header format, use NewB3 to
+// configure the injected format.
+type B3 struct {
+	injectEncoding B3Encoding
+}
+
+var _ TextMapPropagator = B3{}
+
+// B3Option configures a B3 propagator.
+type B3Option interface {
+	apply(B3) B3
+}
+
+type b3OptionFunc func(B3) B3
+
+func (fn b3OptionFunc) apply(b B3) B3 {
+	return fn(b)
+}
+
+// WithB3InjectEncoding sets the encoding the B3 propagator uses to inject
+// trace information. Encodings may be combined to inject both formats, e.g.
+// B3SingleHeader|B3MultipleHeader.
+func WithB3InjectEncoding(encoding B3Encoding) B3Option {
+	return b3OptionFunc(func(b B3) B3 {
+		b.injectEncoding = encoding
+		return b
+	})
+}
+
+// NewB3 returns a B3 propagator configured with opts.
+func NewB3(opts ...B3Option) B3 {
+	var b B3
+	for _, opt := range opts {
+		b = opt.apply(b)
+	}
+	return b
+}
+
+// encoding returns the encoding b3 injects.
+func (b3 B3) encoding() B3Encoding {
+	if b3.injectEncoding == B3Unspecified {
+		return B3SingleHeader
+	}
+	return b3.injectEncoding
+}
+
+// Inject sets B3 headers from the SpanContext in ctx into the carrier.
+func (b3 B3) Inject(ctx context.Context, carrier TextMapCarrier) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+	debug, deferred := b3DebugFromContext(ctx), b3DeferredFromContext(ctx)
+	enc := b3.encoding()
+
+	if enc.supports(B3SingleHeader) {
+		header := sc.TraceID().String() + "-" + sc.SpanID().String()
+		if debug {
+			header += "-d"
+		} else if !deferred {
+			if sc.IsSampled() {
+				header += "-1"
+			} else {
+				header += "-0"
+			}
+		}
+		carrier.Set(b3ContextHeader, header)
+	}
+
+	if enc.supports(B3MultipleHeader) {
+		carrier.Set(b3TraceIDHeader, sc.TraceID().String())
+		carrier.Set(b3SpanIDHeader, sc.SpanID().String())
+
+		if debug {
+			// Since Debug implies sampled, don't also send "X-B3-Sampled".
+			carrier.Set(b3DebugFlagHeader, "1")
+		} else if !deferred {
+			if sc.IsSampled() {
+				carrier.Set(b3SampledHeader, "1")
+			} else {
+				carrier.Set(b3SampledHeader, "0")
+			}
+		}
+	}
+}
+
+// Extract reads B3 headers from the carrier into a returned Context.
+//
+// The returned Context will be a copy of ctx and contain the extracted
+// SpanContext as the remote SpanContext. If the extracted SpanContext is
+// invalid, the passed ctx will be returned directly instead.
+func (b3 B3) Extract(ctx context.Context, carrier TextMapCarrier) context.Context {
+	// Default to the single header if a valid value exists.
+	if h := carrier.Get(b3ContextHeader); h != "" {
+		sCtx, sc, err := extractB3Single(ctx, h)
+		if err == nil && sc.IsValid() {
+			return trace.ContextWithRemoteSpanContext(sCtx, sc)
+		}
+		// The single header value was invalid, fallback to multiple headers.
+	}
+
+	mCtx, sc, err := extractB3Multiple(
+		ctx,
+		carrier.Get(b3TraceIDHeader),
+		carrier.Get(b3SpanIDHeader),
+		carrier.Get(b3ParentSpanIDHeader),
+		carrier.Get(b3SampledHeader),
+		carrier.Get(b3DebugFlagHeader),
+	)
+	if err != nil || !sc.IsValid() {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(mCtx, sc)
+}
+
+// Fields returns the keys whose values are set with Inject.
+func (b3 B3) Fields() []string {
+	var fields []string
+	enc := b3.encoding()
+	if enc.supports(B3SingleHeader) {
+		fields = append(fields, b3ContextHeader)
+	}
+	if enc.supports(B3MultipleHeader) {
+		fields = append(fields, b3TraceIDHeader, b3SpanIDHeader, b3SampledHeader, b3DebugFlagHeader)
+	}
+	return fields
+}
+
+// extractB3Multiple reconstructs a SpanContext from the B3 multiple header
+// values.
+func extractB3Multiple(ctx context.Context, traceID, spanID, parentSpanID, sampled, flags string) (context.Context, trace.SpanContext, error) {
+	var (
+		err           error
+		requiredCount int
+		scc           = trace.SpanContextConfig{Remote: true}
+	)
+
+	// Correct values for an existing sampled header are "0" and "1". For
+	// legacy support and being lenient to other tracing implementations
+	// "true" and "false" are also accepted.
+	switch strings.ToLower(sampled) {
+	case "0", "false":
+		// Zero value for TraceFlags sample bit is unset.
+	case "1", "true":
+		scc.TraceFlags = trace.FlagsSampled
+	case "":
+		ctx = withB3Deferred(ctx, true)
+	default:
+		return ctx, trace.SpanContext{}, errB3InvalidSampledHeader
+	}
+
+	// The only accepted value for Flags is "1". This sets the debug and
+	// sampled flags, debug implies sampled. Any X-B3-Sampled header is
+	// ignored when a valid X-B3-Flags header is sent.
+	if flags == "1" {
+		ctx = withB3Deferred(ctx, false)
+		ctx = withB3Debug(ctx, true)
+		scc.TraceFlags |= trace.FlagsSampled
+	}
+
+	if traceID != "" {
+		requiredCount++
+		id := traceID
+		if len(traceID) == b3TraceID64BitsWidth {
+			// Pad 64-bit trace IDs.
+			id = b3TraceIDPadding + traceID
+		}
+		if scc.TraceID, err = trace.TraceIDFromHex(id); err != nil {
+			return ctx, trace.SpanContext{}, errB3InvalidTraceIDHeader
+		}
+	}
+
+	if spanID != "" {
+		requiredCount++
+		if scc.SpanID, err = trace.SpanIDFromHex(spanID); err != nil {
+			return ctx, trace.SpanContext{}, errB3InvalidSpanIDHeader
+		}
+	}
+
+	if requiredCount != 0 && requiredCount != 2 {
+		return ctx, trace.SpanContext{}, errB3InvalidScope
+	}
+
+	if parentSpanID != "" {
+		if requiredCount == 0 {
+			return ctx, trace.SpanContext{}, errB3InvalidScopeParent
+		}
+		// Validate the parent span ID, it is not used so do not save it.
+		if _, err = trace.SpanIDFromHex(parentSpanID); err != nil {
+			return ctx, trace.SpanContext{}, errB3InvalidParentSpanIDHeader
+		}
+	}
+
+	return ctx, trace.NewSpanContext(scc), nil
+}
+
+// extractB3Single reconstructs a SpanContext from the B3 single header value.
+func extractB3Single(ctx context.Context, contextHeader string) (context.Context, trace.SpanContext, error) {
+	if contextHeader == "" {
+		return ctx, trace.SpanContext{}, errB3EmptyContext
+	}
+
+	var (
+		scc      = trace.SpanContextConfig{Remote: true}
+		sampling string
+	)
+
+	headerLen := len(contextHeader)
+
+	switch {
+	case headerLen == b3SamplingWidth:
+		sampling = contextHeader
+	case headerLen == b3TraceID64BitsWidth || headerLen == b3TraceID128BitsWidth:
+		// Trace ID by itself is invalid.
+		return ctx, trace.SpanContext{}, errB3InvalidScope
+	case headerLen >= b3TraceID64BitsWidth+b3SpanIDWidth+b3SeparatorWidth:
+		pos := 0
+		var traceID string
+		switch {
+		case contextHeader[b3TraceID64BitsWidth] == '-':
+			// The trace ID is 64 bits.
+			pos += b3TraceID64BitsWidth // {traceID}
+			traceID = b3TraceIDPadding + contextHeader[0:pos]
+		case headerLen > b3TraceID128BitsWidth && contextHeader[b3TraceID128BitsWidth] == '-':
+			// The trace ID is 128 bits.
+			pos += b3TraceID128BitsWidth // {traceID}
+			traceID = contextHeader[0:pos]
+		default:
+			return ctx, trace.SpanContext{}, errB3InvalidTraceIDValue
+		}
+		var err error
+		scc.TraceID, err = trace.TraceIDFromHex(traceID)
+		if err != nil {
+			return ctx, trace.SpanContext{}, errB3InvalidTraceIDValue
+		}
+		pos += b3SeparatorWidth // {traceID}-
+
+		if headerLen < pos+b3SpanIDWidth {
+			return ctx, trace.SpanContext{}, errB3InvalidSpanIDValue
+		}
+		scc.SpanID, err = trace.SpanIDFromHex(contextHeader[pos : pos+b3SpanIDWidth])
+		if err != nil {
+			return ctx, trace.SpanContext{}, errB3InvalidSpanIDValue
+		}
+		pos += b3SpanIDWidth // {traceID}-{spanID}
+
+		if headerLen > pos {
+			if headerLen == pos+b3SeparatorWidth {
+				// {traceID}-{spanID}- is invalid.
+				return ctx, trace.SpanContext{}, errB3InvalidSampledByte
+			}
+			pos += b3SeparatorWidth // {traceID}-{spanID}-
+
+			switch headerLen {
+			case pos + b3SamplingWidth:
+				sampling = string(contextHeader[pos])
+			case pos + b3ParentSpanIDWidth:
+				// {traceID}-{spanID}-{parentSpanID} is invalid.
+				return ctx, trace.SpanContext{}, errB3InvalidScopeParentSingle
+			case pos + b3SamplingWidth + b3SeparatorWidth + b3ParentSpanIDWidth:
+				sampling = string(contextHeader[pos])
+				pos += b3SamplingWidth + b3SeparatorWidth // {traceID}-{spanID}-{sampling}-
+
+				// Validate the parent span ID, it is not used so do not
+				// save it.
+				if _, err = trace.SpanIDFromHex(contextHeader[pos:]); err != nil {
+					return ctx, trace.SpanContext{}, errB3InvalidParentSpanIDValue
+				}
+			default:
+				return ctx, trace.SpanContext{}, errB3InvalidParentSpanIDValue
+			}
+		}
+	default:
+		return ctx, trace.SpanContext{}, errB3InvalidTraceIDValue
+	}
+
+	switch sampling {
+	case "":
+		ctx = withB3Deferred(ctx, true)
+	case "d":
+		ctx = withB3Debug(ctx, true)
+		scc.TraceFlags = trace.FlagsSampled
+	case "1":
+		scc.TraceFlags = trace.FlagsSampled
+	case "0":
+		// Zero value for TraceFlags sample bit is unset.
+	default:
+		return ctx, trace.SpanContext{}, errB3InvalidSampledByte
+	}
+
+	return ctx, trace.NewSpanContext(scc), nil
+}
+
+type b3KeyType int
+
+const (
+	// b3DebugKey is the context key for the B3 debug flag.
+	b3DebugKey b3KeyType = iota
+	// b3DeferredKey is the context key for a deferred B3 sampling decision.
+	b3DeferredKey
+)
+
+// withB3Debug returns a copy of parent with debug set as the B3 debug flag
+// value.
+func withB3Debug(parent context.Context, debug bool) context.Context {
+	return context.WithValue(parent, b3DebugKey, debug)
+}
+
+// b3DebugFromContext returns the B3 debug flag value stored in ctx.
+func b3DebugFromContext(ctx context.Context) bool {
+	debug, _ := ctx.Value(b3DebugKey).(bool)
+	return debug
+}
+
+// withB3Deferred returns a copy of parent with deferred set as whether the B3
+// sampling decision is deferred.
+func withB3Deferred(parent context.Context, deferred bool) context.Context {
+	return context.WithValue(parent, b3DeferredKey, deferred)
+}
+
+// b3DeferredFromContext returns whether the B3 sampling decision stored in
+// ctx is deferred.
+func b3DeferredFromContext(ctx context.Context) bool {
+	deferred, _ := ctx.Value(b3DeferredKey).(bool)
+	return deferred
+}
@@ -0,0 +1,287 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	b3TraceID64Str = "a3ce929d0e0e4736"
+	b3ParentStr    = "00f067aa0ba90200"
+)
+
+var (
+	b3TraceID64 = mustTraceIDFromHex("0000000000000000" + b3TraceID64Str)
+
+	b3SC = trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+		Remote:  true,
+	})
+	b3SampledSC = b3SC.WithTraceFlags(trace.FlagsSampled)
+)
+
+func TestB3Extract(t *testing.T) {
+	tests := []struct {
+		name    string
+		carrier propagation.MapCarrier
+		want    trace.SpanContext
+	}{
+		{
+			name:    "single not sampled",
+			carrier: propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr + "-0"},
+			want:    b3SC,
+		},
+		{
+			name:    "single sampled",
+			carrier: propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr + "-1"},
+			want:    b3SampledSC,
+		},
+		{
+			name:    "single debug",
+			carrier: propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr + "-d"},
+			want:    b3SampledSC,
+		},
+		{
+			name:    "single deferred",
+			carrier: propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr},
+			want:    b3SC,
+		},
+		{
+			name:    "single with parent",
+			carrier: propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr + "-1-" + b3ParentStr},
+			want:    b3SampledSC,
+		},
+		{
+			name:    "single 64-bit trace ID",
+			carrier: propagation.MapCarrier{"b3": b3TraceID64Str + "-" + spanIDStr + "-1"},
+			want:    b3SampledSC.WithTraceID(b3TraceID64),
+		},
+		{
+			name: "multiple sampled",
+			carrier: propagation.MapCarrier{
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-sampled": "1",
+			},
+			want: b3SampledSC,
+		},
+		{
+			name: "multiple legacy not sampled",
+			carrier: propagation.MapCarrier{
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-sampled": "false",
+			},
+			want: b3SC,
+		},
+		{
+			name: "multiple debug",
+			carrier: propagation.MapCarrier{
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-flags":   "1",
+			},
+			want: b3SampledSC,
+		},
+		{
+			name: "multiple with parent and 64-bit trace ID",
+			carrier: propagation.MapCarrier{
+				"x-b3-traceid":      b3TraceID64Str,
+				"x-b3-spanid":       spanIDStr,
+				"x-b3-parentspanid": b3ParentStr,
+				"x-b3-sampled":      "0",
+			},
+			want: b3SC.WithTraceID(b3TraceID64),
+		},
+		{
+			name: "single takes precedence",
+			carrier: propagation.MapCarrier{
+				"b3":           traceIDStr + "-" + spanIDStr + "-1",
+				"x-b3-traceid": b3TraceID64Str,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-sampled": "0",
+			},
+			want: b3SampledSC,
+		},
+		{
+			name: "invalid single falls back to multiple",
+			carrier: propagation.MapCarrier{
+				"b3":           "invalid",
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-sampled": "1",
+			},
+			want: b3SampledSC,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := propagation.B3{}.Extract(context.Background(), tc.carrier)
+			assert.Equal(t, tc.want, trace.SpanContextFromContext(ctx))
+		})
+	}
+}
+
+func TestB3ExtractInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		carrier propagation.MapCarrier
+	}{
+		{"empty", propagation.MapCarrier{}},
+		{"single sampling only", propagation.MapCarrier{"b3": "1"}},
+		{"single trace ID only", propagation.MapCarrier{"b3": traceIDStr}},
+		{"single bad trace ID", propagation.MapCarrier{"b3": "xx" + traceIDStr[2:] + "-" + spanIDStr + "-1"}},
+		{"single bad span ID", propagation.MapCarrier{"b3": traceIDStr + "-xx" + spanIDStr[2:] + "-1"}},
+		{"single trailing separator", propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr + "-"}},
+		{"single bad sampling", propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr + "-x"}},
+		{"single parent without sampling", propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr + "-" + b3ParentStr}},
+		{"single bad parent", propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr + "-1-xx" + b3ParentStr[2:]}},
+		{"single short", propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr[:4]}},
+		{"multiple trace ID only", propagation.MapCarrier{"x-b3-traceid": traceIDStr}},
+		{"multiple span ID only", propagation.MapCarrier{"x-b3-spanid": spanIDStr}},
+		{
+			"multiple bad sampled",
+			propagation.MapCarrier{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr, "x-b3-sampled": "2"},
+		},
+		{
+			"multiple bad parent",
+			propagation.MapCarrier{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr, "x-b3-parentspanid": "x"},
+		},
+		{"multiple parent only", propagation.MapCarrier{"x-b3-parentspanid": b3ParentStr}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			assert.Equal(t, ctx, propagation.B3{}.Extract(ctx, tc.carrier))
+		})
+	}
+}
+
+func TestB3Inject(t *testing.T) {
+	tests := []struct {
+		name string
+		prop propagation.B3
+		sc   trace.SpanContext
+		want propagation.MapCarrier
+	}{
+		{
+			name: "default single header",
+			prop: propagation.B3{},
+			sc:   b3SampledSC,
+			want: propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr + "-1"},
+		},
+		{
+			name: "single header not sampled",
+			prop: propagation.NewB3(propagation.WithB3InjectEncoding(propagation.B3SingleHeader)),
+			sc:   b3SC,
+			want: propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr + "-0"},
+		},
+		{
+			name: "multiple header",
+			prop: propagation.NewB3(propagation.WithB3InjectEncoding(propagation.B3MultipleHeader)),
+			sc:   b3SampledSC,
+			want: propagation.MapCarrier{
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-sampled": "1",
+			},
+		},
+		{
+			name: "both",
+			prop: propagation.NewB3(propagation.WithB3InjectEncoding(propagation.B3SingleHeader | propagation.B3MultipleHeader)),
+			sc:   b3SC,
+			want: propagation.MapCarrier{
+				"b3":           traceIDStr + "-" + spanIDStr + "-0",
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-sampled": "0",
+			},
+		},
+		{
+			name: "invalid span context",
+			prop: propagation.B3{},
+			sc:   trace.SpanContext{},
+			want: propagation.MapCarrier{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			carrier := propagation.MapCarrier{}
+			ctx := trace.ContextWithSpanContext(context.Background(), tc.sc)
+			tc.prop.Inject(ctx, carrier)
+			assert.Equal(t, tc.want, carrier)
+		})
+	}
+}
+
+func TestB3RoundTripFlags(t *testing.T) {
+	prop := propagation.NewB3(propagation.WithB3InjectEncoding(propagation.B3SingleHeader | propagation.B3MultipleHeader))
+
+	tests := []struct {
+		name string
+		in   propagation.MapCarrier
+		want propagation.MapCarrier
+	}{
+		{
+			name: "debug",
+			in:   propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr + "-d"},
+			want: propagation.MapCarrier{
+				"b3":           traceIDStr + "-" + spanIDStr + "-d",
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-flags":   "1",
+			},
+		},
+		{
+			name: "deferred",
+			in: propagation.MapCarrier{
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+			},
+			want: propagation.MapCarrier{
+				"b3":           traceIDStr + "-" + spanIDStr,
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := prop.Extract(context.Background(), tc.in)
+			got := propagation.MapCarrier{}
+			prop.Inject(ctx, got)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestB3Fields(t *testing.T) {
+	assert.Equal(t, []string{"b3"}, propagation.B3{}.Fields())
+
+	multi := propagation.NewB3(propagation.WithB3InjectEncoding(propagation.B3MultipleHeader))
+	assert.Equal(t, []string{"x-b3-traceid", "x-b3-spanid", "x-b3-sampled", "x-b3-flags"}, multi.Fields())
+}
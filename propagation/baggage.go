@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/internal/handler"
 )
 
 const baggageHeader = "baggage"
@@ -26,13 +27,52 @@ const baggageHeader = "baggage"
 //
 // This propagates user-defined baggage associated with a trace. The complete
 // specification is defined at https://www.w3.org/TR/baggage/.
-type Baggage struct{}
+//
+// The zero value injects baggage within the limits defined by the W3C Baggage
+// specification. Use NewBaggage to configure other limits.
+type Baggage struct {
+	limits baggage.Limits
+}
 
 var _ TextMapPropagator = Baggage{}
 
+// BaggageOption configures a Baggage propagator.
+type BaggageOption interface {
+	apply(Baggage) Baggage
+}
+
+type baggageOptionFunc func(Baggage) Baggage
+
+func (fn baggageOptionFunc) apply(b Baggage) Baggage {
+	return fn(b)
+}
+
+// WithBaggageLimits sets the limits baggage is held to when it is injected.
+// Baggage exceeding limits is truncated as described by
+// baggage.Baggage.Truncate, and the removed list-members are reported to the
+// global ErrorHandler.
+func WithBaggageLimits(limits baggage.Limits) BaggageOption {
+	return baggageOptionFunc(func(b Baggage) Baggage {
+		b.limits = limits
+		return b
+	})
+}
+
+// NewBaggage returns a Baggage propagator configured with opts.
+func NewBaggage(opts ...BaggageOption) Baggage {
+	var b Baggage
+	for _, opt := range opts {
+		b = opt.apply(b)
+	}
+	return b
+}
+
 // Inject sets baggage key-values from ctx into the carrier.
 func (b Baggage) Inject(ctx context.Context, carrier TextMapCarrier) {
-	bStr := baggage.FromContext(ctx).String()
+	bStr, err := baggage.FromContext(ctx).TruncatedString(b.limits)
+	if err != nil {
+		handler.Handle(err)
+	}
 	if bStr != "" {
 		carrier.Set(baggageHeader, bStr)
 	}
@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/internal/handler"
 	"go.opentelemetry.io/otel/propagation"
 )
 
@@ -248,6 +249,30 @@ func TestInjectBaggageToHTTPReq(t *testing.T) {
 	}
 }
 
+func TestInjectBaggageLimits(t *testing.T) {
+	var handled []error
+	handler.SetHandleFunc(func(err error) { handled = append(handled, err) })
+	t.Cleanup(func() { handler.SetHandleFunc(nil) })
+
+	bag := members{
+		{Key: "key1", Value: "val1"},
+		{Key: "key2", Value: "val2"},
+		{Key: "key3", Value: "val3"},
+	}.Baggage(t)
+	ctx := baggage.ContextWithBaggage(context.Background(), bag)
+
+	carrier := propagation.MapCarrier{}
+	propagation.Baggage{}.Inject(ctx, carrier)
+	assert.ElementsMatch(t, []string{"key1=val1", "key2=val2", "key3=val3"}, strings.Split(carrier.Get("baggage"), ","))
+	assert.Empty(t, handled)
+
+	limits := baggage.Limits{MaxMembers: 2}
+	carrier = propagation.MapCarrier{}
+	propagation.NewBaggage(propagation.WithBaggageLimits(limits)).Inject(ctx, carrier)
+	assert.ElementsMatch(t, []string{"key1=val1", "key2=val2"}, strings.Split(carrier.Get("baggage"), ","))
+	assert.Len(t, handled, 1)
+}
+
 func TestBaggagePropagatorGetAllKeys(t *testing.T) {
 	var propagator propagation.Baggage
 	want := []string{"baggage"}
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation // import "go.opentelemetry.io/otel/propagation"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// BinaryPropagator propagates cross-cutting concerns as binary data. It is
+// intended for transports with binary headers, e.g. gRPC metadata keys
+// ending in "-bin" or message-queue headers.
+type BinaryPropagator interface {
+	// Inject returns the binary encoding of the cross-cutting concerns in
+	// ctx. Nil is returned if ctx contains nothing to propagate.
+	Inject(ctx context.Context) []byte
+
+	// Extract reads cross-cutting concerns from data into a Context.
+	Extract(ctx context.Context, data []byte) context.Context
+}
+
+const (
+	binaryVersion = 0
+
+	binaryTraceIDField    = 0
+	binarySpanIDField     = 1
+	binaryTraceFlagsField = 2
+
+	// binaryTraceContextLen is the length of the encoded version, all field
+	// IDs, and their values.
+	binaryTraceContextLen = 1 + 1 + len(trace.TraceID{}) + 1 + len(trace.SpanID{}) + 1 + 1
+)
+
+// BinaryTraceContext is a BinaryPropagator that supports the binary trace
+// context format (https://w3c.github.io/trace-context-binary/), also used by
+// the OpenCensus "grpc-trace-bin" gRPC metadata.
+//
+// The encoding is a version byte followed by field ID prefixed trace ID,
+// span ID, and trace flags values.
+type BinaryTraceContext struct{}
+
+var _ BinaryPropagator = BinaryTraceContext{}
+
+// Inject returns the binary encoding of the SpanContext in ctx. Nil is
+// returned if ctx does not contain a valid SpanContext.
+func (BinaryTraceContext) Inject(ctx context.Context) []byte {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return nil
+	}
+
+	var b [binaryTraceContextLen]byte
+	b[0] = binaryVersion
+	b[1] = binaryTraceIDField
+	tID, sID := sc.TraceID(), sc.SpanID()
+	n := 2 + copy(b[2:], tID[:])
+	b[n] = binarySpanIDField
+	n += 1 + copy(b[n+1:], sID[:])
+	b[n] = binaryTraceFlagsField
+	// Clear all flags other than the supported sampling bit.
+	b[n+1] = byte(sc.TraceFlags() & trace.FlagsSampled)
+	return b[:]
+}
+
+// Extract reads the binary encoded SpanContext from data into a returned
+// Context.
+//
+// The returned Context will be a copy of ctx and contain the extracted
+// SpanContext as the remote SpanContext. If data does not contain a valid
+// SpanContext, the passed ctx will be returned directly instead.
+func (BinaryTraceContext) Extract(ctx context.Context, data []byte) context.Context {
+	sc := extractBinary(data)
+	if !sc.IsValid() {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// extractBinary decodes a SpanContext from data. An invalid SpanContext is
+// returned if data cannot be decoded.
+//
+// Fields are decoded in order. Unknown fields following the known fields are
+// ignored so future versions of the format can append fields.
+func extractBinary(data []byte) trace.SpanContext {
+	if len(data) == 0 || data[0] != binaryVersion {
+		return trace.SpanContext{}
+	}
+	data = data[1:]
+
+	scc := trace.SpanContextConfig{Remote: true}
+	if len(data) < 1+len(scc.TraceID) || data[0] != binaryTraceIDField {
+		return trace.SpanContext{}
+	}
+	data = data[1+copy(scc.TraceID[:], data[1:]):]
+
+	if len(data) < 1+len(scc.SpanID) || data[0] != binarySpanIDField {
+		return trace.SpanContext{}
+	}
+	data = data[1+copy(scc.SpanID[:], data[1:]):]
+
+	// The trace flags are optional.
+	if len(data) >= 2 && data[0] == binaryTraceFlagsField {
+		scc.TraceFlags = trace.TraceFlags(data[1]) & trace.FlagsSampled
+	}
+	return trace.NewSpanContext(scc)
+}
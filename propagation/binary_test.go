@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var binaryTraceContext = []byte{
+	0,
+	0, 0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36,
+	1, 0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7,
+	2, 1,
+}
+
+func TestBinaryTraceContextInject(t *testing.T) {
+	prop := propagation.BinaryTraceContext{}
+
+	assert.Nil(t, prop.Inject(context.Background()), "empty context")
+
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled | 0xf0,
+	}))
+	assert.Equal(t, binaryTraceContext, prop.Inject(ctx))
+
+	ctx = trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+	}))
+	want := append([]byte{}, binaryTraceContext...)
+	want[len(want)-1] = 0
+	assert.Equal(t, want, prop.Inject(ctx))
+}
+
+func TestBinaryTraceContextExtract(t *testing.T) {
+	sampled := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+	unsampled := sampled.WithTraceFlags(0)
+
+	tests := []struct {
+		name string
+		data []byte
+		want trace.SpanContext
+	}{
+		{name: "nil"},
+		{name: "empty", data: []byte{}},
+		{name: "valid", data: binaryTraceContext, want: sampled},
+		{name: "without flags", data: binaryTraceContext[:27], want: unsampled},
+		{
+			name: "unknown flags cleared",
+			data: append(append([]byte{}, binaryTraceContext[:28]...), 0xff),
+			want: sampled,
+		},
+		{
+			name: "trailing fields ignored",
+			data: append(append([]byte{}, binaryTraceContext...), 3, 0xff),
+			want: sampled,
+		},
+		{
+			name: "unsupported version",
+			data: append([]byte{1}, binaryTraceContext[1:]...),
+		},
+		{name: "truncated trace ID", data: binaryTraceContext[:10]},
+		{name: "missing span ID", data: binaryTraceContext[:18]},
+		{name: "truncated span ID", data: binaryTraceContext[:22]},
+		{
+			name: "wrong field order",
+			data: append(append([]byte{0}, binaryTraceContext[18:27]...), binaryTraceContext[1:18]...),
+		},
+		{
+			name: "invalid trace ID",
+			data: append(append([]byte{0, 0}, make([]byte, 16)...), binaryTraceContext[18:]...),
+		},
+	}
+
+	prop := propagation.BinaryTraceContext{}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := prop.Extract(context.Background(), tc.data)
+			assert.Equal(t, tc.want, trace.SpanContextFromContext(ctx))
+		})
+	}
+}
+
+func TestBinaryTraceContextRoundTrip(t *testing.T) {
+	prop := propagation.BinaryTraceContext{}
+	ctx := prop.Extract(context.Background(), binaryTraceContext)
+	assert.Equal(t, binaryTraceContext, prop.Inject(ctx))
+}
@@ -16,9 +16,14 @@
 Package propagation contains OpenTelemetry context propagators.
 
 OpenTelemetry propagators are used to extract and inject context data from and
-into messages exchanged by applications. The propagator supported by this
-package is the W3C Trace Context encoding
-(https://www.w3.org/TR/trace-context/), and W3C Baggage
-(https://www.w3.org/TR/baggage/).
+into messages exchanged by applications. The propagators supported by this
+package are the W3C Trace Context encoding
+(https://www.w3.org/TR/trace-context/), W3C Baggage
+(https://www.w3.org/TR/baggage/), and B3
+(https://github.com/openzipkin/b3-propagation).
+
+The W3C Trace Context binary encoding
+(https://w3c.github.io/trace-context-binary/) is also supported for
+transports that carry binary data, see BinaryTraceContext.
 */
 package propagation // import "go.opentelemetry.io/otel/propagation"
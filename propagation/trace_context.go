@@ -17,8 +17,7 @@ package propagation // import "go.opentelemetry.io/otel/propagation"
 import (
 	"context"
 	"encoding/hex"
-	"fmt"
-	"regexp"
+	"strings"
 
 	"go.opentelemetry.io/otel/trace"
 )
@@ -30,6 +29,16 @@ const (
 	tracestateHeader  = "tracestate"
 )
 
+// Offsets of the fields in a traceparent header value, e.g.
+// "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01".
+const (
+	versionOffset    = 0
+	traceIDOffset    = versionOffset + 2 + 1
+	spanIDOffset     = traceIDOffset + 32 + 1
+	traceFlagsOffset = spanIDOffset + 16 + 1
+	traceparentLen   = traceFlagsOffset + 2
+)
+
 // TraceContext is a propagator that supports the W3C Trace Context format
 // (https://www.w3.org/TR/trace-context/)
 //
@@ -41,7 +50,6 @@ const (
 type TraceContext struct{}
 
 var _ TextMapPropagator = TraceContext{}
-var traceCtxRegExp = regexp.MustCompile("^(?P<version>[0-9a-f]{2})-(?P<traceID>[a-f0-9]{32})-(?P<spanID>[a-f0-9]{16})-(?P<traceFlags>[a-f0-9]{2})(?:-.*)?$")
 
 // Inject set tracecontext from the Context into the carrier.
 func (tc TraceContext) Inject(ctx context.Context, carrier TextMapCarrier) {
@@ -57,12 +65,16 @@ func (tc TraceContext) Inject(ctx context.Context, carrier TextMapCarrier) {
 	// Clear all flags other than the trace-context supported sampling bit.
 	flags := sc.TraceFlags() & trace.FlagsSampled
 
-	h := fmt.Sprintf("%.2x-%s-%s-%s",
-		supportedVersion,
-		sc.TraceID(),
-		sc.SpanID(),
-		flags)
-	carrier.Set(traceparentHeader, h)
+	var h [traceparentLen]byte
+	tID, sID := sc.TraceID(), sc.SpanID()
+	hex.Encode(h[versionOffset:], []byte{supportedVersion})
+	h[traceIDOffset-1] = '-'
+	hex.Encode(h[traceIDOffset:], tID[:])
+	h[spanIDOffset-1] = '-'
+	hex.Encode(h[spanIDOffset:], sID[:])
+	h[traceFlagsOffset-1] = '-'
+	hex.Encode(h[traceFlagsOffset:], []byte{byte(flags)})
+	carrier.Set(traceparentHeader, string(h[:]))
 }
 
 // Extract reads tracecontext from the carrier into a returned Context.
@@ -84,21 +96,21 @@ func (tc TraceContext) extract(carrier TextMapCarrier) trace.SpanContext {
 		return trace.SpanContext{}
 	}
 
-	matches := traceCtxRegExp.FindStringSubmatch(h)
-
-	if len(matches) == 0 {
+	// The header is parsed in place to avoid allocating intermediate strings
+	// on this hot path. Any data following the known fields must be
+	// separated by a dash and is ignored so future versions can be parsed.
+	if len(h) < traceparentLen ||
+		h[traceIDOffset-1] != '-' ||
+		h[spanIDOffset-1] != '-' ||
+		h[traceFlagsOffset-1] != '-' {
 		return trace.SpanContext{}
 	}
-
-	if len(matches) < 5 { // four subgroups plus the overall match
+	if len(h) > traceparentLen && (h[traceparentLen] != '-' || strings.IndexByte(h[traceparentLen:], '\n') >= 0) {
 		return trace.SpanContext{}
 	}
 
-	if len(matches[1]) != 2 {
-		return trace.SpanContext{}
-	}
-	ver, err := hex.DecodeString(matches[1])
-	if err != nil {
+	var ver [1]byte
+	if !decodeHex(ver[:], h[versionOffset:traceIDOffset-1]) {
 		return trace.SpanContext{}
 	}
 	version := int(ver[0])
@@ -106,34 +118,16 @@ func (tc TraceContext) extract(carrier TextMapCarrier) trace.SpanContext {
 		return trace.SpanContext{}
 	}
 
-	if version == 0 && len(matches) != 5 { // four subgroups plus the overall match
-		return trace.SpanContext{}
-	}
-
-	if len(matches[2]) != 32 {
-		return trace.SpanContext{}
-	}
-
 	var scc trace.SpanContextConfig
-
-	scc.TraceID, err = trace.TraceIDFromHex(matches[2][:32])
-	if err != nil {
-		return trace.SpanContext{}
-	}
-
-	if len(matches[3]) != 16 {
+	if !decodeHex(scc.TraceID[:], h[traceIDOffset:spanIDOffset-1]) {
 		return trace.SpanContext{}
 	}
-	scc.SpanID, err = trace.SpanIDFromHex(matches[3])
-	if err != nil {
+	if !decodeHex(scc.SpanID[:], h[spanIDOffset:traceFlagsOffset-1]) {
 		return trace.SpanContext{}
 	}
 
-	if len(matches[4]) != 2 {
-		return trace.SpanContext{}
-	}
-	opts, err := hex.DecodeString(matches[4])
-	if err != nil || len(opts) < 1 || (version == 0 && opts[0] > 2) {
+	var opts [1]byte
+	if !decodeHex(opts[:], h[traceFlagsOffset:traceparentLen]) || (version == 0 && opts[0] > 2) {
 		return trace.SpanContext{}
 	}
 	// Clear all flags other than the trace-context supported sampling bit.
@@ -153,6 +147,38 @@ func (tc TraceContext) extract(carrier TextMapCarrier) trace.SpanContext {
 	return sc
 }
 
+// decodeHex decodes the lowercase hex encoded src into dst. It returns false
+// if src is not exactly twice the length of dst or contains characters other
+// than lowercase hex digits.
+func decodeHex(dst []byte, src string) bool {
+	if len(src) != 2*len(dst) {
+		return false
+	}
+	for i := range dst {
+		hi, ok := fromHexChar(src[2*i])
+		if !ok {
+			return false
+		}
+		lo, ok := fromHexChar(src[2*i+1])
+		if !ok {
+			return false
+		}
+		dst[i] = hi<<4 | lo
+	}
+	return true
+}
+
+// fromHexChar converts a lowercase hex character into its value.
+func fromHexChar(c byte) (byte, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0', true
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10, true
+	}
+	return 0, false
+}
+
 // Fields returns the keys who's values are set with Inject.
 func (tc TraceContext) Fields() []string {
 	return []string{traceparentHeader, tracestateHeader}
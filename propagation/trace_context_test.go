@@ -252,6 +252,18 @@ func TestExtractInvalidTraceContextFromHTTPReq(t *testing.T) {
 			name:   "empty options",
 			header: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-",
 		},
+		{
+			name:   "wrong separator",
+			header: "00_4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		},
+		{
+			name:   "future data without separator",
+			header: "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01XYZ",
+		},
+		{
+			name:   "version ff",
+			header: "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		},
 	}
 
 	empty := trace.SpanContext{}
@@ -351,3 +363,26 @@ func TestTraceContextFields(t *testing.T) {
 	expected := []string{"traceparent", "tracestate"}
 	assert.Equal(t, expected, propagation.TraceContext{}.Fields())
 }
+
+func TestTraceContextAllocs(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+	carrier := propagation.MapCarrier{}
+
+	// Only the traceparent value passed to the carrier is allocated.
+	allocs := testing.AllocsPerRun(100, func() {
+		prop.Inject(ctx, carrier)
+	})
+	assert.Equal(t, 1.0, allocs, "Inject")
+
+	// Only storing the SpanContext in the returned Context allocates.
+	parent := context.Background()
+	allocs = testing.AllocsPerRun(100, func() {
+		_ = prop.Extract(parent, carrier)
+	})
+	assert.Equal(t, 2.0, allocs, "Extract")
+}
@@ -0,0 +1,329 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/internal/global"
+)
+
+// Defaults for BatchProcessorOptions.
+const (
+	DefaultMaxQueueSize       = 2048
+	DefaultExportInterval     = 1000
+	DefaultExportTimeout      = 30000
+	DefaultMaxExportBatchSize = 512
+)
+
+// BatchProcessorOption configures a BatchProcessor.
+type BatchProcessorOption func(o *BatchProcessorOptions)
+
+// BatchProcessorOptions is configuration settings for a BatchProcessor.
+type BatchProcessorOptions struct {
+	// MaxQueueSize is the maximum number of records buffered for delayed
+	// export. If the queue is full, emitted records are dropped.
+	// The default value of MaxQueueSize is 2048.
+	MaxQueueSize int
+
+	// ExportInterval is the maximum duration between two exports. Records
+	// are exported when the interval elapses, or sooner if a full batch of
+	// records is queued.
+	// The default value of ExportInterval is 1000 msec.
+	ExportInterval time.Duration
+
+	// ExportTimeout specifies the maximum duration for exporting records. If
+	// the timeout is reached, the export will be cancelled.
+	// The default value of ExportTimeout is 30000 msec.
+	ExportTimeout time.Duration
+
+	// MaxExportBatchSize is the maximum number of records exported at once.
+	// It is limited to MaxQueueSize.
+	// The default value of MaxExportBatchSize is 512.
+	MaxExportBatchSize int
+}
+
+// batchProcessor is a Processor that batches asynchronously-received
+// records and sends them to an Exporter when complete.
+type batchProcessor struct {
+	e Exporter
+	o BatchProcessorOptions
+
+	queue   chan Record
+	dropped uint32
+
+	flushCh  chan flushRequest
+	stopCh   chan struct{}
+	done     chan struct{}
+	stopped  int32
+	stopOnce sync.Once
+	stopErr  error
+}
+
+// flushRequest is a request for the batchProcessor to export all queued
+// records.
+type flushRequest struct {
+	ctx    context.Context
+	result chan error
+}
+
+var _ Processor = (*batchProcessor)(nil)
+
+// NewBatchProcessor creates a new Processor that will send completed batches
+// of records to exporter.
+//
+// The BatchProcessor is configured by the OTEL_BLRP_SCHEDULE_DELAY,
+// OTEL_BLRP_EXPORT_TIMEOUT, OTEL_BLRP_MAX_QUEUE_SIZE, and
+// OTEL_BLRP_MAX_EXPORT_BATCH_SIZE environment variables if they are set. The
+// passed options override these values.
+func NewBatchProcessor(exporter Exporter, options ...BatchProcessorOption) Processor {
+	o := BatchProcessorOptions{
+		MaxQueueSize:       envInt(DefaultMaxQueueSize, envMaxQueueSize),
+		ExportInterval:     envDuration(envScheduleDelay, DefaultExportInterval*time.Millisecond),
+		ExportTimeout:      envDuration(envExportTimeout, DefaultExportTimeout*time.Millisecond),
+		MaxExportBatchSize: envInt(DefaultMaxExportBatchSize, envMaxExportBatchSize),
+	}
+	for _, opt := range options {
+		opt(&o)
+	}
+	if o.MaxQueueSize <= 0 {
+		o.MaxQueueSize = DefaultMaxQueueSize
+	}
+	if o.ExportInterval <= 0 {
+		o.ExportInterval = DefaultExportInterval * time.Millisecond
+	}
+	if o.ExportTimeout <= 0 {
+		o.ExportTimeout = DefaultExportTimeout * time.Millisecond
+	}
+	if o.MaxExportBatchSize <= 0 {
+		o.MaxExportBatchSize = DefaultMaxExportBatchSize
+	}
+	if o.MaxExportBatchSize > o.MaxQueueSize {
+		o.MaxExportBatchSize = o.MaxQueueSize
+	}
+
+	bp := &batchProcessor{
+		e:       exporter,
+		o:       o,
+		queue:   make(chan Record, o.MaxQueueSize),
+		flushCh: make(chan flushRequest),
+		stopCh:  make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go func() {
+		defer close(bp.done)
+		bp.run()
+	}()
+	return bp
+}
+
+// OnEmit queues record to be exported. If the queue is full, record is
+// dropped.
+func (bp *batchProcessor) OnEmit(_ context.Context, record Record) {
+	// Do not queue records if they are just going to be dropped.
+	if bp.e == nil || atomic.LoadInt32(&bp.stopped) != 0 {
+		return
+	}
+
+	select {
+	case bp.queue <- record:
+	default:
+		atomic.AddUint32(&bp.dropped, 1)
+	}
+}
+
+// Shutdown exports all queued records and shuts down the exporter. It only
+// executes once, subsequent calls return the result of the first call.
+func (bp *batchProcessor) Shutdown(ctx context.Context) error {
+	bp.stopOnce.Do(func() {
+		atomic.StoreInt32(&bp.stopped, 1)
+
+		wait := make(chan error, 1)
+		go func() {
+			close(bp.stopCh)
+			<-bp.done
+			if bp.e != nil {
+				wait <- bp.e.Shutdown(ctx)
+			}
+			close(wait)
+		}()
+		// Wait until the queue is exported and the exporter is shut down,
+		// or the context is cancelled.
+		select {
+		case bp.stopErr = <-wait:
+		case <-ctx.Done():
+			bp.stopErr = ctx.Err()
+		}
+	})
+	return bp.stopErr
+}
+
+// ForceFlush exports all queued records.
+func (bp *batchProcessor) ForceFlush(ctx context.Context) error {
+	if bp.e == nil || atomic.LoadInt32(&bp.stopped) != 0 {
+		return nil
+	}
+
+	req := flushRequest{ctx: ctx, result: make(chan error, 1)}
+	select {
+	case bp.flushCh <- req:
+	case <-bp.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-req.result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run batches queued records and exports them until the processor is shut
+// down.
+func (bp *batchProcessor) run() {
+	ticker := time.NewTicker(bp.o.ExportInterval)
+	defer ticker.Stop()
+
+	batch := make([]Record, 0, bp.o.MaxExportBatchSize)
+	for {
+		select {
+		case r := <-bp.queue:
+			batch = append(batch, r)
+			if len(batch) == bp.o.MaxExportBatchSize {
+				batch = bp.export(context.Background(), batch)
+				ticker.Reset(bp.o.ExportInterval)
+			}
+		case <-ticker.C:
+			batch = bp.export(context.Background(), batch)
+		case req := <-bp.flushCh:
+			var err error
+			batch, err = bp.drain(req.ctx, batch)
+			req.result <- err
+		case <-bp.stopCh:
+			_, _ = bp.drain(context.Background(), batch)
+			return
+		}
+	}
+}
+
+// drain exports batch and all queued records. It returns the emptied batch
+// and the first export error.
+func (bp *batchProcessor) drain(ctx context.Context, batch []Record) ([]Record, error) {
+	var err error
+	for {
+		select {
+		case r := <-bp.queue:
+			batch = append(batch, r)
+			if len(batch) < bp.o.MaxExportBatchSize {
+				continue
+			}
+		default:
+		}
+
+		var exportErr error
+		batch, exportErr = bp.exportErr(ctx, batch)
+		if err == nil {
+			err = exportErr
+		}
+		if len(bp.queue) == 0 {
+			return batch, err
+		}
+	}
+}
+
+// export exports batch and returns it emptied. Any export error is handled
+// with the global error handler.
+func (bp *batchProcessor) export(ctx context.Context, batch []Record) []Record {
+	batch, err := bp.exportErr(ctx, batch)
+	if err != nil {
+		otel.Handle(err)
+	}
+	return batch
+}
+
+// exportErr exports batch and returns it emptied along with any export
+// error.
+func (bp *batchProcessor) exportErr(ctx context.Context, batch []Record) ([]Record, error) {
+	if len(batch) == 0 {
+		return batch, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, bp.o.ExportTimeout)
+	defer cancel()
+
+	global.Debug("exporting log records", "count", len(batch), "total_dropped", atomic.LoadUint32(&bp.dropped))
+	err := bp.e.Export(ctx, batch)
+
+	// Exporters must not retain the batch, clear it so the records can be
+	// garbage collected and reuse it.
+	for i := range batch {
+		batch[i] = Record{}
+	}
+	return batch[:0], err
+}
+
+// MarshalLog is the marshaling function used by the logging system to represent this Processor.
+func (bp *batchProcessor) MarshalLog() interface{} {
+	return struct {
+		Type     string
+		Exporter Exporter
+		Config   BatchProcessorOptions
+	}{
+		Type:     "BatchProcessor",
+		Exporter: bp.e,
+		Config:   bp.o,
+	}
+}
+
+// WithMaxQueueSize returns a BatchProcessorOption that configures the
+// maximum queue size allowed for a BatchProcessor.
+func WithMaxQueueSize(size int) BatchProcessorOption {
+	return func(o *BatchProcessorOptions) {
+		o.MaxQueueSize = size
+	}
+}
+
+// WithExportInterval returns a BatchProcessorOption that configures the
+// maximum delay allowed for a BatchProcessor before it will export any held
+// records (whether a full batch is queued or not).
+func WithExportInterval(interval time.Duration) BatchProcessorOption {
+	return func(o *BatchProcessorOptions) {
+		o.ExportInterval = interval
+	}
+}
+
+// WithExportTimeout returns a BatchProcessorOption that configures the amount
+// of time a BatchProcessor waits for an exporter to export before abandoning
+// the export.
+func WithExportTimeout(timeout time.Duration) BatchProcessorOption {
+	return func(o *BatchProcessorOptions) {
+		o.ExportTimeout = timeout
+	}
+}
+
+// WithMaxExportBatchSize returns a BatchProcessorOption that configures the
+// maximum export batch size allowed for a BatchProcessor.
+func WithMaxExportBatchSize(size int) BatchProcessorOption {
+	return func(o *BatchProcessorOptions) {
+		o.MaxExportBatchSize = size
+	}
+}
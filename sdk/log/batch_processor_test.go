@@ -0,0 +1,176 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+// blockingExporter is an Exporter that blocks exports until unblocked.
+type blockingExporter struct {
+	testExporter
+
+	unblock chan struct{}
+}
+
+func (e *blockingExporter) Export(ctx context.Context, records []Record) error {
+	select {
+	case <-e.unblock:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return e.testExporter.Export(ctx, records)
+}
+
+func TestNewBatchProcessorOptions(t *testing.T) {
+	bp := NewBatchProcessor(nil).(*batchProcessor)
+	t.Cleanup(func() { require.NoError(t, bp.Shutdown(context.Background())) })
+	assert.Equal(t, BatchProcessorOptions{
+		MaxQueueSize:       DefaultMaxQueueSize,
+		ExportInterval:     DefaultExportInterval * time.Millisecond,
+		ExportTimeout:      DefaultExportTimeout * time.Millisecond,
+		MaxExportBatchSize: DefaultMaxExportBatchSize,
+	}, bp.o)
+
+	t.Setenv(envMaxQueueSize, "10")
+	t.Setenv(envScheduleDelay, "20")
+	bp = NewBatchProcessor(nil, WithMaxExportBatchSize(100), WithExportTimeout(time.Second)).(*batchProcessor)
+	t.Cleanup(func() { require.NoError(t, bp.Shutdown(context.Background())) })
+	assert.Equal(t, BatchProcessorOptions{
+		MaxQueueSize:   10,
+		ExportInterval: 20 * time.Millisecond,
+		ExportTimeout:  time.Second,
+		// Limited to the queue size.
+		MaxExportBatchSize: 10,
+	}, bp.o)
+}
+
+func TestBatchProcessorExportsFullBatch(t *testing.T) {
+	exp := new(testExporter)
+	bp := NewBatchProcessor(exp, WithMaxExportBatchSize(2), WithExportInterval(time.Hour))
+	t.Cleanup(func() { require.NoError(t, bp.Shutdown(context.Background())) })
+
+	for i := 0; i < 4; i++ {
+		bp.OnEmit(context.Background(), Record{})
+	}
+	assert.Eventually(t, func() bool {
+		return len(exp.Records()) == 4
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, 2, exp.Exports())
+}
+
+func TestBatchProcessorExportInterval(t *testing.T) {
+	exp := new(testExporter)
+	bp := NewBatchProcessor(exp, WithExportInterval(time.Millisecond))
+	t.Cleanup(func() { require.NoError(t, bp.Shutdown(context.Background())) })
+
+	bp.OnEmit(context.Background(), Record{})
+	assert.Eventually(t, func() bool {
+		return len(exp.Records()) == 1
+	}, time.Second, time.Millisecond)
+}
+
+func TestBatchProcessorForceFlush(t *testing.T) {
+	exp := new(testExporter)
+	bp := NewBatchProcessor(exp, WithMaxExportBatchSize(2), WithExportInterval(time.Hour))
+	t.Cleanup(func() { require.NoError(t, bp.Shutdown(context.Background())) })
+
+	for i := 0; i < 5; i++ {
+		bp.OnEmit(context.Background(), Record{Record: log.Record{Severity: log.Severity(i)}})
+	}
+	require.NoError(t, bp.ForceFlush(context.Background()))
+	got := exp.Records()
+	require.Len(t, got, 5)
+	for i, r := range got {
+		assert.Equal(t, log.Severity(i), r.Severity, "export order")
+	}
+
+	exp.err = errors.New("export failed")
+	bp.OnEmit(context.Background(), Record{})
+	assert.ErrorIs(t, bp.ForceFlush(context.Background()), exp.err)
+}
+
+func TestBatchProcessorForceFlushHonorsContext(t *testing.T) {
+	exp := &blockingExporter{unblock: make(chan struct{})}
+	bp := NewBatchProcessor(exp, WithExportInterval(time.Hour))
+	t.Cleanup(func() {
+		close(exp.unblock)
+		require.NoError(t, bp.Shutdown(context.Background()))
+	})
+
+	bp.OnEmit(context.Background(), Record{})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, bp.ForceFlush(ctx), context.DeadlineExceeded)
+}
+
+func TestBatchProcessorDropsWhenQueueFull(t *testing.T) {
+	exp := &blockingExporter{unblock: make(chan struct{})}
+	bp := NewBatchProcessor(
+		exp,
+		WithMaxQueueSize(1),
+		WithMaxExportBatchSize(1),
+		WithExportInterval(time.Hour),
+	).(*batchProcessor)
+
+	// The first record is exported, and blocks the exporter. The second
+	// fills the queue.
+	bp.OnEmit(context.Background(), Record{})
+	require.Eventually(t, func() bool {
+		return len(bp.queue) == 0
+	}, time.Second, time.Millisecond)
+	bp.OnEmit(context.Background(), Record{})
+	bp.OnEmit(context.Background(), Record{})
+	bp.OnEmit(context.Background(), Record{})
+
+	close(exp.unblock)
+	require.NoError(t, bp.Shutdown(context.Background()))
+	assert.Len(t, exp.Records(), 2)
+	assert.Equal(t, uint32(2), bp.dropped)
+}
+
+func TestBatchProcessorShutdown(t *testing.T) {
+	exp := new(testExporter)
+	bp := NewBatchProcessor(exp, WithExportInterval(time.Hour))
+
+	bp.OnEmit(context.Background(), Record{})
+	require.NoError(t, bp.Shutdown(context.Background()))
+	assert.Len(t, exp.Records(), 1, "queued records not exported")
+	assert.True(t, exp.shutdown)
+
+	bp.OnEmit(context.Background(), Record{})
+	assert.NoError(t, bp.ForceFlush(context.Background()))
+	assert.Len(t, exp.Records(), 1, "exported after shutdown")
+	assert.NoError(t, bp.Shutdown(context.Background()))
+}
+
+func TestBatchProcessorShutdownHonorsContext(t *testing.T) {
+	exp := &blockingExporter{unblock: make(chan struct{})}
+	t.Cleanup(func() { close(exp.unblock) })
+	bp := NewBatchProcessor(exp, WithExportInterval(time.Hour))
+
+	bp.OnEmit(context.Background(), Record{})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, bp.Shutdown(ctx), context.DeadlineExceeded)
+}
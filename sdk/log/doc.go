@@ -0,0 +1,29 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package log provides the OpenTelemetry Logs SDK.
+
+The LoggerProvider is the implementation of the
+go.opentelemetry.io/otel/log LoggerProvider used by logging bridges to emit
+log records. Every record emitted by a Logger of the LoggerProvider is
+passed to the Processors of the LoggerProvider. A Processor is responsible
+for passing records to an Exporter, either synchronously, like the
+SimpleProcessor, or in batches, like the BatchProcessor.
+
+This package is currently in a pre-GA phase. Backwards incompatible changes
+may be introduced in subsequent minor version releases as we work to track the
+evolving OpenTelemetry specification and user feedback.
+*/
+package log // import "go.opentelemetry.io/otel/sdk/log"
@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/internal/global"
+)
+
+// Environment variable names.
+const (
+	// envLogRecordAttributeValueLength is the maximum allowed attribute
+	// value size of a log record.
+	envLogRecordAttributeValueLength = "OTEL_LOGRECORD_ATTRIBUTE_VALUE_LENGTH_LIMIT"
+	// envLogRecordAttributeCount is the maximum allowed attribute count of a
+	// log record.
+	envLogRecordAttributeCount = "OTEL_LOGRECORD_ATTRIBUTE_COUNT_LIMIT"
+	// envAttributeValueLength is the maximum allowed attribute value size
+	// of all signals.
+	envAttributeValueLength = "OTEL_ATTRIBUTE_VALUE_LENGTH_LIMIT"
+	// envAttributeCount is the maximum allowed attribute count of all
+	// signals.
+	envAttributeCount = "OTEL_ATTRIBUTE_COUNT_LIMIT"
+
+	// envScheduleDelay is the delay interval, in milliseconds, between two
+	// consecutive exports of a BatchProcessor (i.e. 1000).
+	envScheduleDelay = "OTEL_BLRP_SCHEDULE_DELAY"
+	// envExportTimeout is the maximum allowed time, in milliseconds, to
+	// export data for a BatchProcessor (i.e. 30000).
+	envExportTimeout = "OTEL_BLRP_EXPORT_TIMEOUT"
+	// envMaxQueueSize is the maximum queue size of a BatchProcessor (i.e.
+	// 2048).
+	envMaxQueueSize = "OTEL_BLRP_MAX_QUEUE_SIZE"
+	// envMaxExportBatchSize is the maximum batch size of a BatchProcessor
+	// (i.e. 512).
+	envMaxExportBatchSize = "OTEL_BLRP_MAX_EXPORT_BATCH_SIZE"
+)
+
+// envInt returns the integer value of the first environment variable of keys
+// that is set. If none are set, or the value is not an integer,
+// defaultValue is returned.
+func envInt(defaultValue int, keys ...string) int {
+	for _, key := range keys {
+		v, ok := os.LookupEnv(key)
+		if !ok {
+			continue
+		}
+
+		i, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			global.Info("invalid integer value", key, v)
+			return defaultValue
+		}
+		return i
+	}
+	return defaultValue
+}
+
+// envDuration returns the value of the environment variable key as a
+// duration in milliseconds. If the variable is not set, or its value is not a
+// positive integer, defaultValue is returned.
+func envDuration(key string, defaultValue time.Duration) time.Duration {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return defaultValue
+	}
+
+	d, err := strconv.Atoi(strings.TrimSpace(v))
+	if err != nil || d <= 0 {
+		global.Info("invalid duration, positive number of milliseconds expected", key, v)
+		return defaultValue
+	}
+	return time.Duration(d) * time.Millisecond
+}
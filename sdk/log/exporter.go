@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import "context"
+
+// Exporter handles the delivery of log records to external receivers. This
+// is the final component in the log export pipeline.
+type Exporter interface {
+	// Export serializes and transmits records to a receiver.
+	//
+	// This is called synchronously, there is no concurrency safety
+	// requirement. Because of this, it is critical that all timeouts and
+	// cancellations of the passed context be honored.
+	//
+	// Any retry logic must be contained in this function. The SDK does not
+	// implement any retry logic. All errors returned by this function are
+	// considered unrecoverable and will be reported to a configured error
+	// Handler.
+	//
+	// The records must not be modified or retained after Export returns.
+	Export(ctx context.Context, records []Record) error
+
+	// Shutdown flushes any records held by the exporter and releases any
+	// held computational resources. After Shutdown is called, the exporter
+	// is not expected to export any more records.
+	//
+	// This method needs to respect the deadline or cancellation of the
+	// passed context.
+	Shutdown(ctx context.Context) error
+}
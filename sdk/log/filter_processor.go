@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"math/rand"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+// filterConfig is the configuration of the records a filterProcessor
+// passes.
+type filterConfig struct {
+	// minSeverity is the minimum severity of passed records.
+	minSeverity log.Severity
+	// samplingRatio is the probability a record with a severity less than
+	// WARN is passed.
+	samplingRatio float64
+	// scopes contains the configuration of records emitted by Loggers with
+	// a scope name.
+	scopes map[string]filterConfig
+}
+
+func newFilterConfig(options []FilterProcessorOption) filterConfig {
+	cfg := filterConfig{samplingRatio: 1}
+	for _, o := range options {
+		cfg = o.apply(cfg)
+	}
+	return cfg
+}
+
+// FilterProcessorOption configures a FilterProcessor.
+type FilterProcessorOption interface {
+	apply(filterConfig) filterConfig
+}
+
+type filterProcessorOptionFunc func(filterConfig) filterConfig
+
+func (fn filterProcessorOptionFunc) apply(cfg filterConfig) filterConfig {
+	return fn(cfg)
+}
+
+// WithMinSeverity returns a FilterProcessorOption that configures a
+// FilterProcessor to drop records with a severity less than min. Records with
+// an undefined severity are not dropped.
+func WithMinSeverity(min log.Severity) FilterProcessorOption {
+	return filterProcessorOptionFunc(func(cfg filterConfig) filterConfig {
+		cfg.minSeverity = min
+		return cfg
+	})
+}
+
+// WithSamplingRatio returns a FilterProcessorOption that configures a
+// FilterProcessor to pass records with a severity less than WARN, including
+// an undefined severity, with the probability ratio. Records with a severity
+// of WARN or greater are always passed.
+//
+// A ratio greater than or equal to 1, which is the default, passes all
+// records. A ratio less than or equal to 0 drops all records with a severity
+// less than WARN.
+func WithSamplingRatio(ratio float64) FilterProcessorOption {
+	return filterProcessorOptionFunc(func(cfg filterConfig) filterConfig {
+		cfg.samplingRatio = ratio
+		return cfg
+	})
+}
+
+// WithScopeFilter returns a FilterProcessorOption that configures a
+// FilterProcessor to filter the records emitted by Loggers with the
+// instrumentation scope name using options instead of the options passed to
+// NewFilterProcessor. The options configure the filtering of those records
+// starting from the default of passing all of them.
+//
+// If this option is used more than once for the same name, the last options
+// are used.
+func WithScopeFilter(name string, options ...FilterProcessorOption) FilterProcessorOption {
+	return filterProcessorOptionFunc(func(cfg filterConfig) filterConfig {
+		scopes := make(map[string]filterConfig, len(cfg.scopes)+1)
+		for k, v := range cfg.scopes {
+			scopes[k] = v
+		}
+		scopes[name] = newFilterConfig(options)
+		cfg.scopes = scopes
+		return cfg
+	})
+}
+
+// filterProcessor is a Processor that passes the records that are not
+// filtered to another Processor.
+type filterProcessor struct {
+	next Processor
+	cfg  filterConfig
+
+	// random returns a pseudo-random number in [0.0,1.0).
+	random func() float64
+}
+
+var _ Processor = (*filterProcessor)(nil)
+
+// NewFilterProcessor returns a Processor that passes the records it does not
+// filter to next. The records are filtered based on their severity, as
+// configured by options:
+//
+//   - WithMinSeverity drops all records less severe than a minimum.
+//   - WithSamplingRatio samples the records less severe than WARN. This can
+//     be used to reduce the volume of DEBUG and INFO records while keeping
+//     all warnings and errors.
+//   - WithScopeFilter configures the filtering of the records from a specific
+//     instrumentation scope.
+//
+// By default, if no options are passed, all records are passed to next.
+//
+// Calls to ForceFlush and Shutdown are passed to next.
+func NewFilterProcessor(next Processor, options ...FilterProcessorOption) Processor {
+	return &filterProcessor{
+		next:   next,
+		cfg:    newFilterConfig(options),
+		random: rand.Float64,
+	}
+}
+
+// OnEmit passes record to the next Processor if it is not filtered.
+func (fp *filterProcessor) OnEmit(ctx context.Context, record Record) {
+	cfg := fp.cfg
+	if scoped, ok := fp.cfg.scopes[record.InstrumentationScope.Name]; ok {
+		cfg = scoped
+	}
+
+	s := record.Severity
+	if s != log.SeverityUndefined && s < cfg.minSeverity {
+		return
+	}
+	if s < log.SeverityWarn1 && cfg.samplingRatio < 1 {
+		if cfg.samplingRatio <= 0 || fp.random() >= cfg.samplingRatio {
+			return
+		}
+	}
+	fp.next.OnEmit(ctx, record)
+}
+
+// Shutdown shuts down the next Processor.
+func (fp *filterProcessor) Shutdown(ctx context.Context) error {
+	return fp.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the next Processor.
+func (fp *filterProcessor) ForceFlush(ctx context.Context) error {
+	return fp.next.ForceFlush(ctx)
+}
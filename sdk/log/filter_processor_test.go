@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+func severities(records []Record) []log.Severity {
+	out := make([]log.Severity, len(records))
+	for i, r := range records {
+		out[i] = r.Severity
+	}
+	return out
+}
+
+func emitAll(p Processor, scope string) {
+	for _, s := range []log.Severity{
+		log.SeverityUndefined,
+		log.SeverityTrace,
+		log.SeverityDebug,
+		log.SeverityInfo,
+		log.SeverityWarn,
+		log.SeverityError,
+	} {
+		p.OnEmit(context.Background(), Record{
+			Record:               log.Record{Severity: s},
+			InstrumentationScope: instrumentation.Scope{Name: scope},
+		})
+	}
+}
+
+func TestFilterProcessorDefault(t *testing.T) {
+	next := new(testProcessor)
+	emitAll(NewFilterProcessor(next), "")
+	assert.Len(t, next.records, 6)
+}
+
+func TestFilterProcessorMinSeverity(t *testing.T) {
+	next := new(testProcessor)
+	emitAll(NewFilterProcessor(next, WithMinSeverity(log.SeverityInfo)), "")
+	assert.Equal(t, []log.Severity{
+		log.SeverityUndefined,
+		log.SeverityInfo,
+		log.SeverityWarn,
+		log.SeverityError,
+	}, severities(next.records))
+}
+
+func TestFilterProcessorSampling(t *testing.T) {
+	next := new(testProcessor)
+	emitAll(NewFilterProcessor(next, WithSamplingRatio(0)), "")
+	assert.Equal(t, []log.Severity{
+		log.SeverityWarn,
+		log.SeverityError,
+	}, severities(next.records))
+
+	next = new(testProcessor)
+	fp := NewFilterProcessor(next, WithSamplingRatio(0.5)).(*filterProcessor)
+	var n int
+	fp.random = func() float64 {
+		// Alternate between sampled and dropped.
+		n++
+		return float64(n%2) * 0.5
+	}
+	emitAll(fp, "")
+	assert.Equal(t, []log.Severity{
+		log.SeverityTrace,
+		log.SeverityInfo,
+		log.SeverityWarn,
+		log.SeverityError,
+	}, severities(next.records))
+}
+
+func TestFilterProcessorScope(t *testing.T) {
+	next := new(testProcessor)
+	fp := NewFilterProcessor(
+		next,
+		WithMinSeverity(log.SeverityError),
+		WithScopeFilter("verbose"),
+		WithScopeFilter("quiet", WithSamplingRatio(0), WithMinSeverity(log.SeverityError)),
+	)
+
+	emitAll(fp, "other")
+	assert.Equal(t, []log.Severity{
+		log.SeverityUndefined,
+		log.SeverityError,
+	}, severities(next.records))
+
+	next.records = nil
+	emitAll(fp, "verbose")
+	assert.Len(t, next.records, 6)
+
+	next.records = nil
+	emitAll(fp, "quiet")
+	assert.Equal(t, []log.Severity{log.SeverityError}, severities(next.records))
+}
+
+func TestFilterProcessorForwards(t *testing.T) {
+	next := new(testProcessor)
+	fp := NewFilterProcessor(next)
+
+	assert.NoError(t, fp.ForceFlush(context.Background()))
+	assert.NoError(t, fp.Shutdown(context.Background()))
+	assert.Equal(t, 1, next.flushes)
+	assert.Equal(t, 1, next.shutdown)
+}
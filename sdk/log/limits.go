@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"unicode/utf8"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// DefaultAttributeValueLengthLimit is the default maximum allowed
+	// attribute value length, unlimited.
+	DefaultAttributeValueLengthLimit = -1
+
+	// DefaultAttributeCountLimit is the default maximum number of attributes
+	// a log record can have.
+	DefaultAttributeCountLimit = 128
+)
+
+// LogRecordLimits represents the limits of a log record.
+type LogRecordLimits struct {
+	// AttributeValueLengthLimit is the maximum allowed attribute value
+	// length.
+	//
+	// This limit only applies to string and string slice attribute values.
+	// Any string longer than this value will be truncated to this length.
+	//
+	// Setting this to a negative value means no limit is applied.
+	AttributeValueLengthLimit int
+
+	// AttributeCountLimit is the maximum allowed log record attribute count.
+	// Any attribute added to a log record once this limit is reached will be
+	// dropped.
+	//
+	// Setting this to zero means no attributes will be recorded.
+	//
+	// Setting this to a negative value means no limit is applied.
+	AttributeCountLimit int
+}
+
+// NewLogRecordLimits returns a LogRecordLimits with all limits set to the
+// value from their corresponding environment variable or the default value
+// if it is not set.
+//
+// The environment variables used are:
+//
+//   - OTEL_LOGRECORD_ATTRIBUTE_VALUE_LENGTH_LIMIT, falling back to
+//     OTEL_ATTRIBUTE_VALUE_LENGTH_LIMIT
+//   - OTEL_LOGRECORD_ATTRIBUTE_COUNT_LIMIT, falling back to
+//     OTEL_ATTRIBUTE_COUNT_LIMIT
+//
+// If an environment variable is set to an invalid value, the default value
+// is used.
+func NewLogRecordLimits() LogRecordLimits {
+	return LogRecordLimits{
+		AttributeValueLengthLimit: envInt(DefaultAttributeValueLengthLimit, envLogRecordAttributeValueLength, envAttributeValueLength),
+		AttributeCountLimit:       envInt(DefaultAttributeCountLimit, envLogRecordAttributeCount, envAttributeCount),
+	}
+}
+
+// apply returns the attributes of attrs that are within the limits, with
+// their values truncated, and the number of attributes dropped. The
+// attributes are copied, attrs is not modified.
+func (l LogRecordLimits) apply(attrs []attribute.KeyValue) ([]attribute.KeyValue, int) {
+	if len(attrs) == 0 {
+		return nil, 0
+	}
+
+	n := len(attrs)
+	if l.AttributeCountLimit >= 0 && n > l.AttributeCountLimit {
+		n = l.AttributeCountLimit
+	}
+	if n == 0 {
+		return nil, len(attrs)
+	}
+
+	out := make([]attribute.KeyValue, n)
+	for i, a := range attrs[:n] {
+		out[i] = truncateAttr(l.AttributeValueLengthLimit, a)
+	}
+	return out, len(attrs) - n
+}
+
+// truncateAttr returns attr with its string values truncated to limit bytes.
+// If limit is negative, attr is returned unmodified.
+func truncateAttr(limit int, attr attribute.KeyValue) attribute.KeyValue {
+	if limit < 0 {
+		return attr
+	}
+	switch attr.Value.Type() {
+	case attribute.STRING:
+		if v := attr.Value.AsString(); len(v) > limit {
+			return attr.Key.String(truncate(v, limit))
+		}
+	case attribute.STRINGSLICE:
+		v := attr.Value.AsStringSlice()
+		for i := range v {
+			v[i] = truncate(v[i], limit)
+		}
+		return attr.Key.StringSlice(v)
+	}
+	return attr
+}
+
+// truncate returns s truncated to at most limit bytes. A multi-byte UTF-8
+// character is never split, it is dropped entirely if it does not fit.
+func truncate(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	for limit > 0 && !utf8.RuneStart(s[limit]) {
+		limit--
+	}
+	return s[:limit]
+}
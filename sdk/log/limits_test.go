@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestNewLogRecordLimits(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want LogRecordLimits
+	}{
+		{
+			name: "defaults",
+			want: LogRecordLimits{
+				AttributeValueLengthLimit: DefaultAttributeValueLengthLimit,
+				AttributeCountLimit:       DefaultAttributeCountLimit,
+			},
+		},
+		{
+			name: "general",
+			env: map[string]string{
+				envAttributeValueLength: "10",
+				envAttributeCount:       "20",
+			},
+			want: LogRecordLimits{
+				AttributeValueLengthLimit: 10,
+				AttributeCountLimit:       20,
+			},
+		},
+		{
+			name: "log record",
+			env: map[string]string{
+				envAttributeValueLength:          "10",
+				envAttributeCount:                "20",
+				envLogRecordAttributeValueLength: "1",
+				envLogRecordAttributeCount:       "2",
+			},
+			want: LogRecordLimits{
+				AttributeValueLengthLimit: 1,
+				AttributeCountLimit:       2,
+			},
+		},
+		{
+			name: "invalid",
+			env: map[string]string{
+				envLogRecordAttributeValueLength: "invalid",
+				envLogRecordAttributeCount:       "",
+			},
+			want: LogRecordLimits{
+				AttributeValueLengthLimit: DefaultAttributeValueLengthLimit,
+				AttributeCountLimit:       DefaultAttributeCountLimit,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for k, v := range test.env {
+				t.Setenv(k, v)
+			}
+			assert.Equal(t, test.want, NewLogRecordLimits())
+		})
+	}
+}
+
+func TestLogRecordLimitsApply(t *testing.T) {
+	attrs := []attribute.KeyValue{
+		attribute.String("a", "héllo"),
+		attribute.Bool("b", true),
+	}
+
+	got, dropped := LogRecordLimits{AttributeValueLengthLimit: -1, AttributeCountLimit: -1}.apply(attrs)
+	assert.Equal(t, attrs, got)
+	assert.Equal(t, 0, dropped)
+
+	got, dropped = LogRecordLimits{AttributeValueLengthLimit: 2, AttributeCountLimit: 1}.apply(attrs)
+	// Multi-byte characters are not split.
+	assert.Equal(t, []attribute.KeyValue{attribute.String("a", "h")}, got)
+	assert.Equal(t, 1, dropped)
+
+	got, dropped = LogRecordLimits{AttributeValueLengthLimit: -1, AttributeCountLimit: 0}.apply(attrs)
+	assert.Nil(t, got)
+	assert.Equal(t, 2, dropped)
+}
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type logger struct {
+	provider             *LoggerProvider
+	instrumentationScope instrumentation.Scope
+}
+
+var _ log.Logger = &logger{}
+
+// Emit passes a Record, created from record, to the Processors of the
+// LoggerProvider of l.
+//
+// The attributes of record are copied and limited by the LogRecordLimits of
+// the LoggerProvider. If the ObservedTimestamp of record is not set, the
+// current time is used. If the SpanContext of record is not valid, the
+// SpanContext of the span in ctx is used, unless the LoggerProvider was
+// configured with WithoutTraceContext.
+func (l *logger) Emit(ctx context.Context, record log.Record) {
+	p := l.provider
+	if p.stopped() || len(p.processors) == 0 {
+		return
+	}
+
+	r := Record{
+		Record:               record,
+		Resource:             p.resource,
+		InstrumentationScope: l.instrumentationScope,
+	}
+	if r.ObservedTimestamp.IsZero() {
+		r.ObservedTimestamp = time.Now()
+	}
+	if !r.SpanContext.IsValid() && !p.noTraceContext {
+		r.SpanContext = trace.SpanContextFromContext(ctx)
+	}
+	r.Attributes, r.DroppedAttributes = p.limits.apply(record.Attributes)
+
+	for _, proc := range p.processors {
+		proc.OnEmit(ctx, r)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestLoggerEmitTraceContext(t *testing.T) {
+	proc := new(testProcessor)
+	l := NewLoggerProvider(WithProcessor(proc)).Logger("TestLoggerEmitTraceContext")
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+	l.Emit(ctx, log.Record{})
+
+	explicit := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{2},
+		SpanID:  trace.SpanID{2},
+	})
+	l.Emit(ctx, log.Record{SpanContext: explicit})
+
+	require.Len(t, proc.records, 2)
+	assert.Equal(t, sc, proc.records[0].SpanContext)
+	assert.Equal(t, explicit, proc.records[1].SpanContext)
+
+	proc = new(testProcessor)
+	l = NewLoggerProvider(
+		WithProcessor(proc),
+		WithoutTraceContext(),
+	).Logger("TestLoggerEmitTraceContext")
+	l.Emit(ctx, log.Record{})
+	l.Emit(ctx, log.Record{SpanContext: explicit})
+
+	require.Len(t, proc.records, 2)
+	assert.False(t, proc.records[0].SpanContext.IsValid(), "trace context set")
+	assert.Equal(t, explicit, proc.records[1].SpanContext)
+}
+
+func TestLoggerEmitObservedTimestamp(t *testing.T) {
+	proc := new(testProcessor)
+	l := NewLoggerProvider(WithProcessor(proc)).Logger("TestLoggerEmitObservedTimestamp")
+
+	before := time.Now()
+	l.Emit(context.Background(), log.Record{})
+	observed := time.Unix(10, 0)
+	l.Emit(context.Background(), log.Record{ObservedTimestamp: observed})
+
+	require.Len(t, proc.records, 2)
+	assert.False(t, proc.records[0].ObservedTimestamp.Before(before))
+	assert.Equal(t, observed, proc.records[1].ObservedTimestamp)
+}
+
+func TestLoggerEmitLimits(t *testing.T) {
+	proc := new(testProcessor)
+	l := NewLoggerProvider(
+		WithProcessor(proc),
+		WithLogRecordLimits(LogRecordLimits{
+			AttributeValueLengthLimit: 3,
+			AttributeCountLimit:       2,
+		}),
+	).Logger("TestLoggerEmitLimits")
+
+	attrs := []attribute.KeyValue{
+		attribute.String("a", "value"),
+		attribute.StringSlice("b", []string{"value", "v"}),
+		attribute.Int("c", 1),
+	}
+	l.Emit(context.Background(), log.Record{Attributes: attrs})
+
+	require.Len(t, proc.records, 1)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("a", "val"),
+		attribute.StringSlice("b", []string{"val", "v"}),
+	}, proc.records[0].Attributes)
+	assert.Equal(t, 1, proc.records[0].DroppedAttributes)
+	assert.Equal(t, "value", attrs[0].Value.AsString(), "emitted attributes modified")
+}
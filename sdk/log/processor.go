@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import "context"
+
+// Processor handles the records emitted by the Loggers of a LoggerProvider.
+type Processor interface {
+	// OnEmit is called synchronously when a record is emitted. The ctx is
+	// the context passed to Emit.
+	//
+	// The record is owned by the Processor once it is passed, it is not
+	// reused or modified by the Logger.
+	OnEmit(ctx context.Context, record Record)
+
+	// Shutdown is called when the LoggerProvider is shutdown. It should
+	// export any records it holds and release its resources. It should not
+	// block indefinitely and must respect the deadline or cancellation of
+	// the passed context.
+	Shutdown(ctx context.Context) error
+
+	// ForceFlush exports all records the Processor holds. It must respect
+	// the deadline or cancellation of the passed context.
+	ForceFlush(ctx context.Context) error
+}
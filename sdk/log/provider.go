@@ -0,0 +1,279 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+const defaultLoggerName = "go.opentelemetry.io/otel/sdk/logger"
+
+// loggerProviderConfig contains configuration options for a LoggerProvider.
+type loggerProviderConfig struct {
+	// processors contains the Processors records are passed to, in the
+	// order they are registered.
+	processors []Processor
+
+	// limits defines the attribute limits of log records.
+	limits LogRecordLimits
+
+	// resource contains attributes representing an entity that produces
+	// telemetry.
+	resource *resource.Resource
+
+	// noTraceContext determines if the trace context of records is left
+	// unset instead of being set from the context passed to Emit.
+	noTraceContext bool
+}
+
+// MarshalLog is the marshaling function used by the logging system to represent this exporter.
+func (cfg loggerProviderConfig) MarshalLog() interface{} {
+	return struct {
+		Processors          []Processor
+		LogRecordLimits     LogRecordLimits
+		Resource            *resource.Resource
+		TraceContextEnabled bool
+	}{
+		Processors:          cfg.processors,
+		LogRecordLimits:     cfg.limits,
+		Resource:            cfg.resource,
+		TraceContextEnabled: !cfg.noTraceContext,
+	}
+}
+
+// LoggerProvider is an OpenTelemetry LoggerProvider. It provides Loggers to
+// logging bridges so they can emit log records.
+type LoggerProvider struct {
+	mu            sync.Mutex
+	namedLogger   map[instrumentation.Scope]*logger
+	isShutdown    int32
+	processors    []Processor
+	stopOnce      sync.Once
+	shutdownError error
+
+	// These fields are assumed to be immutable after creation of the
+	// LoggerProvider.
+	limits         LogRecordLimits
+	resource       *resource.Resource
+	noTraceContext bool
+}
+
+var _ log.LoggerProvider = &LoggerProvider{}
+
+// NewLoggerProvider returns a new and configured LoggerProvider.
+//
+// By default the returned LoggerProvider is configured with:
+//   - no Processors
+//   - the resource.Default() Resource
+//   - the default LogRecordLimits.
+//
+// The passed opts are used to override these default values and configure the
+// returned LoggerProvider appropriately. A LoggerProvider without Processors
+// drops all records.
+func NewLoggerProvider(opts ...LoggerProviderOption) *LoggerProvider {
+	o := loggerProviderConfig{
+		limits: NewLogRecordLimits(),
+	}
+	for _, opt := range opts {
+		o = opt.apply(o)
+	}
+	if o.resource == nil {
+		o.resource = resource.Default()
+	}
+
+	lp := &LoggerProvider{
+		namedLogger: make(map[instrumentation.Scope]*logger),
+		processors:  o.processors,
+		limits:      o.limits,
+		resource:    o.resource,
+
+		noTraceContext: o.noTraceContext,
+	}
+	global.Info("LoggerProvider created", "config", o)
+	return lp
+}
+
+// Logger returns a Logger with the given name and options. If a Logger for
+// the given name and options does not exist it is created, otherwise the
+// existing Logger is returned.
+//
+// If name is empty, a default name is used instead.
+//
+// Loggers returned after the LoggerProvider is shut down perform no
+// operations.
+//
+// This method is safe to be called concurrently.
+func (p *LoggerProvider) Logger(name string, opts ...log.LoggerOption) log.Logger {
+	if p.stopped() {
+		return log.NewNoopLogger()
+	}
+
+	c := log.NewLoggerConfig(opts...)
+	if name == "" {
+		name = defaultLoggerName
+	}
+	is := instrumentation.Scope{
+		Name:      name,
+		Version:   c.InstrumentationVersion(),
+		SchemaURL: c.SchemaURL(),
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	l, ok := p.namedLogger[is]
+	if !ok {
+		l = &logger{provider: p, instrumentationScope: is}
+		if attrs := c.InstrumentationAttributes(); attrs.Len() > 0 {
+			l.instrumentationScope.Attributes = attrs
+		}
+		p.namedLogger[is] = l
+		global.Info("Logger created", "name", name, "version", is.Version, "schemaURL", is.SchemaURL)
+	}
+	return l
+}
+
+func (p *LoggerProvider) stopped() bool {
+	return atomic.LoadInt32(&p.isShutdown) != 0
+}
+
+// ForceFlush flushes the records held by all the Processors of the
+// LoggerProvider, in the order they were registered.
+//
+// This method is safe to be called concurrently.
+func (p *LoggerProvider) ForceFlush(ctx context.Context) error {
+	if p.stopped() {
+		return nil
+	}
+
+	for _, proc := range p.processors {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if err := proc.ForceFlush(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Shutdown shuts down the Processors of the LoggerProvider, in the order they
+// were registered. Records emitted after Shutdown is called are dropped.
+//
+// Only the first call to Shutdown shuts down the Processors, subsequent
+// calls return the same result as the first.
+//
+// This method is safe to be called concurrently.
+func (p *LoggerProvider) Shutdown(ctx context.Context) error {
+	p.stopOnce.Do(func() {
+		atomic.StoreInt32(&p.isShutdown, 1)
+
+		for _, proc := range p.processors {
+			select {
+			case <-ctx.Done():
+				p.shutdownError = ctx.Err()
+				return
+			default:
+			}
+
+			if err := proc.Shutdown(ctx); err != nil {
+				if p.shutdownError == nil {
+					p.shutdownError = err
+				} else {
+					// Poor man's list of errors
+					p.shutdownError = fmt.Errorf("%v; %v", p.shutdownError, err)
+				}
+			}
+		}
+	})
+	return p.shutdownError
+}
+
+// LoggerProviderOption configures a LoggerProvider.
+type LoggerProviderOption interface {
+	apply(loggerProviderConfig) loggerProviderConfig
+}
+
+type loggerProviderOptionFunc func(loggerProviderConfig) loggerProviderConfig
+
+func (fn loggerProviderOptionFunc) apply(cfg loggerProviderConfig) loggerProviderConfig {
+	return fn(cfg)
+}
+
+// WithProcessor registers the Processor with a LoggerProvider. Records are
+// passed to the Processors in the order they are registered.
+func WithProcessor(processor Processor) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg loggerProviderConfig) loggerProviderConfig {
+		cfg.processors = append(cfg.processors, processor)
+		return cfg
+	})
+}
+
+// WithResource returns a LoggerProviderOption that will configure the
+// Resource r as a LoggerProvider's Resource. The configured Resource is
+// associated with all the records emitted by the Loggers the LoggerProvider
+// creates.
+//
+// If this option is not used, the LoggerProvider will use the
+// resource.Default() Resource by default.
+func WithResource(r *resource.Resource) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg loggerProviderConfig) loggerProviderConfig {
+		var err error
+		cfg.resource, err = resource.Merge(resource.Environment(), r)
+		if err != nil {
+			otel.Handle(err)
+		}
+		return cfg
+	})
+}
+
+// WithLogRecordLimits returns a LoggerProviderOption that configures a
+// LoggerProvider to use the LogRecordLimits limits. These limits bound any
+// record emitted by a Logger from the LoggerProvider.
+//
+// If this option is not used, the LoggerProvider will use the
+// LogRecordLimits returned by NewLogRecordLimits.
+func WithLogRecordLimits(limits LogRecordLimits) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg loggerProviderConfig) loggerProviderConfig {
+		cfg.limits = limits
+		return cfg
+	})
+}
+
+// WithoutTraceContext returns a LoggerProviderOption that configures a
+// LoggerProvider to not set the trace context of records from the context
+// passed to Emit. Only records emitted with an explicit SpanContext are
+// correlated with a trace.
+//
+// By default, if this option is not used, the SpanContext of a record
+// emitted without a valid SpanContext is set to the SpanContext of the span
+// in the context passed to Emit, if any.
+func WithoutTraceContext() LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg loggerProviderConfig) loggerProviderConfig {
+		cfg.noTraceContext = true
+		return cfg
+	})
+}
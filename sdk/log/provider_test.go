@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// testExporter is an Exporter that stores the records it exports.
+type testExporter struct {
+	mu       sync.Mutex
+	records  []Record
+	exports  int
+	err      error
+	shutdown bool
+}
+
+func (e *testExporter) Export(_ context.Context, records []Record) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.exports++
+	e.records = append(e.records, records...)
+	return e.err
+}
+
+func (e *testExporter) Shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.shutdown = true
+	return ctx.Err()
+}
+
+func (e *testExporter) Records() []Record {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]Record(nil), e.records...)
+}
+
+func (e *testExporter) Exports() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.exports
+}
+
+// testProcessor is a Processor that records the calls made to it.
+type testProcessor struct {
+	records  []Record
+	flushes  int
+	shutdown int
+	err      error
+}
+
+func (p *testProcessor) OnEmit(_ context.Context, r Record) {
+	p.records = append(p.records, r)
+}
+
+func (p *testProcessor) ForceFlush(context.Context) error {
+	p.flushes++
+	return p.err
+}
+
+func (p *testProcessor) Shutdown(context.Context) error {
+	p.shutdown++
+	return p.err
+}
+
+func TestLoggerProviderLogger(t *testing.T) {
+	lp := NewLoggerProvider()
+
+	l := lp.Logger("name", log.WithInstrumentationVersion("v1.0.0"))
+	assert.Same(t, l, lp.Logger("name", log.WithInstrumentationVersion("v1.0.0")))
+	assert.NotSame(t, l, lp.Logger("name"))
+	assert.Equal(t, defaultLoggerName, lp.Logger("").(*logger).instrumentationScope.Name)
+
+	scope := l.(*logger).instrumentationScope
+	assert.Equal(t, "name", scope.Name)
+	assert.Equal(t, "v1.0.0", scope.Version)
+}
+
+func TestLoggerProviderEmit(t *testing.T) {
+	p0, p1 := new(testProcessor), new(testProcessor)
+	res := resource.NewSchemaless(attribute.String("service.name", "test"))
+	lp := NewLoggerProvider(
+		WithResource(res),
+		WithProcessor(p0),
+		WithProcessor(p1),
+	)
+
+	lp.Logger("TestLoggerProviderEmit").Emit(context.Background(), log.Record{
+		Severity: log.SeverityInfo,
+		Body:     attribute.StringValue("message"),
+	})
+
+	require.Len(t, p0.records, 1)
+	assert.Equal(t, p0.records, p1.records)
+	r := p0.records[0]
+	assert.Equal(t, log.SeverityInfo, r.Severity)
+	assert.Equal(t, "message", r.Body.AsString())
+	assert.Equal(t, "TestLoggerProviderEmit", r.InstrumentationScope.Name)
+	assert.True(t, r.Resource.Equal(res), "resource")
+}
+
+func TestLoggerProviderForceFlush(t *testing.T) {
+	p0, p1 := new(testProcessor), new(testProcessor)
+	lp := NewLoggerProvider(WithProcessor(p0), WithProcessor(p1))
+
+	require.NoError(t, lp.ForceFlush(context.Background()))
+	assert.Equal(t, 1, p0.flushes)
+	assert.Equal(t, 1, p1.flushes)
+
+	p0.err = errors.New("flush failed")
+	assert.ErrorIs(t, lp.ForceFlush(context.Background()), p0.err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, lp.ForceFlush(ctx), context.Canceled)
+}
+
+func TestLoggerProviderShutdown(t *testing.T) {
+	p0, p1 := new(testProcessor), new(testProcessor)
+	lp := NewLoggerProvider(WithProcessor(p0), WithProcessor(p1))
+	l := lp.Logger("TestLoggerProviderShutdown")
+
+	p0.err = errors.New("shutdown failed")
+	assert.ErrorIs(t, lp.Shutdown(context.Background()), p0.err)
+	assert.ErrorIs(t, lp.Shutdown(context.Background()), p0.err, "second shutdown")
+	assert.Equal(t, 1, p0.shutdown)
+	assert.Equal(t, 1, p1.shutdown)
+
+	// Records are dropped once shut down.
+	l.Emit(context.Background(), log.Record{})
+	lp.Logger("new").Emit(context.Background(), log.Record{})
+	assert.Len(t, p0.records, 0)
+	assert.NoError(t, lp.ForceFlush(context.Background()))
+	assert.Equal(t, 0, p0.flushes)
+}
@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// Record is a log record emitted by a Logger of a LoggerProvider. It is the
+// log record passed to Processors and Exporters.
+type Record struct {
+	log.Record
+
+	// DroppedAttributes is the number of attributes of the record that were
+	// discarded due to the LogRecordLimits of the LoggerProvider.
+	DroppedAttributes int
+
+	// Resource is the entity that produced the record.
+	Resource *resource.Resource
+
+	// InstrumentationScope is the scope of the Logger that emitted the
+	// record.
+	InstrumentationScope instrumentation.Scope
+}
@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+)
+
+// simpleProcessor is a Processor that synchronously exports all emitted
+// records.
+type simpleProcessor struct {
+	exporterMu sync.Mutex
+	exporter   Exporter
+	stopOnce   sync.Once
+}
+
+var _ Processor = (*simpleProcessor)(nil)
+
+// NewSimpleProcessor returns a new Processor that will synchronously export
+// emitted records to exporter.
+//
+// This Processor is not recommended for production use. The synchronous
+// nature of this Processor make it good for testing, debugging, or showing
+// examples of other feature, but it will be slow and have a high computation
+// resource usage overhead. The BatchProcessor is recommended for production
+// use instead.
+func NewSimpleProcessor(exporter Exporter) Processor {
+	return &simpleProcessor{exporter: exporter}
+}
+
+// OnEmit immediately exports record.
+func (sp *simpleProcessor) OnEmit(ctx context.Context, record Record) {
+	sp.exporterMu.Lock()
+	defer sp.exporterMu.Unlock()
+
+	if sp.exporter == nil {
+		return
+	}
+	// Do not let the cancellation of the emitting operation abort the
+	// export.
+	if err := sp.exporter.Export(context.Background(), []Record{record}); err != nil {
+		otel.Handle(err)
+	}
+}
+
+// Shutdown shuts down the exporter of the Processor. Records emitted after
+// Shutdown is called are dropped.
+func (sp *simpleProcessor) Shutdown(ctx context.Context) error {
+	var err error
+	sp.stopOnce.Do(func() {
+		sp.exporterMu.Lock()
+		exp := sp.exporter
+		sp.exporter = nil
+		sp.exporterMu.Unlock()
+
+		if exp == nil {
+			return
+		}
+
+		done := make(chan error, 1)
+		go func() { done <- exp.Shutdown(ctx) }()
+		select {
+		case err = <-done:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	})
+	return err
+}
+
+// ForceFlush does nothing as there is no data to flush.
+func (sp *simpleProcessor) ForceFlush(context.Context) error {
+	return nil
+}
+
+// MarshalLog is the marshaling function used by the logging system to represent this Processor.
+func (sp *simpleProcessor) MarshalLog() interface{} {
+	return struct {
+		Type     string
+		Exporter Exporter
+	}{
+		Type:     "SimpleProcessor",
+		Exporter: sp.exporter,
+	}
+}
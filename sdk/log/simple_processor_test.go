@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+func TestSimpleProcessorOnEmit(t *testing.T) {
+	exp := new(testExporter)
+	sp := NewSimpleProcessor(exp)
+
+	r := Record{Record: log.Record{Severity: log.SeverityWarn}}
+	sp.OnEmit(context.Background(), r)
+	assert.Equal(t, []Record{r}, exp.Records())
+	assert.NoError(t, sp.ForceFlush(context.Background()))
+}
+
+func TestSimpleProcessorShutdown(t *testing.T) {
+	exp := new(testExporter)
+	sp := NewSimpleProcessor(exp)
+
+	require.NoError(t, sp.Shutdown(context.Background()))
+	assert.True(t, exp.shutdown)
+
+	sp.OnEmit(context.Background(), Record{})
+	assert.Len(t, exp.Records(), 0, "exported after shutdown")
+	assert.NoError(t, sp.Shutdown(context.Background()))
+}
+
+func TestSimpleProcessorShutdownHonorsContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	sp := NewSimpleProcessor(new(testExporter))
+	assert.ErrorIs(t, sp.Shutdown(ctx), context.Canceled)
+}
+
+func TestSimpleProcessorNilExporter(t *testing.T) {
+	sp := NewSimpleProcessor(nil)
+	assert.NotPanics(t, func() {
+		sp.OnEmit(context.Background(), Record{})
+		assert.NoError(t, sp.Shutdown(context.Background()))
+	})
+}
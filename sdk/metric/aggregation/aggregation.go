@@ -159,3 +159,100 @@ func (h ExplicitBucketHistogram) Copy() Aggregation {
 		NoMinMax:   h.NoMinMax,
 	}
 }
+
+const (
+	// ExponentialMaxScale is the largest scale an ExponentialBucketHistogram
+	// can use.
+	ExponentialMaxScale = 20
+	// ExponentialMinScale is the smallest scale an
+	// ExponentialBucketHistogram can use.
+	ExponentialMinScale = -10
+)
+
+// ExponentialBucketHistogram is an aggregation that summarizes a set of
+// measurements as an histogram with buckets whose widths grow exponentially.
+//
+// The bucket boundaries are powers of base = 2^(2^-scale). A bucket with
+// index i contains measurements in the range (base^i, base^(i+1)]. The scale
+// is reduced, merging adjacent buckets, any time the measurements would
+// otherwise need more than MaxSize buckets. Measurements equal to zero are
+// counted in a separate zero bucket.
+//
+// The OpenTelemetry specification recommends a MaxSize of 160 and a MaxScale
+// of 20.
+type ExponentialBucketHistogram struct {
+	// MaxSize is the maximum number of buckets to use for positive or
+	// negative measurements. It needs to be at least 2.
+	MaxSize int32
+	// MaxScale is the maximum, and initial, resolution scale to use for the
+	// histogram. It needs to be in the range [ExponentialMinScale,
+	// ExponentialMaxScale].
+	MaxScale int32
+	// NoMinMax indicates whether to not record the min and max of the
+	// distribution. By default, these extremes are recorded.
+	NoMinMax bool
+}
+
+var _ Aggregation = ExponentialBucketHistogram{}
+
+func (ExponentialBucketHistogram) private() {}
+
+// errExpoHist is returned by misconfigured ExponentialBucketHistograms.
+var errExpoHist = fmt.Errorf("%w: exponential bucket histogram", errAgg)
+
+// Err returns an error for any misconfiguration.
+func (h ExponentialBucketHistogram) Err() error {
+	if h.MaxSize < 2 {
+		return fmt.Errorf("%w: max size %d less than 2", errExpoHist, h.MaxSize)
+	}
+	if h.MaxScale > ExponentialMaxScale || h.MaxScale < ExponentialMinScale {
+		return fmt.Errorf(
+			"%w: max scale %d outside of range [%d, %d]",
+			errExpoHist, h.MaxScale, ExponentialMinScale, ExponentialMaxScale,
+		)
+	}
+	return nil
+}
+
+// Copy returns a deep copy of h.
+func (h ExponentialBucketHistogram) Copy() Aggregation { return h }
+
+// Summary is an aggregation that summarizes a set of measurements as their
+// count, sum, and a set of estimated quantiles.
+//
+// Quantiles are estimated with a streaming algorithm that bounds the rank
+// error of each quantile. The error allowed for a quantile q is the smaller of
+// 0.01 and one tenth of the distance from q to the nearer of 0 or 1 (e.g. the
+// 0.99 quantile is estimated with a rank error of at most 0.001). The 0 and 1
+// quantiles are always exact, they are the minimum and maximum measurement.
+type Summary struct {
+	// Quantiles are the quantiles to estimate. Each quantile needs to be in
+	// the range [0, 1]. If no quantiles are defined, only the count and sum
+	// of the measurements are recorded.
+	Quantiles []float64
+}
+
+var _ Aggregation = Summary{}
+
+func (Summary) private() {}
+
+// errSummary is returned by misconfigured Summaries.
+var errSummary = fmt.Errorf("%w: summary", errAgg)
+
+// Err returns an error for any misconfiguration.
+func (s Summary) Err() error {
+	for _, q := range s.Quantiles {
+		// Also rejects NaN.
+		if !(q >= 0 && q <= 1) {
+			return fmt.Errorf("%w: quantile %v outside of range [0, 1]", errSummary, q)
+		}
+	}
+	return nil
+}
+
+// Copy returns a deep copy of s.
+func (s Summary) Copy() Aggregation {
+	q := make([]float64, len(s.Quantiles))
+	copy(q, s.Quantiles)
+	return Summary{Quantiles: q}
+}
@@ -15,6 +15,7 @@
 package aggregation
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -55,6 +56,55 @@ func TestAggregationErr(t *testing.T) {
 			Boundaries: []float64{0, 1, 2, 1, 3, 4},
 		}.Err(), errAgg)
 	})
+
+	t.Run("ExponentialBucketHistogramOperation", func(t *testing.T) {
+		assert.NoError(t, ExponentialBucketHistogram{
+			MaxSize:  160,
+			MaxScale: 20,
+		}.Err())
+
+		assert.NoError(t, ExponentialBucketHistogram{
+			MaxSize:  2,
+			MaxScale: -10,
+			NoMinMax: true,
+		}.Err())
+	})
+
+	t.Run("InvalidExponentialBucketHistogram", func(t *testing.T) {
+		assert.ErrorIs(t, ExponentialBucketHistogram{}.Err(), errAgg)
+
+		assert.ErrorIs(t, ExponentialBucketHistogram{
+			MaxSize:  160,
+			MaxScale: 21,
+		}.Err(), errAgg)
+
+		assert.ErrorIs(t, ExponentialBucketHistogram{
+			MaxSize:  160,
+			MaxScale: -11,
+		}.Err(), errAgg)
+	})
+
+	t.Run("SummaryOperation", func(t *testing.T) {
+		assert.NoError(t, Summary{}.Err())
+
+		assert.NoError(t, Summary{
+			Quantiles: []float64{0, 0.5, 0.9, 0.99, 1},
+		}.Err())
+	})
+
+	t.Run("InvalidSummaryQuantiles", func(t *testing.T) {
+		assert.ErrorIs(t, Summary{
+			Quantiles: []float64{-0.1},
+		}.Err(), errAgg)
+
+		assert.ErrorIs(t, Summary{
+			Quantiles: []float64{0.5, 1.1},
+		}.Err(), errAgg)
+
+		assert.ErrorIs(t, Summary{
+			Quantiles: []float64{math.NaN()},
+		}.Err(), errAgg)
+	})
 }
 
 func TestExplicitBucketHistogramDeepCopy(t *testing.T) {
@@ -65,3 +115,12 @@ func TestExplicitBucketHistogramDeepCopy(t *testing.T) {
 	b[0] = orig + 1
 	assert.Equal(t, orig, cpH.Boundaries[0], "changing the underlying slice data should not affect the copy")
 }
+
+func TestSummaryDeepCopy(t *testing.T) {
+	const orig = 0.5
+	q := []float64{orig}
+	s := Summary{Quantiles: q}
+	cpS := s.Copy().(Summary)
+	q[0] = orig + 0.1
+	assert.Equal(t, orig, cpS.Quantiles[0], "changing the underlying slice data should not affect the copy")
+}
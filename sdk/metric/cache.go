@@ -55,6 +55,19 @@ func (c *cache[K, V]) Lookup(key K, f func() V) V {
 	return val
 }
 
+// Range calls f for each value stored in the cache.
+//
+// Range is safe to call concurrently. It will hold the cache lock, so f
+// should not block excessively.
+func (c *cache[K, V]) Range(f func(V)) {
+	c.Lock()
+	defer c.Unlock()
+
+	for _, v := range c.data {
+		f(v)
+	}
+}
+
 // instrumentCache is a cache of instruments. It is scoped at the Meter level
 // along with a number type. Meaning all instruments it contains need to belong
 // to the same instrumentation.Scope (implicitly) and number type (explicitly).
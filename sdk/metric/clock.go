@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import "time"
+
+// Clock provides the time used for the StartTime and Time of data points, and
+// the Time of exemplars, produced by a MeterProvider.
+//
+// Measurements recorded with an explicit observation time keep that time and
+// do not use a Clock.
+//
+// This has the same method set as the Clock of the
+// go.opentelemetry.io/otel/sdk/trace package, a single implementation can be
+// used with both the MeterProvider and TracerProvider.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// Since returns the time elapsed since t, a time previously returned by
+	// Now.
+	Since(t time.Time) time.Duration
+}
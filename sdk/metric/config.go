@@ -17,30 +17,25 @@ package metric // import "go.opentelemetry.io/otel/sdk/metric"
 import (
 	"context"
 	"fmt"
-	"sync"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/view"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
 
 // config contains configuration options for a MeterProvider.
 type config struct {
-	res     *resource.Resource
-	readers map[Reader][]view.View
-}
-
-// readerSignals returns a force-flush and shutdown function for a
-// MeterProvider to call in their respective options. All Readers c contains
-// will have their force-flush and shutdown methods unified into returned
-// single functions.
-func (c config) readerSignals() (forceFlush, shutdown func(context.Context) error) {
-	var fFuncs, sFuncs []func(context.Context) error
-	for r := range c.readers {
-		sFuncs = append(sFuncs, r.Shutdown)
-		fFuncs = append(fFuncs, r.ForceFlush)
-	}
-
-	return unify(fFuncs), unifyShutdown(sFuncs)
+	res                    *resource.Resource
+	resRefresher           *resource.Refresher
+	readers                map[Reader][]view.View
+	exemplarFilter         ExemplarFilter
+	sumShards              int
+	attributeFilter        attribute.Filter
+	meterFilter            func(instrumentation.Scope) bool
+	selfObservability      bool
+	relaxedInstrumentNames bool
+	clock                  Clock
 }
 
 // unify unifies calling all of funcs into a single function call. All errors
@@ -65,21 +60,12 @@ func unify(funcs []func(context.Context) error) func(context.Context) error {
 	}
 }
 
-// unifyShutdown unifies calling all of funcs once for a shutdown. If called
-// more than once, an ErrReaderShutdown error is returned.
-func unifyShutdown(funcs []func(context.Context) error) func(context.Context) error {
-	f := unify(funcs)
-	var once sync.Once
-	return func(ctx context.Context) error {
-		err := ErrReaderShutdown
-		once.Do(func() { err = f(ctx) })
-		return err
-	}
-}
-
 // newConfig returns a config configured with options.
 func newConfig(options []Option) config {
-	conf := config{res: resource.Default()}
+	conf := config{
+		res:            resource.Default(),
+		exemplarFilter: exemplarFilterFromEnv(),
+	}
 	for _, o := range options {
 		conf = o.apply(conf)
 	}
@@ -112,6 +98,22 @@ func WithResource(res *resource.Resource) Option {
 	})
 }
 
+// WithResourceRefresher associates the Resource held by r with a
+// MeterProvider. The Resource is read from r each time a Reader collects, so
+// refreshed resource attributes are reported from the next collection on.
+//
+// This option overrides any Resource set with WithResource. Shutting down
+// the MeterProvider does not shut down r.
+func WithResourceRefresher(r *resource.Refresher) Option {
+	return optionFunc(func(conf config) config {
+		conf.resRefresher = r
+		if r != nil {
+			conf.res = r.Resource()
+		}
+		return conf
+	})
+}
+
 // WithReader associates a Reader with a MeterProvider. Any passed view config
 // will be used to associate a view with the Reader. If no views are passed
 // the default view will be use for the Reader.
@@ -130,3 +132,145 @@ func WithReader(r Reader, views ...view.View) Option {
 		return cfg
 	})
 }
+
+// WithExemplarFilter configures the ExemplarFilter a MeterProvider uses to
+// determine which measurements are offered to be sampled as exemplars. If
+// filter is nil, no exemplars will be sampled.
+//
+// By default, if this option is not used, the ExemplarFilter will be
+// determined by the OTEL_METRICS_EXEMPLAR_FILTER environment variable. Its
+// supported values are "always_on", "always_off", and "trace_based". If the
+// environment variable is not set or is not a supported value, no exemplars
+// are sampled.
+func WithExemplarFilter(filter ExemplarFilter) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.exemplarFilter = filter
+		return cfg
+	})
+}
+
+// WithSumShards configures the sum aggregations of a MeterProvider to
+// accumulate measurements in shards, the number of shards that can be updated
+// concurrently. The shards are merged when metrics are collected.
+//
+// A single sum aggregation is guarded by a single lock. Sharding reduces the
+// contention on that lock when an instrument, like a counter, is updated from
+// many goroutines at the same time. It comes at the expense of up to shards
+// times the memory for each attribute set of the instrument. A value of
+// runtime.GOMAXPROCS(0) is a good starting point.
+//
+// By default, if this option is not used or shards is less than 2, sums are
+// not sharded.
+func WithSumShards(shards int) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.sumShards = shards
+		return cfg
+	})
+}
+
+// WithAttributeFilter configures a MeterProvider to filter the attributes of
+// all measurements made by the instruments it creates with filter. Only the
+// attributes filter returns true for are kept. The filter is applied before
+// the attribute filter of any view that matches an instrument.
+//
+// This can be used to remove attributes from all instruments, like
+// high-cardinality attributes added by third-party instrumentation, without
+// needing to define a view for each instrument. For example, to remove the
+// "user_id" attribute:
+//
+//	WithAttributeFilter(func(kv attribute.KeyValue) bool {
+//		return kv.Key != "user_id"
+//	})
+//
+// By default, if this option is not used or filter is nil, no attributes are
+// filtered.
+func WithAttributeFilter(filter attribute.Filter) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.attributeFilter = filter
+		return cfg
+	})
+}
+
+// WithMeterFilter configures a MeterProvider to only create Meters that
+// perform operations for the instrumentation scopes filter returns true for.
+// Meters for all other scopes, and the instruments they create, perform no
+// operations. No measurements made with them are aggregated or exported.
+//
+// This can be used to disable all telemetry from an instrumentation library.
+// For example, to disable the Meters of a vendored library:
+//
+//	WithMeterFilter(func(s instrumentation.Scope) bool {
+//		return s.Name != "example.com/vendored/lib"
+//	})
+//
+// By default, if this option is not used or filter is nil, all Meters
+// perform operations.
+func WithMeterFilter(filter func(instrumentation.Scope) bool) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.meterFilter = filter
+		return cfg
+	})
+}
+
+// WithSelfObservability configures a MeterProvider to report metrics about
+// its own health. The metrics are reported with a Meter for the
+// "otel.sdk.metrics" instrumentation scope, the same as any other metrics
+// from the MeterProvider, and include:
+//
+//   - otel.sdk.metrics.measurements.dropped: the number of measurements
+//     aggregated with the overflow attribute set because an instrument reached
+//     its cardinality limit, by instrument name.
+//   - otel.sdk.metrics.callback.errors: the number of callbacks that failed
+//     during a collection.
+//   - otel.sdk.metrics.collect.duration: the time, in milliseconds, a
+//     collection took for a Reader.
+//   - otel.sdk.metrics.collect.batch_size: the number of data points a
+//     collection produced for a Reader to export.
+//
+// By default, if this option is not used, the MeterProvider does not report
+// metrics about its own health.
+func WithSelfObservability() Option {
+	return optionFunc(func(cfg config) config {
+		cfg.selfObservability = true
+		return cfg
+	})
+}
+
+// WithRelaxedInstrumentNames configures a MeterProvider to sanitize invalid
+// instrument names instead of rejecting them. An instrument created with an
+// invalid name has every character that is not alphanumeric, _, ., or -
+// replaced with an underscore, an "m_" prefix added if the name does not
+// start with a letter, and is truncated to 63 characters. The sanitization is
+// logged and no error is returned.
+//
+// This can be used when migrating instrumentation from systems with different
+// naming rules, like StatsD or Prometheus, without renaming every instrument
+// first. Instruments with an empty name are still rejected.
+//
+// By default, if this option is not used, creating an instrument with an
+// invalid name returns an error wrapping ErrInstrumentName along with an
+// instrument that uses the invalid name.
+func WithRelaxedInstrumentNames() Option {
+	return optionFunc(func(cfg config) config {
+		cfg.relaxedInstrumentNames = true
+		return cfg
+	})
+}
+
+// WithClock configures the Clock a MeterProvider uses to get the current
+// time. It determines the StartTime and Time of the data points, and the Time
+// of the exemplars, produced from the instruments of the MeterProvider.
+// Measurements recorded with an explicit observation time keep that time.
+//
+// This is intended for tests that need deterministic timestamps, or that need
+// to control the passing of time, like when testing delta temporality. See
+// the Clock of the go.opentelemetry.io/otel/sdk/metric/metrictest package.
+//
+// By default, if this option is not used or clock is nil, the system clock is
+// used.
+func WithClock(clock Clock) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.clock = clock
+		return cfg
+	})
+}
@@ -18,10 +18,13 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/view"
@@ -32,6 +35,10 @@ type reader struct {
 	producer        producer
 	temporalityFunc TemporalitySelector
 	aggregationFunc AggregationSelector
+	limit           int
+	cbTimeout       time.Duration
+	sdTimeout       time.Duration
+	resTransform    func(*resource.Resource) *resource.Resource
 	collectFunc     func(context.Context) (metricdata.ResourceMetrics, error)
 	forceFlushFunc  func(context.Context) error
 	shutdownFunc    func(context.Context) error
@@ -43,6 +50,16 @@ func (r *reader) aggregation(kind view.InstrumentKind) aggregation.Aggregation {
 	return r.aggregationFunc(kind)
 }
 
+func (r *reader) cardinalityLimit() int { return r.limit }
+
+func (r *reader) callbackTimeout() time.Duration { return r.cbTimeout }
+
+func (r *reader) shutdownTimeout() time.Duration { return r.sdTimeout }
+
+func (r *reader) transformResource(res *resource.Resource) *resource.Resource {
+	return transformResource(r.resTransform, res)
+}
+
 func (r *reader) register(p producer) { r.producer = p }
 func (r *reader) temporality(kind view.InstrumentKind) metricdata.Temporality {
 	return r.temporalityFunc(kind)
@@ -50,65 +67,20 @@ func (r *reader) temporality(kind view.InstrumentKind) metricdata.Temporality {
 func (r *reader) Collect(ctx context.Context) (metricdata.ResourceMetrics, error) {
 	return r.collectFunc(ctx)
 }
-func (r *reader) ForceFlush(ctx context.Context) error { return r.forceFlushFunc(ctx) }
-func (r *reader) Shutdown(ctx context.Context) error   { return r.shutdownFunc(ctx) }
-
-func TestConfigReaderSignalsEmpty(t *testing.T) {
-	f, s := config{}.readerSignals()
-
-	require.NotNil(t, f)
-	require.NotNil(t, s)
-
-	ctx := context.Background()
-	assert.Nil(t, f(ctx))
-	assert.Nil(t, s(ctx))
-	assert.ErrorIs(t, s(ctx), ErrReaderShutdown)
-}
-
-func TestConfigReaderSignalsForwarded(t *testing.T) {
-	var flush, sdown int
-	r := &reader{
-		forceFlushFunc: func(ctx context.Context) error {
-			flush++
-			return nil
-		},
-		shutdownFunc: func(ctx context.Context) error {
-			sdown++
-			return nil
-		},
+func (r *reader) CollectStream(ctx context.Context, fn func(*resource.Resource, metricdata.ScopeMetrics) error) error {
+	rm, err := r.collectFunc(ctx)
+	if err != nil {
+		return err
 	}
-	c := newConfig([]Option{WithReader(r)})
-	f, s := c.readerSignals()
-
-	require.NotNil(t, f)
-	require.NotNil(t, s)
-
-	ctx := context.Background()
-	assert.NoError(t, f(ctx))
-	assert.NoError(t, f(ctx))
-	assert.NoError(t, s(ctx))
-	assert.ErrorIs(t, s(ctx), ErrReaderShutdown)
-
-	assert.Equal(t, 2, flush, "flush not called 2 times")
-	assert.Equal(t, 1, sdown, "shutdown not called 1 time")
-}
-
-func TestConfigReaderSignalsForwardedErrors(t *testing.T) {
-	r := &reader{
-		forceFlushFunc: func(ctx context.Context) error { return assert.AnError },
-		shutdownFunc:   func(ctx context.Context) error { return assert.AnError },
+	for _, sm := range rm.ScopeMetrics {
+		if err := fn(rm.Resource, sm); err != nil {
+			return err
+		}
 	}
-	c := newConfig([]Option{WithReader(r)})
-	f, s := c.readerSignals()
-
-	require.NotNil(t, f)
-	require.NotNil(t, s)
-
-	ctx := context.Background()
-	assert.ErrorIs(t, f(ctx), assert.AnError)
-	assert.ErrorIs(t, s(ctx), assert.AnError)
-	assert.ErrorIs(t, s(ctx), ErrReaderShutdown)
+	return nil
 }
+func (r *reader) ForceFlush(ctx context.Context) error { return r.forceFlushFunc(ctx) }
+func (r *reader) Shutdown(ctx context.Context) error   { return r.shutdownFunc(ctx) }
 
 func TestUnifyMultiError(t *testing.T) {
 	f := func(context.Context) error { return assert.AnError }
@@ -124,6 +96,38 @@ func TestWithResource(t *testing.T) {
 	assert.Same(t, res, c.res)
 }
 
+func TestWithSumShards(t *testing.T) {
+	assert.Equal(t, 0, newConfig(nil).sumShards)
+	assert.Equal(t, 4, newConfig([]Option{WithSumShards(4)}).sumShards)
+}
+
+func TestWithAttributeFilter(t *testing.T) {
+	assert.Nil(t, newConfig(nil).attributeFilter)
+
+	c := newConfig([]Option{WithAttributeFilter(func(kv attribute.KeyValue) bool {
+		return kv.Key != "user_id"
+	})})
+	require.NotNil(t, c.attributeFilter)
+	assert.True(t, c.attributeFilter(attribute.String("region", "us")))
+	assert.False(t, c.attributeFilter(attribute.String("user_id", "alice")))
+}
+
+func TestWithMeterFilter(t *testing.T) {
+	assert.Nil(t, newConfig(nil).meterFilter)
+
+	c := newConfig([]Option{WithMeterFilter(func(s instrumentation.Scope) bool {
+		return s.Name != "disabled"
+	})})
+	require.NotNil(t, c.meterFilter)
+	assert.True(t, c.meterFilter(instrumentation.Scope{Name: "enabled"}))
+	assert.False(t, c.meterFilter(instrumentation.Scope{Name: "disabled"}))
+}
+
+func TestWithSelfObservability(t *testing.T) {
+	assert.False(t, newConfig(nil).selfObservability)
+	assert.True(t, newConfig([]Option{WithSelfObservability()}).selfObservability)
+}
+
 func TestWithReader(t *testing.T) {
 	r := &reader{}
 	c := newConfig([]Option{WithReader(r)})
@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/internal/global"
+)
+
+// Environment variable names.
+const (
+	// envInterval is the time interval, in milliseconds, between the start
+	// of two export attempts of a PeriodicReader (i.e. 60000).
+	envInterval = "OTEL_METRIC_EXPORT_INTERVAL"
+	// envTimeout is the maximum allowed time, in milliseconds, to export
+	// data for a PeriodicReader (i.e. 30000).
+	envTimeout = "OTEL_METRIC_EXPORT_TIMEOUT"
+)
+
+// envDuration returns the value of the environment variable key as a
+// duration in milliseconds. If the variable is not set, or its value is not a
+// positive integer, defaultValue is returned.
+func envDuration(key string, defaultValue time.Duration) time.Duration {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return defaultValue
+	}
+
+	d, err := strconv.Atoi(strings.TrimSpace(v))
+	if err != nil || d <= 0 {
+		global.Info("invalid duration, positive number of milliseconds expected", key, v)
+		return defaultValue
+	}
+	return time.Duration(d) * time.Millisecond
+}
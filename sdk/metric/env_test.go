@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvDuration(t *testing.T) {
+	const key = "OTEL_TEST_DURATION"
+	def := time.Second
+
+	assert.Equal(t, def, envDuration(key, def), "unset")
+
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{value: "1500", want: 1500 * time.Millisecond},
+		{value: " 10 ", want: 10 * time.Millisecond},
+		{value: "0", want: def},
+		{value: "-1", want: def},
+		{value: "1.5", want: def},
+		{value: "5s", want: def},
+		{value: "", want: def},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Setenv(key, tt.value)
+			assert.Equal(t, tt.want, envDuration(key, def))
+		})
+	}
+}
+
+func TestPeriodicReaderConfigFromEnv(t *testing.T) {
+	t.Setenv(envInterval, "1000")
+	t.Setenv(envTimeout, "500")
+
+	conf := newPeriodicReaderConfig(nil)
+	assert.Equal(t, time.Second, conf.interval)
+	assert.Equal(t, 500*time.Millisecond, conf.timeout)
+
+	conf = newPeriodicReaderConfig([]PeriodicReaderOption{
+		WithInterval(testDur),
+		WithTimeout(testDur),
+	})
+	assert.Equal(t, testDur, conf.interval, "option should override environment")
+	assert.Equal(t, testDur, conf.timeout, "option should override environment")
+}
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	exemplarFilterKey = "OTEL_METRICS_EXEMPLAR_FILTER"
+
+	exemplarFilterAlwaysOn   = "always_on"
+	exemplarFilterAlwaysOff  = "always_off"
+	exemplarFilterTraceBased = "trace_based"
+)
+
+// ExemplarFilter determines if a measurement made with ctx should be offered
+// to be sampled as an exemplar.
+type ExemplarFilter func(ctx context.Context) bool
+
+// AlwaysOnExemplarFilter is an ExemplarFilter that offers all measurements to
+// be sampled as exemplars.
+func AlwaysOnExemplarFilter(context.Context) bool { return true }
+
+// AlwaysOffExemplarFilter is an ExemplarFilter that offers no measurements to
+// be sampled as exemplars.
+func AlwaysOffExemplarFilter(context.Context) bool { return false }
+
+// TraceBasedExemplarFilter is an ExemplarFilter that offers measurements made
+// with a context containing a sampled span to be sampled as exemplars.
+func TraceBasedExemplarFilter(ctx context.Context) bool {
+	return trace.SpanContextFromContext(ctx).IsSampled()
+}
+
+// exemplarFilterFromEnv returns the ExemplarFilter configured with the
+// OTEL_METRICS_EXEMPLAR_FILTER environment variable. A nil ExemplarFilter is
+// returned for always_off, or if the variable is not set or its value is not
+// supported, so no exemplars are sampled. This keeps the lock of the exemplar
+// sampler off the measurement path of applications that do not use exemplars.
+func exemplarFilterFromEnv() ExemplarFilter {
+	v, ok := os.LookupEnv(exemplarFilterKey)
+	if !ok {
+		return nil
+	}
+
+	switch strings.ToLower(strings.TrimSpace(v)) {
+	case exemplarFilterAlwaysOn:
+		return AlwaysOnExemplarFilter
+	case exemplarFilterAlwaysOff:
+		return nil
+	case exemplarFilterTraceBased:
+		return TraceBasedExemplarFilter
+	default:
+		global.Info("unsupported exemplar filter, using always_off", exemplarFilterKey, v)
+		return nil
+	}
+}
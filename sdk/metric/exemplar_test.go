@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestExemplarFilters(t *testing.T) {
+	sampled := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x01},
+		TraceFlags: trace.FlagsSampled,
+	}))
+	notSampled := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x01},
+		SpanID:  trace.SpanID{0x01},
+	}))
+
+	for _, ctx := range []context.Context{context.Background(), sampled, notSampled} {
+		assert.True(t, AlwaysOnExemplarFilter(ctx))
+		assert.False(t, AlwaysOffExemplarFilter(ctx))
+	}
+	assert.False(t, TraceBasedExemplarFilter(context.Background()))
+	assert.True(t, TraceBasedExemplarFilter(sampled))
+	assert.False(t, TraceBasedExemplarFilter(notSampled))
+}
+
+func TestExemplarFilterFromEnv(t *testing.T) {
+	// Functions are not comparable, compare their pointers instead.
+	ptr := func(f ExemplarFilter) uintptr {
+		if f == nil {
+			return 0
+		}
+		return reflect.ValueOf(f).Pointer()
+	}
+
+	tests := []struct {
+		value string
+		want  ExemplarFilter
+	}{
+		{value: "always_on", want: AlwaysOnExemplarFilter},
+		{value: "always_off", want: nil},
+		{value: "trace_based", want: TraceBasedExemplarFilter},
+		{value: " ALWAYS_ON ", want: AlwaysOnExemplarFilter},
+		{value: "unknown", want: nil},
+	}
+
+	assert.Nil(t, exemplarFilterFromEnv(), "unset")
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Setenv(exemplarFilterKey, tt.value)
+			assert.Equal(t, ptr(tt.want), ptr(exemplarFilterFromEnv()))
+		})
+	}
+}
+
+func TestWithExemplarFilterOverridesEnv(t *testing.T) {
+	t.Setenv(exemplarFilterKey, "always_off")
+	assert.Nil(t, newConfig(nil).exemplarFilter)
+
+	conf := newConfig([]Option{WithExemplarFilter(AlwaysOnExemplarFilter)})
+	assert.NotNil(t, conf.exemplarFilter)
+}
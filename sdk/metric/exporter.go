@@ -18,7 +18,10 @@ import (
 	"context"
 	"fmt"
 
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/view"
+	"go.opentelemetry.io/otel/sdk/resource"
 )
 
 // ErrExporterShutdown is returned if Export or Shutdown are called after an
@@ -28,6 +31,20 @@ var ErrExporterShutdown = fmt.Errorf("exporter is shutdown")
 // Exporter handles the delivery of metric data to external receivers. This is
 // the final component in the metric push pipeline.
 type Exporter interface {
+	// Temporality returns the Temporality to use for an instrument kind.
+	//
+	// A PeriodicReader uses this to determine the Temporality of the metric
+	// data it produces unless it was created with an explicit
+	// TemporalitySelector.
+	Temporality(view.InstrumentKind) metricdata.Temporality
+
+	// Aggregation returns the Aggregation to use for an instrument kind.
+	//
+	// A PeriodicReader uses this to determine the default Aggregation of the
+	// metric data it produces unless it was created with an explicit
+	// AggregationSelector.
+	Aggregation(view.InstrumentKind) aggregation.Aggregation // nolint:revive  // import-shadow for method scoped by type.
+
 	// Export serializes and transmits metric data to a receiver.
 	//
 	// This is called synchronously, there is no concurrency safety
@@ -56,3 +73,19 @@ type Exporter interface {
 	// instead will return an error indicating the shutdown state.
 	Shutdown(context.Context) error
 }
+
+// ScopeExporter is an Exporter that can also export the metric data of a
+// collection one instrumentation scope at a time.
+//
+// A PeriodicReader created with the WithStreamingExport option exports the
+// metric data it collects with ExportScopeMetrics, as each scope is
+// collected, instead of with Export.
+type ScopeExporter interface {
+	Exporter
+
+	// ExportScopeMetrics serializes and transmits the metric data of a single
+	// instrumentation scope, produced for the resource res, to a receiver.
+	//
+	// The same requirements as those of Export apply to this method.
+	ExportScopeMetrics(ctx context.Context, res *resource.Resource, sm metricdata.ScopeMetrics) error
+}
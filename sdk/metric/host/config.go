@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package host // import "go.opentelemetry.io/otel/sdk/metric/host"
+
+import (
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+)
+
+// config contains options for the host instrumentation.
+type config struct {
+	meterProvider metric.MeterProvider
+}
+
+// newConfig creates a validated config configured with options.
+func newConfig(opts ...Option) config {
+	cfg := config{}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+
+	if cfg.meterProvider == nil {
+		cfg.meterProvider = global.MeterProvider()
+	}
+
+	return cfg
+}
+
+// Option sets host instrumentation option values.
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(cfg config) config {
+	return fn(cfg)
+}
+
+// WithMeterProvider sets the MeterProvider the instruments are registered
+// with. If this option is not used, the global MeterProvider is used.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.meterProvider = mp
+		return cfg
+	})
+}
@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package host // import "go.opentelemetry.io/otel/sdk/metric/host"
+
+import (
+	"syscall"
+	"time"
+)
+
+// processCPUTimes returns the user and system CPU times of the process.
+func processCPUTimes() (user, system float64, err error) {
+	var ru syscall.Rusage
+	if err := syscall.Getrusage(syscall.RUSAGE_SELF, &ru); err != nil {
+		return 0, 0, err
+	}
+	return toSeconds(ru.Utime), toSeconds(ru.Stime), nil
+}
+
+func toSeconds(tv syscall.Timeval) float64 {
+	return time.Duration(tv.Nano()).Seconds()
+}
@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package host provides instrumentation reporting the resource usage of the
+// process and the host it runs on.
+//
+// Calling Start registers asynchronous instruments with a MeterProvider that
+// report, following the OpenTelemetry system semantic conventions:
+//
+//   - process.cpu.time: CPU time used by the process.
+//   - process.memory.usage: resident set size of the process.
+//   - process.open_file_descriptors: file descriptors open in the process.
+//   - system.cpu.time: CPU time of the host by CPU state.
+//   - system.memory.usage: memory of the host by memory state.
+//   - system.network.io: bytes received and transmitted by network device.
+//
+// This is intended for environments where no node agent collects these
+// metrics. Values that cannot be read on the current platform are not
+// reported. Currently all values are reported on Linux and only the process
+// CPU time is reported on other Unix systems.
+package host // import "go.opentelemetry.io/otel/sdk/metric/host"
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package host // import "go.opentelemetry.io/otel/sdk/metric/host"
+
+import (
+	"context"
+	"errors"
+	"runtime"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/asyncfloat64"
+	"go.opentelemetry.io/otel/metric/instrument/asyncint64"
+	"go.opentelemetry.io/otel/metric/unit"
+)
+
+const scopeName = "go.opentelemetry.io/otel/sdk/metric/host"
+
+// errUnsupported is returned when a value cannot be read on the current
+// platform.
+var errUnsupported = errors.New("not supported on " + runtime.GOOS)
+
+var (
+	stateKey     = attribute.Key("state")
+	deviceKey    = attribute.Key("device")
+	directionKey = attribute.Key("direction")
+)
+
+// host holds the instruments reporting process and host usage.
+type host struct {
+	processCPUTime asyncfloat64.Counter
+	processMemory  asyncint64.UpDownCounter
+	processFDs     asyncint64.UpDownCounter
+	systemCPUTime  asyncfloat64.Counter
+	systemMemory   asyncint64.UpDownCounter
+	systemNetIO    asyncint64.Counter
+}
+
+// Start registers instruments reporting the resource usage of the process
+// and the host with the global MeterProvider, or the MeterProvider set with
+// WithMeterProvider. The instruments are observed each time the
+// MeterProvider collects.
+func Start(opts ...Option) error {
+	cfg := newConfig(opts...)
+	meter := cfg.meterProvider.Meter(scopeName)
+
+	var (
+		h   host
+		err error
+	)
+	h.processCPUTime, err = meter.AsyncFloat64().Counter(
+		"process.cpu.time",
+		instrument.WithUnit(unit.Unit("s")),
+		instrument.WithDescription("Total CPU seconds broken down by different states."),
+	)
+	if err != nil {
+		return err
+	}
+	h.processMemory, err = meter.AsyncInt64().UpDownCounter(
+		"process.memory.usage",
+		instrument.WithUnit(unit.Bytes),
+		instrument.WithDescription("The amount of physical memory in use."),
+	)
+	if err != nil {
+		return err
+	}
+	h.processFDs, err = meter.AsyncInt64().UpDownCounter(
+		"process.open_file_descriptors",
+		instrument.WithUnit(unit.Unit("{count}")),
+		instrument.WithDescription("Number of file descriptors in use by the process."),
+	)
+	if err != nil {
+		return err
+	}
+	h.systemCPUTime, err = meter.AsyncFloat64().Counter(
+		"system.cpu.time",
+		instrument.WithUnit(unit.Unit("s")),
+		instrument.WithDescription("Seconds each logical CPU spent on each mode."),
+	)
+	if err != nil {
+		return err
+	}
+	h.systemMemory, err = meter.AsyncInt64().UpDownCounter(
+		"system.memory.usage",
+		instrument.WithUnit(unit.Bytes),
+		instrument.WithDescription("Reports memory in use by state."),
+	)
+	if err != nil {
+		return err
+	}
+	h.systemNetIO, err = meter.AsyncInt64().Counter(
+		"system.network.io",
+		instrument.WithUnit(unit.Bytes),
+		instrument.WithDescription("Bytes received and transmitted by network device."),
+	)
+	if err != nil {
+		return err
+	}
+
+	return meter.RegisterCallback(
+		[]instrument.Asynchronous{
+			h.processCPUTime,
+			h.processMemory,
+			h.processFDs,
+			h.systemCPUTime,
+			h.systemMemory,
+			h.systemNetIO,
+		},
+		h.observe,
+	)
+}
+
+// observe observes all instruments of h. Values that cannot be read are not
+// observed, errors other than the value being unsupported on the platform
+// are sent to the global error handler.
+func (h *host) observe(ctx context.Context) {
+	if user, system, err := processCPUTimes(); handle(err) {
+		h.processCPUTime.Observe(ctx, user, stateKey.String("user"))
+		h.processCPUTime.Observe(ctx, system, stateKey.String("system"))
+	}
+	if rss, err := processRSS(); handle(err) {
+		h.processMemory.Observe(ctx, rss)
+	}
+	if fds, err := processOpenFDs(); handle(err) {
+		h.processFDs.Observe(ctx, fds)
+	}
+	if times, err := hostCPUTimes(); handle(err) {
+		for _, t := range times {
+			h.systemCPUTime.Observe(ctx, t.seconds, stateKey.String(t.state))
+		}
+	}
+	if usage, err := hostMemoryUsage(); handle(err) {
+		for _, u := range usage {
+			h.systemMemory.Observe(ctx, u.bytes, stateKey.String(u.state))
+		}
+	}
+	if devices, err := hostNetworkIO(); handle(err) {
+		for _, d := range devices {
+			h.systemNetIO.Observe(ctx, d.received, deviceKey.String(d.device), directionKey.String("receive"))
+			h.systemNetIO.Observe(ctx, d.transmitted, deviceKey.String(d.device), directionKey.String("transmit"))
+		}
+	}
+}
+
+// handle returns true if err is nil. Otherwise, err is sent to the global
+// error handler unless it is errUnsupported and false is returned.
+func handle(err error) bool {
+	if err == nil {
+		return true
+	}
+	if !errors.Is(err, errUnsupported) {
+		otel.Handle(err)
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package host
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric"
+)
+
+func TestStart(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("all values are only reported on Linux")
+	}
+
+	reader := metric.NewManualReader()
+	mp := metric.NewMeterProvider(metric.WithReader(reader))
+	require.NoError(t, Start(WithMeterProvider(mp)))
+
+	rm, err := reader.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, rm.ScopeMetrics, 1)
+	sm := rm.ScopeMetrics[0]
+	assert.Equal(t, instrumentation.Scope{Name: scopeName}, sm.Scope)
+
+	var names []string
+	for _, m := range sm.Metrics {
+		names = append(names, m.Name)
+	}
+	assert.ElementsMatch(t, []string{
+		"process.cpu.time",
+		"process.memory.usage",
+		"process.open_file_descriptors",
+		"system.cpu.time",
+		"system.memory.usage",
+		"system.network.io",
+	}, names)
+}
+
+func TestHandle(t *testing.T) {
+	assert.True(t, handle(nil))
+	assert.False(t, handle(errUnsupported))
+}
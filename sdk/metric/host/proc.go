@@ -0,0 +1,176 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package host // import "go.opentelemetry.io/otel/sdk/metric/host"
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// userHZ is the number of clock ticks per second used by the Linux kernel to
+// report CPU times to user space.
+const userHZ = 100
+
+// cpuTime is the CPU time spent in a state.
+type cpuTime struct {
+	state   string
+	seconds float64
+}
+
+// memoryUsage is the memory in a state.
+type memoryUsage struct {
+	state string
+	bytes int64
+}
+
+// networkIO is the bytes received and transmitted by a network device.
+type networkIO struct {
+	device      string
+	received    int64
+	transmitted int64
+}
+
+// cpuStates are the CPU states of the fields of the cpu line of
+// /proc/stat, in order. The irq and softirq fields are both reported as
+// interrupt.
+var cpuStates = []string{"user", "nice", "system", "idle", "iowait", "interrupt", "interrupt", "steal"}
+
+// parseStat parses the CPU times of all CPUs from the content of /proc/stat.
+func parseStat(r io.Reader) ([]cpuTime, error) {
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) == 0 || fields[0] != "cpu" {
+			continue
+		}
+		var times []cpuTime
+		index := make(map[string]int, len(cpuStates))
+		for i, f := range fields[1:] {
+			if i >= len(cpuStates) {
+				break
+			}
+			ticks, err := strconv.ParseUint(f, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cpu time %q: %w", f, err)
+			}
+			state := cpuStates[i]
+			if j, ok := index[state]; ok {
+				times[j].seconds += float64(ticks) / userHZ
+				continue
+			}
+			index[state] = len(times)
+			times = append(times, cpuTime{state: state, seconds: float64(ticks) / userHZ})
+		}
+		return times, nil
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("cpu line not found")
+}
+
+// parseMeminfo parses the memory usage from the content of /proc/meminfo.
+func parseMeminfo(r io.Reader) ([]memoryUsage, error) {
+	values := make(map[string]int64, 4)
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		key, value, ok := strings.Cut(s.Text(), ":")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "MemTotal", "MemFree", "Buffers", "Cached":
+		default:
+			continue
+		}
+		fields := strings.Fields(value)
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("invalid %s value %q", key, value)
+		}
+		v, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value %q: %w", key, value, err)
+		}
+		if len(fields) > 1 && fields[1] == "kB" {
+			v *= 1024
+		}
+		values[key] = v
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, key := range []string{"MemTotal", "MemFree", "Buffers", "Cached"} {
+		if _, ok := values[key]; !ok {
+			return nil, fmt.Errorf("%s not found", key)
+		}
+	}
+	free, buffered, cached := values["MemFree"], values["Buffers"], values["Cached"]
+	return []memoryUsage{
+		{state: "used", bytes: values["MemTotal"] - free - buffered - cached},
+		{state: "free", bytes: free},
+		{state: "buffered", bytes: buffered},
+		{state: "cached", bytes: cached},
+	}, nil
+}
+
+// parseNetDev parses the bytes received and transmitted by all network
+// devices from the content of /proc/net/dev.
+func parseNetDev(r io.Reader) ([]networkIO, error) {
+	var out []networkIO
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		device, counters, ok := strings.Cut(s.Text(), ":")
+		if !ok {
+			// Header line.
+			continue
+		}
+		fields := strings.Fields(counters)
+		if len(fields) < 9 {
+			return nil, fmt.Errorf("invalid network device line %q", s.Text())
+		}
+		rx, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid received bytes %q: %w", fields[0], err)
+		}
+		tx, err := strconv.ParseInt(fields[8], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transmitted bytes %q: %w", fields[8], err)
+		}
+		out = append(out, networkIO{
+			device:      strings.TrimSpace(device),
+			received:    rx,
+			transmitted: tx,
+		})
+	}
+	return out, s.Err()
+}
+
+// parseStatm parses the resident set size, in pages, from the content of
+// /proc/<pid>/statm.
+func parseStatm(r io.Reader) (int64, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	fields := strings.Fields(string(b))
+	if len(fields) < 2 {
+		return 0, fmt.Errorf("invalid statm %q", string(b))
+	}
+	return strconv.ParseInt(fields[1], 10, 64)
+}
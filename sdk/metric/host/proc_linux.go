@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package host // import "go.opentelemetry.io/otel/sdk/metric/host"
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// procfs is the mount point of the proc file system.
+var procfs = "/proc"
+
+func readProc(parse func(io.Reader) error, elem ...string) error {
+	f, err := os.Open(filepath.Join(append([]string{procfs}, elem...)...))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return parse(f)
+}
+
+func processRSS() (int64, error) {
+	var pages int64
+	err := readProc(func(r io.Reader) (err error) {
+		pages, err = parseStatm(r)
+		return err
+	}, "self", "statm")
+	return pages * int64(os.Getpagesize()), err
+}
+
+func processOpenFDs() (int64, error) {
+	entries, err := os.ReadDir(filepath.Join(procfs, "self", "fd"))
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(entries)), nil
+}
+
+func hostCPUTimes() ([]cpuTime, error) {
+	var times []cpuTime
+	err := readProc(func(r io.Reader) (err error) {
+		times, err = parseStat(r)
+		return err
+	}, "stat")
+	return times, err
+}
+
+func hostMemoryUsage() ([]memoryUsage, error) {
+	var usage []memoryUsage
+	err := readProc(func(r io.Reader) (err error) {
+		usage, err = parseMeminfo(r)
+		return err
+	}, "meminfo")
+	return usage, err
+}
+
+func hostNetworkIO() ([]networkIO, error) {
+	var devices []networkIO
+	err := readProc(func(r io.Reader) (err error) {
+		devices, err = parseNetDev(r)
+		return err
+	}, "net", "dev")
+	return devices, err
+}
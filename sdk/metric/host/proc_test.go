@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package host
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStat(t *testing.T) {
+	stat := `cpu  100 20 300 4000 50 6 4 8 0 0
+cpu0 50 10 150 2000 25 3 2 4 0 0
+intr 12345
+`
+	got, err := parseStat(strings.NewReader(stat))
+	require.NoError(t, err)
+	assert.Equal(t, []cpuTime{
+		{state: "user", seconds: 1},
+		{state: "nice", seconds: 0.2},
+		{state: "system", seconds: 3},
+		{state: "idle", seconds: 40},
+		{state: "iowait", seconds: 0.5},
+		{state: "interrupt", seconds: 0.1},
+		{state: "steal", seconds: 0.08},
+	}, got)
+
+	_, err = parseStat(strings.NewReader("intr 12345\n"))
+	assert.Error(t, err)
+	_, err = parseStat(strings.NewReader("cpu  1 a\n"))
+	assert.Error(t, err)
+}
+
+func TestParseMeminfo(t *testing.T) {
+	meminfo := `MemTotal:       16000 kB
+MemFree:         4000 kB
+MemAvailable:    9000 kB
+Buffers:         1000 kB
+Cached:          3000 kB
+SwapCached:         0 kB
+`
+	got, err := parseMeminfo(strings.NewReader(meminfo))
+	require.NoError(t, err)
+	assert.Equal(t, []memoryUsage{
+		{state: "used", bytes: 8000 * 1024},
+		{state: "free", bytes: 4000 * 1024},
+		{state: "buffered", bytes: 1000 * 1024},
+		{state: "cached", bytes: 3000 * 1024},
+	}, got)
+
+	_, err = parseMeminfo(strings.NewReader("MemTotal: 16000 kB\n"))
+	assert.Error(t, err)
+	_, err = parseMeminfo(strings.NewReader("MemTotal: a kB\n"))
+	assert.Error(t, err)
+}
+
+func TestParseNetDev(t *testing.T) {
+	netDev := `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo:    1000      10    0    0    0     0          0         0     1000      10    0    0    0     0       0          0
+  eth0:  123456     100    0    0    0     0          0         0    65432      90    0    0    0     0       0          0
+`
+	got, err := parseNetDev(strings.NewReader(netDev))
+	require.NoError(t, err)
+	assert.Equal(t, []networkIO{
+		{device: "lo", received: 1000, transmitted: 1000},
+		{device: "eth0", received: 123456, transmitted: 65432},
+	}, got)
+
+	_, err = parseNetDev(strings.NewReader("eth0: 1 2 3\n"))
+	assert.Error(t, err)
+}
+
+func TestParseStatm(t *testing.T) {
+	got, err := parseStatm(strings.NewReader("5000 1200 300 10 0 900 0\n"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1200), got)
+
+	_, err = parseStatm(strings.NewReader("5000\n"))
+	assert.Error(t, err)
+}
@@ -16,6 +16,8 @@ package metric // import "go.opentelemetry.io/otel/sdk/metric"
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric/instrument"
@@ -53,7 +55,8 @@ type instrumentImpl[N int64 | float64] struct {
 	instrument.Asynchronous
 	instrument.Synchronous
 
-	aggregators []internal.Aggregator[N]
+	name        string
+	aggregators *aggregatorSet[N]
 }
 
 var _ asyncfloat64.Counter = &instrumentImpl[float64]{}
@@ -62,12 +65,14 @@ var _ asyncfloat64.Gauge = &instrumentImpl[float64]{}
 var _ asyncint64.Counter = &instrumentImpl[int64]{}
 var _ asyncint64.UpDownCounter = &instrumentImpl[int64]{}
 var _ asyncint64.Gauge = &instrumentImpl[int64]{}
-var _ syncfloat64.Counter = &instrumentImpl[float64]{}
+var _ syncfloat64.Counter = float64Counter{}
 var _ syncfloat64.UpDownCounter = &instrumentImpl[float64]{}
 var _ syncfloat64.Histogram = &instrumentImpl[float64]{}
-var _ syncint64.Counter = &instrumentImpl[int64]{}
+var _ syncfloat64.Gauge = &instrumentImpl[float64]{}
+var _ syncint64.Counter = int64Counter{}
 var _ syncint64.UpDownCounter = &instrumentImpl[int64]{}
 var _ syncint64.Histogram = &instrumentImpl[int64]{}
+var _ syncint64.Gauge = &instrumentImpl[int64]{}
 
 func (i *instrumentImpl[N]) Observe(ctx context.Context, val N, attrs ...attribute.KeyValue) {
 	// Only record a value if this is being called from the MetricProvider.
@@ -75,6 +80,12 @@ func (i *instrumentImpl[N]) Observe(ctx context.Context, val N, attrs ...attribu
 	if !ok {
 		return
 	}
+	// Hold the observation until the callback completes if it can be
+	// abandoned.
+	if obs, ok := ctx.Value(observationsKey).(*observations); ok {
+		obs.add(func() { i.aggregate(ctx, val, attrs) })
+		return
+	}
 	i.aggregate(ctx, val, attrs)
 }
 
@@ -86,11 +97,201 @@ func (i *instrumentImpl[N]) Record(ctx context.Context, val N, attrs ...attribut
 	i.aggregate(ctx, val, attrs)
 }
 
+// Enabled reports whether any Reader reads the measurements of the
+// instrument. It returns false if the instrument is dropped by all views or
+// no Reader is registered with the MeterProvider.
+func (i *instrumentImpl[N]) Enabled(context.Context) bool {
+	return len(i.aggregators.load()) > 0
+}
+
+// setBuilderPool holds SetBuilders used to build measurement attribute sets
+// without per-measurement allocations for sorting.
+var setBuilderPool = sync.Pool{
+	New: func() interface{} { return new(attribute.SetBuilder) },
+}
+
 func (i *instrumentImpl[N]) aggregate(ctx context.Context, val N, attrs []attribute.KeyValue) {
 	if err := ctx.Err(); err != nil {
 		return
 	}
-	for _, agg := range i.aggregators {
-		agg.Aggregate(val, attribute.NewSet(attrs...))
+	aggs := i.aggregators.load()
+	if len(aggs) == 0 {
+		return
+	}
+
+	b := setBuilderPool.Get().(*attribute.SetBuilder)
+	b.Add(attrs...)
+	set := b.Set()
+	b.Reset()
+	setBuilderPool.Put(b)
+
+	for _, agg := range aggs {
+		agg.Aggregate(ctx, val, set)
+	}
+}
+
+// bind returns a boundInstrument that aggregates values for attrs.
+func (i *instrumentImpl[N]) bind(attrs []attribute.KeyValue) *boundInstrument[N] {
+	return &boundInstrument[N]{
+		aggregators: i.aggregators,
+		attrs:       attribute.NewSet(attrs...),
+	}
+}
+
+// int64Counter is an int64 counter instrument that can be bound to a fixed
+// set of attributes.
+type int64Counter struct {
+	*instrumentImpl[int64]
+}
+
+func (c int64Counter) Bind(attrs ...attribute.KeyValue) syncint64.BoundCounter {
+	return c.bind(attrs)
+}
+
+// float64Counter is a float64 counter instrument that can be bound to a
+// fixed set of attributes.
+type float64Counter struct {
+	*instrumentImpl[float64]
+}
+
+func (c float64Counter) Bind(attrs ...attribute.KeyValue) syncfloat64.BoundCounter {
+	return c.bind(attrs)
+}
+
+// boundInstrument aggregates values for an attribute set that was resolved
+// once when it was created. Where the Aggregators store the values of the
+// attribute set is resolved when a value is first added and again each time
+// the Aggregators change.
+type boundInstrument[N int64 | float64] struct {
+	aggregators *aggregatorSet[N]
+	attrs       attribute.Set
+
+	// resolved holds the *boundAggregators for the Aggregators last loaded.
+	resolved atomic.Value
+}
+
+// boundAggregators are the functions aggregating the values of a
+// boundInstrument into a snapshot of the Aggregators of an aggregatorSet.
+type boundAggregators[N int64 | float64] struct {
+	snapshot *aggregators[N]
+	bound    []func(context.Context, N)
+}
+
+func (b *boundInstrument[N]) Add(ctx context.Context, val N) {
+	if err := ctx.Err(); err != nil {
+		return
+	}
+	for _, aggregate := range b.resolve() {
+		aggregate(ctx, val)
+	}
+}
+
+// resolve returns the functions aggregating values into the current
+// Aggregators of b, binding them if the Aggregators changed.
+func (b *boundInstrument[N]) resolve() []func(context.Context, N) {
+	snapshot := b.aggregators.snapshot()
+	if r, ok := b.resolved.Load().(*boundAggregators[N]); ok && r.snapshot == snapshot {
+		return r.bound
+	}
+	r := &boundAggregators[N]{snapshot: snapshot}
+	if snapshot != nil {
+		r.bound = make([]func(context.Context, N), len(snapshot.aggs))
+		for i, agg := range snapshot.aggs {
+			r.bound[i] = internal.Bind(agg, b.attrs)
+		}
+	}
+	b.resolved.Store(r)
+	return r.bound
+}
+
+// pipelineAggregators are the Aggregators of an instrument a pipeline reads.
+type pipelineAggregators[N int64 | float64] struct {
+	pipe *pipeline
+	aggs []internal.Aggregator[N]
+}
+
+// aggregatorSet is the set of Aggregators an instrument updates when it makes
+// a measurement. The Aggregators are grouped by the pipeline that reads them
+// so they can be added and removed as Readers are registered with and
+// unregistered from a MeterProvider.
+//
+// All methods of an aggregatorSet are safe to call concurrently.
+type aggregatorSet[N int64 | float64] struct {
+	sync.Mutex
+	pipes []pipelineAggregators[N]
+
+	// all holds the *aggregators[N] of all pipes. It is loaded without
+	// acquiring the lock when a measurement is made.
+	all atomic.Value
+}
+
+// aggregators is a snapshot of the Aggregators in an aggregatorSet. A new
+// snapshot is stored each time the Aggregators change.
+type aggregators[N int64 | float64] struct {
+	aggs []internal.Aggregator[N]
+}
+
+func newAggregatorSet[N int64 | float64](pipes []pipelineAggregators[N]) *aggregatorSet[N] {
+	s := &aggregatorSet[N]{}
+	s.store(pipes)
+	return s
+}
+
+// load returns all Aggregators in s.
+func (s *aggregatorSet[N]) load() []internal.Aggregator[N] {
+	if snapshot := s.snapshot(); snapshot != nil {
+		return snapshot.aggs
+	}
+	return nil
+}
+
+// snapshot returns the current snapshot of the Aggregators in s.
+func (s *aggregatorSet[N]) snapshot() *aggregators[N] {
+	snapshot, _ := s.all.Load().(*aggregators[N])
+	return snapshot
+}
+
+// add adds the Aggregators read by a pipeline to s.
+func (s *aggregatorSet[N]) add(p pipelineAggregators[N]) {
+	s.Lock()
+	defer s.Unlock()
+	pipes := make([]pipelineAggregators[N], len(s.pipes), len(s.pipes)+1)
+	copy(pipes, s.pipes)
+	s.store(append(pipes, p))
+}
+
+// remove removes all Aggregators read by pipeline p from s.
+func (s *aggregatorSet[N]) remove(p *pipeline) {
+	s.Lock()
+	defer s.Unlock()
+	pipes := make([]pipelineAggregators[N], 0, len(s.pipes))
+	for _, pAggs := range s.pipes {
+		if pAggs.pipe != p {
+			pipes = append(pipes, pAggs)
+		}
+	}
+	s.store(pipes)
+}
+
+// store sets the Aggregators of s to the ones in pipes. The lock of s needs
+// to be held by the caller if s is shared.
+func (s *aggregatorSet[N]) store(pipes []pipelineAggregators[N]) {
+	var all []internal.Aggregator[N]
+	for _, pAggs := range pipes {
+		all = append(all, pAggs.aggs...)
+	}
+	s.pipes = pipes
+	s.all.Store(&aggregators[N]{aggs: all})
+}
+
+// instrumentName returns the name of inst if it was created by this SDK.
+// Otherwise, an empty string is returned.
+func instrumentName(inst instrument.Asynchronous) string {
+	switch i := inst.(type) {
+	case *instrumentImpl[int64]:
+		return i.name
+	case *instrumentImpl[float64]:
+		return i.name
 	}
+	return ""
 }
@@ -15,8 +15,11 @@
 package metric // import "go.opentelemetry.io/otel/sdk/metric"
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
+	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric/instrument"
 	"go.opentelemetry.io/otel/metric/instrument/asyncfloat64"
 	"go.opentelemetry.io/otel/metric/instrument/asyncint64"
@@ -26,9 +29,79 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric/view"
 )
 
+// maxInstrumentNameLen is the maximum length of a valid instrument name.
+const maxInstrumentNameLen = 63
+
+// ErrInstrumentName indicates the created instrument has an invalid name.
+// Valid names must consist of 63 or fewer characters including alphanumeric,
+// _, ., and -, and start with a letter.
+var ErrInstrumentName = errors.New("invalid instrument name")
+
+// nameValidator validates the names of the instruments a meter creates.
+type nameValidator struct {
+	// relaxed is true if invalid names are sanitized instead of rejected.
+	relaxed bool
+}
+
+// validate returns name and nil if name is a valid instrument name. If name
+// is invalid, and the nameValidator is relaxed, a sanitized version of name
+// is returned after the change is logged. Otherwise, name and an error
+// wrapping ErrInstrumentName are returned.
+func (v nameValidator) validate(name string) (string, error) {
+	if validInstrumentName(name) {
+		return name, nil
+	}
+	if !v.relaxed || name == "" {
+		return name, fmt.Errorf("%w: %q", ErrInstrumentName, name)
+	}
+
+	sanitized := sanitizeInstrumentName(name)
+	global.Info("sanitized invalid instrument name", "name", name, "sanitized", sanitized)
+	return sanitized, nil
+}
+
+func validInstrumentName(name string) bool {
+	if len(name) == 0 || len(name) > maxInstrumentNameLen || !isAlpha(name[0]) {
+		return false
+	}
+	for i := 1; i < len(name); i++ {
+		if !isNameChar(name[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// sanitizeInstrumentName returns name with all invalid characters replaced
+// with an underscore, an "m_" prefix if it does not start with a letter, and
+// truncated to the maximum instrument name length.
+func sanitizeInstrumentName(name string) string {
+	var b strings.Builder
+	if !isAlpha(name[0]) {
+		b.WriteString("m_")
+	}
+	for i := 0; i < len(name) && b.Len() < maxInstrumentNameLen; i++ {
+		if isNameChar(name[i]) {
+			b.WriteByte(name[i])
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
+
+func isAlpha(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isNameChar(c byte) bool {
+	return isAlpha(c) || ('0' <= c && c <= '9') || c == '_' || c == '.' || c == '-'
+}
+
 type asyncInt64Provider struct {
 	scope   instrumentation.Scope
-	resolve *resolver[int64]
+	resolve *meterResolver[int64]
+	names   nameValidator
 }
 
 var _ asyncint64.InstrumentProvider = asyncInt64Provider{}
@@ -36,6 +109,7 @@ var _ asyncint64.InstrumentProvider = asyncInt64Provider{}
 // Counter creates an instrument for recording increasing values.
 func (p asyncInt64Provider) Counter(name string, opts ...instrument.Option) (asyncint64.Counter, error) {
 	cfg := instrument.NewConfig(opts...)
+	name, nameErr := p.names.validate(name)
 
 	aggs, err := p.resolve.Aggregators(view.Instrument{
 		Scope:       p.scope,
@@ -43,11 +117,15 @@ func (p asyncInt64Provider) Counter(name string, opts ...instrument.Option) (asy
 		Description: cfg.Description(),
 		Kind:        view.AsyncCounter,
 	}, cfg.Unit())
-	if len(aggs) == 0 && err != nil {
+	if len(aggs.load()) == 0 && err != nil {
 		err = fmt.Errorf("instrument does not match any view: %w", err)
 	}
+	if err == nil {
+		err = nameErr
+	}
 
 	return &instrumentImpl[int64]{
+		name:        name,
 		aggregators: aggs,
 	}, err
 }
@@ -55,6 +133,7 @@ func (p asyncInt64Provider) Counter(name string, opts ...instrument.Option) (asy
 // UpDownCounter creates an instrument for recording changes of a value.
 func (p asyncInt64Provider) UpDownCounter(name string, opts ...instrument.Option) (asyncint64.UpDownCounter, error) {
 	cfg := instrument.NewConfig(opts...)
+	name, nameErr := p.names.validate(name)
 
 	aggs, err := p.resolve.Aggregators(view.Instrument{
 		Scope:       p.scope,
@@ -62,10 +141,14 @@ func (p asyncInt64Provider) UpDownCounter(name string, opts ...instrument.Option
 		Description: cfg.Description(),
 		Kind:        view.AsyncUpDownCounter,
 	}, cfg.Unit())
-	if len(aggs) == 0 && err != nil {
+	if len(aggs.load()) == 0 && err != nil {
 		err = fmt.Errorf("instrument does not match any view: %w", err)
 	}
+	if err == nil {
+		err = nameErr
+	}
 	return &instrumentImpl[int64]{
+		name:        name,
 		aggregators: aggs,
 	}, err
 }
@@ -73,6 +156,7 @@ func (p asyncInt64Provider) UpDownCounter(name string, opts ...instrument.Option
 // Gauge creates an instrument for recording the current value.
 func (p asyncInt64Provider) Gauge(name string, opts ...instrument.Option) (asyncint64.Gauge, error) {
 	cfg := instrument.NewConfig(opts...)
+	name, nameErr := p.names.validate(name)
 
 	aggs, err := p.resolve.Aggregators(view.Instrument{
 		Scope:       p.scope,
@@ -80,17 +164,22 @@ func (p asyncInt64Provider) Gauge(name string, opts ...instrument.Option) (async
 		Description: cfg.Description(),
 		Kind:        view.AsyncGauge,
 	}, cfg.Unit())
-	if len(aggs) == 0 && err != nil {
+	if len(aggs.load()) == 0 && err != nil {
 		err = fmt.Errorf("instrument does not match any view: %w", err)
 	}
+	if err == nil {
+		err = nameErr
+	}
 	return &instrumentImpl[int64]{
+		name:        name,
 		aggregators: aggs,
 	}, err
 }
 
 type asyncFloat64Provider struct {
 	scope   instrumentation.Scope
-	resolve *resolver[float64]
+	resolve *meterResolver[float64]
+	names   nameValidator
 }
 
 var _ asyncfloat64.InstrumentProvider = asyncFloat64Provider{}
@@ -98,6 +187,7 @@ var _ asyncfloat64.InstrumentProvider = asyncFloat64Provider{}
 // Counter creates an instrument for recording increasing values.
 func (p asyncFloat64Provider) Counter(name string, opts ...instrument.Option) (asyncfloat64.Counter, error) {
 	cfg := instrument.NewConfig(opts...)
+	name, nameErr := p.names.validate(name)
 
 	aggs, err := p.resolve.Aggregators(view.Instrument{
 		Scope:       p.scope,
@@ -105,10 +195,14 @@ func (p asyncFloat64Provider) Counter(name string, opts ...instrument.Option) (a
 		Description: cfg.Description(),
 		Kind:        view.AsyncCounter,
 	}, cfg.Unit())
-	if len(aggs) == 0 && err != nil {
+	if len(aggs.load()) == 0 && err != nil {
 		err = fmt.Errorf("instrument does not match any view: %w", err)
 	}
+	if err == nil {
+		err = nameErr
+	}
 	return &instrumentImpl[float64]{
+		name:        name,
 		aggregators: aggs,
 	}, err
 }
@@ -116,6 +210,7 @@ func (p asyncFloat64Provider) Counter(name string, opts ...instrument.Option) (a
 // UpDownCounter creates an instrument for recording changes of a value.
 func (p asyncFloat64Provider) UpDownCounter(name string, opts ...instrument.Option) (asyncfloat64.UpDownCounter, error) {
 	cfg := instrument.NewConfig(opts...)
+	name, nameErr := p.names.validate(name)
 
 	aggs, err := p.resolve.Aggregators(view.Instrument{
 		Scope:       p.scope,
@@ -123,10 +218,14 @@ func (p asyncFloat64Provider) UpDownCounter(name string, opts ...instrument.Opti
 		Description: cfg.Description(),
 		Kind:        view.AsyncUpDownCounter,
 	}, cfg.Unit())
-	if len(aggs) == 0 && err != nil {
+	if len(aggs.load()) == 0 && err != nil {
 		err = fmt.Errorf("instrument does not match any view: %w", err)
 	}
+	if err == nil {
+		err = nameErr
+	}
 	return &instrumentImpl[float64]{
+		name:        name,
 		aggregators: aggs,
 	}, err
 }
@@ -134,6 +233,7 @@ func (p asyncFloat64Provider) UpDownCounter(name string, opts ...instrument.Opti
 // Gauge creates an instrument for recording the current value.
 func (p asyncFloat64Provider) Gauge(name string, opts ...instrument.Option) (asyncfloat64.Gauge, error) {
 	cfg := instrument.NewConfig(opts...)
+	name, nameErr := p.names.validate(name)
 
 	aggs, err := p.resolve.Aggregators(view.Instrument{
 		Scope:       p.scope,
@@ -141,17 +241,22 @@ func (p asyncFloat64Provider) Gauge(name string, opts ...instrument.Option) (asy
 		Description: cfg.Description(),
 		Kind:        view.AsyncGauge,
 	}, cfg.Unit())
-	if len(aggs) == 0 && err != nil {
+	if len(aggs.load()) == 0 && err != nil {
 		err = fmt.Errorf("instrument does not match any view: %w", err)
 	}
+	if err == nil {
+		err = nameErr
+	}
 	return &instrumentImpl[float64]{
+		name:        name,
 		aggregators: aggs,
 	}, err
 }
 
 type syncInt64Provider struct {
 	scope   instrumentation.Scope
-	resolve *resolver[int64]
+	resolve *meterResolver[int64]
+	names   nameValidator
 }
 
 var _ syncint64.InstrumentProvider = syncInt64Provider{}
@@ -159,6 +264,7 @@ var _ syncint64.InstrumentProvider = syncInt64Provider{}
 // Counter creates an instrument for recording increasing values.
 func (p syncInt64Provider) Counter(name string, opts ...instrument.Option) (syncint64.Counter, error) {
 	cfg := instrument.NewConfig(opts...)
+	name, nameErr := p.names.validate(name)
 
 	aggs, err := p.resolve.Aggregators(view.Instrument{
 		Scope:       p.scope,
@@ -166,17 +272,22 @@ func (p syncInt64Provider) Counter(name string, opts ...instrument.Option) (sync
 		Description: cfg.Description(),
 		Kind:        view.SyncCounter,
 	}, cfg.Unit())
-	if len(aggs) == 0 && err != nil {
+	if len(aggs.load()) == 0 && err != nil {
 		err = fmt.Errorf("instrument does not match any view: %w", err)
 	}
-	return &instrumentImpl[int64]{
+	if err == nil {
+		err = nameErr
+	}
+	return int64Counter{&instrumentImpl[int64]{
+		name:        name,
 		aggregators: aggs,
-	}, err
+	}}, err
 }
 
 // UpDownCounter creates an instrument for recording changes of a value.
 func (p syncInt64Provider) UpDownCounter(name string, opts ...instrument.Option) (syncint64.UpDownCounter, error) {
 	cfg := instrument.NewConfig(opts...)
+	name, nameErr := p.names.validate(name)
 
 	aggs, err := p.resolve.Aggregators(view.Instrument{
 		Scope:       p.scope,
@@ -184,10 +295,14 @@ func (p syncInt64Provider) UpDownCounter(name string, opts ...instrument.Option)
 		Description: cfg.Description(),
 		Kind:        view.SyncUpDownCounter,
 	}, cfg.Unit())
-	if len(aggs) == 0 && err != nil {
+	if len(aggs.load()) == 0 && err != nil {
 		err = fmt.Errorf("instrument does not match any view: %w", err)
 	}
+	if err == nil {
+		err = nameErr
+	}
 	return &instrumentImpl[int64]{
+		name:        name,
 		aggregators: aggs,
 	}, err
 }
@@ -195,24 +310,53 @@ func (p syncInt64Provider) UpDownCounter(name string, opts ...instrument.Option)
 // Histogram creates an instrument for recording the current value.
 func (p syncInt64Provider) Histogram(name string, opts ...instrument.Option) (syncint64.Histogram, error) {
 	cfg := instrument.NewConfig(opts...)
+	name, nameErr := p.names.validate(name)
 
-	aggs, err := p.resolve.Aggregators(view.Instrument{
+	aggs, err := p.resolve.HistogramAggregators(view.Instrument{
 		Scope:       p.scope,
 		Name:        name,
 		Description: cfg.Description(),
 		Kind:        view.SyncHistogram,
+	}, cfg.Unit(), cfg.ExplicitBucketBoundaries())
+	if len(aggs.load()) == 0 && err != nil {
+		err = fmt.Errorf("instrument does not match any view: %w", err)
+	}
+	if err == nil {
+		err = nameErr
+	}
+	return &instrumentImpl[int64]{
+		name:        name,
+		aggregators: aggs,
+	}, err
+}
+
+// Gauge creates an instrument for recording the current value.
+func (p syncInt64Provider) Gauge(name string, opts ...instrument.Option) (syncint64.Gauge, error) {
+	cfg := instrument.NewConfig(opts...)
+	name, nameErr := p.names.validate(name)
+
+	aggs, err := p.resolve.Aggregators(view.Instrument{
+		Scope:       p.scope,
+		Name:        name,
+		Description: cfg.Description(),
+		Kind:        view.SyncGauge,
 	}, cfg.Unit())
-	if len(aggs) == 0 && err != nil {
+	if len(aggs.load()) == 0 && err != nil {
 		err = fmt.Errorf("instrument does not match any view: %w", err)
 	}
+	if err == nil {
+		err = nameErr
+	}
 	return &instrumentImpl[int64]{
+		name:        name,
 		aggregators: aggs,
 	}, err
 }
 
 type syncFloat64Provider struct {
 	scope   instrumentation.Scope
-	resolve *resolver[float64]
+	resolve *meterResolver[float64]
+	names   nameValidator
 }
 
 var _ syncfloat64.InstrumentProvider = syncFloat64Provider{}
@@ -220,6 +364,7 @@ var _ syncfloat64.InstrumentProvider = syncFloat64Provider{}
 // Counter creates an instrument for recording increasing values.
 func (p syncFloat64Provider) Counter(name string, opts ...instrument.Option) (syncfloat64.Counter, error) {
 	cfg := instrument.NewConfig(opts...)
+	name, nameErr := p.names.validate(name)
 
 	aggs, err := p.resolve.Aggregators(view.Instrument{
 		Scope:       p.scope,
@@ -227,17 +372,22 @@ func (p syncFloat64Provider) Counter(name string, opts ...instrument.Option) (sy
 		Description: cfg.Description(),
 		Kind:        view.SyncCounter,
 	}, cfg.Unit())
-	if len(aggs) == 0 && err != nil {
+	if len(aggs.load()) == 0 && err != nil {
 		err = fmt.Errorf("instrument does not match any view: %w", err)
 	}
-	return &instrumentImpl[float64]{
+	if err == nil {
+		err = nameErr
+	}
+	return float64Counter{&instrumentImpl[float64]{
+		name:        name,
 		aggregators: aggs,
-	}, err
+	}}, err
 }
 
 // UpDownCounter creates an instrument for recording changes of a value.
 func (p syncFloat64Provider) UpDownCounter(name string, opts ...instrument.Option) (syncfloat64.UpDownCounter, error) {
 	cfg := instrument.NewConfig(opts...)
+	name, nameErr := p.names.validate(name)
 
 	aggs, err := p.resolve.Aggregators(view.Instrument{
 		Scope:       p.scope,
@@ -245,10 +395,14 @@ func (p syncFloat64Provider) UpDownCounter(name string, opts ...instrument.Optio
 		Description: cfg.Description(),
 		Kind:        view.SyncUpDownCounter,
 	}, cfg.Unit())
-	if len(aggs) == 0 && err != nil {
+	if len(aggs.load()) == 0 && err != nil {
 		err = fmt.Errorf("instrument does not match any view: %w", err)
 	}
+	if err == nil {
+		err = nameErr
+	}
 	return &instrumentImpl[float64]{
+		name:        name,
 		aggregators: aggs,
 	}, err
 }
@@ -256,17 +410,45 @@ func (p syncFloat64Provider) UpDownCounter(name string, opts ...instrument.Optio
 // Histogram creates an instrument for recording the current value.
 func (p syncFloat64Provider) Histogram(name string, opts ...instrument.Option) (syncfloat64.Histogram, error) {
 	cfg := instrument.NewConfig(opts...)
+	name, nameErr := p.names.validate(name)
 
-	aggs, err := p.resolve.Aggregators(view.Instrument{
+	aggs, err := p.resolve.HistogramAggregators(view.Instrument{
 		Scope:       p.scope,
 		Name:        name,
 		Description: cfg.Description(),
 		Kind:        view.SyncHistogram,
+	}, cfg.Unit(), cfg.ExplicitBucketBoundaries())
+	if len(aggs.load()) == 0 && err != nil {
+		err = fmt.Errorf("instrument does not match any view: %w", err)
+	}
+	if err == nil {
+		err = nameErr
+	}
+	return &instrumentImpl[float64]{
+		name:        name,
+		aggregators: aggs,
+	}, err
+}
+
+// Gauge creates an instrument for recording the current value.
+func (p syncFloat64Provider) Gauge(name string, opts ...instrument.Option) (syncfloat64.Gauge, error) {
+	cfg := instrument.NewConfig(opts...)
+	name, nameErr := p.names.validate(name)
+
+	aggs, err := p.resolve.Aggregators(view.Instrument{
+		Scope:       p.scope,
+		Name:        name,
+		Description: cfg.Description(),
+		Kind:        view.SyncGauge,
 	}, cfg.Unit())
-	if len(aggs) == 0 && err != nil {
+	if len(aggs.load()) == 0 && err != nil {
 		err = fmt.Errorf("instrument does not match any view: %w", err)
 	}
+	if err == nil {
+		err = nameErr
+	}
 	return &instrumentImpl[float64]{
+		name:        name,
 		aggregators: aggs,
 	}, err
 }
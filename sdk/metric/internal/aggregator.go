@@ -15,6 +15,7 @@
 package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
 
 import (
+	"context"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -25,6 +26,123 @@ import (
 // override the the default time.Now function.
 var now = time.Now
 
+// clock provides the current time to an Aggregator.
+type clock struct {
+	// nowFunc returns the current time. If nil, now is used.
+	nowFunc func() time.Time
+}
+
+func (c clock) now() time.Time {
+	if c.nowFunc == nil {
+		return now()
+	}
+	return c.nowFunc()
+}
+
+// clockSetter is implemented by Aggregators that timestamp the aggregations
+// they produce, or that wrap an Aggregator that does.
+type clockSetter interface {
+	// setClock sets the function used to get the current time to fn. Any
+	// start time of an aggregation cycle is reset to the current time of fn.
+	setClock(fn func() time.Time)
+}
+
+// SetClock sets the function agg uses to get the current time to fn. It
+// resets the start time of the current aggregation cycle of agg to the
+// current time returned from fn, therefore it needs to be called before any
+// measurement is aggregated. Wrapping Aggregators set the clock of the
+// Aggregator they wrap. If fn is nil, agg is not changed.
+func SetClock[N int64 | float64](agg Aggregator[N], fn func() time.Time) {
+	setClock(agg, fn)
+}
+
+// setClock sets the clock of v to fn if v is a clockSetter.
+func setClock(v interface{}, fn func() time.Time) {
+	if fn == nil {
+		return
+	}
+	if c, ok := v.(clockSetter); ok {
+		c.setClock(fn)
+	}
+}
+
+// reuser is implemented by Aggregators that can form their Aggregation in
+// the memory of an Aggregation previously returned, or that wrap an
+// Aggregator that does.
+type reuser interface {
+	// aggregationReuse is the same as Aggregation, but the backing array of
+	// the data points of prev is reused to hold the returned data points if
+	// prev is of the same type and has the capacity.
+	aggregationReuse(prev metricdata.Aggregation) metricdata.Aggregation
+}
+
+// AggregationReuse returns the Aggregation of agg and ends an aggregation
+// cycle, the same as its Aggregation method. If agg supports it, the memory
+// of prev, an Aggregation previously returned by any Aggregator, is reused to
+// hold the returned Aggregation. Therefore, prev must not be used after this
+// is called.
+//
+// The data points of sum, gauge, and explicit bucket histogram aggregations
+// are reused.
+func AggregationReuse(agg interface{ Aggregation() metricdata.Aggregation }, prev metricdata.Aggregation) metricdata.Aggregation {
+	if r, ok := agg.(reuser); ok && prev != nil {
+		return r.aggregationReuse(prev)
+	}
+	return agg.Aggregation()
+}
+
+// binder is implemented by Aggregators that can resolve where the
+// measurements of an attribute set are aggregated ahead of time, or that wrap
+// an Aggregator that does.
+type binder[N int64 | float64] interface {
+	// bind returns a function that aggregates a measurement scoped by attr.
+	bind(attr attribute.Set) func(ctx context.Context, measurement N)
+}
+
+// Bind returns a function that aggregates measurements scoped by attr with
+// agg, the same as its Aggregate method. If agg supports it, attr is resolved
+// to the storage it is aggregated in once by Bind instead of for every
+// measurement. Wrapping Aggregators apply their attribute filtering and
+// limits to attr once.
+//
+// The returned function remains valid for as long as agg is.
+func Bind[N int64 | float64](agg Aggregator[N], attr attribute.Set) func(context.Context, N) {
+	if b, ok := agg.(binder[N]); ok {
+		return b.bind(attr)
+	}
+	return func(ctx context.Context, measurement N) {
+		agg.Aggregate(ctx, measurement, attr)
+	}
+}
+
+// reuseDataPoints returns an empty slice of DataPoint with a capacity of at
+// least n. The backing array of the data points of prev is used if prev is a
+// Sum or Gauge with the capacity.
+func reuseDataPoints[N int64 | float64](prev metricdata.Aggregation, n int) []metricdata.DataPoint[N] {
+	var dPts []metricdata.DataPoint[N]
+	switch p := prev.(type) {
+	case metricdata.Sum[N]:
+		dPts = p.DataPoints
+	case metricdata.Gauge[N]:
+		dPts = p.DataPoints
+	}
+	if cap(dPts) < n {
+		return make([]metricdata.DataPoint[N], 0, n)
+	}
+	return dPts[:0]
+}
+
+// reuseHistogramDataPoints returns an empty slice of HistogramDataPoint with
+// a capacity of at least n. The backing array of the data points of prev is
+// used if prev is a Histogram with the capacity.
+func reuseHistogramDataPoints(prev metricdata.Aggregation, n int) []metricdata.HistogramDataPoint {
+	p, _ := prev.(metricdata.Histogram)
+	if cap(p.DataPoints) < n {
+		return make([]metricdata.HistogramDataPoint, 0, n)
+	}
+	return p.DataPoints[:0]
+}
+
 // Aggregator forms an aggregation from a collection of recorded measurements.
 //
 // Aggregators need to be comparable so they can be de-duplicated by the SDK when
@@ -32,7 +150,7 @@ var now = time.Now
 type Aggregator[N int64 | float64] interface {
 	// Aggregate records the measurement, scoped by attr, and aggregates it
 	// into an aggregation.
-	Aggregate(measurement N, attr attribute.Set)
+	Aggregate(ctx context.Context, measurement N, attr attribute.Set)
 
 	// Aggregation returns an Aggregation, for all the aggregated
 	// measurements made and ends an aggregation cycle.
@@ -15,6 +15,7 @@
 package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
 
 import (
+	"context"
 	"strconv"
 	"sync"
 	"testing"
@@ -97,7 +98,7 @@ func (at *aggregatorTester[N]) Run(a Aggregator[N], incr setMap, eFunc expectFun
 						defer wg.Done()
 						for k := 0; k < at.MeasurementN; k++ {
 							for attrs, n := range incr {
-								a.Aggregate(N(n), attrs)
+								a.Aggregate(context.Background(), N(n), attrs)
 							}
 						}
 					}()
@@ -125,7 +126,7 @@ func benchmarkAggregatorN[N int64 | float64](b *testing.B, factory func() Aggreg
 
 		for n := 0; n < b.N; n++ {
 			for _, attr := range attrs {
-				agg.Aggregate(1, attr)
+				agg.Aggregate(context.Background(), 1, attr)
 			}
 		}
 		bmarkResults = agg.Aggregation()
@@ -136,7 +137,7 @@ func benchmarkAggregatorN[N int64 | float64](b *testing.B, factory func() Aggreg
 		for n := range aggs {
 			a := factory()
 			for _, attr := range attrs {
-				a.Aggregate(1, attr)
+				a.Aggregate(context.Background(), 1, attr)
 			}
 			aggs[n] = a
 		}
@@ -160,3 +161,64 @@ func benchmarkAggregator[N int64 | float64](factory func() Aggregator[N]) func(*
 		}
 	}
 }
+
+// firstDataPoint returns the address of the first data point of agg, or nil
+// if agg has no data points.
+func firstDataPoint(agg metricdata.Aggregation) interface{} {
+	switch a := agg.(type) {
+	case metricdata.Sum[int64]:
+		if len(a.DataPoints) > 0 {
+			return &a.DataPoints[0]
+		}
+	case metricdata.Gauge[int64]:
+		if len(a.DataPoints) > 0 {
+			return &a.DataPoints[0]
+		}
+	case metricdata.Histogram:
+		if len(a.DataPoints) > 0 {
+			return &a.DataPoints[0]
+		}
+	}
+	return nil
+}
+
+func TestAggregationReuse(t *testing.T) {
+	factories := map[string]func() Aggregator[int64]{
+		"DeltaSum":            func() Aggregator[int64] { return NewDeltaSum[int64](true) },
+		"CumulativeSum":       func() Aggregator[int64] { return NewCumulativeSum[int64](true) },
+		"PrecomputedSum":      func() Aggregator[int64] { return NewPrecomputedCumulativeSum[int64](true) },
+		"LastValue":           func() Aggregator[int64] { return NewLastValue[int64]() },
+		"DeltaHistogram":      func() Aggregator[int64] { return NewDeltaHistogram[int64](histConf) },
+		"CumulativeHistogram": func() Aggregator[int64] { return NewCumulativeHistogram[int64](histConf) },
+		"Filter": func() Aggregator[int64] {
+			return NewFilter[int64](NewCumulativeSum[int64](true), func(s attribute.Set) attribute.Set { return s })
+		},
+		"Limiter": func() Aggregator[int64] {
+			return NewLimiter[int64](NewCumulativeSum[int64](true), 10, nil, metricdata.CumulativeTemporality)
+		},
+	}
+
+	ctx := context.Background()
+	for name, factory := range factories {
+		t.Run(name, func(t *testing.T) {
+			reused, fresh := factory(), factory()
+			aggregate := func() {
+				for _, a := range []Aggregator[int64]{reused, fresh} {
+					a.Aggregate(ctx, 1, alice)
+					a.Aggregate(ctx, 2, bob)
+				}
+			}
+
+			aggregate()
+			prev := AggregationReuse(reused, nil)
+			_ = fresh.Aggregation()
+			want := firstDataPoint(prev)
+			assert.NotNil(t, want)
+
+			aggregate()
+			got := AggregationReuse(reused, prev)
+			assert.Same(t, want, firstDataPoint(got), "data points not reused")
+			metricdatatest.AssertAggregationsEqual(t, fresh.Aggregation(), got, metricdatatest.IgnoreTimestamp())
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// testClock returns a clock function starting at staticTime and a function
+// to advance it by d.
+func testClock() (func() time.Time, func(time.Duration)) {
+	current := staticTime
+	return func() time.Time { return current }, func(d time.Duration) { current = current.Add(d) }
+}
+
+func TestSetClockNil(t *testing.T) {
+	agg := NewDeltaSum[int64](true).(*deltaSum[int64])
+	start := agg.start
+	SetClock[int64](agg, nil)
+	assert.Nil(t, agg.clock.nowFunc)
+	assert.Equal(t, start, agg.start)
+}
+
+func TestSetClockTimestamps(t *testing.T) {
+	hist := aggregation.ExplicitBucketHistogram{Boundaries: []float64{1}}
+	expo := aggregation.ExponentialBucketHistogram{MaxSize: 160, MaxScale: 20}
+	summary := aggregation.Summary{Quantiles: []float64{0.5}}
+
+	tests := []struct {
+		name string
+		agg  Aggregator[int64]
+		// points returns the start and end time of all data points.
+		points func(metricdata.Aggregation) [][2]time.Time
+	}{
+		{"DeltaSum", NewDeltaSum[int64](true), sumTimes},
+		{"CumulativeSum", NewCumulativeSum[int64](true), sumTimes},
+		{"PrecomputedDeltaSum", NewPrecomputedDeltaSum[int64](true), sumTimes},
+		{"DeltaHistogram", NewDeltaHistogram[int64](hist), histTimes},
+		{"CumulativeHistogram", NewCumulativeHistogram[int64](hist), histTimes},
+		{"DeltaExponentialHistogram", NewDeltaExponentialHistogram[int64](expo), expoTimes},
+		{"CumulativeExponentialHistogram", NewCumulativeExponentialHistogram[int64](expo), expoTimes},
+		{"DeltaSummary", NewDeltaSummary[int64](summary), summaryTimes},
+		{"CumulativeSummary", NewCumulativeSummary[int64](summary), summaryTimes},
+		{
+			"Wrapped",
+			NewProcessor(
+				NewFilter(NewLimiter(NewDeltaSum[int64](true), 10, nil, metricdata.DeltaTemporality), userFilter),
+				func(s attribute.Set) (attribute.Set, bool) { return s, true },
+			),
+			sumTimes,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clock, advance := testClock()
+			SetClock(test.agg, clock)
+
+			test.agg.Aggregate(context.Background(), 1, alice)
+			advance(time.Minute)
+			got := test.points(test.agg.Aggregation())
+			require.Len(t, got, 1)
+			assert.Equal(t, staticTime, got[0][0], "start time")
+			assert.Equal(t, staticTime.Add(time.Minute), got[0][1], "time")
+		})
+	}
+}
+
+func TestSetClockLastValue(t *testing.T) {
+	clock, _ := testClock()
+	agg := NewLastValue[int64]()
+	SetClock(agg, clock)
+
+	agg.Aggregate(context.Background(), 1, alice)
+	got := agg.Aggregation().(metricdata.Gauge[int64])
+	require.Len(t, got.DataPoints, 1)
+	assert.Equal(t, staticTime, got.DataPoints[0].Time)
+}
+
+func TestSetClockExemplarSampler(t *testing.T) {
+	clock, advance := testClock()
+	agg := NewFixedSizeExemplarSampler(NewDeltaSum[int64](true), nil, 0, nil, alwaysSample, 1, metricdata.DeltaTemporality)
+	SetClock(agg, clock)
+
+	advance(time.Second)
+	agg.Aggregate(sampledCtx, 1, alice)
+	got := agg.Aggregation().(metricdata.Sum[int64])
+	require.Len(t, got.DataPoints, 1)
+	assert.Equal(t, staticTime, got.DataPoints[0].StartTime)
+	require.Len(t, got.DataPoints[0].Exemplars, 1)
+	assert.Equal(t, staticTime.Add(time.Second), got.DataPoints[0].Exemplars[0].Time)
+}
+
+func sumTimes(agg metricdata.Aggregation) [][2]time.Time {
+	var out [][2]time.Time
+	for _, dp := range agg.(metricdata.Sum[int64]).DataPoints {
+		out = append(out, [2]time.Time{dp.StartTime, dp.Time})
+	}
+	return out
+}
+
+func histTimes(agg metricdata.Aggregation) [][2]time.Time {
+	var out [][2]time.Time
+	for _, dp := range agg.(metricdata.Histogram).DataPoints {
+		out = append(out, [2]time.Time{dp.StartTime, dp.Time})
+	}
+	return out
+}
+
+func expoTimes(agg metricdata.Aggregation) [][2]time.Time {
+	var out [][2]time.Time
+	for _, dp := range agg.(metricdata.ExponentialHistogram).DataPoints {
+		out = append(out, [2]time.Time{dp.StartTime, dp.Time})
+	}
+	return out
+}
+
+func summaryTimes(agg metricdata.Aggregation) [][2]time.Time {
+	var out [][2]time.Time
+	for _, dp := range agg.(metricdata.Summary).DataPoints {
+		out = append(out, [2]time.Time{dp.StartTime, dp.Time})
+	}
+	return out
+}
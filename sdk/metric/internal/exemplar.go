@@ -0,0 +1,340 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// reservoir samples and holds Exemplars of measurements.
+type reservoir[N int64 | float64] interface {
+	// offer offers a measurement, made at t in ctx, to the reservoir. The
+	// dropped attributes are the attributes the measurement was made with
+	// that were filtered out of its timeseries.
+	offer(ctx context.Context, t time.Time, value N, dropped []attribute.KeyValue)
+
+	// collect returns all the Exemplars the reservoir holds.
+	collect() []metricdata.Exemplar[N]
+}
+
+// newExemplar returns an Exemplar for a measurement. If ctx contains a valid
+// span context, the trace and span IDs of that span context are included.
+func newExemplar[N int64 | float64](ctx context.Context, t time.Time, value N, dropped []attribute.KeyValue) metricdata.Exemplar[N] {
+	e := metricdata.Exemplar[N]{
+		FilteredAttributes: dropped,
+		Time:               t,
+		Value:              value,
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		tID, sID := sc.TraceID(), sc.SpanID()
+		e.TraceID, e.SpanID = tID[:], sID[:]
+	}
+	return e
+}
+
+// fixedSizeReservoir is a reservoir that uses simple reservoir sampling
+// (Algorithm R) to hold a uniformly random sample of up to size Exemplars.
+type fixedSizeReservoir[N int64 | float64] struct {
+	size  int
+	count int64
+	store []metricdata.Exemplar[N]
+}
+
+func newFixedSizeReservoir[N int64 | float64](size int) reservoir[N] {
+	return &fixedSizeReservoir[N]{
+		size:  size,
+		store: make([]metricdata.Exemplar[N], 0, size),
+	}
+}
+
+func (r *fixedSizeReservoir[N]) offer(ctx context.Context, t time.Time, value N, dropped []attribute.KeyValue) {
+	r.count++
+	if len(r.store) < r.size {
+		r.store = append(r.store, newExemplar(ctx, t, value, dropped))
+		return
+	}
+	if i := rand.Int63n(r.count); i < int64(r.size) {
+		r.store[i] = newExemplar(ctx, t, value, dropped)
+	}
+}
+
+func (r *fixedSizeReservoir[N]) collect() []metricdata.Exemplar[N] {
+	out := make([]metricdata.Exemplar[N], len(r.store))
+	copy(out, r.store)
+	// Sample the measurements of the next collection cycle as if the
+	// reservoir were empty. Otherwise, for cumulative streams, the chance of
+	// a new measurement replacing a held Exemplar keeps shrinking. The held
+	// Exemplars are still reported until they are replaced.
+	r.count = 0
+	return out
+}
+
+// histogramReservoir is a reservoir that holds the last measurement offered
+// for each bucket of an explicit bucket histogram.
+type histogramReservoir[N int64 | float64] struct {
+	bounds []float64
+	store  []metricdata.Exemplar[N]
+	set    []bool
+}
+
+func newHistogramReservoir[N int64 | float64](bounds []float64) reservoir[N] {
+	b := make([]float64, len(bounds))
+	copy(b, bounds)
+	sort.Float64s(b)
+	return &histogramReservoir[N]{
+		bounds: b,
+		store:  make([]metricdata.Exemplar[N], len(b)+1),
+		set:    make([]bool, len(b)+1),
+	}
+}
+
+func (r *histogramReservoir[N]) offer(ctx context.Context, t time.Time, value N, dropped []attribute.KeyValue) {
+	// Use the same bucket search as the histogram Aggregators so Exemplars
+	// are aligned with the bucket counts they are reported with.
+	idx := sort.SearchFloat64s(r.bounds, float64(value))
+	r.store[idx] = newExemplar(ctx, t, value, dropped)
+	r.set[idx] = true
+}
+
+func (r *histogramReservoir[N]) collect() []metricdata.Exemplar[N] {
+	var out []metricdata.Exemplar[N]
+	for i, ok := range r.set {
+		if ok {
+			out = append(out, r.store[i])
+		}
+	}
+	return out
+}
+
+// filtered is the result of filtering an attribute set.
+type filtered struct {
+	attr    attribute.Set
+	dropped []attribute.KeyValue
+}
+
+// exemplarSampler is an Aggregator that samples Exemplars from the
+// measurements it is passed. It applies any attribute filter itself so the
+// attributes that are filtered out can be recorded with the Exemplars. The
+// sampled Exemplars are added to the data points produced by the wrapped
+// Aggregator.
+type exemplarSampler[N int64 | float64] struct {
+	clock
+	aggregator   Aggregator[N]
+	attrFilter   func(attribute.Set) attribute.Set
+	sample       func(context.Context) bool
+	newReservoir func() reservoir[N]
+	// resetOnCollect is true if the reservoirs are cleared every collection
+	// cycle (delta temporality).
+	resetOnCollect bool
+
+	sync.Mutex
+	seen       map[attribute.Set]filtered
+	reservoirs map[attribute.Set]reservoir[N]
+	attrs      *attrLimiter
+}
+
+// NewFixedSizeExemplarSampler wraps an Aggregator with an exemplar sampler.
+// Measurements sample reports true for are offered to a reservoir holding
+// a uniformly random sample of up to size Exemplars per timeseries. The
+// attribute filtering function fn is applied to measurements before they are
+// aggregated, if fn is nil no filtering is applied. The number of distinct
+// filtered attribute sets is limited, and onOverflow called, as described by
+// NewLimiter.
+//
+// If temporality is delta, the Exemplars and the attribute sets counted
+// towards limit are reset each collection cycle.
+func NewFixedSizeExemplarSampler[N int64 | float64](agg Aggregator[N], fn func(attribute.Set) attribute.Set, limit int, onOverflow func(), sample func(context.Context) bool, size int, temporality metricdata.Temporality) Aggregator[N] {
+	return newExemplarSampler(agg, fn, limit, onOverflow, sample, func() reservoir[N] {
+		return newFixedSizeReservoir[N](size)
+	}, temporality)
+}
+
+// NewHistogramExemplarSampler wraps an Aggregator with an exemplar sampler.
+// Measurements sample reports true for are offered to a reservoir holding
+// the last measurement of each bucket defined by bounds per timeseries. The
+// attribute filtering function fn is applied to measurements before they are
+// aggregated, if fn is nil no filtering is applied. The number of distinct
+// filtered attribute sets is limited, and onOverflow called, as described by
+// NewLimiter.
+//
+// If temporality is delta, the Exemplars and the attribute sets counted
+// towards limit are reset each collection cycle.
+func NewHistogramExemplarSampler[N int64 | float64](agg Aggregator[N], fn func(attribute.Set) attribute.Set, limit int, onOverflow func(), sample func(context.Context) bool, bounds []float64, temporality metricdata.Temporality) Aggregator[N] {
+	return newExemplarSampler(agg, fn, limit, onOverflow, sample, func() reservoir[N] {
+		return newHistogramReservoir[N](bounds)
+	}, temporality)
+}
+
+func newExemplarSampler[N int64 | float64](agg Aggregator[N], fn func(attribute.Set) attribute.Set, limit int, onOverflow func(), sample func(context.Context) bool, newRes func() reservoir[N], temporality metricdata.Temporality) Aggregator[N] {
+	return &exemplarSampler[N]{
+		aggregator:     agg,
+		attrFilter:     fn,
+		sample:         sample,
+		newReservoir:   newRes,
+		resetOnCollect: temporality == metricdata.DeltaTemporality,
+		seen:           map[attribute.Set]filtered{},
+		reservoirs:     map[attribute.Set]reservoir[N]{},
+		attrs:          newAttrLimiter(limit, onOverflow),
+	}
+}
+
+func (s *exemplarSampler[N]) setClock(fn func() time.Time) {
+	s.clock = clock{nowFunc: fn}
+	setClock(s.aggregator, fn)
+}
+
+// Aggregate records the measurement, scoped by attr, and aggregates it
+// into an aggregation. If the measurement is sampled, it is also offered to
+// the reservoir of its timeseries.
+func (s *exemplarSampler[N]) Aggregate(ctx context.Context, measurement N, attr attribute.Set) {
+	// The wrapped Aggregator is updated while holding the lock so a
+	// collection cannot separate a sampled measurement from its aggregation.
+	s.Lock()
+	defer s.Unlock()
+
+	f := s.filter(attr)
+	if a := s.attrs.attributes(f.attr); a != f.attr {
+		// None of the measurement attributes are kept by the overflow set.
+		f = filtered{attr: a, dropped: attr.ToSlice()}
+	}
+	if s.sample(ctx) {
+		r, ok := s.reservoirs[f.attr]
+		if !ok {
+			r = s.newReservoir()
+			s.reservoirs[f.attr] = r
+		}
+		r.offer(ctx, s.now(), measurement, f.dropped)
+	}
+	s.aggregator.Aggregate(ctx, measurement, f.attr)
+}
+
+// bind resolves the filtered attributes of attr once and keeps them active
+// for as long as the sampler is used. Only sampled measurements need to hold
+// the lock. If the filtered attributes cannot be admitted, the measurements
+// are handled by Aggregate.
+func (s *exemplarSampler[N]) bind(attr attribute.Set) func(context.Context, N) {
+	s.Lock()
+	defer s.Unlock()
+	f := s.filter(attr)
+	if !s.attrs.pin(f.attr) {
+		return func(ctx context.Context, measurement N) {
+			s.Aggregate(ctx, measurement, attr)
+		}
+	}
+	aggregate := Bind(s.aggregator, f.attr)
+	return func(ctx context.Context, measurement N) {
+		if !s.sample(ctx) {
+			aggregate(ctx, measurement)
+			return
+		}
+		s.Lock()
+		defer s.Unlock()
+		r, ok := s.reservoirs[f.attr]
+		if !ok {
+			r = s.newReservoir()
+			s.reservoirs[f.attr] = r
+		}
+		r.offer(ctx, s.now(), measurement, f.dropped)
+		aggregate(ctx, measurement)
+	}
+}
+
+// filter returns the filtered attributes for attr along with the attributes
+// that were dropped.
+func (s *exemplarSampler[N]) filter(attr attribute.Set) filtered {
+	if s.attrFilter == nil {
+		return filtered{attr: attr}
+	}
+
+	// TODO (#3006): drop stale attributes from seen.
+	f, ok := s.seen[attr]
+	if !ok {
+		f.attr = s.attrFilter(attr)
+		for iter := attr.Iter(); iter.Next(); {
+			kv := iter.Attribute()
+			if !f.attr.HasValue(kv.Key) {
+				f.dropped = append(f.dropped, kv)
+			}
+		}
+		s.seen[attr] = f
+	}
+	return f
+}
+
+// Aggregation returns an Aggregation, for all the aggregated measurements
+// made and ends an aggregation cycle. The sampled Exemplars are added to the
+// data points of the returned Aggregation.
+func (s *exemplarSampler[N]) Aggregation() metricdata.Aggregation {
+	return s.aggregationReuse(nil)
+}
+
+func (s *exemplarSampler[N]) aggregationReuse(prev metricdata.Aggregation) metricdata.Aggregation {
+	s.Lock()
+	defer s.Unlock()
+
+	agg := AggregationReuse(s.aggregator, prev)
+	switch a := agg.(type) {
+	case metricdata.Sum[N]:
+		for i := range a.DataPoints {
+			if r, ok := s.reservoirs[a.DataPoints[i].Attributes]; ok {
+				a.DataPoints[i].Exemplars = r.collect()
+			}
+		}
+	case metricdata.Histogram:
+		for i := range a.DataPoints {
+			if r, ok := s.reservoirs[a.DataPoints[i].Attributes]; ok {
+				a.DataPoints[i].Exemplars = float64Exemplars(r.collect())
+			}
+		}
+	case metricdata.ExponentialHistogram:
+		for i := range a.DataPoints {
+			if r, ok := s.reservoirs[a.DataPoints[i].Attributes]; ok {
+				a.DataPoints[i].Exemplars = float64Exemplars(r.collect())
+			}
+		}
+	}
+
+	if s.resetOnCollect {
+		s.reservoirs = map[attribute.Set]reservoir[N]{}
+		s.attrs.reset()
+	}
+	return agg
+}
+
+// float64Exemplars returns exemplars converted to float64 Exemplars.
+func float64Exemplars[N int64 | float64](exemplars []metricdata.Exemplar[N]) []metricdata.Exemplar[float64] {
+	if len(exemplars) == 0 {
+		return nil
+	}
+	out := make([]metricdata.Exemplar[float64], len(exemplars))
+	for i, e := range exemplars {
+		out[i] = metricdata.Exemplar[float64]{
+			FilteredAttributes: e.FilteredAttributes,
+			Time:               e.Time,
+			Value:              float64(e.Value),
+			SpanID:             e.SpanID,
+			TraceID:            e.TraceID,
+		}
+	}
+	return out
+}
@@ -0,0 +1,247 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	traceID = trace.TraceID{0x01}
+	spanID  = trace.SpanID{0x01}
+
+	sampledCtx = trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	alwaysSample = func(context.Context) bool { return true }
+	neverSample  = func(context.Context) bool { return false }
+
+	userFilter = func(s attribute.Set) attribute.Set {
+		out, _ := s.Filter(func(kv attribute.KeyValue) bool { return kv.Key == "user" })
+		return out
+	}
+)
+
+func exemplar[N int64 | float64](v N, dropped ...attribute.KeyValue) metricdata.Exemplar[N] {
+	return metricdata.Exemplar[N]{
+		FilteredAttributes: dropped,
+		Time:               now(),
+		Value:              v,
+		SpanID:             spanID[:],
+		TraceID:            traceID[:],
+	}
+}
+
+func TestNewExemplar(t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	e := newExemplar(context.Background(), now(), int64(1), nil)
+	assert.Nil(t, e.TraceID, "trace ID without span context")
+	assert.Nil(t, e.SpanID, "span ID without span context")
+
+	e = newExemplar(sampledCtx, now(), int64(1), nil)
+	metricdatatest.AssertEqual(t, exemplar[int64](1), e)
+}
+
+func TestFixedSizeReservoir(t *testing.T) {
+	t.Run("Int64", testFixedSizeReservoir[int64])
+	t.Run("Float64", testFixedSizeReservoir[float64])
+}
+
+func testFixedSizeReservoir[N int64 | float64](t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	r := newFixedSizeReservoir[N](2)
+	assert.Len(t, r.collect(), 0)
+
+	r.offer(sampledCtx, now(), 1, nil)
+	assert.Equal(t, []metricdata.Exemplar[N]{exemplar[N](1)}, r.collect())
+
+	r.offer(sampledCtx, now(), 2, nil)
+	for i := 0; i < 100; i++ {
+		r.offer(sampledCtx, now(), 3, nil)
+	}
+	got := r.collect()
+	assert.Len(t, got, 2, "reservoir holds more than its size")
+	for _, e := range got {
+		assert.Contains(t, []N{1, 2, 3}, e.Value)
+	}
+
+	// The count is reset each collection cycle, the first measurement after
+	// a collection is always sampled.
+	r.offer(sampledCtx, now(), 4, nil)
+	got = r.collect()
+	assert.Len(t, got, 2)
+	assert.Equal(t, N(4), got[0].Value, "measurement not sampled after collect")
+}
+
+func TestHistogramReservoir(t *testing.T) {
+	t.Run("Int64", testHistogramReservoir[int64])
+	t.Run("Float64", testHistogramReservoir[float64])
+}
+
+func testHistogramReservoir[N int64 | float64](t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	r := newHistogramReservoir[N]([]float64{10, 0})
+	assert.Len(t, r.collect(), 0)
+
+	// Buckets: (-∞, 0], (0, 10], (10, +∞).
+	r.offer(sampledCtx, now(), -1, nil)
+	r.offer(sampledCtx, now(), 5, nil)
+	r.offer(sampledCtx, now(), 10, nil)
+	assert.Equal(t, []metricdata.Exemplar[N]{
+		exemplar[N](-1),
+		exemplar[N](10),
+	}, r.collect(), "last measurement per bucket should be held")
+}
+
+func TestExemplarSamplerSum(t *testing.T) {
+	t.Run("Int64", testExemplarSamplerSum[int64])
+	t.Run("Float64", testExemplarSamplerSum[float64])
+}
+
+func testExemplarSamplerSum[N int64 | float64](t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	fltrAlice := attribute.NewSet(attribute.String("user", "alice"))
+	dropped := attribute.Bool("admin", true)
+
+	t.Run("Delta", func(t *testing.T) {
+		a := NewFixedSizeExemplarSampler(NewDeltaSum[N](true), userFilter, 0, nil, alwaysSample, 1, metricdata.DeltaTemporality)
+		a.Aggregate(sampledCtx, 2, alice)
+
+		dp := point[N](fltrAlice, 2)
+		dp.Exemplars = []metricdata.Exemplar[N]{exemplar[N](2, dropped)}
+		expect := metricdata.Sum[N]{
+			Temporality: metricdata.DeltaTemporality,
+			IsMonotonic: true,
+			DataPoints:  []metricdata.DataPoint[N]{dp},
+		}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+
+		// Exemplars are reset with the delta aggregation.
+		a.Aggregate(context.Background(), 1, alice)
+		dp = point[N](fltrAlice, 1)
+		dp.Exemplars = []metricdata.Exemplar[N]{{
+			FilteredAttributes: []attribute.KeyValue{dropped},
+			Time:               now(),
+			Value:              1,
+		}}
+		expect.DataPoints = []metricdata.DataPoint[N]{dp}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+	})
+
+	t.Run("Cumulative", func(t *testing.T) {
+		a := NewFixedSizeExemplarSampler(NewCumulativeSum[N](true), userFilter, 0, nil, alwaysSample, 1, metricdata.CumulativeTemporality)
+		a.Aggregate(sampledCtx, 2, alice)
+
+		dp := point[N](fltrAlice, 2)
+		dp.Exemplars = []metricdata.Exemplar[N]{exemplar[N](2, dropped)}
+		expect := metricdata.Sum[N]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints:  []metricdata.DataPoint[N]{dp},
+		}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+
+		// Exemplars persist with the cumulative aggregation.
+		expect.DataPoints[0].Value = 3
+		a.Aggregate(context.Background(), 1, alice)
+		// The reservoir samples with a probability, ignore its contents.
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation(), metricdatatest.IgnoreExemplars())
+	})
+
+	t.Run("NotSampled", func(t *testing.T) {
+		a := NewFixedSizeExemplarSampler(NewDeltaSum[N](true), nil, 0, nil, neverSample, 1, metricdata.DeltaTemporality)
+		a.Aggregate(sampledCtx, 2, alice)
+		expect := metricdata.Sum[N]{
+			Temporality: metricdata.DeltaTemporality,
+			IsMonotonic: true,
+			DataPoints:  []metricdata.DataPoint[N]{point[N](alice, 2)},
+		}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+	})
+}
+
+func TestExemplarSamplerHistogram(t *testing.T) {
+	t.Run("Int64", testExemplarSamplerHistogram[int64])
+	t.Run("Float64", testExemplarSamplerHistogram[float64])
+}
+
+func testExemplarSamplerHistogram[N int64 | float64](t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	cfg := aggregation.ExplicitBucketHistogram{
+		Boundaries: []float64{0, 10},
+		NoMinMax:   true,
+	}
+	a := NewHistogramExemplarSampler(NewDeltaHistogram[N](cfg), nil, 0, nil, alwaysSample, cfg.Boundaries, metricdata.DeltaTemporality)
+	a.Aggregate(sampledCtx, 1, alice)
+	a.Aggregate(sampledCtx, 5, alice)
+	a.Aggregate(sampledCtx, 20, alice)
+
+	agg := a.Aggregation()
+	require.IsType(t, metricdata.Histogram{}, agg)
+	h := agg.(metricdata.Histogram)
+	require.Len(t, h.DataPoints, 1)
+
+	metricdatatest.AssertEqual(t, metricdata.HistogramDataPoint{
+		Attributes:   alice,
+		StartTime:    now(),
+		Time:         now(),
+		Count:        3,
+		Bounds:       cfg.Boundaries,
+		BucketCounts: []uint64{0, 2, 1},
+		Sum:          26,
+		Exemplars: []metricdata.Exemplar[float64]{
+			exemplar[float64](5),
+			exemplar[float64](20),
+		},
+	}, h.DataPoints[0])
+}
+
+func BenchmarkExemplarSampler(b *testing.B) {
+	attrs := attribute.NewSet(attribute.String("user", "alice"), attribute.Bool("admin", true))
+	b.Run("Sampled", func(b *testing.B) {
+		a := NewFixedSizeExemplarSampler(NewDeltaSum[int64](true), userFilter, 0, nil, alwaysSample, 1, metricdata.DeltaTemporality)
+		b.ReportAllocs()
+		b.ResetTimer()
+		for n := 0; n < b.N; n++ {
+			a.Aggregate(sampledCtx, 1, attrs)
+		}
+	})
+	b.Run("NotSampled", func(b *testing.B) {
+		a := NewFixedSizeExemplarSampler(NewDeltaSum[int64](true), userFilter, 0, nil, neverSample, 1, metricdata.DeltaTemporality)
+		b.ReportAllocs()
+		b.ResetTimer()
+		for n := 0; n < b.N; n++ {
+			a.Aggregate(sampledCtx, 1, attrs)
+		}
+	})
+}
@@ -0,0 +1,373 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const (
+	expoMaxScale = aggregation.ExponentialMaxScale
+	expoMinScale = aggregation.ExponentialMinScale
+)
+
+// scaleFactors are used to compute the bucket index of a value at a positive
+// scale: scaleFactors[scale] = 2^scale / ln(2).
+var scaleFactors = func() (f [expoMaxScale + 1]float64) {
+	for i := range f {
+		f[i] = math.Ldexp(math.Log2E, i)
+	}
+	return f
+}()
+
+// expoBuckets is a contiguous range of exponential histogram bucket counts.
+type expoBuckets struct {
+	startBin int
+	counts   []uint64
+}
+
+// record increments the count of bin, growing the range if needed.
+func (b *expoBuckets) record(bin int) {
+	if len(b.counts) == 0 {
+		b.counts = []uint64{1}
+		b.startBin = bin
+		return
+	}
+
+	endBin := b.startBin + len(b.counts) - 1
+	switch {
+	case bin >= b.startBin && bin <= endBin:
+		b.counts[bin-b.startBin]++
+	case bin < b.startBin:
+		counts := make([]uint64, endBin-bin+1)
+		copy(counts[b.startBin-bin:], b.counts)
+		counts[0] = 1
+		b.counts = counts
+		b.startBin = bin
+	default:
+		b.counts = append(b.counts, make([]uint64, bin-endBin)...)
+		b.counts[bin-b.startBin] = 1
+	}
+}
+
+// downscale merges the buckets so they represent a scale delta smaller. Each
+// reduction of the scale by one merges pairs of adjacent buckets.
+func (b *expoBuckets) downscale(delta int) {
+	if delta <= 0 {
+		return
+	}
+	if len(b.counts) <= 1 {
+		b.startBin >>= delta
+		return
+	}
+
+	steps := 1 << delta
+	// The position of startBin within its merged bucket. The modulus is made
+	// positive as startBin can be negative.
+	offset := ((b.startBin % steps) + steps) % steps
+	for i := 1; i < len(b.counts); i++ {
+		idx := i + offset
+		if idx%steps == 0 {
+			b.counts[idx/steps] = b.counts[i]
+			continue
+		}
+		b.counts[idx/steps] += b.counts[i]
+	}
+	last := (len(b.counts) - 1 + offset) / steps
+	b.counts = b.counts[:last+1]
+	b.startBin >>= delta
+}
+
+// bucket returns a copy of b as a metricdata.ExponentialBucket.
+func (b *expoBuckets) bucket() metricdata.ExponentialBucket {
+	if len(b.counts) == 0 {
+		return metricdata.ExponentialBucket{}
+	}
+	counts := make([]uint64, len(b.counts))
+	copy(counts, b.counts)
+	return metricdata.ExponentialBucket{
+		Offset: int32(b.startBin),
+		Counts: counts,
+	}
+}
+
+// expoBin returns the index of the bucket v belongs to at scale. The bucket
+// with index i contains the values in (base^i, base^(i+1)] where base is
+// 2^(2^-scale).
+func expoBin(v float64, scale int) int {
+	frac, exp := math.Frexp(v)
+	if scale <= 0 {
+		// The fraction is in [0.5, 1), meaning v is in (2^(exp-1), 2^exp]
+		// unless it is an exact power of two. Powers of two are the upper
+		// inclusive bound of a bucket, they belong one bucket lower.
+		correction := 1
+		if frac == .5 {
+			correction = 2
+		}
+		return (exp - correction) >> -scale
+	}
+	return exp<<scale + int(math.Log(frac)*scaleFactors[scale]) - 1
+}
+
+// expoDataPoint is the exponential histogram summary of measurements with a
+// single attribute set.
+type expoDataPoint struct {
+	count    uint64
+	sum      float64
+	min, max float64
+
+	maxSize   int
+	scale     int
+	zeroCount uint64
+	pos, neg  expoBuckets
+}
+
+func newExpoDataPoint(maxSize, maxScale int, v float64) *expoDataPoint {
+	return &expoDataPoint{
+		maxSize: maxSize,
+		scale:   maxScale,
+		// Ensure min and max are recorded values (not zero).
+		min: v,
+		max: v,
+	}
+}
+
+// record adds v to the data point, reducing the scale if v does not fit
+// within maxSize buckets at the current scale.
+func (p *expoDataPoint) record(v float64) {
+	p.count++
+	p.sum += v
+	if v < p.min {
+		p.min = v
+	} else if v > p.max {
+		p.max = v
+	}
+
+	absV := math.Abs(v)
+	if absV == 0 {
+		p.zeroCount++
+		return
+	}
+
+	buckets := &p.pos
+	if v < 0 {
+		buckets = &p.neg
+	}
+
+	bin := expoBin(absV, p.scale)
+	if delta := p.scaleChange(bin, buckets); delta > 0 {
+		p.scale -= delta
+		p.pos.downscale(delta)
+		p.neg.downscale(delta)
+		bin = expoBin(absV, p.scale)
+	}
+	buckets.record(bin)
+}
+
+// scaleChange returns the reduction of scale needed to fit bin within the
+// maxSize buckets of b.
+func (p *expoDataPoint) scaleChange(bin int, b *expoBuckets) int {
+	if len(b.counts) == 0 {
+		return 0
+	}
+
+	low, high := b.startBin, b.startBin+len(b.counts)-1
+	if bin < low {
+		low = bin
+	}
+	if bin > high {
+		high = bin
+	}
+
+	var delta int
+	for high-low >= p.maxSize && p.scale-delta > expoMinScale {
+		low >>= 1
+		high >>= 1
+		delta++
+	}
+	return delta
+}
+
+// dataPoint returns p as a metricdata.ExponentialHistogramDataPoint. All
+// values are copied so p can continue to be updated.
+func (p *expoDataPoint) dataPoint(attr attribute.Set, start, t time.Time, noMinMax bool) metricdata.ExponentialHistogramDataPoint {
+	dp := metricdata.ExponentialHistogramDataPoint{
+		Attributes:     attr,
+		StartTime:      start,
+		Time:           t,
+		Count:          p.count,
+		Sum:            p.sum,
+		Scale:          int32(p.scale),
+		ZeroCount:      p.zeroCount,
+		PositiveBucket: p.pos.bucket(),
+		NegativeBucket: p.neg.bucket(),
+	}
+	if !noMinMax {
+		min, max := p.min, p.max
+		dp.Min = &min
+		dp.Max = &max
+	}
+	return dp
+}
+
+// expoHistValues summarizes a set of measurements as exponential histograms
+// scoped by attributes.
+type expoHistValues[N int64 | float64] struct {
+	maxSize  int
+	maxScale int
+
+	values   map[attribute.Set]*expoDataPoint
+	valuesMu sync.Mutex
+}
+
+func newExpoHistValues[N int64 | float64](cfg aggregation.ExponentialBucketHistogram) *expoHistValues[N] {
+	return &expoHistValues[N]{
+		maxSize:  int(cfg.MaxSize),
+		maxScale: int(cfg.MaxScale),
+		values:   make(map[attribute.Set]*expoDataPoint),
+	}
+}
+
+// Aggregate records the measurement value, scoped by attr, and aggregates it
+// into an exponential histogram.
+func (s *expoHistValues[N]) Aggregate(_ context.Context, value N, attr attribute.Set) {
+	v := float64(value)
+	// Infinity and NaN cannot be represented by the buckets.
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return
+	}
+
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+
+	p, ok := s.values[attr]
+	if !ok {
+		p = newExpoDataPoint(s.maxSize, s.maxScale, v)
+		s.values[attr] = p
+	}
+	p.record(v)
+}
+
+// NewDeltaExponentialHistogram returns an Aggregator that summarizes a set
+// of measurements as an exponential histogram. Each histogram is scoped by
+// attributes and the aggregation cycle the measurements were made in.
+//
+// Each aggregation cycle is treated independently. When the returned
+// Aggregator's Aggregations method is called it will reset all histogram
+// counts to zero and the scale to the maximum scale.
+func NewDeltaExponentialHistogram[N int64 | float64](cfg aggregation.ExponentialBucketHistogram) Aggregator[N] {
+	return &deltaExpoHistogram[N]{
+		expoHistValues: newExpoHistValues[N](cfg),
+		noMinMax:       cfg.NoMinMax,
+		start:          now(),
+	}
+}
+
+// deltaExpoHistogram summarizes a set of measurements made in a single
+// aggregation cycle as an exponential histogram.
+type deltaExpoHistogram[N int64 | float64] struct {
+	*expoHistValues[N]
+	clock
+
+	noMinMax bool
+	start    time.Time
+}
+
+func (s *deltaExpoHistogram[N]) setClock(fn func() time.Time) {
+	s.clock = clock{nowFunc: fn}
+	s.start = s.now()
+}
+
+func (s *deltaExpoHistogram[N]) Aggregation() metricdata.Aggregation {
+	h := metricdata.ExponentialHistogram{Temporality: metricdata.DeltaTemporality}
+
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+
+	if len(s.values) == 0 {
+		return h
+	}
+
+	t := s.now()
+	h.DataPoints = make([]metricdata.ExponentialHistogramDataPoint, 0, len(s.values))
+	for a, p := range s.values {
+		h.DataPoints = append(h.DataPoints, p.dataPoint(a, s.start, t, s.noMinMax))
+
+		// Unused attribute sets do not report.
+		delete(s.values, a)
+	}
+	// The delta collection cycle resets.
+	s.start = t
+	return h
+}
+
+// NewCumulativeExponentialHistogram returns an Aggregator that summarizes a
+// set of measurements as an exponential histogram. Each histogram is scoped
+// by attributes.
+//
+// Each aggregation cycle builds from the previous, the histogram counts are
+// the bucketed counts of all values aggregated since the returned Aggregator
+// was created.
+func NewCumulativeExponentialHistogram[N int64 | float64](cfg aggregation.ExponentialBucketHistogram) Aggregator[N] {
+	return &cumulativeExpoHistogram[N]{
+		expoHistValues: newExpoHistValues[N](cfg),
+		noMinMax:       cfg.NoMinMax,
+		start:          now(),
+	}
+}
+
+// cumulativeExpoHistogram summarizes a set of measurements made over all
+// aggregation cycles as an exponential histogram.
+type cumulativeExpoHistogram[N int64 | float64] struct {
+	*expoHistValues[N]
+	clock
+
+	noMinMax bool
+	start    time.Time
+}
+
+func (s *cumulativeExpoHistogram[N]) setClock(fn func() time.Time) {
+	s.clock = clock{nowFunc: fn}
+	s.start = s.now()
+}
+
+func (s *cumulativeExpoHistogram[N]) Aggregation() metricdata.Aggregation {
+	h := metricdata.ExponentialHistogram{Temporality: metricdata.CumulativeTemporality}
+
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+
+	if len(s.values) == 0 {
+		return h
+	}
+
+	t := s.now()
+	h.DataPoints = make([]metricdata.ExponentialHistogramDataPoint, 0, len(s.values))
+	for a, p := range s.values {
+		h.DataPoints = append(h.DataPoints, p.dataPoint(a, s.start, t, s.noMinMax))
+		// TODO (#3006): This will use an unbounded amount of memory if there
+		// are unbounded number of attribute sets being aggregated. Attribute
+		// sets that become "stale" need to be forgotten so this will not
+		// overload the system.
+	}
+	return h
+}
@@ -0,0 +1,277 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func TestExpoBin(t *testing.T) {
+	tests := []struct {
+		value float64
+		scale int
+		want  int
+	}{
+		// Scale 0, base 2.
+		{value: 1, scale: 0, want: -1},
+		{value: 1.5, scale: 0, want: 0},
+		{value: 2, scale: 0, want: 0},
+		{value: 3, scale: 0, want: 1},
+		{value: 4, scale: 0, want: 1},
+		{value: 0.25, scale: 0, want: -3},
+		// Scale 1, base √2.
+		{value: 1, scale: 1, want: -1},
+		{value: 1.2, scale: 1, want: 0},
+		{value: 1.5, scale: 1, want: 1},
+		{value: 2, scale: 1, want: 1},
+		{value: 3, scale: 1, want: 3},
+		// Scale -1, base 4.
+		{value: 1, scale: -1, want: -1},
+		{value: 2, scale: -1, want: 0},
+		{value: 4, scale: -1, want: 0},
+		{value: 5, scale: -1, want: 1},
+		{value: 16, scale: -1, want: 1},
+		// Extremes.
+		{value: math.MaxFloat64, scale: expoMaxScale, want: 1024<<expoMaxScale - 1},
+		{value: math.SmallestNonzeroFloat64, scale: expoMinScale, want: -2},
+	}
+
+	for _, tt := range tests {
+		assert.Equalf(t, tt.want, expoBin(tt.value, tt.scale), "value %v, scale %d", tt.value, tt.scale)
+	}
+}
+
+func TestExpoBucketsRecord(t *testing.T) {
+	var b expoBuckets
+	b.record(5)
+	assert.Equal(t, expoBuckets{startBin: 5, counts: []uint64{1}}, b)
+
+	b.record(5)
+	assert.Equal(t, expoBuckets{startBin: 5, counts: []uint64{2}}, b)
+
+	b.record(7)
+	assert.Equal(t, expoBuckets{startBin: 5, counts: []uint64{2, 0, 1}}, b)
+
+	b.record(3)
+	assert.Equal(t, expoBuckets{startBin: 3, counts: []uint64{1, 0, 2, 0, 1}}, b)
+}
+
+func TestExpoBucketsDownscale(t *testing.T) {
+	tests := []struct {
+		name  string
+		b     expoBuckets
+		delta int
+		want  expoBuckets
+	}{
+		{
+			name:  "Empty",
+			delta: 1,
+		},
+		{
+			name:  "SingleBucket",
+			b:     expoBuckets{startBin: 5, counts: []uint64{1}},
+			delta: 1,
+			want:  expoBuckets{startBin: 2, counts: []uint64{1}},
+		},
+		{
+			name:  "Aligned",
+			b:     expoBuckets{startBin: 0, counts: []uint64{1, 2, 3, 4}},
+			delta: 1,
+			want:  expoBuckets{startBin: 0, counts: []uint64{3, 7}},
+		},
+		{
+			name:  "Unaligned",
+			b:     expoBuckets{startBin: 1, counts: []uint64{1, 2, 3, 4}},
+			delta: 1,
+			want:  expoBuckets{startBin: 0, counts: []uint64{1, 5, 4}},
+		},
+		{
+			name:  "Negative",
+			b:     expoBuckets{startBin: -3, counts: []uint64{1, 2, 3, 4}},
+			delta: 1,
+			want:  expoBuckets{startBin: -2, counts: []uint64{1, 5, 4}},
+		},
+		{
+			name:  "MultipleScales",
+			b:     expoBuckets{startBin: -1, counts: []uint64{1, 2, 3, 4, 5}},
+			delta: 2,
+			want:  expoBuckets{startBin: -1, counts: []uint64{1, 14}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.b.downscale(tt.delta)
+			assert.Equal(t, tt.want, tt.b)
+		})
+	}
+}
+
+func TestExpoDataPointRecord(t *testing.T) {
+	t.Run("Downscale", func(t *testing.T) {
+		p := newExpoDataPoint(4, 20, 4)
+		for _, v := range []float64{4, 4, 4, 2, 16, 1} {
+			p.record(v)
+		}
+
+		assert.Equal(t, -1, p.scale)
+		assert.Equal(t, expoBuckets{startBin: -1, counts: []uint64{1, 4, 1}}, p.pos)
+		assert.Equal(t, 1.0, p.min)
+		assert.Equal(t, 16.0, p.max)
+		assert.Equal(t, 31.0, p.sum)
+		assert.Equal(t, uint64(6), p.count)
+	})
+
+	t.Run("ZeroAndNegative", func(t *testing.T) {
+		p := newExpoDataPoint(160, 0, 0)
+		for _, v := range []float64{0, -1, -3, 2, 0} {
+			p.record(v)
+		}
+
+		assert.Equal(t, 0, p.scale)
+		assert.Equal(t, uint64(2), p.zeroCount)
+		assert.Equal(t, expoBuckets{startBin: 0, counts: []uint64{1}}, p.pos)
+		assert.Equal(t, expoBuckets{startBin: -1, counts: []uint64{1, 0, 1}}, p.neg)
+		assert.Equal(t, -3.0, p.min)
+		assert.Equal(t, 2.0, p.max)
+	})
+
+	t.Run("NegativeDownscalesPositive", func(t *testing.T) {
+		p := newExpoDataPoint(2, 0, 1)
+		p.record(2)
+		p.record(3)
+		assert.Equal(t, expoBuckets{startBin: 0, counts: []uint64{1, 1}}, p.pos)
+
+		// Widening the negative range also reduces the scale of the positive.
+		p.record(-1)
+		p.record(-8)
+		assert.Equal(t, -2, p.scale)
+		assert.Equal(t, expoBuckets{startBin: 0, counts: []uint64{2}}, p.pos)
+		assert.Equal(t, expoBuckets{startBin: -1, counts: []uint64{1, 1}}, p.neg)
+	})
+}
+
+func TestExponentialHistogram(t *testing.T) {
+	t.Run("Int64", testExponentialHistogram[int64])
+	t.Run("Float64", testExponentialHistogram[float64])
+}
+
+func testExponentialHistogram[N int64 | float64](t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	cfg := aggregation.ExponentialBucketHistogram{MaxSize: 4, MaxScale: 20}
+	dPt := func(count uint64, sum, min, max float64) metricdata.ExponentialHistogramDataPoint {
+		return metricdata.ExponentialHistogramDataPoint{
+			Attributes:     alice,
+			StartTime:      now(),
+			Time:           now(),
+			Count:          count,
+			Min:            &min,
+			Max:            &max,
+			Sum:            sum,
+			Scale:          -1,
+			ZeroCount:      1,
+			PositiveBucket: metricdata.ExponentialBucket{Offset: -1, Counts: []uint64{1, 4, 1}},
+		}
+	}
+	record := func(a Aggregator[N]) {
+		for _, v := range []N{4, 4, 4, 2, 16, 1, 0} {
+			a.Aggregate(context.Background(), v, alice)
+		}
+	}
+
+	t.Run("Delta", func(t *testing.T) {
+		a := NewDeltaExponentialHistogram[N](cfg)
+		record(a)
+		metricdatatest.AssertAggregationsEqual(t, metricdata.ExponentialHistogram{
+			Temporality: metricdata.DeltaTemporality,
+			DataPoints:  []metricdata.ExponentialHistogramDataPoint{dPt(7, 31, 0, 16)},
+		}, a.Aggregation())
+
+		// The delta aggregation resets.
+		metricdatatest.AssertAggregationsEqual(t, metricdata.ExponentialHistogram{
+			Temporality: metricdata.DeltaTemporality,
+		}, a.Aggregation())
+	})
+
+	t.Run("Cumulative", func(t *testing.T) {
+		a := NewCumulativeExponentialHistogram[N](cfg)
+		record(a)
+		expect := metricdata.ExponentialHistogram{
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints:  []metricdata.ExponentialHistogramDataPoint{dPt(7, 31, 0, 16)},
+		}
+		agg := a.Aggregation()
+		metricdatatest.AssertAggregationsEqual(t, expect, agg)
+
+		// The cumulative aggregation persists and does not modify the
+		// previously returned data points.
+		a.Aggregate(context.Background(), 2, alice)
+		metricdatatest.AssertAggregationsEqual(t, expect, agg)
+
+		hdp := dPt(8, 33, 0, 16)
+		hdp.PositiveBucket.Counts = []uint64{1, 5, 1}
+		expect.DataPoints = []metricdata.ExponentialHistogramDataPoint{hdp}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+	})
+
+	t.Run("NoMinMax", func(t *testing.T) {
+		cfg := cfg
+		cfg.NoMinMax = true
+		a := NewDeltaExponentialHistogram[N](cfg)
+		record(a)
+
+		hdp := dPt(7, 31, 0, 16)
+		hdp.Min, hdp.Max = nil, nil
+		metricdatatest.AssertAggregationsEqual(t, metricdata.ExponentialHistogram{
+			Temporality: metricdata.DeltaTemporality,
+			DataPoints:  []metricdata.ExponentialHistogramDataPoint{hdp},
+		}, a.Aggregation())
+	})
+}
+
+func TestExponentialHistogramIgnoresNonFinite(t *testing.T) {
+	a := NewDeltaExponentialHistogram[float64](aggregation.ExponentialBucketHistogram{MaxSize: 160, MaxScale: 20})
+	a.Aggregate(context.Background(), math.Inf(1), alice)
+	a.Aggregate(context.Background(), math.Inf(-1), alice)
+	a.Aggregate(context.Background(), math.NaN(), alice)
+	metricdatatest.AssertAggregationsEqual(t, metricdata.ExponentialHistogram{
+		Temporality: metricdata.DeltaTemporality,
+	}, a.Aggregation())
+}
+
+func BenchmarkExponentialHistogram(b *testing.B) {
+	cfg := aggregation.ExponentialBucketHistogram{MaxSize: 160, MaxScale: 20}
+	b.Run("Int64", func(b *testing.B) {
+		factory := func() Aggregator[int64] { return NewDeltaExponentialHistogram[int64](cfg) }
+		b.Run("Delta", benchmarkAggregator(factory))
+		factory = func() Aggregator[int64] { return NewCumulativeExponentialHistogram[int64](cfg) }
+		b.Run("Cumulative", benchmarkAggregator(factory))
+	})
+	b.Run("Float64", func(b *testing.B) {
+		factory := func() Aggregator[float64] { return NewDeltaExponentialHistogram[float64](cfg) }
+		b.Run("Delta", benchmarkAggregator(factory))
+		factory = func() Aggregator[float64] { return NewCumulativeExponentialHistogram[float64](cfg) }
+		b.Run("Cumulative", benchmarkAggregator(factory))
+	})
+}
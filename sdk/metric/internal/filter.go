@@ -15,7 +15,9 @@
 package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
 
 import (
+	"context"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
@@ -43,9 +45,13 @@ func NewFilter[N int64 | float64](agg Aggregator[N], fn func(attribute.Set) attr
 	}
 }
 
+func (f *filter[N]) setClock(fn func() time.Time) {
+	setClock(f.aggregator, fn)
+}
+
 // Aggregate records the measurement, scoped by attr, and aggregates it
 // into an aggregation.
-func (f *filter[N]) Aggregate(measurement N, attr attribute.Set) {
+func (f *filter[N]) Aggregate(ctx context.Context, measurement N, attr attribute.Set) {
 	// TODO (#3006): drop stale attributes from seen.
 	f.Lock()
 	defer f.Unlock()
@@ -54,7 +60,18 @@ func (f *filter[N]) Aggregate(measurement N, attr attribute.Set) {
 		fAttr = f.filter(attr)
 		f.seen[attr] = fAttr
 	}
-	f.aggregator.Aggregate(measurement, fAttr)
+	f.aggregator.Aggregate(ctx, measurement, fAttr)
+}
+
+func (f *filter[N]) bind(attr attribute.Set) func(context.Context, N) {
+	f.Lock()
+	fAttr, ok := f.seen[attr]
+	if !ok {
+		fAttr = f.filter(attr)
+		f.seen[attr] = fAttr
+	}
+	f.Unlock()
+	return Bind(f.aggregator, fAttr)
 }
 
 // Aggregation returns an Aggregation, for all the aggregated
@@ -62,3 +79,84 @@ func (f *filter[N]) Aggregate(measurement N, attr attribute.Set) {
 func (f *filter[N]) Aggregation() metricdata.Aggregation {
 	return f.aggregator.Aggregation()
 }
+
+func (f *filter[N]) aggregationReuse(prev metricdata.Aggregation) metricdata.Aggregation {
+	return AggregationReuse(f.aggregator, prev)
+}
+
+// processor is an aggregator that applies an attribute processing function
+// when Aggregating. Measurements the function does not keep are dropped.
+// processors do not have any backing memory, and must be constructed with a
+// backing Aggregator.
+type processor[N int64 | float64] struct {
+	process    func(attribute.Set) (attribute.Set, bool)
+	aggregator Aggregator[N]
+
+	sync.Mutex
+	seen map[attribute.Set]processed
+}
+
+// processed is the result of processing an attribute set.
+type processed struct {
+	attr attribute.Set
+	keep bool
+}
+
+// NewProcessor wraps an Aggregator with an attribute processing function.
+// Measurements are aggregated with the attributes returned by fn. If fn
+// returns false, the measurement is dropped.
+func NewProcessor[N int64 | float64](agg Aggregator[N], fn func(attribute.Set) (attribute.Set, bool)) Aggregator[N] {
+	if fn == nil {
+		return agg
+	}
+	return &processor[N]{
+		process:    fn,
+		aggregator: agg,
+		seen:       map[attribute.Set]processed{},
+	}
+}
+
+func (p *processor[N]) setClock(fn func() time.Time) {
+	setClock(p.aggregator, fn)
+}
+
+// Aggregate records the measurement, scoped by attr, and aggregates it
+// into an aggregation if it is kept by the processing function.
+func (p *processor[N]) Aggregate(ctx context.Context, measurement N, attr attribute.Set) {
+	// TODO (#3006): drop stale attributes from seen.
+	p.Lock()
+	defer p.Unlock()
+	pAttr, ok := p.seen[attr]
+	if !ok {
+		pAttr.attr, pAttr.keep = p.process(attr)
+		p.seen[attr] = pAttr
+	}
+	if !pAttr.keep {
+		return
+	}
+	p.aggregator.Aggregate(ctx, measurement, pAttr.attr)
+}
+
+func (p *processor[N]) bind(attr attribute.Set) func(context.Context, N) {
+	p.Lock()
+	pAttr, ok := p.seen[attr]
+	if !ok {
+		pAttr.attr, pAttr.keep = p.process(attr)
+		p.seen[attr] = pAttr
+	}
+	p.Unlock()
+	if !pAttr.keep {
+		return func(context.Context, N) {}
+	}
+	return Bind(p.aggregator, pAttr.attr)
+}
+
+// Aggregation returns an Aggregation, for all the aggregated
+// measurements made and ends an aggregation cycle.
+func (p *processor[N]) Aggregation() metricdata.Aggregation {
+	return p.aggregator.Aggregation()
+}
+
+func (p *processor[N]) aggregationReuse(prev metricdata.Aggregation) metricdata.Aggregation {
+	return AggregationReuse(p.aggregator, prev)
+}
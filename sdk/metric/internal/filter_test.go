@@ -15,6 +15,7 @@
 package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
 
 import (
+	"context"
 	"sync"
 	"testing"
 
@@ -34,7 +35,7 @@ type testStableAggregator[N int64 | float64] struct {
 
 // Aggregate records the measurement, scoped by attr, and aggregates it
 // into an aggregation.
-func (a *testStableAggregator[N]) Aggregate(measurement N, attr attribute.Set) {
+func (a *testStableAggregator[N]) Aggregate(_ context.Context, measurement N, attr attribute.Set) {
 	a.Lock()
 	defer a.Unlock()
 
@@ -150,7 +151,7 @@ func testFilterAggregate[N int64 | float64](t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			f := NewFilter[N](&testStableAggregator[N]{}, testAttributeFilter)
 			for _, set := range tt.inputAttr {
-				f.Aggregate(1, set)
+				f.Aggregate(context.Background(), 1, set)
 			}
 			out := f.Aggregation().(metricdata.Gauge[N])
 			assert.Equal(t, tt.output, out.DataPoints)
@@ -173,14 +174,14 @@ func testFilterConcurrent[N int64 | float64](t *testing.T) {
 	wg.Add(2)
 
 	go func() {
-		f.Aggregate(1, attribute.NewSet(
+		f.Aggregate(context.Background(), 1, attribute.NewSet(
 			attribute.String("foo", "bar"),
 		))
 		wg.Done()
 	}()
 
 	go func() {
-		f.Aggregate(1, attribute.NewSet(
+		f.Aggregate(context.Background(), 1, attribute.NewSet(
 			attribute.Int("power-level", 9001),
 		))
 		wg.Done()
@@ -197,3 +198,79 @@ func TestFilterConcurrent(t *testing.T) {
 		testFilterConcurrent[float64](t)
 	})
 }
+
+func testAttributeProcessor(input attribute.Set) (attribute.Set, bool) {
+	if v, ok := input.Value("http.route"); ok && v.AsString() == "/healthz" {
+		return attribute.Set{}, false
+	}
+	out, _ := input.Filter(func(kv attribute.KeyValue) bool {
+		return kv.Key != "user"
+	})
+	return out, true
+}
+
+func TestNewProcessor(t *testing.T) {
+	agg := &testStableAggregator[int64]{}
+	assert.Equal(t, agg, NewProcessor[int64](agg, nil))
+
+	p := NewProcessor[int64](agg, testAttributeProcessor)
+	require.IsType(t, &processor[int64]{}, p)
+	assert.Equal(t, agg, p.(*processor[int64]).aggregator)
+}
+
+func testProcessorAggregate[N int64 | float64](t *testing.T) {
+	p := NewProcessor[N](&testStableAggregator[N]{}, testAttributeProcessor)
+
+	healthz := attribute.NewSet(attribute.String("http.route", "/healthz"))
+	users := attribute.NewSet(
+		attribute.String("http.route", "/users"),
+		attribute.String("user", "alice"),
+	)
+	for _, set := range []attribute.Set{healthz, users, healthz, users} {
+		p.Aggregate(context.Background(), 1, set)
+	}
+
+	want := testDataPoint[N](attribute.NewSet(attribute.String("http.route", "/users")))
+	out := p.Aggregation().(metricdata.Gauge[N])
+	assert.Equal(t, []metricdata.DataPoint[N]{want, want}, out.DataPoints)
+}
+
+func TestProcessorAggregate(t *testing.T) {
+	t.Run("int64", func(t *testing.T) {
+		testProcessorAggregate[int64](t)
+	})
+	t.Run("float64", func(t *testing.T) {
+		testProcessorAggregate[float64](t)
+	})
+}
+
+func testProcessorConcurrent[N int64 | float64](t *testing.T) {
+	p := NewProcessor[N](&testStableAggregator[N]{}, testAttributeProcessor)
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go func() {
+		p.Aggregate(context.Background(), 1, attribute.NewSet(
+			attribute.String("http.route", "/healthz"),
+		))
+		wg.Done()
+	}()
+
+	go func() {
+		p.Aggregate(context.Background(), 1, attribute.NewSet(
+			attribute.String("user", "alice"),
+		))
+		wg.Done()
+	}()
+
+	wg.Wait()
+}
+
+func TestProcessorConcurrent(t *testing.T) {
+	t.Run("int64", func(t *testing.T) {
+		testProcessorConcurrent[int64](t)
+	})
+	t.Run("float64", func(t *testing.T) {
+		testProcessorConcurrent[float64](t)
+	})
+}
@@ -15,6 +15,7 @@
 package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
 
 import (
+	"context"
 	"sort"
 	"sync"
 	"time"
@@ -72,7 +73,7 @@ func newHistValues[N int64 | float64](bounds []float64) *histValues[N] {
 
 // Aggregate records the measurement value, scoped by attr, and aggregates it
 // into a histogram.
-func (s *histValues[N]) Aggregate(value N, attr attribute.Set) {
+func (s *histValues[N]) Aggregate(_ context.Context, value N, attr attribute.Set) {
 	// Accept all types to satisfy the Aggregator interface. However, since
 	// the Aggregation produced by this Aggregator is only float64, convert
 	// here to only use this type.
@@ -124,12 +125,22 @@ func NewDeltaHistogram[N int64 | float64](cfg aggregation.ExplicitBucketHistogra
 // aggregation cycle as an histogram with explicitly defined buckets.
 type deltaHistogram[N int64 | float64] struct {
 	*histValues[N]
+	clock
 
 	noMinMax bool
 	start    time.Time
 }
 
+func (s *deltaHistogram[N]) setClock(fn func() time.Time) {
+	s.clock = clock{nowFunc: fn}
+	s.start = s.now()
+}
+
 func (s *deltaHistogram[N]) Aggregation() metricdata.Aggregation {
+	return s.aggregationReuse(nil)
+}
+
+func (s *deltaHistogram[N]) aggregationReuse(prev metricdata.Aggregation) metricdata.Aggregation {
 	h := metricdata.Histogram{Temporality: metricdata.DeltaTemporality}
 
 	s.valuesMu.Lock()
@@ -142,8 +153,8 @@ func (s *deltaHistogram[N]) Aggregation() metricdata.Aggregation {
 	// Do not allow modification of our copy of bounds.
 	bounds := make([]float64, len(s.bounds))
 	copy(bounds, s.bounds)
-	t := now()
-	h.DataPoints = make([]metricdata.HistogramDataPoint, 0, len(s.values))
+	t := s.now()
+	h.DataPoints = reuseHistogramDataPoints(prev, len(s.values))
 	for a, b := range s.values {
 		hdp := metricdata.HistogramDataPoint{
 			Attributes:   a,
@@ -186,12 +197,22 @@ func NewCumulativeHistogram[N int64 | float64](cfg aggregation.ExplicitBucketHis
 // aggregation cycles as an histogram with explicitly defined buckets.
 type cumulativeHistogram[N int64 | float64] struct {
 	*histValues[N]
+	clock
 
 	noMinMax bool
 	start    time.Time
 }
 
+func (s *cumulativeHistogram[N]) setClock(fn func() time.Time) {
+	s.clock = clock{nowFunc: fn}
+	s.start = s.now()
+}
+
 func (s *cumulativeHistogram[N]) Aggregation() metricdata.Aggregation {
+	return s.aggregationReuse(nil)
+}
+
+func (s *cumulativeHistogram[N]) aggregationReuse(prev metricdata.Aggregation) metricdata.Aggregation {
 	h := metricdata.Histogram{Temporality: metricdata.CumulativeTemporality}
 
 	s.valuesMu.Lock()
@@ -204,16 +225,20 @@ func (s *cumulativeHistogram[N]) Aggregation() metricdata.Aggregation {
 	// Do not allow modification of our copy of bounds.
 	bounds := make([]float64, len(s.bounds))
 	copy(bounds, s.bounds)
-	t := now()
-	h.DataPoints = make([]metricdata.HistogramDataPoint, 0, len(s.values))
+	t := s.now()
+	h.DataPoints = reuseHistogramDataPoints(prev, len(s.values))
 	for a, b := range s.values {
 		// The HistogramDataPoint field values returned need to be copies of
 		// the buckets value as we will keep updating them.
 		//
 		// TODO (#3047): Making copies for bounds and counts incurs a large
 		// memory allocation footprint. Alternatives should be explored.
-		counts := make([]uint64, len(b.counts))
-		copy(counts, b.counts)
+		var counts []uint64
+		if n := len(h.DataPoints); n < cap(h.DataPoints) {
+			// Reuse the counts of a reused data point.
+			counts = h.DataPoints[:n+1][n].BucketCounts[:0]
+		}
+		counts = append(counts, b.counts...)
 
 		hdp := metricdata.HistogramDataPoint{
 			Attributes:   a,
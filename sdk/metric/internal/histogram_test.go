@@ -15,6 +15,7 @@
 package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
 
 import (
+	"context"
 	"sort"
 	"testing"
 
@@ -127,7 +128,7 @@ func testHistImmutableBounds[N int64 | float64](newA func(aggregation.ExplicitBu
 		b[0] = 10
 		assert.Equal(t, cpB, getBounds(a), "modifying the bounds argument should not change the bounds")
 
-		a.Aggregate(5, alice)
+		a.Aggregate(context.Background(), 5, alice)
 		hdp := a.Aggregation().(metricdata.Histogram).DataPoints[0]
 		hdp.Bounds[1] = 10
 		assert.Equal(t, cpB, getBounds(a), "modifying the Aggregation bounds should not change the bounds")
@@ -154,7 +155,7 @@ func TestHistogramImmutableBounds(t *testing.T) {
 
 func TestCumulativeHistogramImutableCounts(t *testing.T) {
 	a := NewCumulativeHistogram[int64](histConf)
-	a.Aggregate(5, alice)
+	a.Aggregate(context.Background(), 5, alice)
 	hdp := a.Aggregation().(metricdata.Histogram).DataPoints[0]
 
 	cumuH := a.(*cumulativeHistogram[int64])
@@ -173,7 +174,7 @@ func TestDeltaHistogramReset(t *testing.T) {
 	a := NewDeltaHistogram[int64](histConf)
 	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
 
-	a.Aggregate(1, alice)
+	a.Aggregate(context.Background(), 1, alice)
 	expect.DataPoints = []metricdata.HistogramDataPoint{hPoint(alice, 1, 1)}
 	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
 
@@ -182,7 +183,7 @@ func TestDeltaHistogramReset(t *testing.T) {
 	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
 
 	// Aggregating another set should not affect the original (alice).
-	a.Aggregate(1, bob)
+	a.Aggregate(context.Background(), 1, bob)
 	expect.DataPoints = []metricdata.HistogramDataPoint{hPoint(bob, 1, 1)}
 	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
 }
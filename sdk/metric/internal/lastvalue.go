@@ -15,10 +15,12 @@
 package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
 
 import (
+	"context"
 	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/instrument"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
@@ -31,24 +33,52 @@ type datapoint[N int64 | float64] struct {
 // lastValue summarizes a set of measurements as the last one made.
 type lastValue[N int64 | float64] struct {
 	sync.Mutex
+	clock
 
 	values map[attribute.Set]datapoint[N]
+	// resetOnCollect is true if values are only reported for the collection
+	// cycle they were measured in.
+	resetOnCollect bool
 }
 
 // NewLastValue returns an Aggregator that summarizes a set of measurements as
-// the last one made.
+// the last one made. Each measurement is only reported for the collection
+// cycle it was made in.
 func NewLastValue[N int64 | float64]() Aggregator[N] {
+	return &lastValue[N]{
+		values:         make(map[attribute.Set]datapoint[N]),
+		resetOnCollect: true,
+	}
+}
+
+// NewCumulativeLastValue returns an Aggregator that summarizes a set of
+// measurements as the last one made. The last measurement for an attribute
+// set continues to be reported every collection cycle until a new one is
+// made.
+func NewCumulativeLastValue[N int64 | float64]() Aggregator[N] {
 	return &lastValue[N]{values: make(map[attribute.Set]datapoint[N])}
 }
 
-func (s *lastValue[N]) Aggregate(value N, attr attribute.Set) {
-	d := datapoint[N]{timestamp: now(), value: value}
+func (s *lastValue[N]) setClock(fn func() time.Time) {
+	s.clock = clock{nowFunc: fn}
+}
+
+func (s *lastValue[N]) Aggregate(ctx context.Context, value N, attr attribute.Set) {
+	t, ok := instrument.ObservationTime(ctx)
+	if !ok {
+		t = s.now()
+	}
+	d := datapoint[N]{timestamp: t, value: value}
 	s.Lock()
 	s.values[attr] = d
 	s.Unlock()
 }
 
 func (s *lastValue[N]) Aggregation() metricdata.Aggregation {
+	return s.aggregationReuse(nil)
+}
+
+func (s *lastValue[N]) aggregationReuse(prev metricdata.Aggregation) metricdata.Aggregation {
 	gauge := metricdata.Gauge[N]{}
 
 	s.Lock()
@@ -58,7 +88,7 @@ func (s *lastValue[N]) Aggregation() metricdata.Aggregation {
 		return gauge
 	}
 
-	gauge.DataPoints = make([]metricdata.DataPoint[N], 0, len(s.values))
+	gauge.DataPoints = reuseDataPoints[N](prev, len(s.values))
 	for a, v := range s.values {
 		gauge.DataPoints = append(gauge.DataPoints, metricdata.DataPoint[N]{
 			Attributes: a,
@@ -67,8 +97,10 @@ func (s *lastValue[N]) Aggregation() metricdata.Aggregation {
 			Time:  v.timestamp,
 			Value: v.value,
 		})
-		// Do not report stale values.
-		delete(s.values, a)
+		if s.resetOnCollect {
+			// Do not report stale values.
+			delete(s.values, a)
+		}
 	}
 	return gauge
 }
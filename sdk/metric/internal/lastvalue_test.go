@@ -15,8 +15,11 @@
 package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"go.opentelemetry.io/otel/metric/instrument"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 )
@@ -55,7 +58,7 @@ func testLastValueReset[N int64 | float64](t *testing.T) {
 	expect := metricdata.Gauge[N]{}
 	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
 
-	a.Aggregate(1, alice)
+	a.Aggregate(context.Background(), 1, alice)
 	expect.DataPoints = []metricdata.DataPoint[N]{{
 		Attributes: alice,
 		Time:       now(),
@@ -68,7 +71,7 @@ func testLastValueReset[N int64 | float64](t *testing.T) {
 	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
 
 	// Aggregating another set should not affect the original (alice).
-	a.Aggregate(1, bob)
+	a.Aggregate(context.Background(), 1, bob)
 	expect.DataPoints = []metricdata.DataPoint[N]{{
 		Attributes: bob,
 		Time:       now(),
@@ -82,6 +85,58 @@ func TestLastValueReset(t *testing.T) {
 	t.Run("Float64", testLastValueReset[float64])
 }
 
+func testCumulativeLastValue[N int64 | float64](t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	a := NewCumulativeLastValue[N]()
+	expect := metricdata.Gauge[N]{}
+	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+
+	a.Aggregate(context.Background(), 1, alice)
+	expect.DataPoints = []metricdata.DataPoint[N]{{
+		Attributes: alice,
+		Time:       now(),
+		Value:      1,
+	}}
+	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+
+	// The attr set should be remembered after Aggregations is called.
+	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+
+	// Setting the value again should overwrite the last one.
+	a.Aggregate(context.Background(), 3, alice)
+	expect.DataPoints[0].Value = 3
+	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+}
+
+func TestCumulativeLastValue(t *testing.T) {
+	t.Run("Int64", testCumulativeLastValue[int64])
+	t.Run("Float64", testCumulativeLastValue[float64])
+}
+
+func testLastValueObservationTime[N int64 | float64](t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	observed := time.Unix(1, 0)
+	ctx := instrument.ContextWithObservationTime(context.Background(), observed)
+
+	a := NewLastValue[N]()
+	a.Aggregate(ctx, 1, alice)
+	a.Aggregate(context.Background(), 2, bob)
+	expect := metricdata.Gauge[N]{
+		DataPoints: []metricdata.DataPoint[N]{
+			{Attributes: alice, Time: observed, Value: 1},
+			{Attributes: bob, Time: now(), Value: 2},
+		},
+	}
+	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+}
+
+func TestLastValueObservationTime(t *testing.T) {
+	t.Run("Int64", testLastValueObservationTime[int64])
+	t.Run("Float64", testLastValueObservationTime[float64])
+}
+
 func BenchmarkLastValue(b *testing.B) {
 	b.Run("Int64", benchmarkAggregator(NewLastValue[int64]))
 	b.Run("Float64", benchmarkAggregator(NewLastValue[float64]))
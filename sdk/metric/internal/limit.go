@@ -0,0 +1,188 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// overflowSet is the attribute set measurements are aggregated into once a
+// cardinality limit is reached.
+var overflowSet = attribute.NewSet(attribute.Bool("otel.metric.overflow", true))
+
+// attrLimiter limits the number of distinct attribute sets. A nil
+// *attrLimiter applies no limit.
+type attrLimiter struct {
+	limit      int
+	onOverflow func()
+	active     map[attribute.Set]struct{}
+	// pinned holds the attribute sets that stay active when reset.
+	pinned map[attribute.Set]struct{}
+}
+
+// newAttrLimiter returns an attrLimiter that allows up to limit distinct
+// attribute sets, including the overflow set. If onOverflow is not nil, it is
+// called each time the overflow set is returned in place of an attribute
+// set. If limit is not positive, nil is returned.
+func newAttrLimiter(limit int, onOverflow func()) *attrLimiter {
+	if limit <= 0 {
+		return nil
+	}
+	return &attrLimiter{
+		limit:      limit,
+		onOverflow: onOverflow,
+		active:     map[attribute.Set]struct{}{},
+	}
+}
+
+// attributes returns attr if it is already active or can be added without
+// exceeding the limit. Otherwise, the overflow set is returned.
+func (l *attrLimiter) attributes(attr attribute.Set) attribute.Set {
+	if l.admit(attr) {
+		return attr
+	}
+	if l.onOverflow != nil {
+		l.onOverflow()
+	}
+	return overflowSet
+}
+
+// admit returns true if attr is already active or was added without
+// exceeding the limit.
+func (l *attrLimiter) admit(attr attribute.Set) bool {
+	if l == nil {
+		return true
+	}
+	if _, ok := l.active[attr]; ok {
+		return true
+	}
+	// One slot is reserved for the overflow set.
+	if len(l.active) >= l.limit-1 {
+		return false
+	}
+	l.active[attr] = struct{}{}
+	return true
+}
+
+// pin returns true if attr is admitted and keeps it active across resets.
+func (l *attrLimiter) pin(attr attribute.Set) bool {
+	if l == nil {
+		return true
+	}
+	if !l.admit(attr) {
+		return false
+	}
+	if l.pinned == nil {
+		l.pinned = map[attribute.Set]struct{}{}
+	}
+	l.pinned[attr] = struct{}{}
+	return true
+}
+
+// reset forgets all active attribute sets that are not pinned.
+func (l *attrLimiter) reset() {
+	if l == nil {
+		return
+	}
+	l.active = make(map[attribute.Set]struct{}, len(l.pinned))
+	for attr := range l.pinned {
+		l.active[attr] = struct{}{}
+	}
+}
+
+// limiter is an aggregator that limits the number of distinct attribute sets
+// aggregated. limiters do not have any backing memory, and must be
+// constructed with a backing Aggregator.
+type limiter[N int64 | float64] struct {
+	aggregator Aggregator[N]
+	// resetOnCollect is true if the active attribute sets are cleared every
+	// collection cycle (delta temporality).
+	resetOnCollect bool
+
+	sync.Mutex
+	attrs *attrLimiter
+}
+
+// NewLimiter wraps an Aggregator so it aggregates at most limit distinct
+// attribute sets. Measurements made once the limit is reached with an
+// attribute set not already aggregated are aggregated with the
+// otel.metric.overflow=true attribute instead. The overflow attribute set
+// counts towards limit. If limit is not positive, agg is returned unchanged.
+//
+// If onOverflow is not nil, it is called for each measurement aggregated with
+// the overflow attribute set instead of its own.
+//
+// If temporality is delta, the attribute sets counted towards the limit are
+// reset each collection cycle.
+func NewLimiter[N int64 | float64](agg Aggregator[N], limit int, onOverflow func(), temporality metricdata.Temporality) Aggregator[N] {
+	attrs := newAttrLimiter(limit, onOverflow)
+	if attrs == nil {
+		return agg
+	}
+	return &limiter[N]{
+		aggregator:     agg,
+		resetOnCollect: temporality == metricdata.DeltaTemporality,
+		attrs:          attrs,
+	}
+}
+
+func (l *limiter[N]) setClock(fn func() time.Time) {
+	setClock(l.aggregator, fn)
+}
+
+// Aggregate records the measurement, scoped by attr, and aggregates it
+// into an aggregation.
+func (l *limiter[N]) Aggregate(ctx context.Context, measurement N, attr attribute.Set) {
+	// The wrapped Aggregator is updated while holding the lock so a
+	// collection cannot reset the limit between the two.
+	l.Lock()
+	defer l.Unlock()
+	l.aggregator.Aggregate(ctx, measurement, l.attrs.attributes(attr))
+}
+
+// bind keeps attr active for as long as the limiter is used so measurements
+// can be passed to the wrapped Aggregator without being limited again. If
+// attr cannot be admitted, the measurements are limited by Aggregate.
+func (l *limiter[N]) bind(attr attribute.Set) func(context.Context, N) {
+	l.Lock()
+	defer l.Unlock()
+	if !l.attrs.pin(attr) {
+		return func(ctx context.Context, measurement N) {
+			l.Aggregate(ctx, measurement, attr)
+		}
+	}
+	return Bind(l.aggregator, attr)
+}
+
+// Aggregation returns an Aggregation, for all the aggregated
+// measurements made and ends an aggregation cycle.
+func (l *limiter[N]) Aggregation() metricdata.Aggregation {
+	return l.aggregationReuse(nil)
+}
+
+func (l *limiter[N]) aggregationReuse(prev metricdata.Aggregation) metricdata.Aggregation {
+	l.Lock()
+	defer l.Unlock()
+	agg := AggregationReuse(l.aggregator, prev)
+	if l.resetOnCollect {
+		l.attrs.reset()
+	}
+	return agg
+}
@@ -0,0 +1,198 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func TestNewLimiter(t *testing.T) {
+	agg := NewDeltaSum[int64](true)
+	assert.Equal(t, agg, NewLimiter(agg, 0, nil, metricdata.DeltaTemporality))
+	assert.Equal(t, agg, NewLimiter(agg, -1, nil, metricdata.DeltaTemporality))
+	assert.IsType(t, &limiter[int64]{}, NewLimiter(agg, 2, nil, metricdata.DeltaTemporality))
+}
+
+func TestAttrLimiter(t *testing.T) {
+	var l *attrLimiter
+	assert.Equal(t, alice, l.attributes(alice), "nil limiter should not limit")
+
+	l = newAttrLimiter(3, nil)
+	assert.Equal(t, alice, l.attributes(alice))
+	assert.Equal(t, bob, l.attributes(bob))
+	assert.Equal(t, overflowSet, l.attributes(carol), "limit includes the overflow set")
+	assert.Equal(t, alice, l.attributes(alice), "active set should not overflow")
+
+	l.reset()
+	assert.Equal(t, carol, l.attributes(carol))
+}
+
+func TestAttrLimiterOnOverflow(t *testing.T) {
+	var overflows int
+	l := newAttrLimiter(2, func() { overflows++ })
+	l.attributes(alice)
+	assert.Equal(t, 0, overflows)
+	l.attributes(bob)
+	l.attributes(carol)
+	assert.Equal(t, 2, overflows)
+	l.attributes(alice)
+	assert.Equal(t, 2, overflows, "active set should not overflow")
+}
+
+func TestLimiter(t *testing.T) {
+	t.Run("Int64", testLimiter[int64])
+	t.Run("Float64", testLimiter[float64])
+}
+
+func testLimiter[N int64 | float64](t *testing.T) {
+	t.Cleanup(mockTime(now))
+	ctx := context.Background()
+
+	t.Run("Delta", func(t *testing.T) {
+		a := NewLimiter(NewDeltaSum[N](true), 2, nil, metricdata.DeltaTemporality)
+		a.Aggregate(ctx, 1, alice)
+		a.Aggregate(ctx, 2, bob)
+		a.Aggregate(ctx, 3, carol)
+		a.Aggregate(ctx, 4, alice)
+		metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[N]{
+			Temporality: metricdata.DeltaTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[N]{
+				point[N](alice, 5),
+				point[N](overflowSet, 5),
+			},
+		}, a.Aggregation())
+
+		// The limit is reset with the delta aggregation.
+		a.Aggregate(ctx, 2, bob)
+		a.Aggregate(ctx, 1, alice)
+		metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[N]{
+			Temporality: metricdata.DeltaTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[N]{
+				point[N](bob, 2),
+				point[N](overflowSet, 1),
+			},
+		}, a.Aggregation())
+	})
+
+	t.Run("Cumulative", func(t *testing.T) {
+		a := NewLimiter(NewCumulativeSum[N](true), 2, nil, metricdata.CumulativeTemporality)
+		a.Aggregate(ctx, 1, alice)
+		a.Aggregate(ctx, 2, bob)
+		expect := metricdata.Sum[N]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[N]{
+				point[N](alice, 1),
+				point[N](overflowSet, 2),
+			},
+		}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+
+		// Attribute sets persist with the cumulative aggregation.
+		a.Aggregate(ctx, 3, bob)
+		expect.DataPoints[1].Value = 5
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+	})
+}
+
+func TestLimiterBind(t *testing.T) {
+	t.Cleanup(mockTime(now))
+	ctx := context.Background()
+
+	var overflows int
+	a := NewLimiter(NewDeltaSum[int64](true), 2, func() { overflows++ }, metricdata.DeltaTemporality)
+	addAlice := Bind(a, alice)
+	addBob := Bind(a, bob)
+	addAlice(ctx, 1)
+	addBob(ctx, 2)
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[int64]{
+		Temporality: metricdata.DeltaTemporality,
+		IsMonotonic: true,
+		DataPoints: []metricdata.DataPoint[int64]{
+			point[int64](alice, 1),
+			point[int64](overflowSet, 2),
+		},
+	}, a.Aggregation())
+	assert.Equal(t, 1, overflows)
+
+	// The bound set stays active when the limit is reset.
+	a.Aggregate(ctx, 3, carol)
+	addAlice(ctx, 4)
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[int64]{
+		Temporality: metricdata.DeltaTemporality,
+		IsMonotonic: true,
+		DataPoints: []metricdata.DataPoint[int64]{
+			point[int64](alice, 4),
+			point[int64](overflowSet, 3),
+		},
+	}, a.Aggregation())
+	assert.Equal(t, 2, overflows)
+}
+
+func TestExemplarSamplerBind(t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	a := NewFixedSizeExemplarSampler(NewDeltaSum[int64](true), userFilter, 2, nil, alwaysSample, 1, metricdata.DeltaTemporality)
+	add := Bind(a, alice)
+	add(sampledCtx, 1)
+	a.Aggregate(sampledCtx, 2, bob)
+
+	agg := a.Aggregation()
+	require.IsType(t, metricdata.Sum[int64]{}, agg)
+	dPts := agg.(metricdata.Sum[int64]).DataPoints
+	require.Len(t, dPts, 2)
+
+	want := map[attribute.Set]metricdata.Exemplar[int64]{
+		attribute.NewSet(attribute.String("user", "alice")): exemplar[int64](1, attribute.Bool("admin", true)),
+		overflowSet: exemplar[int64](2, bob.ToSlice()...),
+	}
+	for _, dp := range dPts {
+		require.Len(t, dp.Exemplars, 1)
+		metricdatatest.AssertEqual(t, want[dp.Attributes], dp.Exemplars[0])
+	}
+}
+
+func TestExemplarSamplerLimit(t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	a := NewFixedSizeExemplarSampler(NewDeltaSum[int64](true), nil, 2, nil, alwaysSample, 1, metricdata.DeltaTemporality)
+	a.Aggregate(sampledCtx, 1, alice)
+	a.Aggregate(sampledCtx, 2, bob)
+
+	agg := a.Aggregation()
+	require.IsType(t, metricdata.Sum[int64]{}, agg)
+	dPts := agg.(metricdata.Sum[int64]).DataPoints
+	require.Len(t, dPts, 2)
+
+	want := map[attribute.Set]metricdata.Exemplar[int64]{
+		alice: exemplar[int64](1),
+		// All measurement attributes are filtered from the overflow set.
+		overflowSet: exemplar[int64](2, bob.ToSlice()...),
+	}
+	for _, dp := range dPts {
+		require.Len(t, dp.Exemplars, 1)
+		metricdatatest.AssertEqual(t, want[dp.Attributes], dp.Exemplars[0])
+	}
+}
@@ -15,33 +15,209 @@
 package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
 
 import (
+	"context"
+	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/instrument"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
 // valueMap is the storage for all sums.
+//
+// Measurements are accumulated in one of the shards of the valueMap, each
+// guarded by its own lock, to reduce lock contention when the valueMap is
+// concurrently updated. The sums of all shards are merged when they are
+// loaded.
+//
+// Measurements of bound attribute sets are accumulated in a boundValue
+// resolved when the attribute set is bound, without locking. These are
+// moved to the first shard when the sums are loaded.
 type valueMap[N int64 | float64] struct {
+	shards []valueShard[N]
+
+	// index holds the index of the shard to use. It is a sync.Pool so each
+	// processor tends to reuse the same shard.
+	index sync.Pool
+	next  uint32
+
+	// mergedMu guards merged, the sums of all shards.
+	mergedMu sync.Mutex
+	merged   map[attribute.Set]N
+
+	// boundMu guards bound, the values of bound attribute sets.
+	boundMu sync.Mutex
+	bound   map[attribute.Set]*boundValue[N]
+}
+
+// valueShard holds the sums of a subset of measurements.
+type valueShard[N int64 | float64] struct {
 	sync.Mutex
 	values map[attribute.Set]N
+
+	// Pad to a cache line to prevent false sharing between shards.
+	_ [48]byte
+}
+
+func newValueMap[N int64 | float64](shards int) *valueMap[N] {
+	if shards < 1 {
+		shards = 1
+	}
+	s := &valueMap[N]{shards: make([]valueShard[N], shards)}
+	for i := range s.shards {
+		s.shards[i].values = make(map[attribute.Set]N)
+	}
+	if shards > 1 {
+		s.merged = make(map[attribute.Set]N)
+		s.index.New = func() interface{} {
+			i := int(atomic.AddUint32(&s.next, 1)) % len(s.shards)
+			return &i
+		}
+	}
+	return s
 }
 
-func newValueMap[N int64 | float64]() *valueMap[N] {
-	return &valueMap[N]{values: make(map[attribute.Set]N)}
+// shard returns the shard the calling goroutine accumulates measurements in.
+func (s *valueMap[N]) shard() *valueShard[N] {
+	if len(s.shards) == 1 {
+		return &s.shards[0]
+	}
+	i := s.index.Get().(*int)
+	shard := &s.shards[*i]
+	s.index.Put(i)
+	return shard
 }
 
+// set sets the sum of attr to value. It is only valid to call for a valueMap
+// with a single shard.
 func (s *valueMap[N]) set(value N, attr attribute.Set) { // nolint: unused  // This is indeed used.
-	s.Lock()
-	s.values[attr] = value
-	s.Unlock()
+	shard := &s.shards[0]
+	shard.Lock()
+	shard.values[attr] = value
+	shard.Unlock()
+}
+
+func (s *valueMap[N]) Aggregate(_ context.Context, value N, attr attribute.Set) {
+	shard := s.shard()
+	shard.Lock()
+	shard.values[attr] += value
+	shard.Unlock()
+}
+
+func (s *valueMap[N]) bind(attr attribute.Set) func(context.Context, N) {
+	s.boundMu.Lock()
+	defer s.boundMu.Unlock()
+	v, ok := s.bound[attr]
+	if !ok {
+		if s.bound == nil {
+			s.bound = make(map[attribute.Set]*boundValue[N])
+		}
+		v = new(boundValue[N])
+		s.bound[attr] = v
+	}
+	return func(_ context.Context, value N) { v.add(value) }
+}
+
+// moveBound moves the values of the bound attribute sets into values. The
+// lock of the shard holding values must be held.
+func (s *valueMap[N]) moveBound(values map[attribute.Set]N) {
+	s.boundMu.Lock()
+	defer s.boundMu.Unlock()
+	for attr, v := range s.bound {
+		if value, ok := v.take(); ok {
+			values[attr] += value
+		}
+	}
+}
+
+// load returns the sums of all attribute sets. The returned map is only valid
+// until release is called.
+//
+// If reset is true, the sums are reset to zero once the caller deletes each
+// attribute set it reads from the returned map.
+func (s *valueMap[N]) load(reset bool) (values map[attribute.Set]N, release func()) {
+	if len(s.shards) == 1 {
+		shard := &s.shards[0]
+		shard.Lock()
+		s.moveBound(shard.values)
+		return shard.values, shard.Unlock
+	}
+
+	shard := &s.shards[0]
+	shard.Lock()
+	s.moveBound(shard.values)
+	shard.Unlock()
+
+	s.mergedMu.Lock()
+	for attr := range s.merged {
+		delete(s.merged, attr)
+	}
+	for i := range s.shards {
+		shard := &s.shards[i]
+		shard.Lock()
+		for attr, value := range shard.values {
+			s.merged[attr] += value
+			if reset {
+				delete(shard.values, attr)
+			}
+		}
+		shard.Unlock()
+	}
+	return s.merged, s.mergedMu.Unlock
+}
+
+// boundValue accumulates the measurements of a bound attribute set. It is
+// updated atomically so no lock needs to be held.
+type boundValue[N int64 | float64] struct {
+	// bits holds the accumulated value, an int64 or the IEEE 754 binary
+	// representation of a float64.
+	bits uint64
+	// recorded is 1 if a measurement was made since the value was last
+	// taken.
+	recorded uint32
+}
+
+// add adds value to v.
+func (v *boundValue[N]) add(value N) {
+	atomic.StoreUint32(&v.recorded, 1)
+	for {
+		old := atomic.LoadUint64(&v.bits)
+		if atomic.CompareAndSwapUint64(&v.bits, old, toBits(fromBits[N](old)+value)) {
+			return
+		}
+	}
 }
 
-func (s *valueMap[N]) Aggregate(value N, attr attribute.Set) {
-	s.Lock()
-	s.values[attr] += value
-	s.Unlock()
+// take returns the value accumulated by v and resets it to zero. It returns
+// false if no measurement was made since the value was last taken.
+func (v *boundValue[N]) take() (N, bool) {
+	value := fromBits[N](atomic.SwapUint64(&v.bits, 0))
+	recorded := atomic.SwapUint32(&v.recorded, 0) == 1
+	return value, recorded || value != 0
+}
+
+func toBits[N int64 | float64](value N) uint64 {
+	switch v := interface{}(value).(type) {
+	case float64:
+		return math.Float64bits(v)
+	case int64:
+		return uint64(v)
+	}
+	return 0
+}
+
+func fromBits[N int64 | float64](bits uint64) N {
+	var value N
+	switch p := interface{}(&value).(type) {
+	case *float64:
+		*p = math.Float64frombits(bits)
+	case *int64:
+		*p = int64(bits)
+	}
+	return value
 }
 
 // NewDeltaSum returns an Aggregator that summarizes a set of measurements as
@@ -55,12 +231,22 @@ func (s *valueMap[N]) Aggregate(value N, attr attribute.Set) {
 // Each aggregation cycle is treated independently. When the returned
 // Aggregator's Aggregation method is called it will reset all sums to zero.
 func NewDeltaSum[N int64 | float64](monotonic bool) Aggregator[N] {
-	return newDeltaSum[N](monotonic)
+	return newDeltaSum[N](monotonic, 1)
+}
+
+// NewShardedDeltaSum returns an Aggregator like the one returned from
+// NewDeltaSum that accumulates measurements in shards, the number of shards
+// that are concurrently updated. This reduces lock contention when the
+// returned Aggregator is updated from many goroutines, at the expense of up
+// to shards times the memory for each attribute set. The shards are merged
+// when the Aggregation method is called.
+func NewShardedDeltaSum[N int64 | float64](monotonic bool, shards int) Aggregator[N] {
+	return newDeltaSum[N](monotonic, shards)
 }
 
-func newDeltaSum[N int64 | float64](monotonic bool) *deltaSum[N] {
+func newDeltaSum[N int64 | float64](monotonic bool, shards int) *deltaSum[N] {
 	return &deltaSum[N]{
-		valueMap:  newValueMap[N](),
+		valueMap:  newValueMap[N](shards),
 		monotonic: monotonic,
 		start:     now(),
 	}
@@ -70,27 +256,37 @@ func newDeltaSum[N int64 | float64](monotonic bool) *deltaSum[N] {
 // cycle as their arithmetic sum.
 type deltaSum[N int64 | float64] struct {
 	*valueMap[N]
+	clock
 
 	monotonic bool
 	start     time.Time
 }
 
+func (s *deltaSum[N]) setClock(fn func() time.Time) {
+	s.clock = clock{nowFunc: fn}
+	s.start = s.now()
+}
+
 func (s *deltaSum[N]) Aggregation() metricdata.Aggregation {
+	return s.aggregationReuse(nil)
+}
+
+func (s *deltaSum[N]) aggregationReuse(prev metricdata.Aggregation) metricdata.Aggregation {
 	out := metricdata.Sum[N]{
 		Temporality: metricdata.DeltaTemporality,
 		IsMonotonic: s.monotonic,
 	}
 
-	s.Lock()
-	defer s.Unlock()
+	values, release := s.load(true)
+	defer release()
 
-	if len(s.values) == 0 {
+	if len(values) == 0 {
 		return out
 	}
 
-	t := now()
-	out.DataPoints = make([]metricdata.DataPoint[N], 0, len(s.values))
-	for attr, value := range s.values {
+	t := s.now()
+	out.DataPoints = reuseDataPoints[N](prev, len(values))
+	for attr, value := range values {
 		out.DataPoints = append(out.DataPoints, metricdata.DataPoint[N]{
 			Attributes: attr,
 			StartTime:  s.start,
@@ -98,7 +294,7 @@ func (s *deltaSum[N]) Aggregation() metricdata.Aggregation {
 			Value:      value,
 		})
 		// Unused attribute sets do not report.
-		delete(s.values, attr)
+		delete(values, attr)
 	}
 	// The delta collection cycle resets.
 	s.start = t
@@ -116,12 +312,22 @@ func (s *deltaSum[N]) Aggregation() metricdata.Aggregation {
 // Each aggregation cycle is treated independently. When the returned
 // Aggregator's Aggregation method is called it will reset all sums to zero.
 func NewCumulativeSum[N int64 | float64](monotonic bool) Aggregator[N] {
-	return newCumulativeSum[N](monotonic)
+	return newCumulativeSum[N](monotonic, 1)
+}
+
+// NewShardedCumulativeSum returns an Aggregator like the one returned from
+// NewCumulativeSum that accumulates measurements in shards, the number of
+// shards that are concurrently updated. This reduces lock contention when the
+// returned Aggregator is updated from many goroutines, at the expense of up
+// to shards times the memory for each attribute set. The shards are merged
+// when the Aggregation method is called.
+func NewShardedCumulativeSum[N int64 | float64](monotonic bool, shards int) Aggregator[N] {
+	return newCumulativeSum[N](monotonic, shards)
 }
 
-func newCumulativeSum[N int64 | float64](monotonic bool) *cumulativeSum[N] {
+func newCumulativeSum[N int64 | float64](monotonic bool, shards int) *cumulativeSum[N] {
 	return &cumulativeSum[N]{
-		valueMap:  newValueMap[N](),
+		valueMap:  newValueMap[N](shards),
 		monotonic: monotonic,
 		start:     now(),
 	}
@@ -131,27 +337,37 @@ func newCumulativeSum[N int64 | float64](monotonic bool) *cumulativeSum[N] {
 // cycles as their arithmetic sum.
 type cumulativeSum[N int64 | float64] struct {
 	*valueMap[N]
+	clock
 
 	monotonic bool
 	start     time.Time
 }
 
+func (s *cumulativeSum[N]) setClock(fn func() time.Time) {
+	s.clock = clock{nowFunc: fn}
+	s.start = s.now()
+}
+
 func (s *cumulativeSum[N]) Aggregation() metricdata.Aggregation {
+	return s.aggregationReuse(nil)
+}
+
+func (s *cumulativeSum[N]) aggregationReuse(prev metricdata.Aggregation) metricdata.Aggregation {
 	out := metricdata.Sum[N]{
 		Temporality: metricdata.CumulativeTemporality,
 		IsMonotonic: s.monotonic,
 	}
 
-	s.Lock()
-	defer s.Unlock()
+	values, release := s.load(false)
+	defer release()
 
-	if len(s.values) == 0 {
+	if len(values) == 0 {
 		return out
 	}
 
-	t := now()
-	out.DataPoints = make([]metricdata.DataPoint[N], 0, len(s.values))
-	for attr, value := range s.values {
+	t := s.now()
+	out.DataPoints = reuseDataPoints[N](prev, len(values))
+	for attr, value := range values {
 		out.DataPoints = append(out.DataPoints, metricdata.DataPoint[N]{
 			Attributes: attr,
 			StartTime:  s.start,
@@ -177,7 +393,7 @@ func (s *cumulativeSum[N]) Aggregation() metricdata.Aggregation {
 // The output Aggregation will report recorded values as delta temporality. It
 // is up to the caller to ensure this is accurate.
 func NewPrecomputedDeltaSum[N int64 | float64](monotonic bool) Aggregator[N] {
-	return &precomputedSum[N]{settableSum: newDeltaSum[N](monotonic)}
+	return newPrecomputedSum[N](newDeltaSum[N](monotonic, 1), true)
 }
 
 // NewPrecomputedCumulativeSum returns an Aggregator that summarizes a set of
@@ -191,21 +407,82 @@ func NewPrecomputedDeltaSum[N int64 | float64](monotonic bool) Aggregator[N] {
 // The output Aggregation will report recorded values as cumulative
 // temporality. It is up to the caller to ensure this is accurate.
 func NewPrecomputedCumulativeSum[N int64 | float64](monotonic bool) Aggregator[N] {
-	return &precomputedSum[N]{settableSum: newCumulativeSum[N](monotonic)}
+	return newPrecomputedSum[N](newCumulativeSum[N](monotonic, 1), false)
+}
+
+func newPrecomputedSum[N int64 | float64](sum settableSum[N], resetOnCollect bool) *precomputedSum[N] {
+	return &precomputedSum[N]{
+		settableSum:    sum,
+		times:          make(map[attribute.Set]time.Time),
+		resetOnCollect: resetOnCollect,
+	}
 }
 
 type settableSum[N int64 | float64] interface {
 	set(value N, attr attribute.Set)
 	Aggregation() metricdata.Aggregation
+	aggregationReuse(prev metricdata.Aggregation) metricdata.Aggregation
 }
 
 // precomputedSum summarizes a set of measurements recorded over all
 // aggregation cycles directly as an arithmetic sum.
 type precomputedSum[N int64 | float64] struct {
 	settableSum[N]
+
+	// timesMu guards times and serializes access to settableSum so the
+	// recorded sums and their observation times stay consistent.
+	timesMu sync.Mutex
+	// times holds the explicit observation time, if one was provided, of the
+	// last measurement recorded for an attribute set.
+	times map[attribute.Set]time.Time
+	// resetOnCollect is true if observation times are only reported for the
+	// collection cycle they were measured in.
+	resetOnCollect bool
+}
+
+func (s *precomputedSum[N]) setClock(fn func() time.Time) {
+	setClock(s.settableSum, fn)
 }
 
 // Aggregate records value directly as a sum for attr.
-func (s *precomputedSum[N]) Aggregate(value N, attr attribute.Set) {
+func (s *precomputedSum[N]) Aggregate(ctx context.Context, value N, attr attribute.Set) {
+	s.timesMu.Lock()
+	defer s.timesMu.Unlock()
+
 	s.set(value, attr)
+	if t, ok := instrument.ObservationTime(ctx); ok {
+		s.times[attr] = t
+	} else {
+		delete(s.times, attr)
+	}
+}
+
+// Aggregation returns the recorded sums. Sums recorded with an explicit
+// observation time report that time instead of the collection time.
+func (s *precomputedSum[N]) Aggregation() metricdata.Aggregation {
+	return s.aggregationReuse(nil)
+}
+
+func (s *precomputedSum[N]) aggregationReuse(prev metricdata.Aggregation) metricdata.Aggregation {
+	s.timesMu.Lock()
+	defer s.timesMu.Unlock()
+
+	agg := s.settableSum.aggregationReuse(prev)
+	if len(s.times) == 0 {
+		return agg
+	}
+
+	if out, ok := agg.(metricdata.Sum[N]); ok {
+		for i, dPt := range out.DataPoints {
+			if t, ok := s.times[dPt.Attributes]; ok {
+				out.DataPoints[i].Time = t
+			}
+		}
+	}
+	if s.resetOnCollect {
+		for attr := range s.times {
+			delete(s.times, attr)
+		}
+	}
+	return agg
 }
@@ -15,9 +15,16 @@
 package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
 
 import (
+	"context"
+	"runtime"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/instrument"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 )
@@ -55,6 +62,26 @@ func testSum[N int64 | float64](t *testing.T) {
 		t.Run("NonMonotonic", tester.Run(NewCumulativeSum[N](mono), incr, eFunc))
 	})
 
+	t.Run("ShardedDelta", func(t *testing.T) {
+		incr, mono := monoIncr, true
+		eFunc := deltaExpecter[N](incr, mono)
+		t.Run("Monotonic", tester.Run(NewShardedDeltaSum[N](mono, 4), incr, eFunc))
+
+		incr, mono = nonMonoIncr, false
+		eFunc = deltaExpecter[N](incr, mono)
+		t.Run("NonMonotonic", tester.Run(NewShardedDeltaSum[N](mono, 4), incr, eFunc))
+	})
+
+	t.Run("ShardedCumulative", func(t *testing.T) {
+		incr, mono := monoIncr, true
+		eFunc := cumuExpecter[N](incr, mono)
+		t.Run("Monotonic", tester.Run(NewShardedCumulativeSum[N](mono, 4), incr, eFunc))
+
+		incr, mono = nonMonoIncr, false
+		eFunc = cumuExpecter[N](incr, mono)
+		t.Run("NonMonotonic", tester.Run(NewShardedCumulativeSum[N](mono, 4), incr, eFunc))
+	})
+
 	t.Run("PreComputedDelta", func(t *testing.T) {
 		incr, mono, temp := monoIncr, true, metricdata.DeltaTemporality
 		eFunc := preExpecter[N](incr, mono, temp)
@@ -128,7 +155,7 @@ func testDeltaSumReset[N int64 | float64](t *testing.T) {
 	a := NewDeltaSum[N](false)
 	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
 
-	a.Aggregate(1, alice)
+	a.Aggregate(context.Background(), 1, alice)
 	expect.DataPoints = []metricdata.DataPoint[N]{point[N](alice, 1)}
 	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
 
@@ -137,7 +164,7 @@ func testDeltaSumReset[N int64 | float64](t *testing.T) {
 	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
 
 	// Aggregating another set should not affect the original (alice).
-	a.Aggregate(1, bob)
+	a.Aggregate(context.Background(), 1, bob)
 	expect.DataPoints = []metricdata.DataPoint[N]{point[N](bob, 1)}
 	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
 }
@@ -147,6 +174,150 @@ func TestDeltaSumReset(t *testing.T) {
 	t.Run("Float64", testDeltaSumReset[float64])
 }
 
+func testPrecomputedSumObservationTime[N int64 | float64](t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	observed := time.Unix(1, 0)
+	ctx := instrument.ContextWithObservationTime(context.Background(), observed)
+
+	t.Run("Delta", func(t *testing.T) {
+		a := NewPrecomputedDeltaSum[N](false)
+		a.Aggregate(ctx, 1, alice)
+		a.Aggregate(context.Background(), 2, bob)
+
+		aliceDP := point[N](alice, 1)
+		aliceDP.Time = observed
+		expect := metricdata.Sum[N]{
+			Temporality: metricdata.DeltaTemporality,
+			DataPoints:  []metricdata.DataPoint[N]{aliceDP, point[N](bob, 2)},
+		}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+
+		// The observation time is only reported for the cycle it was made in.
+		a.Aggregate(context.Background(), 3, alice)
+		expect.DataPoints = []metricdata.DataPoint[N]{point[N](alice, 3)}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+	})
+
+	t.Run("Cumulative", func(t *testing.T) {
+		a := NewPrecomputedCumulativeSum[N](false)
+		a.Aggregate(ctx, 1, alice)
+
+		aliceDP := point[N](alice, 1)
+		aliceDP.Time = observed
+		expect := metricdata.Sum[N]{
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints:  []metricdata.DataPoint[N]{aliceDP},
+		}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+		// The last observation continues to be reported with its time.
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+
+		// Observations without an explicit time use the collection time.
+		a.Aggregate(context.Background(), 2, alice)
+		expect.DataPoints = []metricdata.DataPoint[N]{point[N](alice, 2)}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+	})
+}
+
+func TestPrecomputedSumObservationTime(t *testing.T) {
+	t.Run("Int64", testPrecomputedSumObservationTime[int64])
+	t.Run("Float64", testPrecomputedSumObservationTime[float64])
+}
+
+func TestSumBind(t *testing.T) {
+	t.Run("Int64", testSumBind[int64])
+	t.Run("Float64", testSumBind[float64])
+}
+
+func testSumBind[N int64 | float64](t *testing.T) {
+	t.Cleanup(mockTime(now))
+	ctx := context.Background()
+
+	t.Run("Delta", func(t *testing.T) {
+		a := NewShardedDeltaSum[N](false, 2)
+		add := Bind(a, alice)
+		add(ctx, 1)
+		// Bound and unbound measurements are aggregated together.
+		a.Aggregate(ctx, 2, alice)
+		add(ctx, 3)
+		expect := metricdata.Sum[N]{
+			Temporality: metricdata.DeltaTemporality,
+			DataPoints:  []metricdata.DataPoint[N]{point[N](alice, 6)},
+		}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+
+		// A bound set is only reported for the cycles it is measured in.
+		expect.DataPoints = nil
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+
+		add(ctx, 0)
+		expect.DataPoints = []metricdata.DataPoint[N]{point[N](alice, 0)}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+	})
+
+	t.Run("Cumulative", func(t *testing.T) {
+		a := NewCumulativeSum[N](false)
+		add := Bind(a, alice)
+		add(ctx, 1)
+		expect := metricdata.Sum[N]{
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints:  []metricdata.DataPoint[N]{point[N](alice, 1)},
+		}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+
+		add(ctx, 2)
+		a.Aggregate(ctx, 3, alice)
+		expect.DataPoints[0].Value = 6
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+	})
+}
+
+func TestBoundValue(t *testing.T) {
+	var v boundValue[float64]
+	_, ok := v.take()
+	assert.False(t, ok, "empty value taken")
+
+	const n = 100
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v.add(0.5)
+		}()
+	}
+	wg.Wait()
+
+	got, ok := v.take()
+	assert.True(t, ok)
+	assert.Equal(t, float64(n)/2, got)
+	_, ok = v.take()
+	assert.False(t, ok, "value not reset")
+}
+
+func TestValueMapShardsMerged(t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	s := newValueMap[int64](2)
+	s.shards[0].values[alice] = 1
+	s.shards[1].values[alice] = 2
+	s.shards[1].values[bob] = 3
+
+	values, release := s.load(false)
+	assert.Equal(t, map[attribute.Set]int64{alice: 3, bob: 3}, values)
+	release()
+
+	// Merging again should not double count.
+	values, release = s.load(true)
+	assert.Equal(t, map[attribute.Set]int64{alice: 3, bob: 3}, values)
+	release()
+
+	for i := range s.shards {
+		assert.Len(t, s.shards[i].values, 0, "shard %d not reset", i)
+	}
+}
+
 func BenchmarkSum(b *testing.B) {
 	b.Run("Int64", benchmarkSum[int64])
 	b.Run("Float64", benchmarkSum[float64])
@@ -160,4 +331,8 @@ func benchmarkSum[N int64 | float64](b *testing.B) {
 	b.Run("Delta", benchmarkAggregator(factory))
 	factory = func() Aggregator[N] { return NewCumulativeSum[N](false) }
 	b.Run("Cumulative", benchmarkAggregator(factory))
+	factory = func() Aggregator[N] { return NewShardedDeltaSum[N](false, runtime.GOMAXPROCS(0)) }
+	b.Run("ShardedDelta", benchmarkAggregator(factory))
+	factory = func() Aggregator[N] { return NewShardedCumulativeSum[N](false, runtime.GOMAXPROCS(0)) }
+	b.Run("ShardedCumulative", benchmarkAggregator(factory))
 }
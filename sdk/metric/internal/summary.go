@@ -0,0 +1,403 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// summaryBufferSize is the number of measurements buffered by a
+// quantileStream before they are merged into its samples.
+const summaryBufferSize = 500
+
+// quantileTarget is a quantile estimated by a quantileStream and the rank
+// error allowed for its estimate.
+type quantileTarget struct {
+	quantile float64
+	epsilon  float64
+}
+
+// newQuantileTarget returns the quantileTarget for q. The allowed error is
+// the smaller of 0.01 and one tenth of the distance from q to the nearer of 0
+// or 1.
+func newQuantileTarget(q float64) quantileTarget {
+	return quantileTarget{
+		quantile: q,
+		epsilon:  math.Min(0.01, math.Min(q, 1-q)/10),
+	}
+}
+
+// ckmsSample is a sample retained by a quantileStream.
+type ckmsSample struct {
+	value float64
+	// width is the difference between the lowest possible rank of this
+	// sample and the previous one.
+	width float64
+	// delta is the difference between the highest and lowest possible rank
+	// of this sample.
+	delta float64
+}
+
+// quantileStream estimates targeted quantiles of a stream of measurements
+// using the algorithm described in "Effective Computation of Biased Quantiles
+// over Data Streams" by Cormode, Korn, Muthukrishnan, and Srivastava.
+//
+// The quantileStream retains a compressed set of samples that bounds the
+// rank error of the targeted quantiles.
+type quantileStream struct {
+	targets []quantileTarget
+
+	buf     []float64
+	samples []ckmsSample
+	n       float64
+}
+
+func newQuantileStream(targets []quantileTarget) *quantileStream {
+	return &quantileStream{targets: targets}
+}
+
+// insert adds v to the stream.
+func (s *quantileStream) insert(v float64) {
+	s.buf = append(s.buf, v)
+	if len(s.buf) >= summaryBufferSize {
+		s.flush()
+	}
+}
+
+// flush merges all buffered measurements into the samples of s.
+func (s *quantileStream) flush() {
+	if len(s.buf) == 0 {
+		return
+	}
+	sort.Float64s(s.buf)
+	s.merge(s.buf)
+	s.buf = s.buf[:0]
+}
+
+// invariant returns the maximum allowed width and delta of a sample with rank
+// r that still satisfies the error bounds of all targets.
+func (s *quantileStream) invariant(r float64) float64 {
+	m := math.MaxFloat64
+	for _, t := range s.targets {
+		var f float64
+		if t.quantile*s.n <= r {
+			f = (2 * t.epsilon * r) / t.quantile
+		} else {
+			f = (2 * t.epsilon * (s.n - r)) / (1 - t.quantile)
+		}
+		if f < m {
+			m = f
+		}
+	}
+	return m
+}
+
+// merge inserts the sorted values into the samples of s and compresses them.
+func (s *quantileStream) merge(values []float64) {
+	var r float64
+	i := 0
+	for _, v := range values {
+		inserted := false
+		for ; i < len(s.samples); i++ {
+			c := s.samples[i]
+			if c.value > v {
+				delta := math.Max(0, math.Floor(s.invariant(r))-1)
+				s.samples = append(s.samples, ckmsSample{})
+				copy(s.samples[i+1:], s.samples[i:])
+				s.samples[i] = ckmsSample{value: v, width: 1, delta: delta}
+				i++
+				inserted = true
+				break
+			}
+			r += c.width
+		}
+		if !inserted {
+			s.samples = append(s.samples, ckmsSample{value: v, width: 1})
+			i++
+		}
+		s.n++
+		r++
+	}
+	s.compress()
+}
+
+// compress merges adjacent samples of s while the error bounds of all
+// targets are still satisfied.
+func (s *quantileStream) compress() {
+	if len(s.samples) < 2 {
+		return
+	}
+	x := s.samples[len(s.samples)-1]
+	xi := len(s.samples) - 1
+	r := s.n - 1 - x.width
+
+	for i := len(s.samples) - 2; i >= 0; i-- {
+		c := s.samples[i]
+		if c.width+x.width+x.delta <= s.invariant(r) {
+			x.width += c.width
+			s.samples[xi] = x
+			copy(s.samples[i:], s.samples[i+1:])
+			s.samples = s.samples[:len(s.samples)-1]
+			xi--
+		} else {
+			x = c
+			xi = i
+		}
+		r -= c.width
+	}
+}
+
+// query returns the estimated value of quantile q.
+func (s *quantileStream) query(q float64) float64 {
+	s.flush()
+	if len(s.samples) == 0 {
+		return 0
+	}
+
+	// The rank of the quantile and the maximum rank a sample returned for it
+	// is allowed to have.
+	t := math.Ceil(q * s.n)
+	bound := t + s.invariant(t)/2
+	p := s.samples[0]
+	var r float64
+	for _, c := range s.samples[1:] {
+		r += p.width
+		if r+c.width+c.delta > bound {
+			return p.value
+		}
+		p = c
+	}
+	return p.value
+}
+
+// summaryDataPoint is the summary of a set of measurements with the same
+// attributes.
+type summaryDataPoint struct {
+	count    uint64
+	sum      float64
+	min, max float64
+
+	// stream is nil if no quantiles other than 0 and 1 are estimated.
+	stream *quantileStream
+}
+
+func newSummaryDataPoint(targets []quantileTarget, v float64) *summaryDataPoint {
+	p := &summaryDataPoint{min: v, max: v}
+	if len(targets) > 0 {
+		p.stream = newQuantileStream(targets)
+	}
+	return p
+}
+
+func (p *summaryDataPoint) record(v float64) {
+	p.count++
+	p.sum += v
+	if v < p.min {
+		p.min = v
+	} else if v > p.max {
+		p.max = v
+	}
+	if p.stream != nil {
+		p.stream.insert(v)
+	}
+}
+
+// dataPoint returns the SummaryDataPoint of p for attr.
+func (p *summaryDataPoint) dataPoint(attr attribute.Set, quantiles []float64, start, t time.Time) metricdata.SummaryDataPoint {
+	dp := metricdata.SummaryDataPoint{
+		Attributes: attr,
+		StartTime:  start,
+		Time:       t,
+		Count:      p.count,
+		Sum:        p.sum,
+	}
+	if len(quantiles) == 0 {
+		return dp
+	}
+
+	dp.QuantileValues = make([]metricdata.QuantileValue, len(quantiles))
+	for i, q := range quantiles {
+		var v float64
+		switch q {
+		case 0:
+			v = p.min
+		case 1:
+			v = p.max
+		default:
+			v = p.stream.query(q)
+		}
+		dp.QuantileValues[i] = metricdata.QuantileValue{Quantile: q, Value: v}
+	}
+	return dp
+}
+
+// summaryValues summarizes a set of measurements as their count, sum, and
+// estimated quantiles.
+type summaryValues[N int64 | float64] struct {
+	quantiles []float64
+	targets   []quantileTarget
+
+	values   map[attribute.Set]*summaryDataPoint
+	valuesMu sync.Mutex
+}
+
+func newSummaryValues[N int64 | float64](cfg aggregation.Summary) *summaryValues[N] {
+	// Keep a sorted copy of the quantiles so they are not modified by the
+	// user and are reported in order.
+	q := make([]float64, len(cfg.Quantiles))
+	copy(q, cfg.Quantiles)
+	sort.Float64s(q)
+
+	var targets []quantileTarget
+	for _, v := range q {
+		// The 0 and 1 quantiles are the tracked min and max.
+		if v > 0 && v < 1 {
+			targets = append(targets, newQuantileTarget(v))
+		}
+	}
+	return &summaryValues[N]{
+		quantiles: q,
+		targets:   targets,
+		values:    make(map[attribute.Set]*summaryDataPoint),
+	}
+}
+
+// Aggregate records the measurement value, scoped by attr, and aggregates it
+// into a summary.
+func (s *summaryValues[N]) Aggregate(_ context.Context, value N, attr attribute.Set) {
+	v := float64(value)
+	// NaN cannot be ordered and would invalidate all estimates.
+	if math.IsNaN(v) {
+		return
+	}
+
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+
+	p, ok := s.values[attr]
+	if !ok {
+		p = newSummaryDataPoint(s.targets, v)
+		s.values[attr] = p
+	}
+	p.record(v)
+}
+
+// NewDeltaSummary returns an Aggregator that summarizes a set of
+// measurements as their count, sum, and estimated quantiles. Each summary is
+// scoped by attributes and the aggregation cycle the measurements were made
+// in.
+//
+// Each aggregation cycle is treated independently. When the returned
+// Aggregator's Aggregations method is called it will reset all summaries.
+func NewDeltaSummary[N int64 | float64](cfg aggregation.Summary) Aggregator[N] {
+	return &deltaSummary[N]{
+		summaryValues: newSummaryValues[N](cfg),
+		start:         now(),
+	}
+}
+
+// deltaSummary summarizes a set of measurements made in a single aggregation
+// cycle as their count, sum, and estimated quantiles.
+type deltaSummary[N int64 | float64] struct {
+	*summaryValues[N]
+	clock
+
+	start time.Time
+}
+
+func (s *deltaSummary[N]) setClock(fn func() time.Time) {
+	s.clock = clock{nowFunc: fn}
+	s.start = s.now()
+}
+
+func (s *deltaSummary[N]) Aggregation() metricdata.Aggregation {
+	sum := metricdata.Summary{Temporality: metricdata.DeltaTemporality}
+
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+
+	if len(s.values) == 0 {
+		return sum
+	}
+
+	t := s.now()
+	sum.DataPoints = make([]metricdata.SummaryDataPoint, 0, len(s.values))
+	for a, p := range s.values {
+		sum.DataPoints = append(sum.DataPoints, p.dataPoint(a, s.quantiles, s.start, t))
+
+		// Unused attribute sets do not report.
+		delete(s.values, a)
+	}
+	// The delta collection cycle resets.
+	s.start = t
+	return sum
+}
+
+// NewCumulativeSummary returns an Aggregator that summarizes a set of
+// measurements as their count, sum, and estimated quantiles. Each summary is
+// scoped by attributes.
+//
+// Each aggregation cycle builds from the previous, the summaries are of all
+// values aggregated since the returned Aggregator was created.
+func NewCumulativeSummary[N int64 | float64](cfg aggregation.Summary) Aggregator[N] {
+	return &cumulativeSummary[N]{
+		summaryValues: newSummaryValues[N](cfg),
+		start:         now(),
+	}
+}
+
+// cumulativeSummary summarizes a set of measurements made over all
+// aggregation cycles as their count, sum, and estimated quantiles.
+type cumulativeSummary[N int64 | float64] struct {
+	*summaryValues[N]
+	clock
+
+	start time.Time
+}
+
+func (s *cumulativeSummary[N]) setClock(fn func() time.Time) {
+	s.clock = clock{nowFunc: fn}
+	s.start = s.now()
+}
+
+func (s *cumulativeSummary[N]) Aggregation() metricdata.Aggregation {
+	sum := metricdata.Summary{Temporality: metricdata.CumulativeTemporality}
+
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+
+	if len(s.values) == 0 {
+		return sum
+	}
+
+	t := s.now()
+	sum.DataPoints = make([]metricdata.SummaryDataPoint, 0, len(s.values))
+	for a, p := range s.values {
+		sum.DataPoints = append(sum.DataPoints, p.dataPoint(a, s.quantiles, s.start, t))
+		// TODO (#3006): This will use an unbounded amount of memory if there
+		// are unbounded number of attribute sets being aggregated. Attribute
+		// sets that become "stale" need to be forgotten so this will not
+		// overload the system.
+	}
+	return sum
+}
@@ -0,0 +1,168 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func TestNewQuantileTarget(t *testing.T) {
+	assert.Equal(t, quantileTarget{quantile: 0.5, epsilon: 0.01}, newQuantileTarget(0.5))
+	assert.InDelta(t, 0.001, newQuantileTarget(0.99).epsilon, 1e-12)
+	assert.InDelta(t, 0.0001, newQuantileTarget(0.001).epsilon, 1e-12)
+}
+
+func TestQuantileStreamAccuracy(t *testing.T) {
+	const n = 100000
+	quantiles := []float64{0.01, 0.1, 0.5, 0.9, 0.99, 0.999}
+	targets := make([]quantileTarget, len(quantiles))
+	for i, q := range quantiles {
+		targets[i] = newQuantileTarget(q)
+	}
+
+	// The values 1 through n, in random order, so the rank of a value is the
+	// value itself.
+	s := newQuantileStream(targets)
+	for _, v := range rand.New(rand.NewSource(1)).Perm(n) {
+		s.insert(float64(v + 1))
+	}
+
+	for _, tgt := range targets {
+		got := s.query(tgt.quantile)
+		want := tgt.quantile * n
+		assert.InDeltaf(t, want, got, tgt.epsilon*n+1, "quantile %v", tgt.quantile)
+	}
+	assert.Less(t, len(s.samples), n/10, "samples were not compressed")
+}
+
+func TestQuantileStreamEmpty(t *testing.T) {
+	s := newQuantileStream([]quantileTarget{newQuantileTarget(0.5)})
+	assert.Equal(t, 0.0, s.query(0.5))
+}
+
+func TestSummary(t *testing.T) {
+	t.Run("Int64", testSummary[int64])
+	t.Run("Float64", testSummary[float64])
+}
+
+func testSummary[N int64 | float64](t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	cfg := aggregation.Summary{Quantiles: []float64{1, 0.5, 0}}
+	dPt := func(count uint64, sum, min, median, max float64) metricdata.SummaryDataPoint {
+		return metricdata.SummaryDataPoint{
+			Attributes: alice,
+			StartTime:  now(),
+			Time:       now(),
+			Count:      count,
+			Sum:        sum,
+			QuantileValues: []metricdata.QuantileValue{
+				{Quantile: 0, Value: min},
+				{Quantile: 0.5, Value: median},
+				{Quantile: 1, Value: max},
+			},
+		}
+	}
+	record := func(a Aggregator[N]) {
+		for _, v := range []N{4, 2, 16, 1, 8} {
+			a.Aggregate(context.Background(), v, alice)
+		}
+	}
+
+	t.Run("Delta", func(t *testing.T) {
+		a := NewDeltaSummary[N](cfg)
+		record(a)
+		metricdatatest.AssertAggregationsEqual(t, metricdata.Summary{
+			Temporality: metricdata.DeltaTemporality,
+			DataPoints:  []metricdata.SummaryDataPoint{dPt(5, 31, 1, 4, 16)},
+		}, a.Aggregation())
+
+		// The delta aggregation resets.
+		metricdatatest.AssertAggregationsEqual(t, metricdata.Summary{
+			Temporality: metricdata.DeltaTemporality,
+		}, a.Aggregation())
+	})
+
+	t.Run("Cumulative", func(t *testing.T) {
+		a := NewCumulativeSummary[N](cfg)
+		record(a)
+		expect := metricdata.Summary{
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints:  []metricdata.SummaryDataPoint{dPt(5, 31, 1, 4, 16)},
+		}
+		agg := a.Aggregation()
+		metricdatatest.AssertAggregationsEqual(t, expect, agg)
+
+		// The cumulative aggregation persists and does not modify the
+		// previously returned data points.
+		a.Aggregate(context.Background(), 32, alice)
+		metricdatatest.AssertAggregationsEqual(t, expect, agg)
+
+		expect.DataPoints = []metricdata.SummaryDataPoint{dPt(6, 63, 1, 4, 32)}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+	})
+
+	t.Run("NoQuantiles", func(t *testing.T) {
+		a := NewDeltaSummary[N](aggregation.Summary{})
+		record(a)
+
+		dp := dPt(5, 31, 0, 0, 0)
+		dp.QuantileValues = nil
+		metricdatatest.AssertAggregationsEqual(t, metricdata.Summary{
+			Temporality: metricdata.DeltaTemporality,
+			DataPoints:  []metricdata.SummaryDataPoint{dp},
+		}, a.Aggregation())
+	})
+}
+
+func TestSummaryIgnoresNaN(t *testing.T) {
+	a := NewDeltaSummary[float64](aggregation.Summary{Quantiles: []float64{0.5}})
+	a.Aggregate(context.Background(), math.NaN(), alice)
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Summary{
+		Temporality: metricdata.DeltaTemporality,
+	}, a.Aggregation())
+}
+
+func TestSummaryImmutableQuantiles(t *testing.T) {
+	q := []float64{0.5}
+	s := newSummaryValues[int64](aggregation.Summary{Quantiles: q})
+	q[0] = 0.9
+	assert.Equal(t, []float64{0.5}, s.quantiles, "modifying the config quantiles should not change the summary")
+}
+
+func BenchmarkSummary(b *testing.B) {
+	cfg := aggregation.Summary{Quantiles: []float64{0.5, 0.9, 0.99}}
+	b.Run("Int64", func(b *testing.B) {
+		factory := func() Aggregator[int64] { return NewDeltaSummary[int64](cfg) }
+		b.Run("Delta", benchmarkAggregator(factory))
+		factory = func() Aggregator[int64] { return NewCumulativeSummary[int64](cfg) }
+		b.Run("Cumulative", benchmarkAggregator(factory))
+	})
+	b.Run("Float64", func(b *testing.B) {
+		factory := func() Aggregator[float64] { return NewDeltaSummary[float64](cfg) }
+		b.Run("Delta", benchmarkAggregator(factory))
+		factory = func() Aggregator[float64] { return NewCumulativeSummary[float64](cfg) }
+		b.Run("Cumulative", benchmarkAggregator(factory))
+	})
+}
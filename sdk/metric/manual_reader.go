@@ -19,11 +19,13 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/view"
+	"go.opentelemetry.io/otel/sdk/resource"
 )
 
 // manualReader is a a simple Reader that allows an application to
@@ -34,6 +36,11 @@ type manualReader struct {
 
 	temporalitySelector TemporalitySelector
 	aggregationSelector AggregationSelector
+	limit               int
+	cbTimeout           time.Duration
+	sdTimeout           time.Duration
+	externalProducers   []Producer
+	resTransform        func(*resource.Resource) *resource.Resource
 }
 
 // Compile time check the manualReader implements Reader and is comparable.
@@ -45,6 +52,11 @@ func NewManualReader(opts ...ManualReaderOption) Reader {
 	return &manualReader{
 		temporalitySelector: cfg.temporalitySelector,
 		aggregationSelector: cfg.aggregationSelector,
+		limit:               cfg.cardinalityLimit,
+		cbTimeout:           cfg.callbackTimeout,
+		sdTimeout:           cfg.shutdownTimeout,
+		externalProducers:   cfg.producers,
+		resTransform:        cfg.resourceTransform,
 	}
 }
 
@@ -52,7 +64,7 @@ func NewManualReader(opts ...ManualReaderOption) Reader {
 // metrics on demand.
 func (mr *manualReader) register(p producer) {
 	// Only register once. If producer is already set, do nothing.
-	if !mr.producer.CompareAndSwap(nil, produceHolder{produce: p.produce}) {
+	if !mr.producer.CompareAndSwap(nil, newProduceHolder(p)) {
 		msg := "did not register manual reader"
 		global.Error(errDuplicateRegister, msg)
 	}
@@ -68,6 +80,30 @@ func (mr *manualReader) aggregation(kind view.InstrumentKind) aggregation.Aggreg
 	return mr.aggregationSelector(kind)
 }
 
+// cardinalityLimit returns the maximum number of attribute sets an instrument
+// aggregates per collection cycle.
+func (mr *manualReader) cardinalityLimit() int {
+	return mr.limit
+}
+
+// callbackTimeout returns the time each callback is given to complete during
+// a collection.
+func (mr *manualReader) callbackTimeout() time.Duration {
+	return mr.cbTimeout
+}
+
+// shutdownTimeout returns the time the reader is given to complete each of
+// its final flush and shutdown when a MeterProvider is shut down.
+func (mr *manualReader) shutdownTimeout() time.Duration {
+	return mr.sdTimeout
+}
+
+// transformResource returns the Resource the reader reports metric data with
+// in place of res.
+func (mr *manualReader) transformResource(res *resource.Resource) *resource.Resource {
+	return transformResource(mr.resTransform, res)
+}
+
 // ForceFlush is a no-op, it always returns nil.
 func (mr *manualReader) ForceFlush(context.Context) error {
 	return nil
@@ -78,39 +114,68 @@ func (mr *manualReader) Shutdown(context.Context) error {
 	err := ErrReaderShutdown
 	mr.shutdownOnce.Do(func() {
 		// Any future call to Collect will now return ErrReaderShutdown.
-		mr.producer.Store(produceHolder{
-			produce: shutdownProducer{}.produce,
-		})
+		mr.producer.Store(newProduceHolder(shutdownProducer{}))
 		err = nil
 	})
 	return err
 }
 
-// Collect gathers all metrics from the SDK, calling any callbacks necessary.
-// Collect will return an error if called after shutdown.
+// Collect gathers all metrics from the SDK, calling any callbacks necessary,
+// and from any external Producers of the reader. Collect will return an error
+// if called after shutdown.
 func (mr *manualReader) Collect(ctx context.Context) (metricdata.ResourceMetrics, error) {
+	ph, err := mr.produceHolder()
+	if err != nil {
+		return metricdata.ResourceMetrics{}, err
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := ph.produce(ctx, &rm); err != nil {
+		return metricdata.ResourceMetrics{}, err
+	}
+	return rm, produceExternal(ctx, mr.externalProducers, &rm)
+}
+
+// CollectStream gathers all metrics from the SDK, calling any callbacks
+// necessary, and from any external Producers of the reader. Instead of
+// returning them, fn is called with the metrics of each instrumentation scope
+// as they are gathered. CollectStream will return an error if called after
+// shutdown.
+func (mr *manualReader) CollectStream(ctx context.Context, fn func(*resource.Resource, metricdata.ScopeMetrics) error) error {
+	ph, err := mr.produceHolder()
+	if err != nil {
+		return err
+	}
+	return ph.produceStream(ctx, mr.externalProducers, fn)
+}
+
+// produceHolder returns the produceHolder registered with mr.
+func (mr *manualReader) produceHolder() (produceHolder, error) {
 	p := mr.producer.Load()
 	if p == nil {
-		return metricdata.ResourceMetrics{}, ErrReaderNotRegistered
+		return produceHolder{}, ErrReaderNotRegistered
 	}
 
 	ph, ok := p.(produceHolder)
 	if !ok {
-		// The atomic.Value is entirely in the periodicReader's control so
+		// The atomic.Value is entirely in the manualReader's control so
 		// this should never happen. In the unforeseen case that this does
 		// happen, return an error instead of panicking so a users code does
 		// not halt in the processes.
-		err := fmt.Errorf("manual reader: invalid producer: %T", p)
-		return metricdata.ResourceMetrics{}, err
+		return produceHolder{}, fmt.Errorf("manual reader: invalid producer: %T", p)
 	}
-
-	return ph.produce(ctx)
+	return ph, nil
 }
 
 // manualReaderConfig contains configuration options for a ManualReader.
 type manualReaderConfig struct {
 	temporalitySelector TemporalitySelector
 	aggregationSelector AggregationSelector
+	cardinalityLimit    int
+	callbackTimeout     time.Duration
+	shutdownTimeout     time.Duration
+	producers           []Producer
+	resourceTransform   func(*resource.Resource) *resource.Resource
 }
 
 // newManualReaderConfig returns a manualReaderConfig configured with options.
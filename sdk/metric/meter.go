@@ -16,6 +16,7 @@ package metric // import "go.opentelemetry.io/otel/sdk/metric"
 
 import (
 	"context"
+	"sync"
 
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/instrument"
@@ -36,13 +37,18 @@ type meter struct {
 	// *Resolvers are used by the provided instrument providers to resolve new
 	// instruments aggregators and maintain a cache across instruments this
 	// meter owns.
-	int64Resolver   resolver[int64]
-	float64Resolver resolver[float64]
+	int64Resolver   *meterResolver[int64]
+	float64Resolver *meterResolver[float64]
 
-	pipes pipelines
+	// names validates the names of the instruments the meter creates.
+	names nameValidator
+
+	mu        sync.Mutex
+	pipes     pipelines
+	callbacks []callback
 }
 
-func newMeter(s instrumentation.Scope, p pipelines) *meter {
+func newMeter(s instrumentation.Scope, p pipelines, names nameValidator) *meter {
 	// viewCache ensures instrument conflicts, including number conflicts, this
 	// meter is asked to create are logged to the user.
 	var viewCache cache[string, instrumentID]
@@ -54,10 +60,11 @@ func newMeter(s instrumentation.Scope, p pipelines) *meter {
 
 	return &meter{
 		Scope: s,
+		names: names,
 		pipes: p,
 
-		int64Resolver:   newResolver(p, ic),
-		float64Resolver: newResolver(p, fc),
+		int64Resolver:   newMeterResolver(p, ic),
+		float64Resolver: newMeterResolver(p, fc),
 	}
 }
 
@@ -66,27 +73,76 @@ var _ metric.Meter = (*meter)(nil)
 
 // AsyncInt64 returns the asynchronous integer instrument provider.
 func (m *meter) AsyncInt64() asyncint64.InstrumentProvider {
-	return asyncInt64Provider{scope: m.Scope, resolve: &m.int64Resolver}
+	return asyncInt64Provider{scope: m.Scope, resolve: m.int64Resolver, names: m.names}
 }
 
 // AsyncFloat64 returns the asynchronous floating-point instrument provider.
 func (m *meter) AsyncFloat64() asyncfloat64.InstrumentProvider {
-	return asyncFloat64Provider{scope: m.Scope, resolve: &m.float64Resolver}
+	return asyncFloat64Provider{scope: m.Scope, resolve: m.float64Resolver, names: m.names}
 }
 
 // RegisterCallback registers the function f to be called when any of the
 // insts Collect method is called.
 func (m *meter) RegisterCallback(insts []instrument.Asynchronous, f func(context.Context)) error {
-	m.pipes.registerCallback(f)
+	names := make([]string, 0, len(insts))
+	for _, inst := range insts {
+		if name := instrumentName(inst); name != "" {
+			names = append(names, name)
+		}
+	}
+	cb := callback{scope: m.Scope, instruments: names, fn: f}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.callbacks = append(m.callbacks, cb)
+	m.pipes.registerCallback(cb)
 	return nil
 }
 
+// addPipeline adds p to the pipelines m creates instruments and registers
+// callbacks with. All instruments already created and callbacks already
+// registered with m are added to p.
+//
+// Any error creating the Aggregators of an instrument for p is appended to
+// errs.
+func (m *meter) addPipeline(p *pipeline, errs *multierror) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.pipes = append(m.pipes[:len(m.pipes):len(m.pipes)], p)
+	for _, cb := range m.callbacks {
+		p.addCallback(cb)
+	}
+
+	m.int64Resolver.addPipeline(p, errs)
+	m.float64Resolver.addPipeline(p, errs)
+}
+
+// removePipeline removes p from the pipelines m creates instruments and
+// registers callbacks with. The instruments m created stop updating the
+// Aggregators p reads.
+func (m *meter) removePipeline(p *pipeline) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pipes := make(pipelines, 0, len(m.pipes))
+	for _, pipe := range m.pipes {
+		if pipe != p {
+			pipes = append(pipes, pipe)
+		}
+	}
+	m.pipes = pipes
+
+	m.int64Resolver.removePipeline(p)
+	m.float64Resolver.removePipeline(p)
+}
+
 // SyncInt64 returns the synchronous integer instrument provider.
 func (m *meter) SyncInt64() syncint64.InstrumentProvider {
-	return syncInt64Provider{scope: m.Scope, resolve: &m.int64Resolver}
+	return syncInt64Provider{scope: m.Scope, resolve: m.int64Resolver, names: m.names}
 }
 
 // SyncFloat64 returns the synchronous floating-point instrument provider.
 func (m *meter) SyncFloat64() syncfloat64.InstrumentProvider {
-	return syncFloat64Provider{scope: m.Scope, resolve: &m.float64Resolver}
+	return syncFloat64Provider{scope: m.Scope, resolve: m.float64Resolver, names: m.names}
 }
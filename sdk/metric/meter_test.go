@@ -16,25 +16,32 @@ package metric
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/unit"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"go.opentelemetry.io/otel/sdk/metric/view"
 	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // A meter should be able to make instruments concurrently.
 func TestMeterInstrumentConcurrency(t *testing.T) {
 	wg := &sync.WaitGroup{}
-	wg.Add(12)
+	wg.Add(14)
 
 	m := NewMeterProvider().Meter("inst-concurrency")
 
@@ -74,6 +81,10 @@ func TestMeterInstrumentConcurrency(t *testing.T) {
 		_, _ = m.SyncFloat64().Histogram("SFHistogram")
 		wg.Done()
 	}()
+	go func() {
+		_, _ = m.SyncFloat64().Gauge("SFGauge")
+		wg.Done()
+	}()
 	go func() {
 		_, _ = m.SyncInt64().Counter("SICounter")
 		wg.Done()
@@ -86,6 +97,10 @@ func TestMeterInstrumentConcurrency(t *testing.T) {
 		_, _ = m.SyncInt64().Histogram("SIHistogram")
 		wg.Done()
 	}()
+	go func() {
+		_, _ = m.SyncInt64().Gauge("SIGauge")
+		wg.Done()
+	}()
 
 	wg.Wait()
 }
@@ -385,6 +400,42 @@ func TestMeterCreatesInstruments(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "SyncInt64Gauge",
+			fn: func(t *testing.T, m metric.Meter) {
+				gauge, err := m.SyncInt64().Gauge("sint")
+				assert.NoError(t, err)
+
+				gauge.Record(context.Background(), 3)
+				gauge.Record(context.Background(), 11)
+			},
+			want: metricdata.Metrics{
+				Name: "sint",
+				Data: metricdata.Gauge[int64]{
+					DataPoints: []metricdata.DataPoint[int64]{
+						{Value: 11},
+					},
+				},
+			},
+		},
+		{
+			name: "SyncFloat64Gauge",
+			fn: func(t *testing.T, m metric.Meter) {
+				gauge, err := m.SyncFloat64().Gauge("sfloat")
+				assert.NoError(t, err)
+
+				gauge.Record(context.Background(), 3)
+				gauge.Record(context.Background(), 7)
+			},
+			want: metricdata.Metrics{
+				Name: "sfloat",
+				Data: metricdata.Gauge[float64]{
+					DataPoints: []metricdata.DataPoint[float64]{
+						{Value: 7},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range testCases {
@@ -474,3 +525,1006 @@ func TestMetersProvideScope(t *testing.T) {
 	assert.NoError(t, err)
 	metricdatatest.AssertEqual(t, want, got, metricdatatest.IgnoreTimestamp())
 }
+
+func TestAttributeFilter(t *testing.T) {
+	v, err := view.New(
+		view.MatchInstrumentName("sfcounter"),
+		view.WithFilterAttributes("foo"),
+	)
+	require.NoError(t, err)
+
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr, v), WithExemplarFilter(nil))
+
+	ctr, err := mp.Meter("TestAttributeFilter").SyncFloat64().Counter("sfcounter")
+	require.NoError(t, err)
+	ctr.Add(context.Background(), 1.0, attribute.String("foo", "bar"), attribute.Int("version", 1))
+	ctr.Add(context.Background(), 2.0, attribute.String("foo", "bar"), attribute.Int("version", 2))
+
+	got, err := rdr.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	require.Len(t, got.ScopeMetrics[0].Metrics, 1)
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name: "sfcounter",
+		Data: metricdata.Sum[float64]{
+			DataPoints: []metricdata.DataPoint[float64]{
+				{
+					Attributes: attribute.NewSet(attribute.String("foo", "bar")),
+					Value:      3.0,
+				},
+			},
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+		},
+	}, got.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestViewTemporality(t *testing.T) {
+	v, err := view.New(
+		view.MatchInstrumentKind(view.SyncCounter),
+		view.WithTemporality(metricdata.DeltaTemporality),
+	)
+	require.NoError(t, err)
+
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr, v), WithExemplarFilter(nil))
+	meter := mp.Meter("TestViewTemporality")
+
+	ctr, err := meter.SyncInt64().Counter("counter")
+	require.NoError(t, err)
+	hist, err := meter.SyncInt64().Histogram("histogram")
+	require.NoError(t, err)
+
+	collect := func(incr int64) {
+		ctr.Add(context.Background(), incr)
+		hist.Record(context.Background(), incr)
+
+		got, err := rdr.Collect(context.Background())
+		require.NoError(t, err)
+		require.Len(t, got.ScopeMetrics, 1)
+		metrics := got.ScopeMetrics[0].Metrics
+		require.Len(t, metrics, 2)
+
+		metricdatatest.AssertEqual(t, metricdata.Metrics{
+			Name: "counter",
+			Data: metricdata.Sum[int64]{
+				DataPoints:  []metricdata.DataPoint[int64]{{Value: incr}},
+				Temporality: metricdata.DeltaTemporality,
+				IsMonotonic: true,
+			},
+		}, metrics[0], metricdatatest.IgnoreTimestamp())
+
+		h, ok := metrics[1].Data.(metricdata.Histogram)
+		require.True(t, ok)
+		assert.Equal(t, metricdata.CumulativeTemporality, h.Temporality)
+	}
+
+	collect(1)
+	collect(2)
+
+	got, err := rdr.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	h, ok := got.ScopeMetrics[0].Metrics[1].Data.(metricdata.Histogram)
+	require.True(t, ok)
+	require.Len(t, h.DataPoints, 1)
+	assert.Equal(t, uint64(2), h.DataPoints[0].Count, "cumulative histogram")
+}
+
+func TestCallbackTimeout(t *testing.T) {
+	defer func(orig otel.ErrorHandler) {
+		otel.SetErrorHandler(orig)
+	}(otel.GetErrorHandler())
+	eh := newChErrorHandler()
+	otel.SetErrorHandler(eh)
+
+	rdr := NewManualReader(WithCallbackTimeout(10 * time.Millisecond))
+	mp := NewMeterProvider(WithReader(rdr))
+	meter := mp.Meter("TestCallbackTimeout")
+
+	hung, err := meter.AsyncInt64().Gauge("hung")
+	require.NoError(t, err)
+	unblock := make(chan struct{})
+	t.Cleanup(func() { close(unblock) })
+	err = meter.RegisterCallback([]instrument.Asynchronous{hung}, func(ctx context.Context) {
+		hung.Observe(ctx, 1)
+		<-unblock
+	})
+	require.NoError(t, err)
+
+	gauge, err := meter.AsyncInt64().Gauge("gauge")
+	require.NoError(t, err)
+	err = meter.RegisterCallback([]instrument.Asynchronous{gauge}, func(ctx context.Context) {
+		gauge.Observe(ctx, 2)
+	})
+	require.NoError(t, err)
+
+	got, err := rdr.Collect(context.Background())
+	require.NoError(t, err)
+
+	select {
+	case err := <-eh.Err:
+		assert.ErrorIs(t, err, errCallbackTimeout)
+		assert.ErrorContains(t, err, `"TestCallbackTimeout"`)
+		assert.ErrorContains(t, err, `"hung"`)
+	default:
+		t.Error("callback timeout not reported")
+	}
+
+	// The observation of the hung callback is dropped.
+	want := metricdata.ScopeMetrics{
+		Scope: instrumentation.Scope{Name: "TestCallbackTimeout"},
+		Metrics: []metricdata.Metrics{
+			{
+				Name: "hung",
+				Data: metricdata.Gauge[int64]{},
+			},
+			{
+				Name: "gauge",
+				Data: metricdata.Gauge[int64]{
+					DataPoints: []metricdata.DataPoint[int64]{{Value: 2}},
+				},
+			},
+		},
+	}
+	require.Len(t, got.ScopeMetrics, 1)
+	metricdatatest.AssertEqual(t, want, got.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestSelfObservability(t *testing.T) {
+	defer func(orig otel.ErrorHandler) {
+		otel.SetErrorHandler(orig)
+	}(otel.GetErrorHandler())
+	// Each collection times out the hung callback.
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(error) {}))
+
+	rdr := NewManualReader(WithCardinalityLimit(2), WithCallbackTimeout(10*time.Millisecond))
+	mp := NewMeterProvider(WithReader(rdr), WithSelfObservability())
+	meter := mp.Meter("TestSelfObservability")
+
+	limited, err := meter.SyncInt64().Counter("limited")
+	require.NoError(t, err)
+	for _, user := range []string{"alice", "bob", "carol"} {
+		limited.Add(context.Background(), 1, attribute.String("user", user))
+	}
+
+	hung, err := meter.AsyncInt64().Gauge("hung")
+	require.NoError(t, err)
+	unblock := make(chan struct{})
+	t.Cleanup(func() { close(unblock) })
+	err = meter.RegisterCallback([]instrument.Asynchronous{hung}, func(ctx context.Context) {
+		<-unblock
+	})
+	require.NoError(t, err)
+
+	scopeMetrics := func() map[string]metricdata.Metrics {
+		rm, err := rdr.Collect(context.Background())
+		require.NoError(t, err)
+		out := map[string]metricdata.Metrics{}
+		for _, sm := range rm.ScopeMetrics {
+			if sm.Scope.Name != observabilityScope {
+				continue
+			}
+			for _, m := range sm.Metrics {
+				out[m.Name] = m
+			}
+		}
+		return out
+	}
+
+	got := scopeMetrics()
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        "otel.sdk.metrics.measurements.dropped",
+		Description: "The number of measurements aggregated with the overflow attribute set because an instrument reached its cardinality limit",
+		Unit:        unit.Dimensionless,
+		Data: metricdata.Sum[int64]{
+			DataPoints: []metricdata.DataPoint[int64]{{
+				Attributes: attribute.NewSet(instrumentKey.String("limited")),
+				Value:      2,
+			}},
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+		},
+	}, got["otel.sdk.metrics.measurements.dropped"], metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreExemplars())
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        "otel.sdk.metrics.callback.errors",
+		Description: "The number of callbacks that failed during a collection",
+		Unit:        unit.Dimensionless,
+		Data: metricdata.Sum[int64]{
+			DataPoints:  []metricdata.DataPoint[int64]{{Value: 1}},
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+		},
+	}, got["otel.sdk.metrics.callback.errors"], metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreExemplars())
+
+	// The first collection is recorded once it completes.
+	got = scopeMetrics()
+	require.Contains(t, got, "otel.sdk.metrics.collect.duration")
+	duration, ok := got["otel.sdk.metrics.collect.duration"].Data.(metricdata.Histogram)
+	require.True(t, ok)
+	require.Len(t, duration.DataPoints, 1)
+	assert.Equal(t, uint64(1), duration.DataPoints[0].Count)
+
+	require.Contains(t, got, "otel.sdk.metrics.collect.batch_size")
+	batch, ok := got["otel.sdk.metrics.collect.batch_size"].Data.(metricdata.Histogram)
+	require.True(t, ok)
+	require.Len(t, batch.DataPoints, 1)
+	assert.Equal(t, uint64(1), batch.DataPoints[0].Count)
+	// limited has two data points, alice and the overflow set, and each
+	// counter of the first collection has one.
+	assert.Equal(t, float64(4), batch.DataPoints[0].Sum)
+}
+
+func TestSumShards(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr), WithSumShards(4))
+	ctr, err := mp.Meter("TestSumShards").SyncInt64().Counter("counter")
+	require.NoError(t, err)
+
+	const goroutines, adds = 8, 100
+	var wg sync.WaitGroup
+	wg.Add(goroutines)
+	for i := 0; i < goroutines; i++ {
+		go func() {
+			defer wg.Done()
+			for j := 0; j < adds; j++ {
+				ctr.Add(context.Background(), 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	got, err := rdr.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	require.Len(t, got.ScopeMetrics[0].Metrics, 1)
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name: "counter",
+		Data: metricdata.Sum[int64]{
+			DataPoints:  []metricdata.DataPoint[int64]{{Value: goroutines * adds}},
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+		},
+	}, got.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreExemplars())
+}
+
+func TestProviderAttributeFilter(t *testing.T) {
+	// The view keeps the user_id attribute, but the MeterProvider filter is
+	// applied first and removes it.
+	v, err := view.New(
+		view.MatchInstrumentName("filtered"),
+		view.WithFilterAttributes("user_id", "region"),
+	)
+	require.NoError(t, err)
+
+	rdr := NewManualReader()
+	mp := NewMeterProvider(
+		WithReader(rdr, v),
+		WithAttributeFilter(func(kv attribute.KeyValue) bool {
+			return kv.Key != "user_id"
+		}),
+	)
+	meter := mp.Meter("TestProviderAttributeFilter")
+
+	attrs := []attribute.KeyValue{
+		attribute.String("user_id", "alice"),
+		attribute.String("region", "us"),
+		attribute.String("host", "a"),
+	}
+	filtered, err := meter.SyncInt64().Counter("filtered")
+	require.NoError(t, err)
+	filtered.Add(context.Background(), 1, attrs...)
+	ctr, err := meter.SyncInt64().Counter("counter")
+	require.NoError(t, err)
+	ctr.Add(context.Background(), 1, attrs...)
+	gauge, err := meter.AsyncInt64().Gauge("gauge")
+	require.NoError(t, err)
+	err = meter.RegisterCallback([]instrument.Asynchronous{gauge}, func(ctx context.Context) {
+		gauge.Observe(ctx, 1, attrs...)
+	})
+	require.NoError(t, err)
+
+	got, err := rdr.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	require.Len(t, got.ScopeMetrics[0].Metrics, 3)
+
+	region := attribute.NewSet(attribute.String("region", "us"))
+	regionHost := attribute.NewSet(attribute.String("region", "us"), attribute.String("host", "a"))
+	want := []metricdata.Metrics{
+		{
+			Name: "filtered",
+			Data: metricdata.Sum[int64]{
+				DataPoints:  []metricdata.DataPoint[int64]{{Attributes: region, Value: 1}},
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+			},
+		},
+		{
+			Name: "counter",
+			Data: metricdata.Sum[int64]{
+				DataPoints:  []metricdata.DataPoint[int64]{{Attributes: regionHost, Value: 1}},
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+			},
+		},
+		{
+			Name: "gauge",
+			Data: metricdata.Gauge[int64]{
+				DataPoints: []metricdata.DataPoint[int64]{{Attributes: regionHost, Value: 1}},
+			},
+		},
+	}
+	for i, m := range got.ScopeMetrics[0].Metrics {
+		metricdatatest.AssertEqual(t, want[i], m, metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreExemplars())
+	}
+}
+
+func TestViewAttributeProcessor(t *testing.T) {
+	// The processor drops health checks and rewrites user paths before the
+	// view attribute filter is applied.
+	v, err := view.New(
+		view.MatchInstrumentName("requests"),
+		view.WithAttributeProcessor(func(set attribute.Set) (attribute.Set, bool) {
+			route, _ := set.Value("http.route")
+			switch route.AsString() {
+			case "/healthz":
+				return set, false
+			case "/users/alice", "/users/bob":
+				return attribute.NewSet(
+					attribute.String("http.route", "/users/{id}"),
+					attribute.String("host", "a"),
+				), true
+			}
+			return set, true
+		}),
+		view.WithFilterAttributes("http.route"),
+	)
+	require.NoError(t, err)
+
+	rdr := NewManualReader()
+	meter := NewMeterProvider(WithReader(rdr, v)).Meter("TestViewAttributeProcessor")
+	ctr, err := meter.SyncInt64().Counter("requests")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for _, route := range []string{"/healthz", "/users/alice", "/users/bob", "/", "/healthz"} {
+		ctr.Add(ctx, 1, attribute.String("http.route", route), attribute.String("host", "b"))
+	}
+
+	got, err := rdr.Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	require.Len(t, got.ScopeMetrics[0].Metrics, 1)
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name: "requests",
+		Data: metricdata.Sum[int64]{
+			DataPoints: []metricdata.DataPoint[int64]{
+				{Attributes: attribute.NewSet(attribute.String("http.route", "/users/{id}")), Value: 2},
+				{Attributes: attribute.NewSet(attribute.String("http.route", "/")), Value: 1},
+			},
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+		},
+	}, got.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreExemplars())
+}
+
+func TestSyncInstrumentEnabled(t *testing.T) {
+	ctx := context.Background()
+	dropView, err := view.New(
+		view.MatchInstrumentName("dropped"),
+		view.WithSetAggregation(aggregation.Drop{}),
+	)
+	require.NoError(t, err)
+
+	mp := NewMeterProvider()
+	meter := mp.Meter("TestSyncInstrumentEnabled")
+	ctr, err := meter.SyncInt64().Counter("counter")
+	require.NoError(t, err)
+	hist, err := meter.SyncFloat64().Histogram("histogram")
+	require.NoError(t, err)
+	dropped, err := meter.SyncInt64().Counter("dropped")
+	require.NoError(t, err)
+
+	assert.False(t, ctr.Enabled(ctx), "enabled without a Reader")
+	assert.False(t, hist.Enabled(ctx), "enabled without a Reader")
+	assert.False(t, dropped.Enabled(ctx), "enabled without a Reader")
+
+	rdr := NewManualReader()
+	require.NoError(t, mp.RegisterReader(rdr, dropView))
+	assert.True(t, ctr.Enabled(ctx), "not enabled with a Reader")
+	assert.True(t, hist.Enabled(ctx), "not enabled with a Reader")
+	assert.False(t, dropped.Enabled(ctx), "enabled when dropped by a view")
+
+	require.NoError(t, mp.UnregisterReader(rdr))
+	assert.False(t, ctr.Enabled(ctx), "enabled after Reader unregistered")
+	assert.False(t, hist.Enabled(ctx), "enabled after Reader unregistered")
+}
+
+func TestBoundCounter(t *testing.T) {
+	attrs := []attribute.KeyValue{attribute.String("user", "alice")}
+	rdr := NewManualReader()
+	meter := NewMeterProvider(WithReader(rdr)).Meter("TestBoundCounter")
+
+	iCtr, err := meter.SyncInt64().Counter("int64")
+	require.NoError(t, err)
+	fCtr, err := meter.SyncFloat64().Counter("float64")
+	require.NoError(t, err)
+
+	iBound := iCtr.Bind(attrs...)
+	fBound := fCtr.Bind(attrs...)
+	// Modifying attrs after the bind should not change the bound attributes.
+	attrs[0] = attribute.String("user", "bob")
+
+	iBound.Add(context.Background(), 2)
+	fBound.Add(context.Background(), 2)
+	// Bound and unbound values with the same attributes are aggregated
+	// together.
+	iCtr.Add(context.Background(), 1, attribute.String("user", "alice"))
+	fCtr.Add(context.Background(), 1, attribute.String("user", "alice"))
+
+	// Values recorded with a canceled context are dropped.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	iBound.Add(ctx, 10)
+	fBound.Add(ctx, 10)
+
+	got, err := rdr.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	require.Len(t, got.ScopeMetrics[0].Metrics, 2)
+
+	alice := attribute.NewSet(attribute.String("user", "alice"))
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name: "int64",
+		Data: metricdata.Sum[int64]{
+			DataPoints:  []metricdata.DataPoint[int64]{{Attributes: alice, Value: 3}},
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+		},
+	}, got.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreExemplars())
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name: "float64",
+		Data: metricdata.Sum[float64]{
+			DataPoints:  []metricdata.DataPoint[float64]{{Attributes: alice, Value: 3}},
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+		},
+	}, got.ScopeMetrics[0].Metrics[1], metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreExemplars())
+}
+
+func TestBoundCounterReaderRegistered(t *testing.T) {
+	attr := attribute.String("user", "alice")
+	rdr0 := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr0))
+	ctr, err := mp.Meter("TestBoundCounterReaderRegistered").SyncInt64().Counter("int64")
+	require.NoError(t, err)
+
+	bound := ctr.Bind(attr)
+	bound.Add(context.Background(), 1)
+
+	// The bound counter aggregates into the Aggregators of Readers
+	// registered after it was bound.
+	rdr1 := NewManualReader()
+	require.NoError(t, mp.RegisterReader(rdr1))
+	bound.Add(context.Background(), 2)
+
+	want := func(v int64) metricdata.Metrics {
+		return metricdata.Metrics{
+			Name: "int64",
+			Data: metricdata.Sum[int64]{
+				DataPoints:  []metricdata.DataPoint[int64]{{Attributes: attribute.NewSet(attr), Value: v}},
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+			},
+		}
+	}
+	for rdr, v := range map[Reader]int64{rdr0: 3, rdr1: 2} {
+		got, err := rdr.Collect(context.Background())
+		require.NoError(t, err)
+		require.Len(t, got.ScopeMetrics, 1)
+		require.Len(t, got.ScopeMetrics[0].Metrics, 1)
+		metricdatatest.AssertEqual(t, want(v), got.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreExemplars())
+	}
+
+	// The Aggregators of unregistered Readers are no longer updated.
+	require.NoError(t, mp.UnregisterReader(rdr0))
+	bound.Add(context.Background(), 4)
+	got, err := rdr1.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	metricdatatest.AssertEqual(t, want(6), got.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreExemplars())
+}
+
+func TestCardinalityLimit(t *testing.T) {
+	overflow := attribute.NewSet(attribute.Bool("otel.metric.overflow", true))
+	user := func(name string) attribute.KeyValue { return attribute.String("user", name) }
+
+	v, err := view.New(
+		view.MatchInstrumentName("limited"),
+		view.WithCardinalityLimit(2),
+	)
+	require.NoError(t, err)
+
+	rdr := NewManualReader(WithCardinalityLimit(3))
+	mp := NewMeterProvider(WithReader(rdr, v))
+	meter := mp.Meter("TestCardinalityLimit")
+
+	ctr, err := meter.SyncInt64().Counter("counter")
+	require.NoError(t, err)
+	limited, err := meter.SyncInt64().Counter("limited")
+	require.NoError(t, err)
+	for _, name := range []string{"alice", "bob", "carol", "alice"} {
+		ctr.Add(context.Background(), 1, user(name))
+		limited.Add(context.Background(), 1, user(name))
+	}
+
+	got, err := rdr.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	require.Len(t, got.ScopeMetrics[0].Metrics, 2)
+
+	want := []metricdata.Metrics{
+		{
+			Name: "counter",
+			Data: metricdata.Sum[int64]{
+				DataPoints: []metricdata.DataPoint[int64]{
+					{Attributes: attribute.NewSet(user("alice")), Value: 2},
+					{Attributes: attribute.NewSet(user("bob")), Value: 1},
+					{Attributes: overflow, Value: 1},
+				},
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+			},
+		},
+		{
+			Name: "limited",
+			Data: metricdata.Sum[int64]{
+				DataPoints: []metricdata.DataPoint[int64]{
+					{Attributes: attribute.NewSet(user("alice")), Value: 2},
+					{Attributes: overflow, Value: 2},
+				},
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+			},
+		},
+	}
+	for i, m := range got.ScopeMetrics[0].Metrics {
+		metricdatatest.AssertEqual(t, want[i], m, metricdatatest.IgnoreTimestamp())
+	}
+}
+
+func TestExplicitBucketBoundariesAdvice(t *testing.T) {
+	advice := instrument.WithExplicitBucketBoundaries(1, 2, 3)
+	defaultBounds := DefaultAggregationSelector(view.SyncHistogram).(aggregation.ExplicitBucketHistogram).Boundaries
+
+	testcases := []struct {
+		name       string
+		views      []view.View
+		opts       []instrument.Option
+		wantBounds []float64
+	}{
+		{
+			name:       "NoAdvice",
+			wantBounds: defaultBounds,
+		},
+		{
+			name:       "Advice",
+			opts:       []instrument.Option{advice},
+			wantBounds: []float64{1, 2, 3},
+		},
+		{
+			name: "ViewOverride",
+			views: []view.View{func() view.View {
+				v, err := view.New(
+					view.MatchInstrumentName("histogram"),
+					view.WithSetAggregation(aggregation.ExplicitBucketHistogram{Boundaries: []float64{10}}),
+				)
+				require.NoError(t, err)
+				return v
+			}()},
+			opts:       []instrument.Option{advice},
+			wantBounds: []float64{10},
+		},
+		{
+			name:       "InvalidAdvice",
+			opts:       []instrument.Option{instrument.WithExplicitBucketBoundaries(3, 2, 1)},
+			wantBounds: defaultBounds,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			rdr := NewManualReader()
+			mp := NewMeterProvider(WithReader(rdr, tc.views...), WithExemplarFilter(nil))
+			hist, err := mp.Meter("TestExplicitBucketBoundariesAdvice").SyncFloat64().Histogram("histogram", tc.opts...)
+			require.NoError(t, err)
+			hist.Record(context.Background(), 1)
+
+			got, err := rdr.Collect(context.Background())
+			require.NoError(t, err)
+			require.Len(t, got.ScopeMetrics, 1)
+			require.Len(t, got.ScopeMetrics[0].Metrics, 1)
+			h, ok := got.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram)
+			require.True(t, ok)
+			require.Len(t, h.DataPoints, 1)
+			assert.Equal(t, tc.wantBounds, h.DataPoints[0].Bounds)
+		})
+	}
+}
+
+func TestExemplars(t *testing.T) {
+	traceID, spanID := trace.TraceID{0x01}, trace.SpanID{0x01}
+	sampled := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+	notSampled := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x02},
+		SpanID:  trace.SpanID{0x02},
+	}))
+
+	v, err := view.New(
+		view.MatchInstrumentName("*"),
+		view.WithFilterAttributes("foo"),
+	)
+	require.NoError(t, err)
+
+	measure := func(t *testing.T, mp *MeterProvider) {
+		m := mp.Meter("TestExemplars")
+		ctr, err := m.SyncInt64().Counter("counter")
+		require.NoError(t, err)
+		ctr.Add(sampled, 1, attribute.String("foo", "bar"), attribute.Int("version", 1))
+		ctr.Add(notSampled, 1, attribute.String("foo", "bar"), attribute.Int("version", 2))
+
+		hist, err := m.SyncFloat64().Histogram("histogram")
+		require.NoError(t, err)
+		hist.Record(sampled, 1, attribute.String("foo", "bar"), attribute.Int("version", 1))
+		hist.Record(notSampled, 1000, attribute.String("foo", "bar"), attribute.Int("version", 2))
+	}
+
+	exemplars := func(t *testing.T, rdr Reader) (sum []metricdata.Exemplar[int64], hist []metricdata.Exemplar[float64]) {
+		got, err := rdr.Collect(context.Background())
+		require.NoError(t, err)
+		require.Len(t, got.ScopeMetrics, 1)
+		for _, m := range got.ScopeMetrics[0].Metrics {
+			switch a := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				require.Len(t, a.DataPoints, 1)
+				sum = a.DataPoints[0].Exemplars
+			case metricdata.Histogram:
+				require.Len(t, a.DataPoints, 1)
+				hist = a.DataPoints[0].Exemplars
+			}
+		}
+		return sum, hist
+	}
+
+	t.Run("TraceBased", func(t *testing.T) {
+		rdr := NewManualReader()
+		measure(t, NewMeterProvider(WithReader(rdr, v), WithExemplarFilter(TraceBasedExemplarFilter)))
+
+		sum, hist := exemplars(t, rdr)
+		require.Len(t, sum, 1)
+		metricdatatest.AssertEqual(t, metricdata.Exemplar[int64]{
+			FilteredAttributes: []attribute.KeyValue{attribute.Int("version", 1)},
+			Value:              1,
+			SpanID:             spanID[:],
+			TraceID:            traceID[:],
+		}, sum[0], metricdatatest.IgnoreTimestamp())
+
+		require.Len(t, hist, 1)
+		metricdatatest.AssertEqual(t, metricdata.Exemplar[float64]{
+			FilteredAttributes: []attribute.KeyValue{attribute.Int("version", 1)},
+			Value:              1,
+			SpanID:             spanID[:],
+			TraceID:            traceID[:],
+		}, hist[0], metricdatatest.IgnoreTimestamp())
+	})
+
+	t.Run("AlwaysOn", func(t *testing.T) {
+		rdr := NewManualReader()
+		measure(t, NewMeterProvider(WithReader(rdr, v), WithExemplarFilter(AlwaysOnExemplarFilter)))
+
+		sum, hist := exemplars(t, rdr)
+		assert.Len(t, sum, 1, "default sum reservoir size")
+		assert.Len(t, hist, 2, "one exemplar per histogram bucket")
+	})
+
+	t.Run("AlwaysOff", func(t *testing.T) {
+		rdr := NewManualReader()
+		measure(t, NewMeterProvider(WithReader(rdr, v), WithExemplarFilter(AlwaysOffExemplarFilter)))
+
+		sum, hist := exemplars(t, rdr)
+		assert.Len(t, sum, 0)
+		assert.Len(t, hist, 0)
+	})
+
+	t.Run("ReservoirSize", func(t *testing.T) {
+		v, err := view.New(
+			view.MatchInstrumentName("*"),
+			view.WithExemplarReservoirSize(4),
+		)
+		require.NoError(t, err)
+
+		rdr := NewManualReader()
+		mp := NewMeterProvider(WithReader(rdr, v), WithExemplarFilter(AlwaysOnExemplarFilter))
+		ctr, err := mp.Meter("TestExemplars").SyncInt64().Counter("counter")
+		require.NoError(t, err)
+		for i := 0; i < 10; i++ {
+			ctr.Add(context.Background(), 1)
+		}
+
+		sum, _ := exemplars(t, rdr)
+		assert.Len(t, sum, 4)
+	})
+}
+
+func TestExponentialHistogram(t *testing.T) {
+	v, err := view.New(
+		view.MatchInstrumentName("histogram"),
+		view.WithSetAggregation(aggregation.ExponentialBucketHistogram{MaxSize: 4, MaxScale: 20}),
+	)
+	require.NoError(t, err)
+
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr, v), WithExemplarFilter(nil))
+	hist, err := mp.Meter("TestExponentialHistogram").SyncFloat64().Histogram("histogram")
+	require.NoError(t, err)
+	for _, v := range []float64{4, 4, 4, 2, 16, 1, 0} {
+		hist.Record(context.Background(), v)
+	}
+
+	got, err := rdr.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	require.Len(t, got.ScopeMetrics[0].Metrics, 1)
+
+	min, max := 0.0, 16.0
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name: "histogram",
+		Data: metricdata.ExponentialHistogram{
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints: []metricdata.ExponentialHistogramDataPoint{{
+				Count:          7,
+				Min:            &min,
+				Max:            &max,
+				Sum:            31,
+				Scale:          -1,
+				ZeroCount:      1,
+				PositiveBucket: metricdata.ExponentialBucket{Offset: -1, Counts: []uint64{1, 4, 1}},
+			}},
+		},
+	}, got.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestViewRecordMinMax(t *testing.T) {
+	testCases := []struct {
+		name    string
+		opts    []view.Option
+		wantMin bool
+	}{
+		{
+			name:    "Default",
+			wantMin: true,
+		},
+		{
+			name:    "Disabled",
+			opts:    []view.Option{view.WithRecordMinMax(false)},
+			wantMin: false,
+		},
+		{
+			name: "Enabled",
+			opts: []view.Option{
+				view.WithSetAggregation(aggregation.ExplicitBucketHistogram{
+					Boundaries: []float64{0, 5, 10},
+					NoMinMax:   true,
+				}),
+				view.WithRecordMinMax(true),
+			},
+			wantMin: true,
+		},
+		{
+			name: "ExponentialDisabled",
+			opts: []view.Option{
+				view.WithSetAggregation(aggregation.ExponentialBucketHistogram{MaxSize: 4, MaxScale: 20}),
+				view.WithRecordMinMax(false),
+			},
+			wantMin: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, err := view.New(append([]view.Option{view.MatchInstrumentName("histogram")}, tc.opts...)...)
+			require.NoError(t, err)
+
+			rdr := NewManualReader()
+			mp := NewMeterProvider(WithReader(rdr, v))
+			hist, err := mp.Meter("TestViewRecordMinMax").SyncFloat64().Histogram("histogram")
+			require.NoError(t, err)
+			hist.Record(context.Background(), 3)
+
+			got, err := rdr.Collect(context.Background())
+			require.NoError(t, err)
+			require.Len(t, got.ScopeMetrics, 1)
+			require.Len(t, got.ScopeMetrics[0].Metrics, 1)
+
+			var min, max *float64
+			switch data := got.ScopeMetrics[0].Metrics[0].Data.(type) {
+			case metricdata.Histogram:
+				require.Len(t, data.DataPoints, 1)
+				min, max = data.DataPoints[0].Min, data.DataPoints[0].Max
+			case metricdata.ExponentialHistogram:
+				require.Len(t, data.DataPoints, 1)
+				min, max = data.DataPoints[0].Min, data.DataPoints[0].Max
+			default:
+				t.Fatalf("unexpected data type: %T", data)
+			}
+
+			if !tc.wantMin {
+				assert.Nil(t, min, "min recorded")
+				assert.Nil(t, max, "max recorded")
+				return
+			}
+			require.NotNil(t, min, "min not recorded")
+			require.NotNil(t, max, "max not recorded")
+			assert.Equal(t, 3.0, *min)
+			assert.Equal(t, 3.0, *max)
+		})
+	}
+}
+
+func TestSummary(t *testing.T) {
+	v, err := view.New(
+		view.MatchInstrumentName("histogram"),
+		view.WithSetAggregation(aggregation.Summary{Quantiles: []float64{0, 0.5, 1}}),
+	)
+	require.NoError(t, err)
+
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr, v), WithExemplarFilter(nil))
+	hist, err := mp.Meter("TestSummary").SyncFloat64().Histogram("histogram")
+	require.NoError(t, err)
+	for _, v := range []float64{4, 2, 16, 1, 8} {
+		hist.Record(context.Background(), v)
+	}
+
+	got, err := rdr.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	require.Len(t, got.ScopeMetrics[0].Metrics, 1)
+
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name: "histogram",
+		Data: metricdata.Summary{
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints: []metricdata.SummaryDataPoint{{
+				Count: 5,
+				Sum:   31,
+				QuantileValues: []metricdata.QuantileValue{
+					{Quantile: 0, Value: 1},
+					{Quantile: 0.5, Value: 4},
+					{Quantile: 1, Value: 16},
+				},
+			}},
+		},
+	}, got.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestObservationTime(t *testing.T) {
+	observed := time.Unix(1666000000, 0)
+
+	rdr := NewManualReader()
+	meter := NewMeterProvider(WithReader(rdr)).Meter("TestObservationTime")
+	ctr, err := meter.AsyncInt64().Counter("counter")
+	require.NoError(t, err)
+	gauge, err := meter.AsyncFloat64().Gauge("gauge")
+	require.NoError(t, err)
+	err = meter.RegisterCallback([]instrument.Asynchronous{ctr, gauge}, func(ctx context.Context) {
+		ctx = instrument.ContextWithObservationTime(ctx, observed)
+		ctr.Observe(ctx, 3)
+		gauge.Observe(ctx, 1)
+	})
+	require.NoError(t, err)
+
+	got, err := rdr.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	require.Len(t, got.ScopeMetrics[0].Metrics, 2)
+
+	sum, ok := got.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+	require.Truef(t, ok, "wrong data type: %T", got.ScopeMetrics[0].Metrics[0].Data)
+	require.Len(t, sum.DataPoints, 1)
+	assert.Equal(t, observed, sum.DataPoints[0].Time, "counter")
+
+	g, ok := got.ScopeMetrics[0].Metrics[1].Data.(metricdata.Gauge[float64])
+	require.Truef(t, ok, "wrong data type: %T", got.ScopeMetrics[0].Metrics[1].Data)
+	require.Len(t, g.DataPoints, 1)
+	assert.Equal(t, observed, g.DataPoints[0].Time, "gauge")
+}
+
+func TestInstrumentNameValidation(t *testing.T) {
+	testCases := []struct {
+		name      string
+		sanitized string
+	}{
+		{name: "valid.name_1-2", sanitized: "valid.name_1-2"},
+		{name: "1leading.digit", sanitized: "m_1leading.digit"},
+		{name: "_leading.underscore", sanitized: "m__leading.underscore"},
+		{name: "statsd:name with spaces", sanitized: "statsd_name_with_spaces"},
+		{name: "utf8.ñ", sanitized: "utf8.__"},
+		{name: strings.Repeat("a", 64), sanitized: strings.Repeat("a", 63)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			valid := tc.name == tc.sanitized
+
+			rdr := NewManualReader()
+			meter := NewMeterProvider(WithReader(rdr)).Meter("TestInstrumentNameValidation")
+			ctr, err := meter.SyncInt64().Counter(tc.name)
+			if valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInstrumentName)
+			}
+			require.NotNil(t, ctr, "instrument not returned with error")
+			ctr.Add(context.Background(), 1)
+
+			got, err := rdr.Collect(context.Background())
+			require.NoError(t, err)
+			require.Len(t, got.ScopeMetrics, 1)
+			require.Len(t, got.ScopeMetrics[0].Metrics, 1)
+			assert.Equal(t, tc.name, got.ScopeMetrics[0].Metrics[0].Name)
+
+			rdr = NewManualReader()
+			meter = NewMeterProvider(WithReader(rdr), WithRelaxedInstrumentNames()).
+				Meter("TestInstrumentNameValidation")
+			ctr, err = meter.SyncInt64().Counter(tc.name)
+			assert.NoError(t, err)
+			ctr.Add(context.Background(), 1)
+
+			got, err = rdr.Collect(context.Background())
+			require.NoError(t, err)
+			require.Len(t, got.ScopeMetrics, 1)
+			require.Len(t, got.ScopeMetrics[0].Metrics, 1)
+			assert.Equal(t, tc.sanitized, got.ScopeMetrics[0].Metrics[0].Name)
+		})
+	}
+}
+
+func TestRelaxedInstrumentNamesRejectsEmptyName(t *testing.T) {
+	meter := NewMeterProvider(WithRelaxedInstrumentNames()).Meter("TestRelaxedInstrumentNames")
+	_, err := meter.AsyncFloat64().Gauge("")
+	assert.ErrorIs(t, err, ErrInstrumentName)
+}
+
+func BenchmarkCounterAdd(b *testing.B) {
+	attrs := []attribute.KeyValue{
+		attribute.String("user", "alice"),
+		attribute.Bool("admin", true),
+		attribute.Int("group", 7),
+	}
+	ctr, err := NewMeterProvider(WithReader(NewManualReader())).
+		Meter("BenchmarkCounterAdd").SyncInt64().Counter("counter")
+	require.NoError(b, err)
+	ctx := context.Background()
+
+	b.Run("Unbound", func(b *testing.B) {
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			ctr.Add(ctx, 1, attrs...)
+		}
+	})
+
+	b.Run("Bound", func(b *testing.B) {
+		bound := ctr.Bind(attrs...)
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			bound.Add(ctx, 1)
+		}
+	})
+}
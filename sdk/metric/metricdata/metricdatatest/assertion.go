@@ -27,6 +27,10 @@ import (
 type Datatypes interface {
 	metricdata.DataPoint[float64] |
 		metricdata.DataPoint[int64] |
+		metricdata.Exemplar[float64] |
+		metricdata.Exemplar[int64] |
+		metricdata.ExponentialHistogram |
+		metricdata.ExponentialHistogramDataPoint |
 		metricdata.Gauge[float64] |
 		metricdata.Gauge[int64] |
 		metricdata.Histogram |
@@ -35,7 +39,9 @@ type Datatypes interface {
 		metricdata.ResourceMetrics |
 		metricdata.ScopeMetrics |
 		metricdata.Sum[float64] |
-		metricdata.Sum[int64]
+		metricdata.Sum[int64] |
+		metricdata.Summary |
+		metricdata.SummaryDataPoint
 
 	// Interface types are not allowed in union types, therefore the
 	// Aggregation and Value type from metricdata are not included here.
@@ -43,6 +49,8 @@ type Datatypes interface {
 
 type config struct {
 	ignoreTimestamp bool
+	ignoreExemplars bool
+	floatTolerance  float64
 }
 
 // Option allows for fine grain control over how AssertEqual operates.
@@ -64,6 +72,24 @@ func IgnoreTimestamp() Option {
 	})
 }
 
+// IgnoreExemplars disables checking if Exemplars are different.
+func IgnoreExemplars() Option {
+	return fnOption(func(cfg config) config {
+		cfg.ignoreExemplars = true
+		return cfg
+	})
+}
+
+// FloatTolerance sets the maximum difference of float64 values, e.g. the
+// value of a DataPoint[float64] or the sum of a HistogramDataPoint, for them
+// to be considered equal.
+func FloatTolerance(tolerance float64) Option {
+	return fnOption(func(cfg config) config {
+		cfg.floatTolerance = tolerance
+		return cfg
+	})
+}
+
 // AssertEqual asserts that the two concrete data-types from the metricdata
 // package are equal.
 func AssertEqual[T Datatypes](t *testing.T, expected, actual T, opts ...Option) bool {
@@ -83,6 +109,14 @@ func AssertEqual[T Datatypes](t *testing.T, expected, actual T, opts ...Option)
 		r = equalDataPoints(e, aIface.(metricdata.DataPoint[int64]), cfg)
 	case metricdata.DataPoint[float64]:
 		r = equalDataPoints(e, aIface.(metricdata.DataPoint[float64]), cfg)
+	case metricdata.Exemplar[int64]:
+		r = equalExemplars(e, aIface.(metricdata.Exemplar[int64]), cfg)
+	case metricdata.Exemplar[float64]:
+		r = equalExemplars(e, aIface.(metricdata.Exemplar[float64]), cfg)
+	case metricdata.ExponentialHistogram:
+		r = equalExponentialHistograms(e, aIface.(metricdata.ExponentialHistogram), cfg)
+	case metricdata.ExponentialHistogramDataPoint:
+		r = equalExponentialHistogramDataPoints(e, aIface.(metricdata.ExponentialHistogramDataPoint), cfg)
 	case metricdata.Gauge[int64]:
 		r = equalGauges(e, aIface.(metricdata.Gauge[int64]), cfg)
 	case metricdata.Gauge[float64]:
@@ -101,6 +135,10 @@ func AssertEqual[T Datatypes](t *testing.T, expected, actual T, opts ...Option)
 		r = equalSums(e, aIface.(metricdata.Sum[int64]), cfg)
 	case metricdata.Sum[float64]:
 		r = equalSums(e, aIface.(metricdata.Sum[float64]), cfg)
+	case metricdata.Summary:
+		r = equalSummaries(e, aIface.(metricdata.Summary), cfg)
+	case metricdata.SummaryDataPoint:
+		r = equalSummaryDataPoints(e, aIface.(metricdata.SummaryDataPoint), cfg)
 	default:
 		// We control all types passed to this, panic to signal developers
 		// early they changed things in an incompatible way.
@@ -129,3 +167,47 @@ func AssertAggregationsEqual(t *testing.T, expected, actual metricdata.Aggregati
 	}
 	return true
 }
+
+// AssertContainsMetric asserts that actual contains a Metrics matching
+// expected, in any of its ScopeMetrics. Other Metrics of actual are ignored.
+//
+// A Metrics matches expected if it has the same Name and, if they are set in
+// expected, the same Description, Unit, and Data. The Data of expected
+// matches if it is the same type of Aggregation with the same temporality
+// and monotonicity, and all its data points are equal to a data point of
+// the Metrics. Additional data points are ignored. This means expected only
+// needs to contain the data points, identified by their attributes, a test
+// is interested in.
+func AssertContainsMetric(t *testing.T, expected metricdata.Metrics, actual metricdata.ResourceMetrics, opts ...Option) bool {
+	t.Helper()
+
+	cfg := config{}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+
+	if r := containsMetric(expected, actual, cfg); len(r) > 0 {
+		t.Error(r)
+		return false
+	}
+	return true
+}
+
+// AssertContainsDataPoints asserts that actual is the same type of
+// Aggregation as expected, with the same temporality and monotonicity, and
+// that each data point of expected is equal to a data point of actual.
+// Additional data points of actual are ignored.
+func AssertContainsDataPoints(t *testing.T, expected, actual metricdata.Aggregation, opts ...Option) bool {
+	t.Helper()
+
+	cfg := config{}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+
+	if r := containsDataPoints(expected, actual, cfg); len(r) > 0 {
+		t.Error(r)
+		return false
+	}
+	return true
+}
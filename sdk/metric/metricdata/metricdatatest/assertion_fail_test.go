@@ -19,6 +19,8 @@ package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata
 
 import (
 	"testing"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
 // These tests are used to develop the failure messages of this package's
@@ -37,14 +39,32 @@ func TestFailAssertEqual(t *testing.T) {
 	t.Run("ScopeMetrics", testFailDatatype(scopeMetricsA, scopeMetricsB))
 	t.Run("Metrics", testFailDatatype(metricsA, metricsB))
 	t.Run("Histogram", testFailDatatype(histogramA, histogramB))
+	t.Run("ExponentialHistogram", testFailDatatype(expoHistogramA, expoHistogramB))
+	t.Run("Summary", testFailDatatype(summaryA, summaryB))
 	t.Run("SumInt64", testFailDatatype(sumInt64A, sumInt64B))
 	t.Run("SumFloat64", testFailDatatype(sumFloat64A, sumFloat64B))
 	t.Run("GaugeInt64", testFailDatatype(gaugeInt64A, gaugeInt64B))
 	t.Run("GaugeFloat64", testFailDatatype(gaugeFloat64A, gaugeFloat64B))
 	t.Run("HistogramDataPoint", testFailDatatype(histogramDataPointA, histogramDataPointB))
+	t.Run("ExponentialHistogramDataPoint", testFailDatatype(expoHistogramDataPointA, expoHistogramDataPointB))
+	t.Run("SummaryDataPoint", testFailDatatype(summaryDataPointA, summaryDataPointB))
 	t.Run("DataPointInt64", testFailDatatype(dataPointInt64A, dataPointInt64B))
 	t.Run("DataPointFloat64", testFailDatatype(dataPointFloat64A, dataPointFloat64B))
+	t.Run("ExemplarInt64", testFailDatatype(exemplarInt64A, exemplarInt64B))
+	t.Run("ExemplarFloat64", testFailDatatype(exemplarFloat64A, exemplarFloat64B))
+
+}
+
+func TestFailAssertContainsMetric(t *testing.T) {
+	AssertContainsMetric(t, metricdata.Metrics{Name: "C"}, resourceMetricsA)
+	AssertContainsMetric(t, metricsC, resourceMetricsA)
+}
 
+func TestFailAssertContainsDataPoints(t *testing.T) {
+	AssertContainsDataPoints(t, sumInt64A, nil)
+	AssertContainsDataPoints(t, sumInt64A, gaugeInt64A)
+	AssertContainsDataPoints(t, sumInt64A, sumInt64B)
+	AssertContainsDataPoints(t, histogramA, histogramB)
 }
 
 func TestFailAssertAggregationsEqual(t *testing.T) {
@@ -56,4 +76,6 @@ func TestFailAssertAggregationsEqual(t *testing.T) {
 	AssertAggregationsEqual(t, gaugeInt64A, gaugeInt64B)
 	AssertAggregationsEqual(t, gaugeFloat64A, gaugeFloat64B)
 	AssertAggregationsEqual(t, histogramA, histogramB)
+	AssertAggregationsEqual(t, expoHistogramA, expoHistogramB)
+	AssertAggregationsEqual(t, summaryA, summaryB)
 }
@@ -36,41 +36,93 @@ var (
 	endA   = startA.Add(time.Second)
 	endB   = startB.Add(time.Second)
 
+	spanIDA  = []byte{0, 0, 0, 0, 0, 0, 0, 1}
+	spanIDB  = []byte{0, 0, 0, 0, 0, 0, 0, 2}
+	traceIDA = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}
+	traceIDB = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2}
+
+	exemplarInt64A = metricdata.Exemplar[int64]{
+		FilteredAttributes: []attribute.KeyValue{attribute.Bool("filtered", true)},
+		Time:               endA,
+		Value:              -10,
+		SpanID:             spanIDA,
+		TraceID:            traceIDA,
+	}
+	exemplarFloat64A = metricdata.Exemplar[float64]{
+		FilteredAttributes: []attribute.KeyValue{attribute.Bool("filtered", true)},
+		Time:               endA,
+		Value:              -10.0,
+		SpanID:             spanIDA,
+		TraceID:            traceIDA,
+	}
+	exemplarInt64B = metricdata.Exemplar[int64]{
+		Time:    endB,
+		Value:   12,
+		SpanID:  spanIDB,
+		TraceID: traceIDB,
+	}
+	exemplarFloat64B = metricdata.Exemplar[float64]{
+		Time:    endB,
+		Value:   12.0,
+		SpanID:  spanIDB,
+		TraceID: traceIDB,
+	}
+	exemplarInt64C = metricdata.Exemplar[int64]{
+		FilteredAttributes: []attribute.KeyValue{attribute.Bool("filtered", true)},
+		Time:               endB,
+		Value:              -10,
+		SpanID:             spanIDA,
+		TraceID:            traceIDA,
+	}
+	exemplarFloat64C = metricdata.Exemplar[float64]{
+		FilteredAttributes: []attribute.KeyValue{attribute.Bool("filtered", true)},
+		Time:               endB,
+		Value:              -10.0,
+		SpanID:             spanIDA,
+		TraceID:            traceIDA,
+	}
+
 	dataPointInt64A = metricdata.DataPoint[int64]{
 		Attributes: attrA,
 		StartTime:  startA,
 		Time:       endA,
 		Value:      -1,
+		Exemplars:  []metricdata.Exemplar[int64]{exemplarInt64A},
 	}
 	dataPointFloat64A = metricdata.DataPoint[float64]{
 		Attributes: attrA,
 		StartTime:  startA,
 		Time:       endA,
 		Value:      -1.0,
+		Exemplars:  []metricdata.Exemplar[float64]{exemplarFloat64A},
 	}
 	dataPointInt64B = metricdata.DataPoint[int64]{
 		Attributes: attrB,
 		StartTime:  startB,
 		Time:       endB,
 		Value:      2,
+		Exemplars:  []metricdata.Exemplar[int64]{exemplarInt64B},
 	}
 	dataPointFloat64B = metricdata.DataPoint[float64]{
 		Attributes: attrB,
 		StartTime:  startB,
 		Time:       endB,
 		Value:      2.0,
+		Exemplars:  []metricdata.Exemplar[float64]{exemplarFloat64B},
 	}
 	dataPointInt64C = metricdata.DataPoint[int64]{
 		Attributes: attrA,
 		StartTime:  startB,
 		Time:       endB,
 		Value:      -1,
+		Exemplars:  []metricdata.Exemplar[int64]{exemplarInt64C},
 	}
 	dataPointFloat64C = metricdata.DataPoint[float64]{
 		Attributes: attrA,
 		StartTime:  startB,
 		Time:       endB,
 		Value:      -1.0,
+		Exemplars:  []metricdata.Exemplar[float64]{exemplarFloat64C},
 	}
 
 	max, min            = 99.0, 3.
@@ -82,6 +134,7 @@ var (
 		Bounds:       []float64{0, 10},
 		BucketCounts: []uint64{1, 1},
 		Sum:          2,
+		Exemplars:    []metricdata.Exemplar[float64]{exemplarFloat64A},
 	}
 	histogramDataPointB = metricdata.HistogramDataPoint{
 		Attributes:   attrB,
@@ -93,6 +146,7 @@ var (
 		Max:          &max,
 		Min:          &min,
 		Sum:          3,
+		Exemplars:    []metricdata.Exemplar[float64]{exemplarFloat64B},
 	}
 	histogramDataPointC = metricdata.HistogramDataPoint{
 		Attributes:   attrA,
@@ -102,6 +156,7 @@ var (
 		Bounds:       []float64{0, 10},
 		BucketCounts: []uint64{1, 1},
 		Sum:          2,
+		Exemplars:    []metricdata.Exemplar[float64]{exemplarFloat64C},
 	}
 
 	gaugeInt64A = metricdata.Gauge[int64]{
@@ -154,6 +209,94 @@ var (
 		DataPoints:  []metricdata.DataPoint[float64]{dataPointFloat64C},
 	}
 
+	expoHistogramDataPointA = metricdata.ExponentialHistogramDataPoint{
+		Attributes:     attrA,
+		StartTime:      startA,
+		Time:           endA,
+		Count:          3,
+		Sum:            2,
+		Scale:          1,
+		ZeroCount:      1,
+		PositiveBucket: metricdata.ExponentialBucket{Offset: 1, Counts: []uint64{1}},
+		NegativeBucket: metricdata.ExponentialBucket{Offset: 0, Counts: []uint64{1}},
+		Exemplars:      []metricdata.Exemplar[float64]{exemplarFloat64A},
+	}
+	expoHistogramDataPointB = metricdata.ExponentialHistogramDataPoint{
+		Attributes:     attrB,
+		StartTime:      startB,
+		Time:           endB,
+		Count:          3,
+		Max:            &max,
+		Min:            &min,
+		Sum:            3,
+		Scale:          2,
+		PositiveBucket: metricdata.ExponentialBucket{Offset: -1, Counts: []uint64{1, 2}},
+		Exemplars:      []metricdata.Exemplar[float64]{exemplarFloat64B},
+	}
+	expoHistogramDataPointC = metricdata.ExponentialHistogramDataPoint{
+		Attributes:     attrA,
+		StartTime:      startB,
+		Time:           endB,
+		Count:          3,
+		Sum:            2,
+		Scale:          1,
+		ZeroCount:      1,
+		PositiveBucket: metricdata.ExponentialBucket{Offset: 1, Counts: []uint64{1}},
+		NegativeBucket: metricdata.ExponentialBucket{Offset: 0, Counts: []uint64{1}},
+		Exemplars:      []metricdata.Exemplar[float64]{exemplarFloat64C},
+	}
+
+	expoHistogramA = metricdata.ExponentialHistogram{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints:  []metricdata.ExponentialHistogramDataPoint{expoHistogramDataPointA},
+	}
+	expoHistogramB = metricdata.ExponentialHistogram{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints:  []metricdata.ExponentialHistogramDataPoint{expoHistogramDataPointB},
+	}
+	expoHistogramC = metricdata.ExponentialHistogram{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints:  []metricdata.ExponentialHistogramDataPoint{expoHistogramDataPointC},
+	}
+
+	summaryDataPointA = metricdata.SummaryDataPoint{
+		Attributes:     attrA,
+		StartTime:      startA,
+		Time:           endA,
+		Count:          2,
+		Sum:            3,
+		QuantileValues: []metricdata.QuantileValue{{Quantile: 0.5, Value: 1}},
+	}
+	summaryDataPointB = metricdata.SummaryDataPoint{
+		Attributes:     attrB,
+		StartTime:      startB,
+		Time:           endB,
+		Count:          3,
+		Sum:            3,
+		QuantileValues: []metricdata.QuantileValue{{Quantile: 0.5, Value: 2}, {Quantile: 1, Value: 2}},
+	}
+	summaryDataPointC = metricdata.SummaryDataPoint{
+		Attributes:     attrA,
+		StartTime:      startB,
+		Time:           endB,
+		Count:          2,
+		Sum:            3,
+		QuantileValues: []metricdata.QuantileValue{{Quantile: 0.5, Value: 1}},
+	}
+
+	summaryA = metricdata.Summary{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints:  []metricdata.SummaryDataPoint{summaryDataPointA},
+	}
+	summaryB = metricdata.Summary{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints:  []metricdata.SummaryDataPoint{summaryDataPointB},
+	}
+	summaryC = metricdata.Summary{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints:  []metricdata.SummaryDataPoint{summaryDataPointC},
+	}
+
 	histogramA = metricdata.Histogram{
 		Temporality: metricdata.CumulativeTemporality,
 		DataPoints:  []metricdata.HistogramDataPoint{histogramDataPointA},
@@ -240,13 +383,19 @@ func TestAssertEqual(t *testing.T) {
 	t.Run("ScopeMetrics", testDatatype(scopeMetricsA, scopeMetricsB, equalScopeMetrics))
 	t.Run("Metrics", testDatatype(metricsA, metricsB, equalMetrics))
 	t.Run("Histogram", testDatatype(histogramA, histogramB, equalHistograms))
+	t.Run("ExponentialHistogram", testDatatype(expoHistogramA, expoHistogramB, equalExponentialHistograms))
+	t.Run("Summary", testDatatype(summaryA, summaryB, equalSummaries))
 	t.Run("SumInt64", testDatatype(sumInt64A, sumInt64B, equalSums[int64]))
 	t.Run("SumFloat64", testDatatype(sumFloat64A, sumFloat64B, equalSums[float64]))
 	t.Run("GaugeInt64", testDatatype(gaugeInt64A, gaugeInt64B, equalGauges[int64]))
 	t.Run("GaugeFloat64", testDatatype(gaugeFloat64A, gaugeFloat64B, equalGauges[float64]))
 	t.Run("HistogramDataPoint", testDatatype(histogramDataPointA, histogramDataPointB, equalHistogramDataPoints))
+	t.Run("ExponentialHistogramDataPoint", testDatatype(expoHistogramDataPointA, expoHistogramDataPointB, equalExponentialHistogramDataPoints))
+	t.Run("SummaryDataPoint", testDatatype(summaryDataPointA, summaryDataPointB, equalSummaryDataPoints))
 	t.Run("DataPointInt64", testDatatype(dataPointInt64A, dataPointInt64B, equalDataPoints[int64]))
 	t.Run("DataPointFloat64", testDatatype(dataPointFloat64A, dataPointFloat64B, equalDataPoints[float64]))
+	t.Run("ExemplarInt64", testDatatype(exemplarInt64A, exemplarInt64B, equalExemplars[int64]))
+	t.Run("ExemplarFloat64", testDatatype(exemplarFloat64A, exemplarFloat64B, equalExemplars[float64]))
 }
 
 func TestAssertEqualIgnoreTime(t *testing.T) {
@@ -254,13 +403,33 @@ func TestAssertEqualIgnoreTime(t *testing.T) {
 	t.Run("ScopeMetrics", testDatatypeIgnoreTime(scopeMetricsA, scopeMetricsC, equalScopeMetrics))
 	t.Run("Metrics", testDatatypeIgnoreTime(metricsA, metricsC, equalMetrics))
 	t.Run("Histogram", testDatatypeIgnoreTime(histogramA, histogramC, equalHistograms))
+	t.Run("ExponentialHistogram", testDatatypeIgnoreTime(expoHistogramA, expoHistogramC, equalExponentialHistograms))
+	t.Run("Summary", testDatatypeIgnoreTime(summaryA, summaryC, equalSummaries))
 	t.Run("SumInt64", testDatatypeIgnoreTime(sumInt64A, sumInt64C, equalSums[int64]))
 	t.Run("SumFloat64", testDatatypeIgnoreTime(sumFloat64A, sumFloat64C, equalSums[float64]))
 	t.Run("GaugeInt64", testDatatypeIgnoreTime(gaugeInt64A, gaugeInt64C, equalGauges[int64]))
 	t.Run("GaugeFloat64", testDatatypeIgnoreTime(gaugeFloat64A, gaugeFloat64C, equalGauges[float64]))
 	t.Run("HistogramDataPoint", testDatatypeIgnoreTime(histogramDataPointA, histogramDataPointC, equalHistogramDataPoints))
+	t.Run("ExponentialHistogramDataPoint", testDatatypeIgnoreTime(expoHistogramDataPointA, expoHistogramDataPointC, equalExponentialHistogramDataPoints))
+	t.Run("SummaryDataPoint", testDatatypeIgnoreTime(summaryDataPointA, summaryDataPointC, equalSummaryDataPoints))
 	t.Run("DataPointInt64", testDatatypeIgnoreTime(dataPointInt64A, dataPointInt64C, equalDataPoints[int64]))
 	t.Run("DataPointFloat64", testDatatypeIgnoreTime(dataPointFloat64A, dataPointFloat64C, equalDataPoints[float64]))
+	t.Run("ExemplarInt64", testDatatypeIgnoreTime(exemplarInt64A, exemplarInt64C, equalExemplars[int64]))
+	t.Run("ExemplarFloat64", testDatatypeIgnoreTime(exemplarFloat64A, exemplarFloat64C, equalExemplars[float64]))
+}
+
+func TestAssertEqualIgnoreExemplars(t *testing.T) {
+	hdp := histogramDataPointB
+	hdp.Exemplars = []metricdata.Exemplar[float64]{exemplarFloat64A}
+	AssertEqual(t, histogramDataPointB, hdp, IgnoreExemplars())
+	r := equalHistogramDataPoints(histogramDataPointB, hdp, config{})
+	assert.Greaterf(t, len(r), 0, "%v == %v", histogramDataPointB, hdp)
+
+	dp := dataPointInt64B
+	dp.Exemplars = nil
+	AssertEqual(t, dataPointInt64B, dp, IgnoreExemplars())
+	r = equalDataPoints(dataPointInt64B, dp, config{})
+	assert.Greaterf(t, len(r), 0, "%v == %v", dataPointInt64B, dp)
 }
 
 type unknownAggregation struct {
@@ -274,6 +443,8 @@ func TestAssertAggregationsEqual(t *testing.T) {
 	AssertAggregationsEqual(t, gaugeInt64A, gaugeInt64A)
 	AssertAggregationsEqual(t, gaugeFloat64A, gaugeFloat64A)
 	AssertAggregationsEqual(t, histogramA, histogramA)
+	AssertAggregationsEqual(t, expoHistogramA, expoHistogramA)
+	AssertAggregationsEqual(t, summaryA, summaryA)
 
 	r := equalAggregations(sumInt64A, nil, config{})
 	assert.Len(t, r, 1, "should return nil comparison mismatch only")
@@ -313,4 +484,97 @@ func TestAssertAggregationsEqual(t *testing.T) {
 
 	r = equalAggregations(histogramA, histogramC, config{ignoreTimestamp: true})
 	assert.Equalf(t, len(r), 0, "%v == %v", histogramA, histogramC)
+
+	r = equalAggregations(expoHistogramA, expoHistogramB, config{})
+	assert.Greaterf(t, len(r), 0, "%v == %v", expoHistogramA, expoHistogramB)
+
+	r = equalAggregations(expoHistogramA, expoHistogramC, config{ignoreTimestamp: true})
+	assert.Equalf(t, len(r), 0, "%v == %v", expoHistogramA, expoHistogramC)
+
+	r = equalAggregations(summaryA, summaryB, config{})
+	assert.Greaterf(t, len(r), 0, "%v == %v", summaryA, summaryB)
+
+	r = equalAggregations(summaryA, summaryC, config{ignoreTimestamp: true})
+	assert.Equalf(t, len(r), 0, "%v == %v", summaryA, summaryC)
+}
+
+func TestFloatTolerance(t *testing.T) {
+	tolerance := config{floatTolerance: 0.01}
+
+	dp := dataPointFloat64A
+	dp.Value += 0.001
+	AssertEqual(t, dataPointFloat64A, dp, FloatTolerance(0.01))
+	assert.Greater(t, len(equalDataPoints(dataPointFloat64A, dp, config{})), 0)
+	dp.Value += 0.1
+	assert.Greater(t, len(equalDataPoints(dataPointFloat64A, dp, tolerance)), 0)
+
+	intDP := dataPointInt64A
+	intDP.Value++
+	assert.Greater(t, len(equalDataPoints(dataPointInt64A, intDP, config{floatTolerance: 10})), 0, "int64 values compared with tolerance")
+
+	hdp := histogramDataPointA
+	hdp.Sum += 0.001
+	AssertEqual(t, histogramDataPointA, hdp, FloatTolerance(0.01))
+	assert.Greater(t, len(equalHistogramDataPoints(histogramDataPointA, hdp, config{})), 0)
+
+	sdp := summaryDataPointA
+	sdp.QuantileValues = []metricdata.QuantileValue{{Quantile: 0.5, Value: 1.001}}
+	AssertEqual(t, summaryDataPointA, sdp, FloatTolerance(0.01))
+	assert.Greater(t, len(equalSummaryDataPoints(summaryDataPointA, sdp, config{})), 0)
+	sdp.QuantileValues = []metricdata.QuantileValue{{Quantile: 0.51, Value: 1}}
+	assert.Greater(t, len(equalSummaryDataPoints(summaryDataPointA, sdp, tolerance)), 0, "quantiles compared with tolerance")
+}
+
+func TestAssertContainsDataPoints(t *testing.T) {
+	both := metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints:  []metricdata.DataPoint[int64]{dataPointInt64A, dataPointInt64B},
+	}
+	AssertContainsDataPoints(t, sumInt64A, both)
+	AssertContainsDataPoints(t, sumInt64B, both)
+	AssertContainsDataPoints(t, sumInt64C, both, IgnoreTimestamp())
+	AssertContainsDataPoints(t, nil, nil)
+
+	assert.Greater(t, len(containsDataPoints(both, sumInt64A, config{})), 0, "missing data point")
+	assert.Greater(t, len(containsDataPoints(sumInt64C, both, config{})), 0, "timestamps compared")
+	assert.Len(t, containsDataPoints(sumInt64A, nil, config{}), 1, "nil aggregation")
+	assert.Len(t, containsDataPoints(sumInt64A, gaugeInt64A, config{}), 1, "type mismatch")
+	assert.Len(t, containsDataPoints(unknownAggregation{}, unknownAggregation{}, config{}), 1, "unknown aggregation")
+
+	delta := both
+	delta.Temporality = metricdata.DeltaTemporality
+	assert.Len(t, containsDataPoints(sumInt64A, delta, config{}), 1, "temporality mismatch")
+
+	AssertContainsDataPoints(t, gaugeFloat64A, gaugeFloat64A)
+	AssertContainsDataPoints(t, sumFloat64A, sumFloat64A)
+	AssertContainsDataPoints(t, histogramA, histogramA)
+	AssertContainsDataPoints(t, expoHistogramA, expoHistogramA)
+	AssertContainsDataPoints(t, summaryA, summaryA)
+	assert.Greater(t, len(containsDataPoints(histogramA, histogramB, config{})), 0)
+	assert.Greater(t, len(containsDataPoints(expoHistogramA, expoHistogramB, config{})), 0)
+	assert.Greater(t, len(containsDataPoints(summaryA, summaryB, config{})), 0)
+}
+
+func TestAssertContainsMetric(t *testing.T) {
+	rm := metricdata.ResourceMetrics{
+		Resource:     resourceMetricsA.Resource,
+		ScopeMetrics: []metricdata.ScopeMetrics{scopeMetricsA, scopeMetricsB},
+	}
+
+	AssertContainsMetric(t, metricdata.Metrics{Name: "A"}, rm)
+	AssertContainsMetric(t, metricdata.Metrics{Name: "B", Unit: unit.Bytes}, rm)
+	AssertContainsMetric(t, metricsA, rm)
+	AssertContainsMetric(t, metricsC, rm, IgnoreTimestamp())
+	AssertContainsMetric(t, metricdata.Metrics{
+		Name: "B",
+		Data: metricdata.Gauge[float64]{
+			DataPoints: []metricdata.DataPoint[float64]{dataPointFloat64B},
+		},
+	}, rm)
+
+	assert.Equal(t, []string{`Metrics "C" not found`}, containsMetric(metricdata.Metrics{Name: "C"}, rm, config{}))
+	assert.Greater(t, len(containsMetric(metricdata.Metrics{Name: "A", Description: "B desc"}, rm, config{})), 0)
+	assert.Greater(t, len(containsMetric(metricdata.Metrics{Name: "A", Unit: unit.Bytes}, rm, config{})), 0)
+	assert.Greater(t, len(containsMetric(metricsC, rm, config{})), 0)
 }
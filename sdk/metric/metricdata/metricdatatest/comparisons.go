@@ -17,6 +17,7 @@ package metricdatatest // import "go.opentelemetry.io/otel/sdk/metric/metricdata
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"reflect"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -137,12 +138,146 @@ func equalAggregations(a, b metricdata.Aggregation, cfg config) (reasons []strin
 			reasons = append(reasons, "Histogram not equal:")
 			reasons = append(reasons, r...)
 		}
+	case metricdata.ExponentialHistogram:
+		r := equalExponentialHistograms(v, b.(metricdata.ExponentialHistogram), cfg)
+		if len(r) > 0 {
+			reasons = append(reasons, "ExponentialHistogram not equal:")
+			reasons = append(reasons, r...)
+		}
+	case metricdata.Summary:
+		r := equalSummaries(v, b.(metricdata.Summary), cfg)
+		if len(r) > 0 {
+			reasons = append(reasons, "Summary not equal:")
+			reasons = append(reasons, r...)
+		}
 	default:
 		reasons = append(reasons, fmt.Sprintf("Aggregation of unknown types %T", a))
 	}
 	return reasons
 }
 
+// containsMetric returns reasons actual does not contain a Metrics matching
+// expected. If it does, the returned reasons will be empty.
+//
+// A Metrics matches expected if it has the same name and, if they are set in
+// expected, the same description, unit, and data points. See
+// containsDataPoints for how data points are matched.
+func containsMetric(expected metricdata.Metrics, actual metricdata.ResourceMetrics, cfg config) (reasons []string) {
+	var found bool
+	for _, sm := range actual.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != expected.Name {
+				continue
+			}
+			found = true
+
+			r := matchMetrics(expected, m, cfg)
+			if len(r) == 0 {
+				return nil
+			}
+			reasons = append(reasons, fmt.Sprintf("Metrics %q of Scope %q does not match:", m.Name, sm.Scope.Name))
+			reasons = append(reasons, r...)
+		}
+	}
+	if !found {
+		return []string{fmt.Sprintf("Metrics %q not found", expected.Name)}
+	}
+	return reasons
+}
+
+// matchMetrics returns reasons actual does not match expected. If it does,
+// the returned reasons will be empty.
+func matchMetrics(expected, actual metricdata.Metrics, cfg config) (reasons []string) {
+	if expected.Description != "" && expected.Description != actual.Description {
+		reasons = append(reasons, notEqualStr("Description", expected.Description, actual.Description))
+	}
+	if expected.Unit != "" && expected.Unit != actual.Unit {
+		reasons = append(reasons, notEqualStr("Unit", expected.Unit, actual.Unit))
+	}
+	if expected.Data != nil {
+		reasons = append(reasons, containsDataPoints(expected.Data, actual.Data, cfg)...)
+	}
+	return reasons
+}
+
+// containsDataPoints returns reasons actual does not contain the data points
+// of expected. If it does, the returned reasons will be empty.
+//
+// The Aggregations need to be of the same type and have the same temporality
+// and monotonicity. Each data point of expected needs to be equal to a data
+// point of actual, additional data points of actual are ignored.
+func containsDataPoints(expected, actual metricdata.Aggregation, cfg config) (reasons []string) {
+	if expected == nil || actual == nil {
+		if expected != actual {
+			return []string{notEqualStr("Aggregation", expected, actual)}
+		}
+		return reasons
+	}
+
+	if reflect.TypeOf(expected) != reflect.TypeOf(actual) {
+		return []string{fmt.Sprintf("Aggregation types not equal:\nexpected: %T\nactual: %T", expected, actual)}
+	}
+
+	switch e := expected.(type) {
+	case metricdata.Gauge[int64]:
+		reasons = missingDataPoints(e.DataPoints, actual.(metricdata.Gauge[int64]).DataPoints, equalDataPoints[int64], cfg)
+	case metricdata.Gauge[float64]:
+		reasons = missingDataPoints(e.DataPoints, actual.(metricdata.Gauge[float64]).DataPoints, equalDataPoints[float64], cfg)
+	case metricdata.Sum[int64]:
+		a := actual.(metricdata.Sum[int64])
+		reasons = equalSumKinds(e.Temporality, a.Temporality, e.IsMonotonic, a.IsMonotonic)
+		reasons = append(reasons, missingDataPoints(e.DataPoints, a.DataPoints, equalDataPoints[int64], cfg)...)
+	case metricdata.Sum[float64]:
+		a := actual.(metricdata.Sum[float64])
+		reasons = equalSumKinds(e.Temporality, a.Temporality, e.IsMonotonic, a.IsMonotonic)
+		reasons = append(reasons, missingDataPoints(e.DataPoints, a.DataPoints, equalDataPoints[float64], cfg)...)
+	case metricdata.Histogram:
+		a := actual.(metricdata.Histogram)
+		if e.Temporality != a.Temporality {
+			reasons = append(reasons, notEqualStr("Temporality", e.Temporality, a.Temporality))
+		}
+		reasons = append(reasons, missingDataPoints(e.DataPoints, a.DataPoints, equalHistogramDataPoints, cfg)...)
+	case metricdata.ExponentialHistogram:
+		a := actual.(metricdata.ExponentialHistogram)
+		if e.Temporality != a.Temporality {
+			reasons = append(reasons, notEqualStr("Temporality", e.Temporality, a.Temporality))
+		}
+		reasons = append(reasons, missingDataPoints(e.DataPoints, a.DataPoints, equalExponentialHistogramDataPoints, cfg)...)
+	case metricdata.Summary:
+		a := actual.(metricdata.Summary)
+		if e.Temporality != a.Temporality {
+			reasons = append(reasons, notEqualStr("Temporality", e.Temporality, a.Temporality))
+		}
+		reasons = append(reasons, missingDataPoints(e.DataPoints, a.DataPoints, equalSummaryDataPoints, cfg)...)
+	default:
+		reasons = append(reasons, fmt.Sprintf("Aggregation of unknown types %T", expected))
+	}
+	return reasons
+}
+
+func equalSumKinds(tempA, tempB metricdata.Temporality, monoA, monoB bool) (reasons []string) {
+	if tempA != tempB {
+		reasons = append(reasons, notEqualStr("Temporality", tempA, tempB))
+	}
+	if monoA != monoB {
+		reasons = append(reasons, notEqualStr("IsMonotonic", monoA, monoB))
+	}
+	return reasons
+}
+
+// missingDataPoints returns reasons some of the expected data points are not
+// equal to any of the actual data points. If all are found, the returned
+// reasons will be empty.
+func missingDataPoints[T any](expected, actual []T, equal func(T, T, config) []string, cfg config) []string {
+	missing, _ := diffSlices(expected, actual, func(a, b T) bool {
+		return len(equal(a, b, cfg)) == 0
+	})
+	if r := compareDiff(missing, nil); r != "" {
+		return []string{fmt.Sprintf("DataPoints not found:\n%s", r)}
+	}
+	return nil
+}
+
 // equalGauges returns reasons Gauges are not equal. If they are equal, the
 // returned reasons will be empty.
 //
@@ -214,6 +349,55 @@ func equalHistograms(a, b metricdata.Histogram, cfg config) (reasons []string) {
 	return reasons
 }
 
+// equalExponentialHistograms returns reasons ExponentialHistograms are not
+// equal. If they are equal, the returned reasons will be empty.
+//
+// The DataPoints each ExponentialHistogram contains are compared based on
+// containing the same ExponentialHistogramDataPoint, not the order they are
+// stored in.
+func equalExponentialHistograms(a, b metricdata.ExponentialHistogram, cfg config) (reasons []string) {
+	if a.Temporality != b.Temporality {
+		reasons = append(reasons, notEqualStr("Temporality", a.Temporality, b.Temporality))
+	}
+
+	r := compareDiff(diffSlices(
+		a.DataPoints,
+		b.DataPoints,
+		func(a, b metricdata.ExponentialHistogramDataPoint) bool {
+			r := equalExponentialHistogramDataPoints(a, b, cfg)
+			return len(r) == 0
+		},
+	))
+	if r != "" {
+		reasons = append(reasons, fmt.Sprintf("ExponentialHistogram DataPoints not equal:\n%s", r))
+	}
+	return reasons
+}
+
+// equalSummaries returns reasons Summaries are not equal. If they are equal,
+// the returned reasons will be empty.
+//
+// The DataPoints each Summary contains are compared based on containing the
+// same SummaryDataPoint, not the order they are stored in.
+func equalSummaries(a, b metricdata.Summary, cfg config) (reasons []string) {
+	if a.Temporality != b.Temporality {
+		reasons = append(reasons, notEqualStr("Temporality", a.Temporality, b.Temporality))
+	}
+
+	r := compareDiff(diffSlices(
+		a.DataPoints,
+		b.DataPoints,
+		func(a, b metricdata.SummaryDataPoint) bool {
+			r := equalSummaryDataPoints(a, b, cfg)
+			return len(r) == 0
+		},
+	))
+	if r != "" {
+		reasons = append(reasons, fmt.Sprintf("Summary DataPoints not equal:\n%s", r))
+	}
+	return reasons
+}
+
 // equalDataPoints returns reasons DataPoints are not equal. If they are
 // equal, the returned reasons will be empty.
 func equalDataPoints[N int64 | float64](a, b metricdata.DataPoint[N], cfg config) (reasons []string) { // nolint: revive // Intentional internal control flag
@@ -234,9 +418,23 @@ func equalDataPoints[N int64 | float64](a, b metricdata.DataPoint[N], cfg config
 		}
 	}
 
-	if a.Value != b.Value {
+	if !equalValues(a.Value, b.Value, cfg) {
 		reasons = append(reasons, notEqualStr("Value", a.Value, b.Value))
 	}
+
+	if !cfg.ignoreExemplars {
+		r := compareDiff(diffSlices(
+			a.Exemplars,
+			b.Exemplars,
+			func(a, b metricdata.Exemplar[N]) bool {
+				r := equalExemplars(a, b, cfg)
+				return len(r) == 0
+			},
+		))
+		if r != "" {
+			reasons = append(reasons, fmt.Sprintf("Exemplars not equal:\n%s", r))
+		}
+	}
 	return reasons
 }
 
@@ -267,15 +465,162 @@ func equalHistogramDataPoints(a, b metricdata.HistogramDataPoint, cfg config) (r
 	if !equalSlices(a.BucketCounts, b.BucketCounts) {
 		reasons = append(reasons, notEqualStr("BucketCounts", a.BucketCounts, b.BucketCounts))
 	}
-	if !equalPtrValues(a.Min, b.Min) {
+	if !equalFloatPtrs(a.Min, b.Min, cfg) {
+		reasons = append(reasons, notEqualStr("Min", a.Min, b.Min))
+	}
+	if !equalFloatPtrs(a.Max, b.Max, cfg) {
+		reasons = append(reasons, notEqualStr("Max", a.Max, b.Max))
+	}
+	if !equalValues(a.Sum, b.Sum, cfg) {
+		reasons = append(reasons, notEqualStr("Sum", a.Sum, b.Sum))
+	}
+	if !cfg.ignoreExemplars {
+		r := compareDiff(diffSlices(
+			a.Exemplars,
+			b.Exemplars,
+			func(a, b metricdata.Exemplar[float64]) bool {
+				r := equalExemplars(a, b, cfg)
+				return len(r) == 0
+			},
+		))
+		if r != "" {
+			reasons = append(reasons, fmt.Sprintf("Exemplars not equal:\n%s", r))
+		}
+	}
+	return reasons
+}
+
+// equalExponentialHistogramDataPoints returns reasons
+// ExponentialHistogramDataPoints are not equal. If they are equal, the
+// returned reasons will be empty.
+func equalExponentialHistogramDataPoints(a, b metricdata.ExponentialHistogramDataPoint, cfg config) (reasons []string) { // nolint: revive // Intentional internal control flag
+	if !a.Attributes.Equals(&b.Attributes) {
+		reasons = append(reasons, notEqualStr(
+			"Attributes",
+			a.Attributes.Encoded(attribute.DefaultEncoder()),
+			b.Attributes.Encoded(attribute.DefaultEncoder()),
+		))
+	}
+	if !cfg.ignoreTimestamp {
+		if !a.StartTime.Equal(b.StartTime) {
+			reasons = append(reasons, notEqualStr("StartTime", a.StartTime.UnixNano(), b.StartTime.UnixNano()))
+		}
+		if !a.Time.Equal(b.Time) {
+			reasons = append(reasons, notEqualStr("Time", a.Time.UnixNano(), b.Time.UnixNano()))
+		}
+	}
+	if a.Count != b.Count {
+		reasons = append(reasons, notEqualStr("Count", a.Count, b.Count))
+	}
+	if !equalFloatPtrs(a.Min, b.Min, cfg) {
 		reasons = append(reasons, notEqualStr("Min", a.Min, b.Min))
 	}
-	if !equalPtrValues(a.Max, b.Max) {
+	if !equalFloatPtrs(a.Max, b.Max, cfg) {
 		reasons = append(reasons, notEqualStr("Max", a.Max, b.Max))
 	}
-	if a.Sum != b.Sum {
+	if !equalValues(a.Sum, b.Sum, cfg) {
+		reasons = append(reasons, notEqualStr("Sum", a.Sum, b.Sum))
+	}
+	if a.Scale != b.Scale {
+		reasons = append(reasons, notEqualStr("Scale", a.Scale, b.Scale))
+	}
+	if a.ZeroCount != b.ZeroCount {
+		reasons = append(reasons, notEqualStr("ZeroCount", a.ZeroCount, b.ZeroCount))
+	}
+	if r := equalExponentialBuckets(a.PositiveBucket, b.PositiveBucket); len(r) > 0 {
+		reasons = append(reasons, "PositiveBucket not equal:")
+		reasons = append(reasons, r...)
+	}
+	if r := equalExponentialBuckets(a.NegativeBucket, b.NegativeBucket); len(r) > 0 {
+		reasons = append(reasons, "NegativeBucket not equal:")
+		reasons = append(reasons, r...)
+	}
+	if !cfg.ignoreExemplars {
+		r := compareDiff(diffSlices(
+			a.Exemplars,
+			b.Exemplars,
+			func(a, b metricdata.Exemplar[float64]) bool {
+				r := equalExemplars(a, b, cfg)
+				return len(r) == 0
+			},
+		))
+		if r != "" {
+			reasons = append(reasons, fmt.Sprintf("Exemplars not equal:\n%s", r))
+		}
+	}
+	return reasons
+}
+
+// equalSummaryDataPoints returns reasons SummaryDataPoints are not equal. If
+// they are equal, the returned reasons will be empty.
+func equalSummaryDataPoints(a, b metricdata.SummaryDataPoint, cfg config) (reasons []string) { // nolint: revive // Intentional internal control flag
+	if !a.Attributes.Equals(&b.Attributes) {
+		reasons = append(reasons, notEqualStr(
+			"Attributes",
+			a.Attributes.Encoded(attribute.DefaultEncoder()),
+			b.Attributes.Encoded(attribute.DefaultEncoder()),
+		))
+	}
+	if !cfg.ignoreTimestamp {
+		if !a.StartTime.Equal(b.StartTime) {
+			reasons = append(reasons, notEqualStr("StartTime", a.StartTime.UnixNano(), b.StartTime.UnixNano()))
+		}
+		if !a.Time.Equal(b.Time) {
+			reasons = append(reasons, notEqualStr("Time", a.Time.UnixNano(), b.Time.UnixNano()))
+		}
+	}
+	if a.Count != b.Count {
+		reasons = append(reasons, notEqualStr("Count", a.Count, b.Count))
+	}
+	if !equalValues(a.Sum, b.Sum, cfg) {
 		reasons = append(reasons, notEqualStr("Sum", a.Sum, b.Sum))
 	}
+	if !equalQuantileValues(a.QuantileValues, b.QuantileValues, cfg) {
+		reasons = append(reasons, notEqualStr("QuantileValues", a.QuantileValues, b.QuantileValues))
+	}
+	return reasons
+}
+
+// equalExponentialBuckets returns reasons ExponentialBuckets are not equal.
+// If they are equal, the returned reasons will be empty.
+func equalExponentialBuckets(a, b metricdata.ExponentialBucket) (reasons []string) {
+	if a.Offset != b.Offset {
+		reasons = append(reasons, notEqualStr("Offset", a.Offset, b.Offset))
+	}
+	if !equalSlices(a.Counts, b.Counts) {
+		reasons = append(reasons, notEqualStr("Counts", a.Counts, b.Counts))
+	}
+	return reasons
+}
+
+// equalExemplars returns reasons Exemplars are not equal. If they are equal,
+// the returned reasons will be empty.
+//
+// The FilteredAttributes each Exemplar contains are compared based on
+// containing the same KeyValues, not the order they are stored in.
+func equalExemplars[N int64 | float64](a, b metricdata.Exemplar[N], cfg config) (reasons []string) {
+	r := compareDiff(diffSlices(
+		a.FilteredAttributes,
+		b.FilteredAttributes,
+		func(a, b attribute.KeyValue) bool { return a == b },
+	))
+	if r != "" {
+		reasons = append(reasons, fmt.Sprintf("FilteredAttributes not equal:\n%s", r))
+	}
+	if !cfg.ignoreTimestamp {
+		if !a.Time.Equal(b.Time) {
+			reasons = append(reasons, notEqualStr("Time", a.Time.UnixNano(), b.Time.UnixNano()))
+		}
+	}
+	if !equalValues(a.Value, b.Value, cfg) {
+		reasons = append(reasons, notEqualStr("Value", a.Value, b.Value))
+	}
+	if !equalSlices(a.SpanID, b.SpanID) {
+		reasons = append(reasons, notEqualStr("SpanID", a.SpanID, b.SpanID))
+	}
+	if !equalSlices(a.TraceID, b.TraceID) {
+		reasons = append(reasons, notEqualStr("TraceID", a.TraceID, b.TraceID))
+	}
 	return reasons
 }
 
@@ -295,12 +640,36 @@ func equalSlices[T comparable](a, b []T) bool {
 	return true
 }
 
-func equalPtrValues[T comparable](a, b *T) bool {
+// equalValues returns if a and b are equal. Float64 values are also equal if
+// they differ by no more than the float tolerance of cfg.
+func equalValues[N int64 | float64](a, b N, cfg config) bool {
+	if a == b {
+		return true
+	}
+	if _, ok := interface{}(a).(float64); !ok {
+		return false
+	}
+	return math.Abs(float64(a)-float64(b)) <= cfg.floatTolerance
+}
+
+func equalFloatPtrs(a, b *float64, cfg config) bool {
 	if a == nil || b == nil {
 		return a == b
 	}
 
-	return *a == *b
+	return equalValues(*a, *b, cfg)
+}
+
+func equalQuantileValues(a, b []metricdata.QuantileValue, cfg config) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, v := range a {
+		if v.Quantile != b[i].Quantile || !equalValues(v.Value, b[i].Value, cfg) {
+			return false
+		}
+	}
+	return true
 }
 
 func diffSlices[T any](a, b []T, equal func(T, T) bool) (extraA, extraB []T) {
@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrictest // import "go.opentelemetry.io/otel/sdk/metric/metrictest"
+
+import (
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/metric"
+)
+
+// Clock is a manually advanced clock. It can be passed to a MeterProvider
+// with the metric.WithClock option to make the timestamps of the metric data
+// it produces deterministic:
+//
+//	clock := metrictest.NewClock(time.Unix(0, 0))
+//	provider := metric.NewMeterProvider(
+//		metric.WithReader(reader),
+//		metric.WithClock(clock),
+//	)
+type Clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+var _ metric.Clock = (*Clock)(nil)
+
+// NewClock returns a Clock set to t.
+func NewClock(t time.Time) *Clock {
+	return &Clock{now: t}
+}
+
+// Now returns the current time of the Clock.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Since returns the time elapsed between t and the current time of the Clock.
+func (c *Clock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+// Advance moves the current time of the Clock forward by d.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Set sets the current time of the Clock to t.
+func (c *Clock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+}
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrictest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"go.opentelemetry.io/otel/sdk/metric/view"
+)
+
+func TestClock(t *testing.T) {
+	start := time.Unix(946684800, 0)
+	c := NewClock(start)
+	assert.Equal(t, start, c.Now())
+
+	c.Advance(time.Second)
+	assert.Equal(t, start.Add(time.Second), c.Now())
+	assert.Equal(t, time.Second, c.Since(start))
+
+	c.Set(start)
+	assert.Equal(t, start, c.Now())
+}
+
+func TestClockDeltaTemporality(t *testing.T) {
+	start := time.Unix(946684800, 0)
+	clock := NewClock(start)
+	reader := metric.NewManualReader(metric.WithTemporalitySelector(
+		func(view.InstrumentKind) metricdata.Temporality {
+			return metricdata.DeltaTemporality
+		},
+	))
+	provider := metric.NewMeterProvider(
+		metric.WithReader(reader),
+		metric.WithClock(clock),
+	)
+
+	ctx := context.Background()
+	ctr, err := provider.Meter("testing").SyncInt64().Counter("counter")
+	require.NoError(t, err)
+
+	attr := attribute.String("key", "value")
+	want := func(start, end time.Time, value int64) metricdata.Metrics {
+		return metricdata.Metrics{
+			Name: "counter",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.DeltaTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{{
+					Attributes: attribute.NewSet(attr),
+					StartTime:  start,
+					Time:       end,
+					Value:      value,
+				}},
+			},
+		}
+	}
+
+	ctr.Add(ctx, 1, attr)
+	clock.Advance(time.Minute)
+	rm, err := reader.Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	metricdatatest.AssertEqual(t, want(start, start.Add(time.Minute), 1), rm.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreExemplars())
+
+	ctr.Add(ctx, 2, attr)
+	clock.Advance(time.Minute)
+	rm, err = reader.Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	metricdatatest.AssertEqual(t, want(start.Add(time.Minute), start.Add(2*time.Minute), 2), rm.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreExemplars())
+}
@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrictest is a testing helper package for the metric SDK. It
+// provides an in-memory Exporter to verify the metric data produced by
+// instrumentation in tests.
+package metrictest // import "go.opentelemetry.io/otel/sdk/metric/metrictest"
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/view"
+)
+
+// config contains options for the InMemoryExporter.
+type config struct {
+	temporalitySelector metric.TemporalitySelector
+	aggregationSelector metric.AggregationSelector
+}
+
+// Option sets InMemoryExporter option values.
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(cfg config) config {
+	return fn(cfg)
+}
+
+// WithTemporalitySelector sets the TemporalitySelector the InMemoryExporter
+// returns the Temporality of instruments with. If this option is not used,
+// metric.DefaultTemporalitySelector is used.
+func WithTemporalitySelector(selector metric.TemporalitySelector) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.temporalitySelector = selector
+		return cfg
+	})
+}
+
+// WithAggregationSelector sets the AggregationSelector the InMemoryExporter
+// returns the Aggregation of instruments with. If this option is not used,
+// metric.DefaultAggregationSelector is used.
+func WithAggregationSelector(selector metric.AggregationSelector) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.aggregationSelector = selector
+		return cfg
+	})
+}
+
+var _ metric.Exporter = (*InMemoryExporter)(nil)
+
+// InMemoryExporter is an exporter that stores all received metric data
+// in-memory. It is intended to be used with a PeriodicReader:
+//
+//	exp := metrictest.NewInMemoryExporter()
+//	provider := metric.NewMeterProvider(metric.WithReader(
+//		metric.NewPeriodicReader(exp, metric.WithInterval(time.Millisecond)),
+//	))
+//
+//	// Use provider in the tested instrumentation.
+//
+//	got, err := exp.WaitForMetrics(ctx, 1)
+type InMemoryExporter struct {
+	temporalitySelector metric.TemporalitySelector
+	aggregationSelector metric.AggregationSelector
+
+	mu       sync.Mutex
+	metrics  []metricdata.ResourceMetrics
+	shutdown bool
+	// exported is closed, and replaced, each time metric data is exported.
+	exported chan struct{}
+}
+
+// NewInMemoryExporter returns a new InMemoryExporter.
+func NewInMemoryExporter(opts ...Option) *InMemoryExporter {
+	cfg := config{}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+
+	if cfg.temporalitySelector == nil {
+		cfg.temporalitySelector = metric.DefaultTemporalitySelector
+	}
+	if cfg.aggregationSelector == nil {
+		cfg.aggregationSelector = metric.DefaultAggregationSelector
+	}
+
+	return &InMemoryExporter{
+		temporalitySelector: cfg.temporalitySelector,
+		aggregationSelector: cfg.aggregationSelector,
+		exported:            make(chan struct{}),
+	}
+}
+
+// Temporality returns the Temporality to use for an instrument kind.
+func (e *InMemoryExporter) Temporality(k view.InstrumentKind) metricdata.Temporality {
+	return e.temporalitySelector(k)
+}
+
+// Aggregation returns the Aggregation to use for an instrument kind.
+func (e *InMemoryExporter) Aggregation(k view.InstrumentKind) aggregation.Aggregation { // nolint:revive  // import-shadow for method scoped by type.
+	return e.aggregationSelector(k)
+}
+
+// Export stores data in memory. An error is returned if the exporter has
+// been shut down.
+func (e *InMemoryExporter) Export(_ context.Context, data metricdata.ResourceMetrics) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.shutdown {
+		return metric.ErrExporterShutdown
+	}
+	e.metrics = append(e.metrics, data)
+	close(e.exported)
+	e.exported = make(chan struct{})
+	return nil
+}
+
+// ForceFlush does nothing, all exported data is already stored.
+func (e *InMemoryExporter) ForceFlush(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// Shutdown stops the exporter from storing any more data. The data already
+// stored is kept and can still be retrieved with GetMetrics.
+func (e *InMemoryExporter) Shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.shutdown {
+		return metric.ErrExporterShutdown
+	}
+	e.shutdown = true
+	return ctx.Err()
+}
+
+// Reset the current in-memory storage.
+func (e *InMemoryExporter) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.metrics = nil
+}
+
+// GetMetrics returns the metric data of all exports stored in-memory, in the
+// order they were exported.
+func (e *InMemoryExporter) GetMetrics() []metricdata.ResourceMetrics {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	ret := make([]metricdata.ResourceMetrics, len(e.metrics))
+	copy(ret, e.metrics)
+	return ret
+}
+
+// WaitForMetrics waits until at least n exports are stored in-memory and
+// returns the metric data of all stored exports. If ctx is done before that
+// happens, the stored metric data is returned along with the ctx error.
+func (e *InMemoryExporter) WaitForMetrics(ctx context.Context, n int) ([]metricdata.ResourceMetrics, error) {
+	for {
+		e.mu.Lock()
+		if len(e.metrics) >= n {
+			e.mu.Unlock()
+			return e.GetMetrics(), nil
+		}
+		exported := e.exported
+		e.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return e.GetMetrics(), ctx.Err()
+		case <-exported:
+		}
+	}
+}
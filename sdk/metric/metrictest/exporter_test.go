@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrictest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/view"
+)
+
+func TestInMemoryExporterWaitForMetrics(t *testing.T) {
+	exp := NewInMemoryExporter()
+	provider := metric.NewMeterProvider(metric.WithReader(
+		metric.NewPeriodicReader(exp, metric.WithInterval(time.Millisecond)),
+	))
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+	ctr, err := provider.Meter("testing").SyncInt64().Counter("counter")
+	require.NoError(t, err)
+	ctr.Add(context.Background(), 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	got, err := exp.WaitForMetrics(ctx, 2)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(got), 2)
+
+	require.Len(t, got[0].ScopeMetrics, 1)
+	require.Len(t, got[0].ScopeMetrics[0].Metrics, 1)
+	assert.Equal(t, "counter", got[0].ScopeMetrics[0].Metrics[0].Name)
+}
+
+func TestInMemoryExporterWaitForMetricsContextDone(t *testing.T) {
+	exp := NewInMemoryExporter()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	got, err := exp.WaitForMetrics(ctx, 1)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, got)
+}
+
+func TestInMemoryExporterResetAndGetMetrics(t *testing.T) {
+	exp := NewInMemoryExporter()
+	ctx := context.Background()
+
+	rm := metricdata.ResourceMetrics{}
+	require.NoError(t, exp.Export(ctx, rm))
+	require.NoError(t, exp.Export(ctx, rm))
+	got := exp.GetMetrics()
+	assert.Len(t, got, 2)
+
+	// The returned slice must be a copy.
+	got[0].ScopeMetrics = []metricdata.ScopeMetrics{{}}
+	assert.Nil(t, exp.GetMetrics()[0].ScopeMetrics)
+
+	exp.Reset()
+	assert.Empty(t, exp.GetMetrics())
+}
+
+func TestInMemoryExporterShutdown(t *testing.T) {
+	exp := NewInMemoryExporter()
+	ctx := context.Background()
+
+	require.NoError(t, exp.Export(ctx, metricdata.ResourceMetrics{}))
+	require.NoError(t, exp.Shutdown(ctx))
+	assert.ErrorIs(t, exp.Shutdown(ctx), metric.ErrExporterShutdown)
+	assert.ErrorIs(t, exp.Export(ctx, metricdata.ResourceMetrics{}), metric.ErrExporterShutdown)
+	assert.Len(t, exp.GetMetrics(), 1, "stored data dropped on shutdown")
+}
+
+func TestInMemoryExporterSelectors(t *testing.T) {
+	exp := NewInMemoryExporter()
+	assert.Equal(t, metricdata.CumulativeTemporality, exp.Temporality(view.SyncCounter))
+
+	exp = NewInMemoryExporter(WithTemporalitySelector(func(view.InstrumentKind) metricdata.Temporality {
+		return metricdata.DeltaTemporality
+	}))
+	assert.Equal(t, metricdata.DeltaTemporality, exp.Temporality(view.SyncCounter))
+	assert.Equal(t, metric.DefaultAggregationSelector(view.SyncCounter), exp.Aggregation(view.SyncCounter))
+}
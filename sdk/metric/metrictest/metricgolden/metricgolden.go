@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metricgolden provides snapshot testing of metric data with golden
+// files.
+//
+// Metric data is compared using a normalized JSON encoding that is stable
+// across test runs. Importing this package registers the -otel.update flag.
+// Running the tests of a package with it writes the golden files instead of
+// comparing against them:
+//
+//	go test ./... -otel.update
+package metricgolden // import "go.opentelemetry.io/otel/sdk/metric/metrictest/metricgolden"
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/internal/golden"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// Marshal returns the normalized JSON encoding of rm. All timestamps are
+// zeroed and the trace and span IDs of exemplars are omitted. Scopes are
+// sorted by name, version, and schema URL, metrics are sorted by name, and
+// data points are sorted by their attributes. Attributes are sorted by key.
+func Marshal(rm metricdata.ResourceMetrics) ([]byte, error) {
+	n, err := normalize(rm)
+	if err != nil {
+		return nil, err
+	}
+	out, err := json.MarshalIndent(n, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	return append(out, '\n'), nil
+}
+
+// Assert asserts that the normalized JSON encoding of rm, as returned from
+// Marshal, equals the content of the golden file at path. If the test binary
+// is run with the -otel.update flag, the golden file is written instead.
+func Assert(t *testing.T, path string, rm metricdata.ResourceMetrics) bool {
+	t.Helper()
+
+	got, err := Marshal(rm)
+	if err != nil {
+		t.Errorf("failed to marshal metric data: %v", err)
+		return false
+	}
+	return golden.Assert(t, path, got)
+}
+
+// normalize returns a normalized deep copy of rm.
+func normalize(rm metricdata.ResourceMetrics) (metricdata.ResourceMetrics, error) {
+	out := metricdata.ResourceMetrics{
+		Resource:     rm.Resource,
+		ScopeMetrics: make([]metricdata.ScopeMetrics, 0, len(rm.ScopeMetrics)),
+	}
+	for _, sm := range rm.ScopeMetrics {
+		metrics := make([]metricdata.Metrics, 0, len(sm.Metrics))
+		for _, m := range sm.Metrics {
+			data, err := normalizeAggregation(m.Data)
+			if err != nil {
+				return out, fmt.Errorf("metric %q: %w", m.Name, err)
+			}
+			m.Data = data
+			metrics = append(metrics, m)
+		}
+		sort.SliceStable(metrics, func(i, j int) bool {
+			return metrics[i].Name < metrics[j].Name
+		})
+		out.ScopeMetrics = append(out.ScopeMetrics, metricdata.ScopeMetrics{
+			Scope:   sm.Scope,
+			Metrics: metrics,
+		})
+	}
+	sort.SliceStable(out.ScopeMetrics, func(i, j int) bool {
+		a, b := out.ScopeMetrics[i].Scope, out.ScopeMetrics[j].Scope
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.Version != b.Version {
+			return a.Version < b.Version
+		}
+		return a.SchemaURL < b.SchemaURL
+	})
+	return out, nil
+}
+
+func normalizeAggregation(agg metricdata.Aggregation) (metricdata.Aggregation, error) {
+	switch a := agg.(type) {
+	case nil:
+		return nil, nil
+	case metricdata.Gauge[int64]:
+		a.DataPoints = normalizeDataPoints(a.DataPoints)
+		return a, nil
+	case metricdata.Gauge[float64]:
+		a.DataPoints = normalizeDataPoints(a.DataPoints)
+		return a, nil
+	case metricdata.Sum[int64]:
+		a.DataPoints = normalizeDataPoints(a.DataPoints)
+		return a, nil
+	case metricdata.Sum[float64]:
+		a.DataPoints = normalizeDataPoints(a.DataPoints)
+		return a, nil
+	case metricdata.Histogram:
+		dPts := make([]metricdata.HistogramDataPoint, len(a.DataPoints))
+		for i, dp := range a.DataPoints {
+			dp.StartTime, dp.Time = time.Time{}, time.Time{}
+			dp.Exemplars = normalizeExemplars(dp.Exemplars)
+			dPts[i] = dp
+		}
+		sortByAttributes(dPts, func(dp metricdata.HistogramDataPoint) attribute.Set { return dp.Attributes })
+		a.DataPoints = dPts
+		return a, nil
+	case metricdata.ExponentialHistogram:
+		dPts := make([]metricdata.ExponentialHistogramDataPoint, len(a.DataPoints))
+		for i, dp := range a.DataPoints {
+			dp.StartTime, dp.Time = time.Time{}, time.Time{}
+			dp.Exemplars = normalizeExemplars(dp.Exemplars)
+			dPts[i] = dp
+		}
+		sortByAttributes(dPts, func(dp metricdata.ExponentialHistogramDataPoint) attribute.Set { return dp.Attributes })
+		a.DataPoints = dPts
+		return a, nil
+	case metricdata.Summary:
+		dPts := make([]metricdata.SummaryDataPoint, len(a.DataPoints))
+		for i, dp := range a.DataPoints {
+			dp.StartTime, dp.Time = time.Time{}, time.Time{}
+			dPts[i] = dp
+		}
+		sortByAttributes(dPts, func(dp metricdata.SummaryDataPoint) attribute.Set { return dp.Attributes })
+		a.DataPoints = dPts
+		return a, nil
+	default:
+		return nil, fmt.Errorf("unknown aggregation: %T", agg)
+	}
+}
+
+func normalizeDataPoints[N int64 | float64](dPts []metricdata.DataPoint[N]) []metricdata.DataPoint[N] {
+	out := make([]metricdata.DataPoint[N], len(dPts))
+	for i, dp := range dPts {
+		dp.StartTime, dp.Time = time.Time{}, time.Time{}
+		dp.Exemplars = normalizeExemplars(dp.Exemplars)
+		out[i] = dp
+	}
+	sortByAttributes(out, func(dp metricdata.DataPoint[N]) attribute.Set { return dp.Attributes })
+	return out
+}
+
+// normalizeExemplars returns a copy of exemplars without timestamps, trace
+// IDs, or span IDs, sorted by value and then filtered attributes.
+func normalizeExemplars[N int64 | float64](exemplars []metricdata.Exemplar[N]) []metricdata.Exemplar[N] {
+	if len(exemplars) == 0 {
+		return nil
+	}
+
+	out := make([]metricdata.Exemplar[N], len(exemplars))
+	for i, e := range exemplars {
+		attrs := make([]attribute.KeyValue, len(e.FilteredAttributes))
+		copy(attrs, e.FilteredAttributes)
+		sort.SliceStable(attrs, func(i, j int) bool { return attrs[i].Key < attrs[j].Key })
+		out[i] = metricdata.Exemplar[N]{FilteredAttributes: attrs, Value: e.Value}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Value != out[j].Value {
+			return out[i].Value < out[j].Value
+		}
+		return encode(out[i].FilteredAttributes) < encode(out[j].FilteredAttributes)
+	})
+	return out
+}
+
+// encode returns the encoding of the attribute set of attrs.
+func encode(attrs []attribute.KeyValue) string {
+	set := attribute.NewSet(attrs...)
+	return set.Encoded(attribute.DefaultEncoder())
+}
+
+// sortByAttributes sorts dPts by the encoding of their attributes.
+func sortByAttributes[T any](dPts []T, attrs func(T) attribute.Set) {
+	enc := attribute.DefaultEncoder()
+	sort.SliceStable(dPts, func(i, j int) bool {
+		a, b := attrs(dPts[i]), attrs(dPts[j])
+		return a.Encoded(enc) < b.Encoded(enc)
+	})
+}
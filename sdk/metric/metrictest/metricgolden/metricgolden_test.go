@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricgolden
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func collect(t *testing.T) metricdata.ResourceMetrics {
+	t.Helper()
+
+	reader := metric.NewManualReader()
+	provider := metric.NewMeterProvider(
+		metric.WithReader(reader),
+		metric.WithResource(resource.NewSchemaless(attribute.String("service.name", "test"))),
+	)
+	ctx := context.Background()
+
+	ctr, err := provider.Meter("b").SyncInt64().Counter("requests")
+	require.NoError(t, err)
+	ctr.Add(ctx, 1, attribute.String("path", "/b"))
+	ctr.Add(ctx, 2, attribute.String("path", "/a"))
+
+	hist, err := provider.Meter("a").SyncFloat64().Histogram("latency")
+	require.NoError(t, err)
+	hist.Record(ctx, 3, attribute.String("method", "GET"), attribute.String("code", "200"))
+
+	rm, err := reader.Collect(ctx)
+	require.NoError(t, err)
+	return rm
+}
+
+func TestAssert(t *testing.T) {
+	// Metric data collected in different runs have different timestamps and
+	// ordering, they need to match the same golden file.
+	Assert(t, filepath.Join("testdata", "metrics.golden"), collect(t))
+	Assert(t, filepath.Join("testdata", "metrics.golden"), collect(t))
+}
+
+func TestMarshalNormalizes(t *testing.T) {
+	now := time.Now()
+	dPts := func(values ...int64) []metricdata.DataPoint[int64] {
+		out := make([]metricdata.DataPoint[int64], len(values))
+		for i, v := range values {
+			out[i] = metricdata.DataPoint[int64]{
+				Attributes: attribute.NewSet(attribute.Int64("value", v)),
+				StartTime:  now,
+				Time:       now.Add(time.Duration(v)),
+				Value:      v,
+				Exemplars: []metricdata.Exemplar[int64]{{
+					Time:    now,
+					Value:   v,
+					SpanID:  []byte{1},
+					TraceID: []byte{1},
+				}},
+			}
+		}
+		return out
+	}
+	rm := func(reverse bool) metricdata.ResourceMetrics {
+		values := []int64{1, 2, 3}
+		metrics := []metricdata.Metrics{
+			{Name: "a", Data: metricdata.Sum[int64]{DataPoints: dPts(values...)}},
+			{Name: "b", Data: metricdata.Gauge[int64]{DataPoints: dPts(values...)}},
+		}
+		scopes := []metricdata.ScopeMetrics{
+			{Scope: instrumentation.Scope{Name: "a"}, Metrics: metrics},
+			{Scope: instrumentation.Scope{Name: "b"}},
+		}
+		if reverse {
+			values[0], values[2] = values[2], values[0]
+			metrics[0], metrics[1] = metrics[1], metrics[0]
+			metrics[0].Data = metricdata.Gauge[int64]{DataPoints: dPts(values...)}
+			metrics[1].Data = metricdata.Sum[int64]{DataPoints: dPts(values...)}
+			scopes[0], scopes[1] = scopes[1], scopes[0]
+		}
+		return metricdata.ResourceMetrics{ScopeMetrics: scopes}
+	}
+
+	a := rm(false)
+	want, err := Marshal(a)
+	require.NoError(t, err)
+	got, err := Marshal(rm(true))
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(got))
+
+	sum := a.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+	assert.Equal(t, now, sum.DataPoints[0].StartTime, "input modified")
+	assert.NotNil(t, sum.DataPoints[0].Exemplars[0].SpanID, "input modified")
+}
+
+func TestMarshalUnknownAggregation(t *testing.T) {
+	_, err := Marshal(metricdata.ResourceMetrics{
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Metrics: []metricdata.Metrics{{Name: "unknown", Data: unknownAggregation{}}},
+		}},
+	})
+	assert.Error(t, err)
+}
+
+type unknownAggregation struct {
+	metricdata.Aggregation
+}
@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncfloat64"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
+	"go.opentelemetry.io/otel/metric/unit"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// observabilityScope is the name of the instrumentation scope a MeterProvider
+// reports metrics about its own health under.
+const observabilityScope = "otel.sdk.metrics"
+
+// instrumentKey is the attribute key of the instrument name a dropped
+// measurement was made with.
+const instrumentKey = attribute.Key("instrument")
+
+// observability holds the instruments a MeterProvider reports metrics about
+// its own health with. A nil *observability reports nothing.
+type observability struct {
+	dropped         syncint64.Counter
+	callbackErrors  syncint64.Counter
+	collectDuration syncfloat64.Histogram
+	batchSize       syncint64.Histogram
+}
+
+// newObservability returns an observability that creates its instruments
+// with m. Errors creating the instruments are passed to the global error
+// handler.
+func newObservability(m metric.Meter) *observability {
+	obs := &observability{}
+	var err error
+	handle := func(e error) {
+		if e != nil {
+			otel.Handle(e)
+		}
+	}
+
+	obs.dropped, err = m.SyncInt64().Counter(
+		"otel.sdk.metrics.measurements.dropped",
+		instrument.WithDescription("The number of measurements aggregated with the overflow attribute set because an instrument reached its cardinality limit"),
+		instrument.WithUnit(unit.Dimensionless),
+	)
+	handle(err)
+	obs.callbackErrors, err = m.SyncInt64().Counter(
+		"otel.sdk.metrics.callback.errors",
+		instrument.WithDescription("The number of callbacks that failed during a collection"),
+		instrument.WithUnit(unit.Dimensionless),
+	)
+	handle(err)
+	obs.collectDuration, err = m.SyncFloat64().Histogram(
+		"otel.sdk.metrics.collect.duration",
+		instrument.WithDescription("The time taken to collect metric data for a Reader"),
+		instrument.WithUnit(unit.Milliseconds),
+	)
+	handle(err)
+	obs.batchSize, err = m.SyncInt64().Histogram(
+		"otel.sdk.metrics.collect.batch_size",
+		instrument.WithDescription("The number of data points the SDK produced in a collection for a Reader to export"),
+		instrument.WithUnit(unit.Dimensionless),
+	)
+	handle(err)
+	return obs
+}
+
+// droppedFunc returns a function that counts a dropped measurement made with
+// the instrument named name. If o is nil, nil is returned.
+func (o *observability) droppedFunc(name string) func() {
+	if o == nil {
+		return nil
+	}
+	bound := o.dropped.Bind(instrumentKey.String(name))
+	return func() { bound.Add(context.Background(), 1) }
+}
+
+// callbackFailed counts a failed callback.
+func (o *observability) callbackFailed(ctx context.Context) {
+	if o == nil {
+		return
+	}
+	o.callbackErrors.Add(ctx, 1)
+}
+
+// collected records a collection that started at start and produced points
+// data points.
+func (o *observability) collected(ctx context.Context, start time.Time, points int) {
+	if o == nil {
+		return
+	}
+	o.collectDuration.Record(ctx, float64(time.Since(start))/float64(time.Millisecond))
+	o.batchSize.Record(ctx, int64(points))
+}
+
+// dataPoints returns the number of data points in the metric data of scopes.
+func dataPoints(scopes ...metricdata.ScopeMetrics) int {
+	var n int
+	for _, sm := range scopes {
+		for _, m := range sm.Metrics {
+			n += aggregationDataPoints(m.Data)
+		}
+	}
+	return n
+}
+
+// aggregationDataPoints returns the number of data points in agg.
+func aggregationDataPoints(agg metricdata.Aggregation) int {
+	switch a := agg.(type) {
+	case metricdata.Sum[int64]:
+		return len(a.DataPoints)
+	case metricdata.Sum[float64]:
+		return len(a.DataPoints)
+	case metricdata.Gauge[int64]:
+		return len(a.DataPoints)
+	case metricdata.Gauge[float64]:
+		return len(a.DataPoints)
+	case metricdata.Histogram:
+		return len(a.DataPoints)
+	case metricdata.ExponentialHistogram:
+		return len(a.DataPoints)
+	}
+	return 0
+}
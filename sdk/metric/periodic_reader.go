@@ -16,7 +16,9 @@ package metric // import "go.opentelemetry.io/otel/sdk/metric"
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -26,6 +28,7 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/view"
+	"go.opentelemetry.io/otel/sdk/resource"
 )
 
 // Default periodic reader timing.
@@ -37,19 +40,28 @@ const (
 // periodicReaderConfig contains configuration options for a PeriodicReader.
 type periodicReaderConfig struct {
 	interval            time.Duration
+	jitter              float64
 	timeout             time.Duration
 	temporalitySelector TemporalitySelector
 	aggregationSelector AggregationSelector
+	cardinalityLimit    int
+	callbackTimeout     time.Duration
+	shutdownTimeout     time.Duration
+	producers           []Producer
+	resourceTransform   func(*resource.Resource) *resource.Resource
+	exportErrorHandler  func(error, metricdata.ResourceMetrics)
+	exportRetries       int
+	exportRetryBackoff  time.Duration
+	memoryReuse         bool
+	streamingExport     bool
 }
 
 // newPeriodicReaderConfig returns a periodicReaderConfig configured with
 // options.
 func newPeriodicReaderConfig(options []PeriodicReaderOption) periodicReaderConfig {
 	c := periodicReaderConfig{
-		interval:            defaultInterval,
-		timeout:             defaultTimeout,
-		temporalitySelector: DefaultTemporalitySelector,
-		aggregationSelector: DefaultAggregationSelector,
+		interval: envDuration(envInterval, defaultInterval),
+		timeout:  envDuration(envTimeout, defaultTimeout),
 	}
 	for _, o := range options {
 		c = o.applyPeriodic(c)
@@ -73,8 +85,12 @@ func (o periodicReaderOptionFunc) applyPeriodic(conf periodicReaderConfig) perio
 // WithTimeout configures the time a PeriodicReader waits for an export to
 // complete before canceling it.
 //
-// If this option is not used or d is less than or equal to zero, 30 seconds
-// is used as the default.
+// This option overrides any value set for the OTEL_METRIC_EXPORT_TIMEOUT
+// environment variable.
+//
+// If this option is not used or d is less than or equal to zero, the
+// OTEL_METRIC_EXPORT_TIMEOUT environment variable, in milliseconds, is used.
+// If that is not set or is invalid, 30 seconds is used as the default.
 func WithTimeout(d time.Duration) PeriodicReaderOption {
 	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
 		if d <= 0 {
@@ -88,8 +104,12 @@ func WithTimeout(d time.Duration) PeriodicReaderOption {
 // WithInterval configures the intervening time between exports for a
 // PeriodicReader.
 //
-// If this option is not used or d is less than or equal to zero, 60 seconds
-// is used as the default.
+// This option overrides any value set for the OTEL_METRIC_EXPORT_INTERVAL
+// environment variable.
+//
+// If this option is not used or d is less than or equal to zero, the
+// OTEL_METRIC_EXPORT_INTERVAL environment variable, in milliseconds, is used.
+// If that is not set or is invalid, 60 seconds is used as the default.
 func WithInterval(d time.Duration) PeriodicReaderOption {
 	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
 		if d <= 0 {
@@ -100,27 +120,148 @@ func WithInterval(d time.Duration) PeriodicReaderOption {
 	})
 }
 
+// WithJitter configures a PeriodicReader to randomize the time between
+// exports within ±fraction of the interval. This spreads the exports of many
+// processes started at the same time so they do not all reach the receiving
+// endpoint at once. For example, a fraction of 0.1 with a 60 second interval
+// will export every 54 to 66 seconds.
+//
+// If this option is not used or fraction is not greater than zero and less
+// than one, no jitter is applied.
+func WithJitter(fraction float64) PeriodicReaderOption {
+	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
+		if fraction <= 0 || fraction >= 1 {
+			return conf
+		}
+		conf.jitter = fraction
+		return conf
+	})
+}
+
+// WithExportErrorHandler configures a PeriodicReader to call h with the error
+// and the metric data of every export that fails. The handler is called once
+// all retries configured with WithExportRetry have failed and the data is
+// dropped. This allows failures of the export pipeline to be counted or
+// routed, it does not change how the error is otherwise reported: periodic
+// export errors are still passed to the global error handler and the errors
+// of ForceFlush and Shutdown are still returned.
+//
+// The handler is called synchronously from the export path and should not
+// block. If this option is not used or h is nil, no handler is called.
+func WithExportErrorHandler(h func(error, metricdata.ResourceMetrics)) PeriodicReaderOption {
+	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
+		conf.exportErrorHandler = h
+		return conf
+	})
+}
+
+// WithExportRetry configures a PeriodicReader to retry a failed export of the
+// same metric data up to maxRetries times, waiting backoff between attempts,
+// before the data is dropped. Each attempt is bound by the timeout set with
+// WithTimeout. Retries stop early if the reader is shutdown.
+//
+// If this option is not used or maxRetries is less than or equal to zero,
+// failed exports are not retried. A negative backoff is treated as zero.
+func WithExportRetry(maxRetries int, backoff time.Duration) PeriodicReaderOption {
+	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
+		if maxRetries <= 0 {
+			return conf
+		}
+		if backoff < 0 {
+			backoff = 0
+		}
+		conf.exportRetries = maxRetries
+		conf.exportRetryBackoff = backoff
+		return conf
+	})
+}
+
+// WithMemoryReuse configures a PeriodicReader to reuse the memory of the
+// metric data it exports in the following exports. The backing arrays of the
+// ScopeMetrics of the exported ResourceMetrics, the Metrics of each
+// ScopeMetrics, and the data points of sum, gauge, and explicit bucket
+// histogram aggregations, are reused instead of being allocated every
+// interval. This reduces the allocations of a PeriodicReader once it reaches
+// a steady state.
+//
+// This option must only be used with an exporter that does not retain the
+// metric data passed to its Export method after it returns. The same applies
+// to the metric data passed to a handler set with WithExportErrorHandler.
+//
+// The metric data returned from the Collect method of the PeriodicReader is
+// never reused.
+func WithMemoryReuse() PeriodicReaderOption {
+	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
+		conf.memoryReuse = true
+		return conf
+	})
+}
+
+// WithStreamingExport configures a PeriodicReader to export the metric data
+// it collects one instrumentation scope at a time, as it is collected, using
+// the ExportScopeMetrics method of its exporter. This bounds the metric data
+// held in memory during an export to that of a single scope. Each scope is
+// exported, and retried, within the timeout of the PeriodicReader.
+//
+// The metric data of a scope that fails to export is passed to a handler set
+// with WithExportErrorHandler. The remaining scopes are still exported.
+//
+// This option has no effect if the exporter of the PeriodicReader does not
+// implement ScopeExporter.
+func WithStreamingExport() PeriodicReaderOption {
+	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
+		conf.streamingExport = true
+		return conf
+	})
+}
+
 // NewPeriodicReader returns a Reader that collects and exports metric data to
 // the exporter at a defined interval. By default, the returned Reader will
 // collect and export data every 60 seconds, and will cancel export attempts
 // that exceed 30 seconds. The export time is not counted towards the interval
 // between attempts.
 //
+// The Temporality and default Aggregation of the metric data produced by the
+// returned Reader are determined by the exporter unless the
+// WithTemporalitySelector or WithAggregationSelector options are passed.
+//
 // The Collect method of the returned Reader continues to gather and return
 // metric data to the user. It will not automatically send that data to the
 // exporter. That is left to the user to accomplish.
 func NewPeriodicReader(exporter Exporter, options ...PeriodicReaderOption) Reader {
 	conf := newPeriodicReaderConfig(options)
+	if conf.temporalitySelector == nil {
+		conf.temporalitySelector = exporter.Temporality
+	}
+	if conf.aggregationSelector == nil {
+		conf.aggregationSelector = validAggregationSelector(exporter.Aggregation)
+	}
 	ctx, cancel := context.WithCancel(context.Background())
 	r := &periodicReader{
 		timeout:  conf.timeout,
+		jitter:   conf.jitter,
 		exporter: exporter,
 		flushCh:  make(chan chan error),
 		cancel:   cancel,
 		done:     make(chan struct{}),
 
+		exportErrorHandler: conf.exportErrorHandler,
+		exportRetries:      conf.exportRetries,
+		exportRetryBackoff: conf.exportRetryBackoff,
+
 		temporalitySelector: conf.temporalitySelector,
 		aggregationSelector: conf.aggregationSelector,
+		limit:               conf.cardinalityLimit,
+		cbTimeout:           conf.callbackTimeout,
+		sdTimeout:           conf.shutdownTimeout,
+		externalProducers:   conf.producers,
+		resTransform:        conf.resourceTransform,
+	}
+	if conf.memoryReuse {
+		r.rm = new(metricdata.ResourceMetrics)
+	}
+	if se, ok := exporter.(ScopeExporter); ok && conf.streamingExport {
+		r.scopeExporter = se
 	}
 
 	go func() {
@@ -137,11 +278,28 @@ type periodicReader struct {
 	producer atomic.Value
 
 	timeout  time.Duration
+	jitter   float64
 	exporter Exporter
 	flushCh  chan chan error
 
+	exportErrorHandler func(error, metricdata.ResourceMetrics)
+	exportRetries      int
+	exportRetryBackoff time.Duration
+
+	// rm holds the metric data reused across exports. It is nil if memory is
+	// not reused.
+	rm *metricdata.ResourceMetrics
+	// scopeExporter is the exporter of the reader if the metric data is
+	// streamed to it. It is nil if the metric data is not streamed.
+	scopeExporter ScopeExporter
+
 	temporalitySelector TemporalitySelector
 	aggregationSelector AggregationSelector
+	limit               int
+	cbTimeout           time.Duration
+	sdTimeout           time.Duration
+	externalProducers   []Producer
+	resTransform        func(*resource.Resource) *resource.Resource
 
 	done         chan struct{}
 	cancel       context.CancelFunc
@@ -154,10 +312,13 @@ var _ = map[Reader]struct{}{&periodicReader{}: {}}
 // newTicker allows testing override.
 var newTicker = time.NewTicker
 
+// randFloat64 allows testing override.
+var randFloat64 = rand.Float64
+
 // run continuously collects and exports metric data at the specified
 // interval. This will run until ctx is canceled or times out.
 func (r *periodicReader) run(ctx context.Context, interval time.Duration) {
-	ticker := newTicker(interval)
+	ticker := newTicker(r.jittered(interval))
 	defer ticker.Stop()
 
 	for {
@@ -167,19 +328,34 @@ func (r *periodicReader) run(ctx context.Context, interval time.Duration) {
 			if err != nil {
 				otel.Handle(err)
 			}
+			if r.jitter > 0 {
+				ticker.Reset(r.jittered(interval))
+			}
 		case errCh := <-r.flushCh:
 			errCh <- r.collectAndExport(ctx)
-			ticker.Reset(interval)
+			ticker.Reset(r.jittered(interval))
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
+// jittered returns interval randomized within ±r.jitter of its value.
+func (r *periodicReader) jittered(interval time.Duration) time.Duration {
+	if r.jitter <= 0 {
+		return interval
+	}
+	delta := time.Duration(r.jitter * float64(interval) * (2*randFloat64() - 1))
+	if d := interval + delta; d > 0 {
+		return d
+	}
+	return interval
+}
+
 // register registers p as the producer of this reader.
 func (r *periodicReader) register(p producer) {
 	// Only register once. If producer is already set, do nothing.
-	if !r.producer.CompareAndSwap(nil, produceHolder{produce: p.produce}) {
+	if !r.producer.CompareAndSwap(nil, newProduceHolder(p)) {
 		msg := "did not register periodic reader"
 		global.Error(errDuplicateRegister, msg)
 	}
@@ -195,29 +371,110 @@ func (r *periodicReader) aggregation(kind view.InstrumentKind) aggregation.Aggre
 	return r.aggregationSelector(kind)
 }
 
+// cardinalityLimit returns the maximum number of attribute sets an instrument
+// aggregates per collection cycle.
+func (r *periodicReader) cardinalityLimit() int {
+	return r.limit
+}
+
+// callbackTimeout returns the time each callback is given to complete during
+// a collection.
+func (r *periodicReader) callbackTimeout() time.Duration {
+	return r.cbTimeout
+}
+
+// shutdownTimeout returns the time the reader is given to complete each of
+// its final flush and shutdown when a MeterProvider is shut down.
+func (r *periodicReader) shutdownTimeout() time.Duration {
+	return r.sdTimeout
+}
+
+// transformResource returns the Resource the reader reports metric data with
+// in place of res.
+func (r *periodicReader) transformResource(res *resource.Resource) *resource.Resource {
+	return transformResource(r.resTransform, res)
+}
+
 // collectAndExport gather all metric data related to the periodicReader r from
 // the SDK and exports it with r's exporter.
 func (r *periodicReader) collectAndExport(ctx context.Context) error {
-	m, err := r.Collect(ctx)
-	if err == nil {
-		err = r.export(ctx, m)
+	return r.collectAndExportWith(ctx, r.producer.Load())
+}
+
+// collectAndExportWith collects all metric data produced by p and exports it
+// with r's exporter.
+func (r *periodicReader) collectAndExportWith(ctx context.Context, p interface{}) error {
+	if r.scopeExporter != nil {
+		return r.streamAndExport(ctx, p)
+	}
+
+	// The run loop, and Shutdown after it has stopped, are the only callers.
+	// Therefore, r.rm is never used concurrently.
+	rm := r.rm
+	if rm == nil {
+		rm = new(metricdata.ResourceMetrics)
+	}
+	err := r.collect(ctx, p, rm)
+	return r.exportCollected(ctx, *rm, err)
+}
+
+// exportCollected exports m, the metric data collected along with err. The
+// metric data is still exported if err is only the failure of an external
+// Producer, in which case err is returned if the export succeeds.
+func (r *periodicReader) exportCollected(ctx context.Context, m metricdata.ResourceMetrics, err error) error {
+	if err != nil && !errors.Is(err, errExternalProducer) {
+		return err
+	}
+	if eErr := r.export(ctx, m); eErr != nil {
+		return eErr
 	}
 	return err
 }
 
 // Collect gathers and returns all metric data related to the Reader from
-// the SDK. The returned metric data is not exported to the configured
-// exporter, it is left to the caller to handle that if desired.
+// the SDK and any external Producers of the Reader. The returned metric data
+// is not exported to the configured exporter, it is left to the caller to
+// handle that if desired.
 //
 // An error is returned if this is called after Shutdown.
 func (r *periodicReader) Collect(ctx context.Context) (metricdata.ResourceMetrics, error) {
-	return r.collect(ctx, r.producer.Load())
+	var rm metricdata.ResourceMetrics
+	err := r.collect(ctx, r.producer.Load(), &rm)
+	return rm, err
+}
+
+// CollectStream gathers all metric data related to the Reader from the SDK
+// and any external Producers of the Reader, the same as Collect. Instead of
+// returning it, fn is called with the metric data of each instrumentation
+// scope as it is gathered. The metric data is not exported to the configured
+// exporter, it is left to the caller to handle that if desired.
+//
+// An error is returned if this is called after Shutdown.
+func (r *periodicReader) CollectStream(ctx context.Context, fn func(*resource.Resource, metricdata.ScopeMetrics) error) error {
+	ph, err := r.produceHolder(r.producer.Load())
+	if err != nil {
+		return err
+	}
+	return ph.produceStream(ctx, r.externalProducers, fn)
+}
+
+// collect unwraps p as a produceHolder and stores its produce results in rm.
+func (r *periodicReader) collect(ctx context.Context, p interface{}, rm *metricdata.ResourceMetrics) error {
+	ph, err := r.produceHolder(p)
+	if err != nil {
+		return err
+	}
+
+	if err := ph.produce(ctx, rm); err != nil {
+		return err
+	}
+	return produceExternal(ctx, r.externalProducers, rm)
 }
 
-// collect unwraps p as a produceHolder and returns its produce results.
-func (r *periodicReader) collect(ctx context.Context, p interface{}) (metricdata.ResourceMetrics, error) {
+// produceHolder unwraps p as a produceHolder.
+func (r *periodicReader) produceHolder(p interface{}) (produceHolder, error) {
 	if p == nil {
-		return metricdata.ResourceMetrics{}, ErrReaderNotRegistered
+		return produceHolder{}, ErrReaderNotRegistered
 	}
 
 	ph, ok := p.(produceHolder)
@@ -226,17 +483,92 @@ func (r *periodicReader) collect(ctx context.Context, p interface{}) (metricdata
 		// this should never happen. In the unforeseen case that this does
 		// happen, return an error instead of panicking so a users code does
 		// not halt in the processes.
-		err := fmt.Errorf("periodic reader: invalid producer: %T", p)
-		return metricdata.ResourceMetrics{}, err
+		return produceHolder{}, fmt.Errorf("periodic reader: invalid producer: %T", p)
 	}
-	return ph.produce(ctx)
+	return ph, nil
 }
 
-// export exports metric data m using r's exporter.
+// streamAndExport exports the metric data produced by p with r's scope
+// exporter one instrumentation scope at a time. A scope that fails to export
+// does not stop the export of the remaining scopes, the first export error is
+// returned after all scopes are exported.
+func (r *periodicReader) streamAndExport(ctx context.Context, p interface{}) error {
+	ph, err := r.produceHolder(p)
+	if err != nil {
+		return err
+	}
+
+	var exportErr error
+	err = ph.produceStream(ctx, r.externalProducers, func(res *resource.Resource, sm metricdata.ScopeMetrics) error {
+		if err := r.exportScope(ctx, res, sm); err != nil && exportErr == nil {
+			exportErr = err
+		}
+		return nil
+	})
+	if exportErr != nil {
+		return exportErr
+	}
+	return err
+}
+
+// export exports metric data m using r's exporter. A failed export is retried
+// as configured for r, and if it still fails the export error handler of r is
+// called before the error is returned.
 func (r *periodicReader) export(ctx context.Context, m metricdata.ResourceMetrics) error {
+	return r.exportWith(ctx, m, func(c context.Context) error {
+		return r.exporter.Export(c, m)
+	})
+}
+
+// exportScope exports the metric data sm of a single instrumentation scope,
+// produced for res, using r's scope exporter. A failed export is handled the
+// same as one made by export.
+func (r *periodicReader) exportScope(ctx context.Context, res *resource.Resource, sm metricdata.ScopeMetrics) error {
+	m := metricdata.ResourceMetrics{
+		Resource:     res,
+		ScopeMetrics: []metricdata.ScopeMetrics{sm},
+	}
+	return r.exportWith(ctx, m, func(c context.Context) error {
+		return r.scopeExporter.ExportScopeMetrics(c, res, sm)
+	})
+}
+
+// exportWith exports metric data m by calling exportFn. A failed export is
+// retried as configured for r, and if it still fails the export error handler
+// of r is called with m before the error is returned.
+func (r *periodicReader) exportWith(ctx context.Context, m metricdata.ResourceMetrics, exportFn func(context.Context) error) error {
+	err := r.exportOnce(ctx, exportFn)
+	for i := 0; err != nil && i < r.exportRetries; i++ {
+		timer := time.NewTimer(r.exportRetryBackoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			r.handleExportError(err, m)
+			return err
+		}
+		err = r.exportOnce(ctx, exportFn)
+	}
+	if err != nil {
+		r.handleExportError(err, m)
+	}
+	return err
+}
+
+// exportOnce makes a single attempt to export by calling exportFn within the
+// timeout of r.
+func (r *periodicReader) exportOnce(ctx context.Context, exportFn func(context.Context) error) error {
 	c, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
-	return r.exporter.Export(c, m)
+	return exportFn(c)
+}
+
+// handleExportError passes err and the dropped metric data m to the export
+// error handler of r, if one is configured.
+func (r *periodicReader) handleExportError(err error, m metricdata.ResourceMetrics) {
+	if r.exportErrorHandler != nil {
+		r.exportErrorHandler(err, m)
+	}
 }
 
 // ForceFlush flushes pending telemetry.
@@ -270,17 +602,11 @@ func (r *periodicReader) Shutdown(ctx context.Context) error {
 		<-r.done
 
 		// Any future call to Collect will now return ErrReaderShutdown.
-		ph := r.producer.Swap(produceHolder{
-			produce: shutdownProducer{}.produce,
-		})
+		ph := r.producer.Swap(newProduceHolder(shutdownProducer{}))
 
 		if ph != nil { // Reader was registered.
 			// Flush pending telemetry.
-			var m metricdata.ResourceMetrics
-			m, err = r.collect(ctx, ph)
-			if err == nil {
-				err = r.export(ctx, m)
-			}
+			err = r.collectAndExportWith(ctx, ph)
 		}
 
 		sErr := r.exporter.Shutdown(ctx)
@@ -20,11 +20,14 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/view"
+	"go.opentelemetry.io/otel/sdk/resource"
 )
 
 const testDur = time.Second * 2
@@ -53,14 +56,82 @@ func TestWithInterval(t *testing.T) {
 	assert.Equal(t, defaultInterval, test(time.Duration(-1)), "invalid interval should use default")
 }
 
+func TestWithJitter(t *testing.T) {
+	test := func(fraction float64) float64 {
+		opts := []PeriodicReaderOption{WithJitter(fraction)}
+		return newPeriodicReaderConfig(opts).jitter
+	}
+
+	assert.Equal(t, 0.1, test(0.1))
+	assert.Equal(t, 0.0, newPeriodicReaderConfig(nil).jitter)
+	assert.Equal(t, 0.0, test(0), "invalid jitter should be ignored")
+	assert.Equal(t, 0.0, test(-0.1), "invalid jitter should be ignored")
+	assert.Equal(t, 0.0, test(1), "invalid jitter should be ignored")
+}
+
+func TestPeriodicReaderJittered(t *testing.T) {
+	orig := randFloat64
+	t.Cleanup(func() { randFloat64 = orig })
+
+	r := &periodicReader{jitter: 0.1}
+	tests := []struct {
+		rand float64
+		want time.Duration
+	}{
+		{rand: 0, want: 54 * time.Second},
+		{rand: 0.5, want: 60 * time.Second},
+		{rand: 0.75, want: 63 * time.Second},
+	}
+	for _, tt := range tests {
+		randFloat64 = func() float64 { return tt.rand }
+		assert.Equal(t, tt.want, r.jittered(time.Minute), "rand: %v", tt.rand)
+	}
+
+	r.jitter = 0
+	assert.Equal(t, time.Minute, r.jittered(time.Minute), "no jitter")
+}
+
+func TestPeriodicReaderRunJitter(t *testing.T) {
+	origRand, origTicker := randFloat64, newTicker
+	t.Cleanup(func() { randFloat64, newTicker = origRand, origTicker })
+
+	randFloat64 = func() float64 { return 0 }
+	got := make(chan time.Duration, 1)
+	newTicker = func(d time.Duration) *time.Ticker {
+		got <- d
+		return time.NewTicker(d)
+	}
+
+	exp := new(fnExporter)
+	r := NewPeriodicReader(exp, WithInterval(time.Minute), WithJitter(0.5))
+	assert.Equal(t, 30*time.Second, <-got)
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
+
 type fnExporter struct {
-	exportFunc   func(context.Context, metricdata.ResourceMetrics) error
-	flushFunc    func(context.Context) error
-	shutdownFunc func(context.Context) error
+	temporalityFunc TemporalitySelector
+	aggregationFunc AggregationSelector
+	exportFunc      func(context.Context, metricdata.ResourceMetrics) error
+	flushFunc       func(context.Context) error
+	shutdownFunc    func(context.Context) error
 }
 
 var _ Exporter = (*fnExporter)(nil)
 
+func (e *fnExporter) Temporality(k view.InstrumentKind) metricdata.Temporality {
+	if e.temporalityFunc != nil {
+		return e.temporalityFunc(k)
+	}
+	return DefaultTemporalitySelector(k)
+}
+
+func (e *fnExporter) Aggregation(k view.InstrumentKind) aggregation.Aggregation {
+	if e.aggregationFunc != nil {
+		return e.aggregationFunc(k)
+	}
+	return DefaultAggregationSelector(k)
+}
+
 func (e *fnExporter) Export(ctx context.Context, m metricdata.ResourceMetrics) error {
 	if e.exportFunc != nil {
 		return e.exportFunc(ctx, m)
@@ -222,6 +293,251 @@ func TestPeriodicReaderFlushesPending(t *testing.T) {
 	})
 }
 
+func TestWithExportRetry(t *testing.T) {
+	test := func(n int, d time.Duration) (int, time.Duration) {
+		c := newPeriodicReaderConfig([]PeriodicReaderOption{WithExportRetry(n, d)})
+		return c.exportRetries, c.exportRetryBackoff
+	}
+
+	n, d := test(3, time.Second)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, time.Second, d)
+
+	n, d = test(3, -time.Second)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, time.Duration(0), d, "negative backoff should be zero")
+
+	n, _ = test(0, time.Second)
+	assert.Equal(t, 0, n, "invalid retries should be ignored")
+	n, _ = test(-1, time.Second)
+	assert.Equal(t, 0, n, "invalid retries should be ignored")
+}
+
+func TestPeriodicReaderExportRetry(t *testing.T) {
+	newExporter := func(failures int) (*fnExporter, *int) {
+		calls := new(int)
+		return &fnExporter{
+			exportFunc: func(context.Context, metricdata.ResourceMetrics) error {
+				*calls++
+				if *calls <= failures {
+					return assert.AnError
+				}
+				return nil
+			},
+		}, calls
+	}
+
+	t.Run("Recovers", func(t *testing.T) {
+		exp, calls := newExporter(2)
+		var handled bool
+		r := NewPeriodicReader(
+			exp,
+			WithExportRetry(2, 0),
+			WithExportErrorHandler(func(error, metricdata.ResourceMetrics) { handled = true }),
+		)
+		r.register(testProducer{})
+		assert.NoError(t, r.ForceFlush(context.Background()))
+		assert.Equal(t, 3, *calls)
+		assert.False(t, handled, "export error handler called for recovered export")
+		_ = r.Shutdown(context.Background())
+	})
+
+	t.Run("Bounded", func(t *testing.T) {
+		exp, calls := newExporter(10)
+		var (
+			handledErr error
+			handledRM  metricdata.ResourceMetrics
+		)
+		r := NewPeriodicReader(
+			exp,
+			WithExportRetry(2, 0),
+			WithExportErrorHandler(func(err error, rm metricdata.ResourceMetrics) {
+				handledErr, handledRM = err, rm
+			}),
+		)
+		r.register(testProducer{})
+		assert.ErrorIs(t, r.ForceFlush(context.Background()), assert.AnError)
+		assert.Equal(t, 3, *calls)
+		assert.ErrorIs(t, handledErr, assert.AnError)
+		assert.Equal(t, testMetrics, handledRM)
+		_ = r.Shutdown(context.Background())
+	})
+
+	t.Run("CanceledBackoff", func(t *testing.T) {
+		exp, calls := newExporter(10)
+		r := &periodicReader{
+			exporter:           exp,
+			timeout:            defaultTimeout,
+			exportRetries:      2,
+			exportRetryBackoff: time.Hour,
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, r.export(ctx, testMetrics), assert.AnError)
+		assert.Equal(t, 1, *calls)
+	})
+}
+
+func TestPeriodicReaderExportErrorHandler(t *testing.T) {
+	trigger := triggerTicker(t)
+
+	defer func(orig otel.ErrorHandler) {
+		otel.SetErrorHandler(orig)
+	}(otel.GetErrorHandler())
+	eh := newChErrorHandler()
+	otel.SetErrorHandler(eh)
+
+	exp := &fnExporter{
+		exportFunc: func(context.Context, metricdata.ResourceMetrics) error {
+			return assert.AnError
+		},
+	}
+
+	handled := make(chan metricdata.ResourceMetrics, 1)
+	r := NewPeriodicReader(exp, WithExportErrorHandler(func(err error, rm metricdata.ResourceMetrics) {
+		assert.Equal(t, assert.AnError, err)
+		handled <- rm
+	}))
+	r.register(testProducer{})
+	trigger <- time.Now()
+	assert.Equal(t, testMetrics, <-handled)
+	assert.Equal(t, assert.AnError, <-eh.Err, "error not passed to global handler")
+
+	// Ensure Reader is allowed clean up attempt.
+	_ = r.Shutdown(context.Background())
+}
+
+func TestPeriodicReaderMemoryReuse(t *testing.T) {
+	test := func(reuse bool) func(*testing.T) {
+		return func(t *testing.T) {
+			var exported []*metricdata.ScopeMetrics
+			exp := &fnExporter{
+				exportFunc: func(_ context.Context, m metricdata.ResourceMetrics) error {
+					require.NotEmpty(t, m.ScopeMetrics)
+					exported = append(exported, &m.ScopeMetrics[0])
+					return nil
+				},
+			}
+
+			opts := []PeriodicReaderOption{WithProducer(testExternalProducer{})}
+			if reuse {
+				opts = append(opts, WithMemoryReuse())
+			}
+			r := NewPeriodicReader(exp, opts...)
+			mp := NewMeterProvider(WithReader(r))
+			t.Cleanup(func() { _ = mp.Shutdown(context.Background()) })
+
+			ctr, err := mp.Meter("TestPeriodicReaderMemoryReuse").SyncInt64().Counter("counter")
+			require.NoError(t, err)
+			ctr.Add(context.Background(), 1)
+
+			extern := testExternalMetrics[0].Metrics[0]
+			require.NoError(t, r.ForceFlush(context.Background()))
+
+			// Produce another scope so the SDK uses the memory that held the
+			// external metrics in the previous export.
+			ctr, err = mp.Meter("TestPeriodicReaderMemoryReuse/2").SyncInt64().Counter("counter")
+			require.NoError(t, err)
+			ctr.Add(context.Background(), 1)
+			require.NoError(t, r.ForceFlush(context.Background()))
+			require.NoError(t, r.ForceFlush(context.Background()))
+			require.Len(t, exported, 3)
+			if reuse {
+				assert.Same(t, exported[1], exported[2], "ScopeMetrics not reused")
+			} else {
+				assert.NotSame(t, exported[1], exported[2], "ScopeMetrics reused")
+			}
+			assert.Equal(t, extern, testExternalMetrics[0].Metrics[0], "external metrics modified")
+
+			// Collect never reuses the exported memory.
+			rm, err := r.Collect(context.Background())
+			require.NoError(t, err)
+			require.Len(t, rm.ScopeMetrics, 3)
+			assert.NotSame(t, exported[2], &rm.ScopeMetrics[0])
+		}
+	}
+
+	t.Run("Reuse", test(true))
+	t.Run("NoReuse", test(false))
+}
+
+type fnScopeExporter struct {
+	*fnExporter
+
+	exportScopeFunc func(context.Context, *resource.Resource, metricdata.ScopeMetrics) error
+}
+
+var _ ScopeExporter = fnScopeExporter{}
+
+func (e fnScopeExporter) ExportScopeMetrics(ctx context.Context, res *resource.Resource, sm metricdata.ScopeMetrics) error {
+	if e.exportScopeFunc != nil {
+		return e.exportScopeFunc(ctx, res, sm)
+	}
+	return nil
+}
+
+func TestPeriodicReaderStreamingExport(t *testing.T) {
+	var exportCalls int
+	var streamed []metricdata.ScopeMetrics
+	exp := fnScopeExporter{
+		fnExporter: &fnExporter{
+			exportFunc: func(context.Context, metricdata.ResourceMetrics) error {
+				exportCalls++
+				return nil
+			},
+		},
+		exportScopeFunc: func(_ context.Context, res *resource.Resource, sm metricdata.ScopeMetrics) error {
+			assert.Equal(t, testMetrics.Resource, res)
+			streamed = append(streamed, sm)
+			if sm.Scope == testExternalMetrics[0].Scope {
+				return assert.AnError
+			}
+			return nil
+		},
+	}
+
+	var handled []metricdata.ResourceMetrics
+	r := NewPeriodicReader(
+		exp,
+		WithStreamingExport(),
+		WithProducer(testExternalProducer{}),
+		WithExportErrorHandler(func(_ error, rm metricdata.ResourceMetrics) {
+			handled = append(handled, rm)
+		}),
+	)
+	r.register(testProducer{})
+	t.Cleanup(func() { _ = r.Shutdown(context.Background()) })
+
+	assert.ErrorIs(t, r.ForceFlush(context.Background()), assert.AnError)
+	assert.Equal(t, 0, exportCalls, "Export called when streaming")
+	assert.Equal(t, append(testMetrics.ScopeMetrics, testExternalMetrics...), streamed)
+	assert.Equal(t, []metricdata.ResourceMetrics{{
+		Resource:     testMetrics.Resource,
+		ScopeMetrics: testExternalMetrics,
+	}}, handled, "failed scope not passed to the export error handler")
+
+	t.Run("NotStreaming", func(t *testing.T) {
+		exportCalls, streamed = 0, nil
+		r := NewPeriodicReader(exp)
+		r.register(testProducer{})
+		t.Cleanup(func() { _ = r.Shutdown(context.Background()) })
+
+		assert.NoError(t, r.ForceFlush(context.Background()))
+		assert.Equal(t, 1, exportCalls)
+		assert.Empty(t, streamed, "streamed without WithStreamingExport")
+	})
+
+	t.Run("NotScopeExporter", func(t *testing.T) {
+		exportCalls = 0
+		r := NewPeriodicReader(exp.fnExporter, WithStreamingExport())
+		r.register(testProducer{})
+		t.Cleanup(func() { _ = r.Shutdown(context.Background()) })
+
+		assert.NoError(t, r.ForceFlush(context.Background()))
+		assert.Equal(t, 1, exportCalls)
+	})
+}
+
 func BenchmarkPeriodicReader(b *testing.B) {
 	b.Run("Collect", benchReaderCollectFunc(
 		NewPeriodicReader(new(fnExporter)),
@@ -230,8 +546,9 @@ func BenchmarkPeriodicReader(b *testing.B) {
 
 func TestPeriodiclReaderTemporality(t *testing.T) {
 	tests := []struct {
-		name    string
-		options []PeriodicReaderOption
+		name     string
+		exporter *fnExporter
+		options  []PeriodicReaderOption
 		// Currently only testing constant temporality. This should be expanded
 		// if we put more advanced selection in the SDK
 		wantTemporality metricdata.Temporality
@@ -240,6 +557,19 @@ func TestPeriodiclReaderTemporality(t *testing.T) {
 			name:            "default",
 			wantTemporality: metricdata.CumulativeTemporality,
 		},
+		{
+			name:            "exporter",
+			exporter:        &fnExporter{temporalityFunc: deltaTemporalitySelector},
+			wantTemporality: metricdata.DeltaTemporality,
+		},
+		{
+			name:     "option overrides exporter",
+			exporter: &fnExporter{temporalityFunc: deltaTemporalitySelector},
+			options: []PeriodicReaderOption{
+				WithTemporalitySelector(cumulativeTemporalitySelector),
+			},
+			wantTemporality: metricdata.CumulativeTemporality,
+		},
 		{
 			name: "delta",
 			options: []PeriodicReaderOption{
@@ -260,8 +590,66 @@ func TestPeriodiclReaderTemporality(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			var undefinedInstrument view.InstrumentKind
-			rdr := NewPeriodicReader(new(fnExporter), tt.options...)
+			exp := tt.exporter
+			if exp == nil {
+				exp = new(fnExporter)
+			}
+			rdr := NewPeriodicReader(exp, tt.options...)
 			assert.Equal(t, tt.wantTemporality, rdr.temporality(undefinedInstrument))
 		})
 	}
 }
+
+func TestPeriodicReaderAggregation(t *testing.T) {
+	sumSelector := func(view.InstrumentKind) aggregation.Aggregation {
+		return aggregation.Sum{}
+	}
+	dropSelector := func(view.InstrumentKind) aggregation.Aggregation {
+		return aggregation.Drop{}
+	}
+	invalidSelector := func(view.InstrumentKind) aggregation.Aggregation {
+		return aggregation.ExplicitBucketHistogram{Boundaries: []float64{1, 0}}
+	}
+
+	tests := []struct {
+		name     string
+		exporter *fnExporter
+		options  []PeriodicReaderOption
+		want     aggregation.Aggregation
+	}{
+		{
+			name: "default",
+			want: aggregation.LastValue{},
+		},
+		{
+			name:     "exporter",
+			exporter: &fnExporter{aggregationFunc: sumSelector},
+			want:     aggregation.Sum{},
+		},
+		{
+			name:     "option overrides exporter",
+			exporter: &fnExporter{aggregationFunc: sumSelector},
+			options: []PeriodicReaderOption{
+				WithAggregationSelector(dropSelector),
+			},
+			want: aggregation.Drop{},
+		},
+		{
+			name:     "invalid exporter aggregation",
+			exporter: &fnExporter{aggregationFunc: invalidSelector},
+			want:     aggregation.LastValue{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exp := tt.exporter
+			if exp == nil {
+				exp = new(fnExporter)
+			}
+			rdr := NewPeriodicReader(exp, tt.options...)
+			t.Cleanup(func() { _ = rdr.Shutdown(context.Background()) })
+			assert.Equal(t, tt.want, rdr.aggregation(view.AsyncGauge))
+		})
+	}
+}
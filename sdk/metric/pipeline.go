@@ -20,7 +20,10 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric/unit"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
@@ -36,6 +39,7 @@ var (
 	errIncompatibleAggregation = errors.New("incompatible aggregation")
 	errUnknownAggregation      = errors.New("unrecognized aggregation")
 	errUnknownTemporality      = errors.New("unrecognized temporality")
+	errCallbackTimeout         = errors.New("callback timed out")
 )
 
 type aggregator interface {
@@ -70,13 +74,68 @@ func newPipeline(res *resource.Resource, reader Reader, views []view.View) *pipe
 // views of a the Reader, and if so each aggregator should be added to the pipeline.
 type pipeline struct {
 	resource *resource.Resource
+	// refresher, if not nil, provides the Resource used instead of resource.
+	refresher *resource.Refresher
 
 	reader Reader
 	views  []view.View
+	// exemplarFilter determines the measurements offered to be sampled as
+	// exemplars. If nil, no exemplars are sampled.
+	exemplarFilter ExemplarFilter
+	// sumShards is the number of shards sum aggregators accumulate
+	// measurements in.
+	sumShards int
+	// attributeFilter is applied to the attributes of all measurements
+	// before the attribute filter of a view. If nil, no attributes are
+	// filtered.
+	attributeFilter attribute.Filter
+	// clock returns the current time used to timestamp aggregations. If nil,
+	// time.Now is used.
+	clock func() time.Time
 
 	sync.Mutex
 	aggregations map[instrumentation.Scope][]instrumentSync
-	callbacks    []func(context.Context)
+	callbacks    []callback
+	// reusable holds the last Aggregation of each aggregator produced in
+	// reused memory. Their memory is reused by the next such collection.
+	reusable map[aggregator]metricdata.Aggregation
+	// obs reports metrics about the health of the pipeline. If nil, nothing
+	// is reported.
+	obs *observability
+}
+
+// setObservability sets the observability p reports metrics about its health
+// with. Only instruments created after this is called report their dropped
+// measurements.
+func (p *pipeline) setObservability(obs *observability) {
+	p.Lock()
+	defer p.Unlock()
+	p.obs = obs
+}
+
+// setResourceRefresher sets the Refresher that provides the Resource the
+// metric data p produces is reported with. The Resource is read from r each
+// time p is collected.
+func (p *pipeline) setResourceRefresher(r *resource.Refresher) {
+	p.Lock()
+	defer p.Unlock()
+	p.refresher = r
+}
+
+// setClock sets the function used to get the current time when timestamping
+// the aggregations of instruments created after this is called.
+func (p *pipeline) setClock(clock func() time.Time) {
+	p.Lock()
+	defer p.Unlock()
+	p.clock = clock
+}
+
+// observability returns the observability p reports metrics about its
+// health with.
+func (p *pipeline) observability() *observability {
+	p.Lock()
+	defer p.Unlock()
+	return p.obs
 }
 
 // addSync adds the instrumentSync to pipeline p with scope. This method is not
@@ -94,53 +153,160 @@ func (p *pipeline) addSync(scope instrumentation.Scope, iSync instrumentSync) {
 	p.aggregations[scope] = append(p.aggregations[scope], iSync)
 }
 
+// callback is a function registered with a Meter to make observations for
+// asynchronous instruments.
+type callback struct {
+	// scope is the instrumentation scope of the Meter the callback was
+	// registered with.
+	scope instrumentation.Scope
+	// instruments are the names of the instruments the callback observes.
+	instruments []string
+
+	fn func(context.Context)
+}
+
 // addCallback registers a callback to be run when `produce()` is called.
-func (p *pipeline) addCallback(callback func(context.Context)) {
+func (p *pipeline) addCallback(cb callback) {
 	p.Lock()
 	defer p.Unlock()
-	p.callbacks = append(p.callbacks, callback)
+	p.callbacks = append(p.callbacks, cb)
 }
 
 // callbackKey is a context key type used to identify context that came from the SDK.
 type callbackKey int
 
-// produceKey is the context key to tell if a Observe is called within a callback.
-// Its value of zero is arbitrary. If this package defined other context keys,
-// they would have different integer values.
-const produceKey callbackKey = 0
+const (
+	// produceKey is the context key to tell if a Observe is called within a
+	// callback. Its value of zero is arbitrary. If this package defined other
+	// context keys, they would have different integer values.
+	produceKey callbackKey = 0
+	// observationsKey is the context key of the observations made by a
+	// callback that can be abandoned.
+	observationsKey callbackKey = 1
+)
+
+// observations holds the observations made by a callback until it completes.
+type observations struct {
+	sync.Mutex
+	done bool
+	fns  []func()
+}
+
+// add holds fn, the aggregation of an observation, if the callback has not
+// already completed or been abandoned.
+func (o *observations) add(fn func()) {
+	o.Lock()
+	defer o.Unlock()
+	if !o.done {
+		o.fns = append(o.fns, fn)
+	}
+}
+
+// commit aggregates all held observations.
+func (o *observations) commit() {
+	o.Lock()
+	o.done = true
+	fns := o.fns
+	o.fns = nil
+	o.Unlock()
 
-// produce returns aggregated metrics from a single collection.
+	for _, fn := range fns {
+		fn()
+	}
+}
+
+// drop discards all held observations.
+func (o *observations) drop() {
+	o.Lock()
+	defer o.Unlock()
+	o.done = true
+	o.fns = nil
+}
+
+// runCallback calls cb with ctx. If the reader of p has a callback timeout
+// and cb does not complete within it, cb is abandoned, its observations are
+// dropped, and an error is returned.
+func (p *pipeline) runCallback(ctx context.Context, cb callback) error {
+	var timeout time.Duration
+	if p.reader != nil {
+		timeout = p.reader.callbackTimeout()
+	}
+	if timeout <= 0 {
+		cb.fn(ctx)
+		return nil
+	}
+
+	cbCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	obs := &observations{}
+	cbCtx = context.WithValue(cbCtx, observationsKey, obs)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		cb.fn(cbCtx)
+	}()
+
+	select {
+	case <-done:
+		obs.commit()
+		return nil
+	case <-cbCtx.Done():
+		obs.drop()
+		if ctx.Err() != nil {
+			// The collection itself is done, not just this callback.
+			return nil
+		}
+		return fmt.Errorf(
+			"%w after %s: scope %q, instruments %q",
+			errCallbackTimeout, timeout, cb.scope.Name, cb.instruments,
+		)
+	}
+}
+
+// produce stores aggregated metrics from a single collection in rm. The
+// backing arrays of rm.ScopeMetrics, and of the Metrics of each of its
+// elements up to its capacity, are reused to hold the collected metrics.
+//
+// If rm.ScopeMetrics has a non-zero capacity, rm is assumed to hold the
+// metrics of a previous collection no longer in use. The data points of the
+// aggregations produced in that case are reused by the next such collection.
 //
 // This method is safe to call concurrently.
-func (p *pipeline) produce(ctx context.Context) (metricdata.ResourceMetrics, error) {
+func (p *pipeline) produce(ctx context.Context, rm *metricdata.ResourceMetrics) error {
 	p.Lock()
 	defer p.Unlock()
 
+	start := time.Now()
 	ctx = context.WithValue(ctx, produceKey, struct{}{})
+	if err := p.runCallbacks(ctx); err != nil {
+		rm.Resource = nil
+		rm.ScopeMetrics = rm.ScopeMetrics[:0]
+		return err
+	}
 
-	for _, callback := range p.callbacks {
-		// TODO make the callbacks parallel. ( #3034 )
-		callback(ctx)
-		if err := ctx.Err(); err != nil {
-			// This means the context expired before we finished running callbacks.
-			return metricdata.ResourceMetrics{}, err
+	var reusable map[aggregator]metricdata.Aggregation
+	if cap(rm.ScopeMetrics) > 0 {
+		if p.reusable == nil {
+			p.reusable = make(map[aggregator]metricdata.Aggregation)
 		}
+		reusable = p.reusable
 	}
 
-	sm := make([]metricdata.ScopeMetrics, 0, len(p.aggregations))
+	sm := rm.ScopeMetrics[:0]
+	if sm == nil {
+		sm = make([]metricdata.ScopeMetrics, 0, len(p.aggregations))
+	}
 	for scope, instruments := range p.aggregations {
-		metrics := make([]metricdata.Metrics, 0, len(instruments))
-		for _, inst := range instruments {
-			data := inst.aggregator.Aggregation()
-			if data != nil {
-				metrics = append(metrics, metricdata.Metrics{
-					Name:        inst.name,
-					Description: inst.description,
-					Unit:        inst.unit,
-					Data:        data,
-				})
-			}
+		var metrics []metricdata.Metrics
+		if n := len(sm); n < cap(sm) {
+			// Reuse the Metrics of a previous collection.
+			metrics = sm[:n+1][n].Metrics[:0]
+		}
+		if metrics == nil {
+			metrics = make([]metricdata.Metrics, 0, len(instruments))
 		}
+		metrics = appendMetrics(metrics, instruments, reusable)
 		if len(metrics) > 0 {
 			sm = append(sm, metricdata.ScopeMetrics{
 				Scope:   scope,
@@ -149,10 +315,112 @@ func (p *pipeline) produce(ctx context.Context) (metricdata.ResourceMetrics, err
 		}
 	}
 
-	return metricdata.ResourceMetrics{
-		Resource:     p.resource,
-		ScopeMetrics: sm,
-	}, nil
+	rm.Resource = p.collectResource()
+	rm.ScopeMetrics = sm
+	p.obs.collected(ctx, start, dataPoints(sm...))
+	return nil
+}
+
+// produceStream calls fn with the resource of p and the aggregated metrics of
+// each instrumentation scope from a single collection, one scope at a time.
+// The metrics of producers are streamed after those of p. Only the metrics of
+// the scope being streamed are held in memory.
+//
+// Streaming stops at the first error returned by fn and that error is
+// returned. The failure of a Producer does not stop streaming, its error is
+// returned once all metrics have been streamed.
+//
+// This method is safe to call concurrently.
+func (p *pipeline) produceStream(ctx context.Context, producers []Producer, fn func(*resource.Resource, metricdata.ScopeMetrics) error) error {
+	p.Lock()
+	start := time.Now()
+	ctx = context.WithValue(ctx, produceKey, struct{}{})
+	if err := p.runCallbacks(ctx); err != nil {
+		p.Unlock()
+		return err
+	}
+	obs := p.obs
+	res := p.collectResource()
+	// Copy the instruments of each scope so the lock is not held while fn
+	// is called. Instruments added after this point are not streamed.
+	aggregations := make(map[instrumentation.Scope][]instrumentSync, len(p.aggregations))
+	for scope, instruments := range p.aggregations {
+		aggregations[scope] = instruments
+	}
+	p.Unlock()
+
+	var points int
+	for scope, instruments := range aggregations {
+		metrics := appendMetrics(make([]metricdata.Metrics, 0, len(instruments)), instruments, nil)
+		if len(metrics) == 0 {
+			continue
+		}
+		sm := metricdata.ScopeMetrics{Scope: scope, Metrics: metrics}
+		points += dataPoints(sm)
+		if err := fn(res, sm); err != nil {
+			return err
+		}
+	}
+	obs.collected(ctx, start, points)
+	return streamExternal(ctx, producers, res, fn)
+}
+
+// collectResource returns the Resource the metric data p produces is
+// reported with.
+//
+// The lock of p needs to be held when this is called.
+func (p *pipeline) collectResource() *resource.Resource {
+	res := p.resource
+	if p.refresher != nil {
+		res = p.refresher.Resource()
+	}
+	if p.reader == nil {
+		return res
+	}
+	return p.reader.transformResource(res)
+}
+
+// runCallbacks runs all callbacks registered with p. An error is returned if
+// ctx expires before all callbacks are run.
+//
+// The lock of p needs to be held when this is called.
+func (p *pipeline) runCallbacks(ctx context.Context) error {
+	for _, cb := range p.callbacks {
+		// TODO make the callbacks parallel. ( #3034 )
+		if err := p.runCallback(ctx, cb); err != nil {
+			p.obs.callbackFailed(ctx)
+			otel.Handle(err)
+		}
+		if err := ctx.Err(); err != nil {
+			// This means the context expired before we finished running callbacks.
+			return err
+		}
+	}
+	return nil
+}
+
+// appendMetrics appends the aggregated metrics of instruments to dst and
+// returns the extended slice. Instruments without any aggregated data are
+// not appended.
+//
+// If reusable is not nil, the memory of the Aggregation it holds for an
+// aggregator is reused and replaced with the new Aggregation.
+func appendMetrics(dst []metricdata.Metrics, instruments []instrumentSync, reusable map[aggregator]metricdata.Aggregation) []metricdata.Metrics {
+	for _, inst := range instruments {
+		data := internal.AggregationReuse(inst.aggregator, reusable[inst.aggregator])
+		if reusable != nil {
+			reusable[inst.aggregator] = data
+		}
+		if data != nil {
+			dst = append(dst, metricdata.Metrics{
+				Name:        inst.name,
+				Description: inst.description,
+				Unit:        inst.unit,
+				Data:        data,
+			})
+		}
+	}
+	return dst
 }
 
 // inserter facilitates inserting of new instruments into a pipeline.
@@ -186,7 +454,10 @@ func newInserter[N int64 | float64](p *pipeline, c instrumentCache[N]) *inserter
 //
 // If an instrument is determined to use a Drop aggregation, that instrument is
 // not inserted nor returned.
-func (i *inserter[N]) Instrument(inst view.Instrument, instUnit unit.Unit) ([]internal.Aggregator[N], error) {
+//
+// If boundaries is not nil, it is the bucket boundaries advised for the
+// instrument. See cachedAggregator for how they are applied.
+func (i *inserter[N]) Instrument(inst view.Instrument, instUnit unit.Unit, boundaries []float64) ([]internal.Aggregator[N], error) {
 	var (
 		matched bool
 		aggs    []internal.Aggregator[N]
@@ -203,7 +474,7 @@ func (i *inserter[N]) Instrument(inst view.Instrument, instUnit unit.Unit) ([]in
 		}
 		matched = true
 
-		agg, err := i.cachedAggregator(inst, instUnit)
+		agg, err := i.cachedAggregator(inst, instUnit, boundaries, v)
 		if err != nil {
 			errs.append(err)
 		}
@@ -223,7 +494,7 @@ func (i *inserter[N]) Instrument(inst view.Instrument, instUnit unit.Unit) ([]in
 	}
 
 	// Apply implicit default view if no explicit matched.
-	agg, err := i.cachedAggregator(inst, instUnit)
+	agg, err := i.cachedAggregator(inst, instUnit, boundaries, view.View{})
 	if err != nil {
 		errs.append(err)
 	}
@@ -245,13 +516,31 @@ func (i *inserter[N]) Instrument(inst view.Instrument, instUnit unit.Unit) ([]in
 // Aggregator for the instrument configuration will still be returned without
 // an error.
 //
+// The attribute filter and exemplar reservoir of v are applied to a newly
+// computed Aggregator.
+//
+// If inst does not define an aggregation and the reader selects an explicit
+// bucket histogram, the advised boundaries are used if they are not nil.
+//
 // If the instrument defines an unknown or incompatible aggregation, an error
 // is returned.
-func (i *inserter[N]) cachedAggregator(inst view.Instrument, u unit.Unit) (internal.Aggregator[N], error) {
+func (i *inserter[N]) cachedAggregator(inst view.Instrument, u unit.Unit, boundaries []float64, v view.View) (internal.Aggregator[N], error) {
 	switch inst.Aggregation.(type) {
 	case nil, aggregation.Default:
 		// Undefined, nil, means to use the default from the reader.
 		inst.Aggregation = i.pipeline.reader.aggregation(inst.Kind)
+		if h, ok := inst.Aggregation.(aggregation.ExplicitBucketHistogram); ok && boundaries != nil {
+			h.Boundaries = boundaries
+			if err := h.Err(); err != nil {
+				global.Error(err, "ignoring advised bucket boundaries", "instrument", inst.Name)
+			} else {
+				inst.Aggregation = h
+			}
+		}
+	}
+
+	if record, ok := v.RecordMinMax(); ok {
+		inst.Aggregation = withMinMax(inst.Aggregation, record)
 	}
 
 	if err := isAggregatorCompatible(inst.Kind, inst.Aggregation); err != nil {
@@ -261,7 +550,7 @@ func (i *inserter[N]) cachedAggregator(inst view.Instrument, u unit.Unit) (inter
 		)
 	}
 
-	id := i.instrumentID(inst, u)
+	id := i.instrumentID(inst, u, v)
 	// If there is a conflict, the specification says the view should
 	// still be applied and a warning should be logged.
 	i.logConflict(id)
@@ -273,6 +562,8 @@ func (i *inserter[N]) cachedAggregator(inst view.Instrument, u unit.Unit) (inter
 		if agg == nil { // Drop aggregator.
 			return nil, nil
 		}
+		agg = i.decorate(agg, inst, id.Temporality, v)
+		internal.SetClock(agg, i.pipeline.clock)
 		i.pipeline.addSync(inst.Scope, instrumentSync{
 			name:        inst.Name,
 			description: inst.Description,
@@ -283,6 +574,21 @@ func (i *inserter[N]) cachedAggregator(inst view.Instrument, u unit.Unit) (inter
 	})
 }
 
+// withMinMax returns agg configured to record, or not, the min and max of
+// measurements if it is a histogram aggregation. All other aggregations are
+// returned unchanged.
+func withMinMax(agg aggregation.Aggregation, record bool) aggregation.Aggregation {
+	switch a := agg.(type) {
+	case aggregation.ExplicitBucketHistogram:
+		a.NoMinMax = !record
+		return a
+	case aggregation.ExponentialBucketHistogram:
+		a.NoMinMax = !record
+		return a
+	}
+	return agg
+}
+
 // logConflict validates if an instrument with the same name as id has already
 // been created. If that instrument conflicts with id, a warning is logged.
 func (i *inserter[N]) logConflict(id instrumentID) {
@@ -303,14 +609,14 @@ func (i *inserter[N]) logConflict(id instrumentID) {
 	)
 }
 
-func (i *inserter[N]) instrumentID(vi view.Instrument, u unit.Unit) instrumentID {
+func (i *inserter[N]) instrumentID(vi view.Instrument, u unit.Unit, v view.View) instrumentID {
 	var zero N
 	id := instrumentID{
 		Name:        vi.Name,
 		Description: vi.Description,
 		Unit:        u,
 		Aggregation: fmt.Sprintf("%T", vi.Aggregation),
-		Temporality: i.pipeline.reader.temporality(vi.Kind),
+		Temporality: i.temporality(vi.Kind, v),
 		Number:      fmt.Sprintf("%T", zero),
 	}
 
@@ -322,6 +628,17 @@ func (i *inserter[N]) instrumentID(vi view.Instrument, u unit.Unit) instrumentID
 	return id
 }
 
+// temporality returns the temporality used for an instrument of kind matched
+// by v. The temporality set by v takes precedence over the one selected by
+// the reader.
+func (i *inserter[N]) temporality(kind view.InstrumentKind, v view.View) metricdata.Temporality {
+	switch t := v.Temporality(); t {
+	case metricdata.CumulativeTemporality, metricdata.DeltaTemporality:
+		return t
+	}
+	return i.pipeline.reader.temporality(kind)
+}
+
 // aggregator returns a new Aggregator matching agg, kind, temporality, and
 // monotonic. If the agg is unknown or temporality is invalid, an error is
 // returned.
@@ -330,6 +647,10 @@ func (i *inserter[N]) aggregator(agg aggregation.Aggregation, kind view.Instrume
 	case aggregation.Drop:
 		return nil, nil
 	case aggregation.LastValue:
+		if kind == view.SyncGauge && temporality == metricdata.CumulativeTemporality {
+			// A synchronous gauge value is reported until it is set again.
+			return internal.NewCumulativeLastValue[N](), nil
+		}
 		return internal.NewLastValue[N](), nil
 	case aggregation.Sum:
 		switch kind {
@@ -349,9 +670,9 @@ func (i *inserter[N]) aggregator(agg aggregation.Aggregation, kind view.Instrume
 
 		switch temporality {
 		case metricdata.CumulativeTemporality:
-			return internal.NewCumulativeSum[N](monotonic), nil
+			return internal.NewShardedCumulativeSum[N](monotonic, i.pipeline.sumShards), nil
 		case metricdata.DeltaTemporality:
-			return internal.NewDeltaSum[N](monotonic), nil
+			return internal.NewShardedDeltaSum[N](monotonic, i.pipeline.sumShards), nil
 		default:
 			return nil, fmt.Errorf("%w: %s(%d)", errUnknownTemporality, temporality.String(), temporality)
 		}
@@ -364,24 +685,104 @@ func (i *inserter[N]) aggregator(agg aggregation.Aggregation, kind view.Instrume
 		default:
 			return nil, fmt.Errorf("%w: %s(%d)", errUnknownTemporality, temporality.String(), temporality)
 		}
+	case aggregation.ExponentialBucketHistogram:
+		switch temporality {
+		case metricdata.CumulativeTemporality:
+			return internal.NewCumulativeExponentialHistogram[N](a), nil
+		case metricdata.DeltaTemporality:
+			return internal.NewDeltaExponentialHistogram[N](a), nil
+		default:
+			return nil, fmt.Errorf("%w: %s(%d)", errUnknownTemporality, temporality.String(), temporality)
+		}
+	case aggregation.Summary:
+		switch temporality {
+		case metricdata.CumulativeTemporality:
+			return internal.NewCumulativeSummary[N](a), nil
+		case metricdata.DeltaTemporality:
+			return internal.NewDeltaSummary[N](a), nil
+		default:
+			return nil, fmt.Errorf("%w: %s(%d)", errUnknownTemporality, temporality.String(), temporality)
+		}
 	}
 	return nil, errUnknownAggregation
 }
 
+// decorate returns agg wrapped with the attribute filter of the pipeline and
+// v, and the cardinality limit of v, or of the reader if v does not define
+// one. Measurements dropped by the cardinality limit are reported by the
+// observability of the pipeline. If exemplars are sampled for the instrument,
+// agg is also wrapped with an exemplar sampler using the reservoir defined by
+// v or the default reservoir for the instrument aggregation.
+//
+// Exemplars are only sampled for synchronous instruments with a sum or
+// histogram aggregation.
+//
+// The attribute processor of v is applied to measurements before any of the
+// above, and measurements it does not keep are dropped.
+func (i *inserter[N]) decorate(agg internal.Aggregator[N], inst view.Instrument, temporality metricdata.Temporality, v view.View) internal.Aggregator[N] {
+	return internal.NewProcessor(i.filterAndSample(agg, inst, temporality, v), v.AttributeProcessor())
+}
+
+// filterAndSample returns agg wrapped with the attribute filters, cardinality
+// limit, and exemplar sampler described by decorate.
+func (i *inserter[N]) filterAndSample(agg internal.Aggregator[N], inst view.Instrument, temporality metricdata.Temporality, v view.View) internal.Aggregator[N] {
+	fltr, sample := i.pipeline.filterAttributes(v), i.pipeline.exemplarFilter
+	overflow := i.pipeline.observability().droppedFunc(inst.Name)
+	limit := v.CardinalityLimit()
+	if limit <= 0 {
+		limit = i.pipeline.reader.cardinalityLimit()
+	}
+	if sample == nil {
+		return internal.NewFilter(internal.NewLimiter(agg, limit, overflow, temporality), fltr)
+	}
+
+	switch inst.Kind {
+	case view.SyncCounter, view.SyncUpDownCounter, view.SyncHistogram:
+	default:
+		return internal.NewFilter(internal.NewLimiter(agg, limit, overflow, temporality), fltr)
+	}
+
+	size := v.ExemplarReservoirSize()
+	switch a := inst.Aggregation.(type) {
+	case aggregation.Sum:
+		if size <= 0 {
+			size = 1
+		}
+		return internal.NewFixedSizeExemplarSampler(agg, fltr, limit, overflow, sample, size, temporality)
+	case aggregation.ExplicitBucketHistogram:
+		if size <= 0 {
+			return internal.NewHistogramExemplarSampler(agg, fltr, limit, overflow, sample, a.Boundaries, temporality)
+		}
+		return internal.NewFixedSizeExemplarSampler(agg, fltr, limit, overflow, sample, size, temporality)
+	case aggregation.ExponentialBucketHistogram:
+		if size <= 0 {
+			// The specification recommends the smaller of the maximum
+			// number of buckets and twenty.
+			size = 20
+			if int(a.MaxSize) < size {
+				size = int(a.MaxSize)
+			}
+		}
+		return internal.NewFixedSizeExemplarSampler(agg, fltr, limit, overflow, sample, size, temporality)
+	}
+	return internal.NewFilter(internal.NewLimiter(agg, limit, overflow, temporality), fltr)
+}
+
 // isAggregatorCompatible checks if the aggregation can be used by the instrument.
 // Current compatibility:
 //
-// | Instrument Kind      | Drop | LastValue | Sum | Histogram | Exponential Histogram |
-// |----------------------|------|-----------|-----|-----------|-----------------------|
-// | Sync Counter         | X    |           | X   | X         | X                     |
-// | Sync UpDown Counter  | X    |           | X   |           |                       |
-// | Sync Histogram       | X    |           | X   | X         | X                     |
-// | Async Counter        | X    |           | X   |           |                       |
-// | Async UpDown Counter | X    |           | X   |           |                       |
-// | Async Gauge          | X    | X         |     |           |                       |.
+// | Instrument Kind      | Drop | LastValue | Sum | Histogram | Exponential Histogram | Summary |
+// |----------------------|------|-----------|-----|-----------|-----------------------|---------|
+// | Sync Counter         | X    |           | X   | X         | X                     | X       |
+// | Sync UpDown Counter  | X    |           | X   |           |                       |         |
+// | Sync Histogram       | X    |           | X   | X         | X                     | X       |
+// | Async Counter        | X    |           | X   |           |                       |         |
+// | Async UpDown Counter | X    |           | X   |           |                       |         |
+// | Sync Gauge           | X    | X         |     |           |                       |         |
+// | Async Gauge          | X    | X         |     |           |                       |         |.
 func isAggregatorCompatible(kind view.InstrumentKind, agg aggregation.Aggregation) error {
 	switch agg.(type) {
-	case aggregation.ExplicitBucketHistogram:
+	case aggregation.ExplicitBucketHistogram, aggregation.ExponentialBucketHistogram, aggregation.Summary:
 		if kind == view.SyncCounter || kind == view.SyncHistogram {
 			return nil
 		}
@@ -398,7 +799,7 @@ func isAggregatorCompatible(kind view.InstrumentKind, agg aggregation.Aggregatio
 			return errIncompatibleAggregation
 		}
 	case aggregation.LastValue:
-		if kind == view.AsyncGauge {
+		if kind == view.AsyncGauge || kind == view.SyncGauge {
 			return nil
 		}
 		// TODO: review need for aggregation check after
@@ -412,17 +813,37 @@ func isAggregatorCompatible(kind view.InstrumentKind, agg aggregation.Aggregatio
 	}
 }
 
+// filterAttributes returns a function that applies the attribute filter of
+// p and then the attribute filter of v to an attribute set. If neither
+// defines a filter, nil is returned.
+func (p *pipeline) filterAttributes(v view.View) func(attribute.Set) attribute.Set {
+	vFltr := v.AttributeFilter()
+	if p.attributeFilter == nil {
+		return vFltr
+	}
+	return func(input attribute.Set) attribute.Set {
+		out, _ := input.Filter(p.attributeFilter)
+		if vFltr != nil {
+			out = vFltr(out)
+		}
+		return out
+	}
+}
+
 // pipelines is the group of pipelines connecting Readers with instrument
 // measurement.
 type pipelines []*pipeline
 
-func newPipelines(res *resource.Resource, readers map[Reader][]view.View) pipelines {
+func newPipelines(res *resource.Resource, readers map[Reader][]view.View, filter ExemplarFilter, sumShards int, attrFilter attribute.Filter) pipelines {
 	pipes := make([]*pipeline, 0, len(readers))
 	for r, v := range readers {
 		p := &pipeline{
-			resource: res,
-			reader:   r,
-			views:    v,
+			resource:        res,
+			reader:          r,
+			views:           v,
+			exemplarFilter:  filter,
+			sumShards:       sumShards,
+			attributeFilter: attrFilter,
 		}
 		r.register(p)
 		pipes = append(pipes, p)
@@ -430,10 +851,33 @@ func newPipelines(res *resource.Resource, readers map[Reader][]view.View) pipeli
 	return pipes
 }
 
+// setObservability sets the observability all pipelines in p report metrics
+// about their health with.
+func (p pipelines) setObservability(obs *observability) {
+	for _, pipe := range p {
+		pipe.setObservability(obs)
+	}
+}
+
+// setResourceRefresher sets the Refresher that provides the Resource all
+// pipelines in p report metric data with.
+func (p pipelines) setResourceRefresher(r *resource.Refresher) {
+	for _, pipe := range p {
+		pipe.setResourceRefresher(r)
+	}
+}
+
+// setClock sets the function all pipelines in p use to get the current time.
+func (p pipelines) setClock(clock func() time.Time) {
+	for _, pipe := range p {
+		pipe.setClock(clock)
+	}
+}
+
 // TODO (#3053) Only register callbacks if any instrument matches in a view.
-func (p pipelines) registerCallback(fn func(context.Context)) {
+func (p pipelines) registerCallback(cb callback) {
 	for _, pipe := range p {
-		pipe.addCallback(fn)
+		pipe.addCallback(cb)
 	}
 }
 
@@ -455,17 +899,167 @@ func newResolver[N int64 | float64](p pipelines, c instrumentCache[N]) resolver[
 // Aggregators returns the Aggregators instrument inst needs to update when it
 // makes a measurement.
 func (r resolver[N]) Aggregators(inst view.Instrument, instUnit unit.Unit) ([]internal.Aggregator[N], error) {
+	return r.HistogramAggregators(inst, instUnit, nil)
+}
+
+// HistogramAggregators returns the Aggregators histogram instrument inst
+// needs to update when it makes a measurement. If boundaries is not nil, they
+// are used instead of the boundaries of an explicit bucket histogram
+// aggregation selected by a reader.
+func (r resolver[N]) HistogramAggregators(inst view.Instrument, instUnit unit.Unit, boundaries []float64) ([]internal.Aggregator[N], error) {
+	pipes, err := r.resolve(inst, instUnit, boundaries)
+
 	var aggs []internal.Aggregator[N]
+	for _, pAggs := range pipes {
+		aggs = append(aggs, pAggs.aggs...)
+	}
+	return aggs, err
+}
+
+// resolve returns the Aggregators inst needs to update when it makes a
+// measurement grouped by the pipeline that reads them. Pipelines that do not
+// read any Aggregator for inst are not included.
+func (r resolver[N]) resolve(inst view.Instrument, instUnit unit.Unit, boundaries []float64) ([]pipelineAggregators[N], error) {
+	var pipes []pipelineAggregators[N]
 
 	errs := &multierror{}
 	for _, i := range r.inserters {
-		a, err := i.Instrument(inst, instUnit)
+		a, err := i.Instrument(inst, instUnit, boundaries)
 		if err != nil {
 			errs.append(err)
 		}
-		aggs = append(aggs, a...)
+		if len(a) > 0 {
+			pipes = append(pipes, pipelineAggregators[N]{pipe: i.pipeline, aggs: a})
+		}
+	}
+	return pipes, errs.errorOrNil()
+}
+
+// meterResolver resolves the Aggregators of the instruments a meter creates.
+// It records the instruments it resolves so they can be resolved again for
+// pipelines added after they were created.
+//
+// All methods of a meterResolver are safe to call concurrently.
+type meterResolver[N int64 | float64] struct {
+	// views is used to ensure instrument conflicts are logged for pipelines
+	// added after the meterResolver was created.
+	views *cache[string, instrumentID]
+
+	sync.Mutex
+	resolver    resolver[N]
+	instruments map[resolvedKey]*resolvedInstrument[N]
+}
+
+// resolvedKey uniquely identifies an instrument resolved by a meterResolver.
+type resolvedKey struct {
+	scope       instrumentation.Scope
+	name        string
+	description string
+	kind        view.InstrumentKind
+	unit        unit.Unit
+	boundaries  string
+}
+
+// resolvedInstrument is an instrument resolved by a meterResolver.
+type resolvedInstrument[N int64 | float64] struct {
+	inst        view.Instrument
+	unit        unit.Unit
+	boundaries  []float64
+	aggregators *aggregatorSet[N]
+	err         error
+}
+
+func newMeterResolver[N int64 | float64](p pipelines, c instrumentCache[N]) *meterResolver[N] {
+	return &meterResolver[N]{
+		views:       c.views,
+		resolver:    newResolver(p, c),
+		instruments: make(map[resolvedKey]*resolvedInstrument[N]),
+	}
+}
+
+// Aggregators returns the Aggregators instrument inst needs to update when it
+// makes a measurement.
+func (r *meterResolver[N]) Aggregators(inst view.Instrument, instUnit unit.Unit) (*aggregatorSet[N], error) {
+	return r.HistogramAggregators(inst, instUnit, nil)
+}
+
+// HistogramAggregators returns the Aggregators histogram instrument inst
+// needs to update when it makes a measurement. If boundaries is not nil, they
+// are used instead of the boundaries of an explicit bucket histogram
+// aggregation selected by a reader.
+//
+// Resolving the same instrument more than once returns the same Aggregators.
+func (r *meterResolver[N]) HistogramAggregators(inst view.Instrument, instUnit unit.Unit, boundaries []float64) (*aggregatorSet[N], error) {
+	key := resolvedKey{
+		scope:       inst.Scope,
+		name:        inst.Name,
+		description: inst.Description,
+		kind:        inst.Kind,
+		unit:        instUnit,
+	}
+	if boundaries != nil {
+		key.boundaries = fmt.Sprint(boundaries)
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if ri, ok := r.instruments[key]; ok {
+		return ri.aggregators, ri.err
+	}
+
+	pipes, err := r.resolver.resolve(inst, instUnit, boundaries)
+	ri := &resolvedInstrument[N]{
+		inst:        inst,
+		unit:        instUnit,
+		boundaries:  boundaries,
+		aggregators: newAggregatorSet(pipes),
+		err:         err,
+	}
+	r.instruments[key] = ri
+	return ri.aggregators, ri.err
+}
+
+// addPipeline adds p to the pipelines r resolves instruments for. All
+// instruments r has already resolved are resolved for p.
+//
+// Any error creating the Aggregators of an instrument for p is appended to
+// errs. All instruments that could be resolved are still added.
+func (r *meterResolver[N]) addPipeline(p *pipeline, errs *multierror) {
+	r.Lock()
+	defer r.Unlock()
+
+	in := newInserter(p, newInstrumentCache[N](nil, r.views))
+	r.resolver.inserters = append(r.resolver.inserters, in)
+
+	for _, ri := range r.instruments {
+		aggs, err := in.Instrument(ri.inst, ri.unit, ri.boundaries)
+		if err != nil {
+			errs.append(err)
+		}
+		if len(aggs) > 0 {
+			ri.aggregators.add(pipelineAggregators[N]{pipe: p, aggs: aggs})
+		}
+	}
+}
+
+// removePipeline removes p from the pipelines r resolves instruments for.
+// The Aggregators p reads are removed from all instruments r has resolved.
+func (r *meterResolver[N]) removePipeline(p *pipeline) {
+	r.Lock()
+	defer r.Unlock()
+
+	inserters := make([]*inserter[N], 0, len(r.resolver.inserters))
+	for _, in := range r.resolver.inserters {
+		if in.pipeline != p {
+			inserters = append(inserters, in)
+		}
+	}
+	r.resolver.inserters = inserters
+
+	for _, ri := range r.instruments {
+		ri.aggregators.remove(p)
 	}
-	return aggs, errs.errorOrNil()
 }
 
 type multierror struct {
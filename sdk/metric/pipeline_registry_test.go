@@ -48,6 +48,18 @@ func testCreateAggregators[N int64 | float64](t *testing.T) {
 		view.MatchInstrumentName("foo"),
 		view.WithSetAggregation(aggregation.ExplicitBucketHistogram{}),
 	)
+	expoHistAgg := aggregation.ExponentialBucketHistogram{MaxSize: 160, MaxScale: 20}
+	expoHistSelector := func(view.InstrumentKind) aggregation.Aggregation { return expoHistAgg }
+	changeExpoAggView, _ := view.New(
+		view.MatchInstrumentName("foo"),
+		view.WithSetAggregation(expoHistAgg),
+	)
+	summaryAgg := aggregation.Summary{Quantiles: []float64{0.5, 0.99}}
+	summarySelector := func(view.InstrumentKind) aggregation.Aggregation { return summaryAgg }
+	changeSummaryAggView, _ := view.New(
+		view.MatchInstrumentName("foo"),
+		view.WithSetAggregation(summaryAgg),
+	)
 	renameView, _ := view.New(
 		view.MatchInstrumentName("foo"),
 		view.WithRename("bar"),
@@ -190,6 +202,38 @@ func testCreateAggregators[N int64 | float64](t *testing.T) {
 			wantKind: internal.NewCumulativeHistogram[N](aggregation.ExplicitBucketHistogram{}),
 			wantLen:  1,
 		},
+		{
+			name:     "view should set exponential histogram",
+			reader:   NewManualReader(),
+			views:    []view.View{changeExpoAggView},
+			inst:     instruments[view.SyncHistogram],
+			wantKind: internal.NewCumulativeExponentialHistogram[N](expoHistAgg),
+			wantLen:  1,
+		},
+		{
+			name:     "reader should set delta exponential histogram",
+			reader:   NewManualReader(WithTemporalitySelector(deltaTemporalitySelector), WithAggregationSelector(expoHistSelector)),
+			views:    []view.View{{}},
+			inst:     instruments[view.SyncHistogram],
+			wantKind: internal.NewDeltaExponentialHistogram[N](expoHistAgg),
+			wantLen:  1,
+		},
+		{
+			name:     "view should set summary",
+			reader:   NewManualReader(),
+			views:    []view.View{changeSummaryAggView},
+			inst:     instruments[view.SyncHistogram],
+			wantKind: internal.NewCumulativeSummary[N](summaryAgg),
+			wantLen:  1,
+		},
+		{
+			name:     "reader should set delta summary",
+			reader:   NewManualReader(WithTemporalitySelector(deltaTemporalitySelector), WithAggregationSelector(summarySelector)),
+			views:    []view.View{{}},
+			inst:     instruments[view.SyncHistogram],
+			wantKind: internal.NewDeltaSummary[N](summaryAgg),
+			wantLen:  1,
+		},
 		{
 			name:     "multiple views should create multiple aggregators",
 			reader:   NewManualReader(),
@@ -217,7 +261,7 @@ func testCreateAggregators[N int64 | float64](t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			c := newInstrumentCache[N](nil, nil)
 			i := newInserter(newPipeline(nil, tt.reader, tt.views), c)
-			got, err := i.Instrument(tt.inst, unit.Dimensionless)
+			got, err := i.Instrument(tt.inst, unit.Dimensionless, nil)
 			assert.ErrorIs(t, err, tt.wantErr)
 			require.Len(t, got, tt.wantLen)
 			for _, agg := range got {
@@ -234,7 +278,7 @@ func testInvalidInstrumentShouldPanic[N int64 | float64]() {
 		Name: "foo",
 		Kind: view.InstrumentKind(255),
 	}
-	_, _ = i.Instrument(inst, unit.Dimensionless)
+	_, _ = i.Instrument(inst, unit.Dimensionless, nil)
 }
 
 func TestInvalidInstrumentShouldPanic(t *testing.T) {
@@ -329,9 +373,9 @@ func TestPipelineRegistryCreateAggregators(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			p := newPipelines(resource.Empty(), tt.views)
+			p := newPipelines(resource.Empty(), tt.views, nil, 0, nil)
 			testPipelineRegistryResolveIntAggregators(t, p, tt.wantCount)
-			p = newPipelines(resource.Empty(), tt.views)
+			p = newPipelines(resource.Empty(), tt.views, nil, 0, nil)
 			testPipelineRegistryResolveFloatAggregators(t, p, tt.wantCount)
 		})
 	}
@@ -366,7 +410,7 @@ func TestPipelineRegistryResource(t *testing.T) {
 		NewManualReader(): {{}, v},
 	}
 	res := resource.NewSchemaless(attribute.String("key", "val"))
-	pipes := newPipelines(res, views)
+	pipes := newPipelines(res, views, nil, 0, nil)
 	for _, p := range pipes {
 		assert.True(t, res.Equal(p.resource), "resource not set")
 	}
@@ -380,7 +424,7 @@ func TestPipelineRegistryCreateAggregatorsIncompatibleInstrument(t *testing.T) {
 			{},
 		},
 	}
-	p := newPipelines(resource.Empty(), views)
+	p := newPipelines(resource.Empty(), views, nil, 0, nil)
 	inst := view.Instrument{Name: "foo", Kind: view.AsyncGauge}
 
 	vc := cache[string, instrumentID]{}
@@ -389,7 +433,7 @@ func TestPipelineRegistryCreateAggregatorsIncompatibleInstrument(t *testing.T) {
 	assert.Error(t, err)
 	assert.Len(t, intAggs, 0)
 
-	p = newPipelines(resource.Empty(), views)
+	p = newPipelines(resource.Empty(), views, nil, 0, nil)
 
 	rf := newResolver(p, newInstrumentCache[float64](nil, &vc))
 	floatAggs, err := rf.Aggregators(inst, unit.Dimensionless)
@@ -431,7 +475,7 @@ func TestResolveAggregatorsDuplicateErrors(t *testing.T) {
 	fooInst := view.Instrument{Name: "foo", Kind: view.SyncCounter}
 	barInst := view.Instrument{Name: "bar", Kind: view.SyncCounter}
 
-	p := newPipelines(resource.Empty(), views)
+	p := newPipelines(resource.Empty(), views, nil, 0, nil)
 
 	vc := cache[string, instrumentID]{}
 	ri := newResolver(p, newInstrumentCache[int64](nil, &vc))
@@ -500,6 +544,16 @@ func TestIsAggregatorCompatible(t *testing.T) {
 			kind: view.SyncCounter,
 			agg:  aggregation.ExplicitBucketHistogram{},
 		},
+		{
+			name: "SyncCounter and ExponentialBucketHistogram",
+			kind: view.SyncCounter,
+			agg:  aggregation.ExponentialBucketHistogram{MaxSize: 160, MaxScale: 20},
+		},
+		{
+			name: "SyncCounter and Summary",
+			kind: view.SyncCounter,
+			agg:  aggregation.Summary{},
+		},
 		{
 			name: "SyncUpDownCounter and Drop",
 			kind: view.SyncUpDownCounter,
@@ -522,6 +576,18 @@ func TestIsAggregatorCompatible(t *testing.T) {
 			agg:  aggregation.ExplicitBucketHistogram{},
 			want: errIncompatibleAggregation,
 		},
+		{
+			name: "SyncUpDownCounter and ExponentialBucketHistogram",
+			kind: view.SyncUpDownCounter,
+			agg:  aggregation.ExponentialBucketHistogram{MaxSize: 160, MaxScale: 20},
+			want: errIncompatibleAggregation,
+		},
+		{
+			name: "SyncUpDownCounter and Summary",
+			kind: view.SyncUpDownCounter,
+			agg:  aggregation.Summary{},
+			want: errIncompatibleAggregation,
+		},
 		{
 			name: "SyncHistogram and Drop",
 			kind: view.SyncHistogram,
@@ -543,6 +609,16 @@ func TestIsAggregatorCompatible(t *testing.T) {
 			kind: view.SyncHistogram,
 			agg:  aggregation.ExplicitBucketHistogram{},
 		},
+		{
+			name: "SyncHistogram and ExponentialBucketHistogram",
+			kind: view.SyncHistogram,
+			agg:  aggregation.ExponentialBucketHistogram{MaxSize: 160, MaxScale: 20},
+		},
+		{
+			name: "SyncHistogram and Summary",
+			kind: view.SyncHistogram,
+			agg:  aggregation.Summary{},
+		},
 		{
 			name: "AsyncCounter and Drop",
 			kind: view.AsyncCounter,
@@ -565,6 +641,18 @@ func TestIsAggregatorCompatible(t *testing.T) {
 			agg:  aggregation.ExplicitBucketHistogram{},
 			want: errIncompatibleAggregation,
 		},
+		{
+			name: "AsyncCounter and ExponentialBucketHistogram",
+			kind: view.AsyncCounter,
+			agg:  aggregation.ExponentialBucketHistogram{MaxSize: 160, MaxScale: 20},
+			want: errIncompatibleAggregation,
+		},
+		{
+			name: "AsyncCounter and Summary",
+			kind: view.AsyncCounter,
+			agg:  aggregation.Summary{},
+			want: errIncompatibleAggregation,
+		},
 		{
 			name: "AsyncUpDownCounter and Drop",
 			kind: view.AsyncUpDownCounter,
@@ -587,6 +675,18 @@ func TestIsAggregatorCompatible(t *testing.T) {
 			agg:  aggregation.ExplicitBucketHistogram{},
 			want: errIncompatibleAggregation,
 		},
+		{
+			name: "AsyncUpDownCounter and ExponentialBucketHistogram",
+			kind: view.AsyncUpDownCounter,
+			agg:  aggregation.ExponentialBucketHistogram{MaxSize: 160, MaxScale: 20},
+			want: errIncompatibleAggregation,
+		},
+		{
+			name: "AsyncUpDownCounter and Summary",
+			kind: view.AsyncUpDownCounter,
+			agg:  aggregation.Summary{},
+			want: errIncompatibleAggregation,
+		},
 		{
 			name: "AsyncGauge and Drop",
 			kind: view.AsyncGauge,
@@ -609,6 +709,40 @@ func TestIsAggregatorCompatible(t *testing.T) {
 			agg:  aggregation.ExplicitBucketHistogram{},
 			want: errIncompatibleAggregation,
 		},
+		{
+			name: "AsyncGauge and ExponentialBucketHistogram",
+			kind: view.AsyncGauge,
+			agg:  aggregation.ExponentialBucketHistogram{MaxSize: 160, MaxScale: 20},
+			want: errIncompatibleAggregation,
+		},
+		{
+			name: "AsyncGauge and Summary",
+			kind: view.AsyncGauge,
+			agg:  aggregation.Summary{},
+			want: errIncompatibleAggregation,
+		},
+		{
+			name: "SyncGauge and Drop",
+			kind: view.SyncGauge,
+			agg:  aggregation.Drop{},
+		},
+		{
+			name: "SyncGauge and aggregation.LastValue{}",
+			kind: view.SyncGauge,
+			agg:  aggregation.LastValue{},
+		},
+		{
+			name: "SyncGauge and Sum",
+			kind: view.SyncGauge,
+			agg:  aggregation.Sum{},
+			want: errIncompatibleAggregation,
+		},
+		{
+			name: "SyncGauge and ExplicitBucketHistogram",
+			kind: view.SyncGauge,
+			agg:  aggregation.ExplicitBucketHistogram{},
+			want: errIncompatibleAggregation,
+		},
 		{
 			name: "Default aggregation should error",
 			kind: view.SyncCounter,
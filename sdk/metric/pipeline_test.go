@@ -27,6 +27,7 @@ import (
 	"go.opentelemetry.io/otel/metric/unit"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/internal"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 	"go.opentelemetry.io/otel/sdk/metric/view"
@@ -45,7 +46,8 @@ func (testSumAggregator) Aggregation() metricdata.Aggregation {
 func TestEmptyPipeline(t *testing.T) {
 	pipe := &pipeline{}
 
-	output, err := pipe.produce(context.Background())
+	var output metricdata.ResourceMetrics
+	err := pipe.produce(context.Background(), &output)
 	require.NoError(t, err)
 	assert.Nil(t, output.Resource)
 	assert.Len(t, output.ScopeMetrics, 0)
@@ -56,10 +58,10 @@ func TestEmptyPipeline(t *testing.T) {
 	})
 
 	require.NotPanics(t, func() {
-		pipe.addCallback(func(ctx context.Context) {})
+		pipe.addCallback(callback{fn: func(ctx context.Context) {}})
 	})
 
-	output, err = pipe.produce(context.Background())
+	err = pipe.produce(context.Background(), &output)
 	require.NoError(t, err)
 	assert.Nil(t, output.Resource)
 	require.Len(t, output.ScopeMetrics, 1)
@@ -69,7 +71,8 @@ func TestEmptyPipeline(t *testing.T) {
 func TestNewPipeline(t *testing.T) {
 	pipe := newPipeline(nil, nil, nil)
 
-	output, err := pipe.produce(context.Background())
+	var output metricdata.ResourceMetrics
+	err := pipe.produce(context.Background(), &output)
 	require.NoError(t, err)
 	assert.Equal(t, resource.Empty(), output.Resource)
 	assert.Len(t, output.ScopeMetrics, 0)
@@ -80,10 +83,10 @@ func TestNewPipeline(t *testing.T) {
 	})
 
 	require.NotPanics(t, func() {
-		pipe.addCallback(func(ctx context.Context) {})
+		pipe.addCallback(callback{fn: func(ctx context.Context) {}})
 	})
 
-	output, err = pipe.produce(context.Background())
+	err = pipe.produce(context.Background(), &output)
 	require.NoError(t, err)
 	assert.Equal(t, resource.Empty(), output.Resource)
 	require.Len(t, output.ScopeMetrics, 1)
@@ -94,11 +97,109 @@ func TestPipelineUsesResource(t *testing.T) {
 	res := resource.NewWithAttributes("noSchema", attribute.String("test", "resource"))
 	pipe := newPipeline(res, nil, nil)
 
-	output, err := pipe.produce(context.Background())
+	var output metricdata.ResourceMetrics
+	err := pipe.produce(context.Background(), &output)
 	assert.NoError(t, err)
 	assert.Equal(t, res, output.Resource)
 }
 
+func TestPipelineProduceReusesMemory(t *testing.T) {
+	pipe := newPipeline(nil, nil, nil)
+	iSync := instrumentSync{"name", "desc", unit.Dimensionless, testSumAggregator{}}
+	pipe.addSync(instrumentation.Scope{Name: "a"}, iSync)
+	pipe.addSync(instrumentation.Scope{Name: "b"}, iSync)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, pipe.produce(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 2)
+	scopes := &rm.ScopeMetrics[0]
+	metrics := map[*metricdata.Metrics]struct{}{
+		&rm.ScopeMetrics[0].Metrics[0]: {},
+		&rm.ScopeMetrics[1].Metrics[0]: {},
+	}
+
+	require.NoError(t, pipe.produce(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 2)
+	assert.Same(t, scopes, &rm.ScopeMetrics[0], "ScopeMetrics not reused")
+	for _, sm := range rm.ScopeMetrics {
+		require.Len(t, sm.Metrics, 1)
+		assert.Contains(t, metrics, &sm.Metrics[0], "Metrics not reused")
+	}
+}
+
+func TestPipelineProduceStream(t *testing.T) {
+	res := resource.NewSchemaless(attribute.String("test", "resource"))
+	pipe := newPipeline(res, nil, nil)
+	iSync := instrumentSync{"name", "desc", unit.Dimensionless, testSumAggregator{}}
+	pipe.addSync(instrumentation.Scope{Name: "a"}, iSync)
+	pipe.addSync(instrumentation.Scope{Name: "b"}, iSync)
+	var called bool
+	pipe.addCallback(callback{fn: func(context.Context) { called = true }})
+
+	var want metricdata.ResourceMetrics
+	require.NoError(t, pipe.produce(context.Background(), &want))
+	want.ScopeMetrics = append(want.ScopeMetrics, testExternalMetrics...)
+
+	called = false
+	var got []metricdata.ScopeMetrics
+	err := pipe.produceStream(context.Background(), []Producer{testExternalProducer{}}, func(r *resource.Resource, sm metricdata.ScopeMetrics) error {
+		assert.Equal(t, res, r)
+		got = append(got, sm)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, called, "callback not run")
+	assert.ElementsMatch(t, want.ScopeMetrics, got)
+
+	var calls int
+	err = pipe.produceStream(context.Background(), []Producer{testExternalProducer{}}, func(*resource.Resource, metricdata.ScopeMetrics) error {
+		calls++
+		return assert.AnError
+	})
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, 1, calls, "streaming did not stop on error")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = pipe.produceStream(ctx, nil, func(*resource.Resource, metricdata.ScopeMetrics) error {
+		t.Error("metrics streamed after the context was canceled")
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestPipelineProduceReusesDataPoints(t *testing.T) {
+	ctx := context.Background()
+	pipe := newPipeline(nil, nil, nil)
+	agg := internal.NewCumulativeSum[int64](true)
+	pipe.addSync(instrumentation.Scope{Name: "a"}, instrumentSync{"name", "desc", unit.Dimensionless, agg})
+	agg.Aggregate(ctx, 1, *attribute.EmptySet())
+
+	dataPoint := func(rm metricdata.ResourceMetrics) *metricdata.DataPoint[int64] {
+		require.Len(t, rm.ScopeMetrics, 1)
+		require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+		sum, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+		require.True(t, ok)
+		require.Len(t, sum.DataPoints, 1)
+		return &sum.DataPoints[0]
+	}
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, pipe.produce(ctx, &rm))
+	// The data points of rm are only reused once its memory is.
+	require.NoError(t, pipe.produce(ctx, &rm))
+	dPt := dataPoint(rm)
+
+	// Collections in new memory neither reuse nor are reused.
+	var other metricdata.ResourceMetrics
+	require.NoError(t, pipe.produce(ctx, &other))
+	assert.NotSame(t, dPt, dataPoint(other))
+
+	require.NoError(t, pipe.produce(ctx, &rm))
+	assert.Same(t, dPt, dataPoint(rm), "DataPoints not reused")
+	assert.Equal(t, int64(1), dPt.Value)
+}
+
 func TestPipelineConcurrency(t *testing.T) {
 	pipe := newPipeline(nil, nil, nil)
 	ctx := context.Background()
@@ -109,7 +210,15 @@ func TestPipelineConcurrency(t *testing.T) {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			_, _ = pipe.produce(ctx)
+			_ = pipe.produce(ctx, &metricdata.ResourceMetrics{})
+		}()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = pipe.produceStream(ctx, nil, func(*resource.Resource, metricdata.ScopeMetrics) error {
+				return nil
+			})
 		}()
 
 		wg.Add(1)
@@ -123,7 +232,7 @@ func TestPipelineConcurrency(t *testing.T) {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			pipe.addCallback(func(ctx context.Context) {})
+			pipe.addCallback(callback{fn: func(ctx context.Context) {}})
 		}()
 	}
 	wg.Wait()
@@ -165,11 +274,12 @@ func testDefaultViewImplicit[N int64 | float64]() func(t *testing.T) {
 			t.Run(test.name, func(t *testing.T) {
 				c := newInstrumentCache[N](nil, nil)
 				i := newInserter(test.pipe, c)
-				got, err := i.Instrument(inst, unit.Dimensionless)
+				got, err := i.Instrument(inst, unit.Dimensionless, nil)
 				require.NoError(t, err)
 				assert.Len(t, got, 1, "default view not applied")
 
-				out, err := test.pipe.produce(context.Background())
+				var out metricdata.ResourceMetrics
+				err = test.pipe.produce(context.Background(), &out)
 				require.NoError(t, err)
 				require.Len(t, out.ScopeMetrics, 1, "Aggregator not registered with pipeline")
 				sm := out.ScopeMetrics[0]
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// errReaderProducer is wrapped by all errors returned from the Produce method
+// of a Producer returned from NewReaderProducer.
+var errReaderProducer = errors.New("reader producer")
+
+// readerProducer produces the metrics collected from Readers.
+type readerProducer struct {
+	readers []Reader
+}
+
+// NewReaderProducer returns a Producer that collects from readers each time
+// it produces metrics. This can be used by a process that hosts several
+// isolated components, each with its own MeterProvider, to export the metrics
+// of all of them with a single export pipeline. Each component MeterProvider
+// is registered with a ManualReader passed to this function, and the returned
+// Producer is registered with the Reader of the export pipeline using
+// WithProducer:
+//
+//	componentA := metric.NewManualReader()
+//	providerA := metric.NewMeterProvider(metric.WithReader(componentA))
+//	componentB := metric.NewManualReader()
+//	providerB := metric.NewMeterProvider(metric.WithReader(componentB))
+//
+//	exp := metric.NewPeriodicReader(exporter, metric.WithProducer(
+//		metric.NewReaderProducer(componentA, componentB),
+//	))
+//	provider := metric.NewMeterProvider(metric.WithReader(exp))
+//
+// The metrics of each instrumentation scope are merged across readers into a
+// single ScopeMetrics. The produced metrics are reported with the Resource of
+// the MeterProvider of the Reader the Producer is registered with, the
+// Resources of the MeterProviders of readers are not reported.
+//
+// If collecting from any of readers fails, the metrics collected from all
+// other readers are still returned along with an error.
+func NewReaderProducer(readers ...Reader) Producer {
+	return &readerProducer{readers: append([]Reader(nil), readers...)}
+}
+
+// Produce collects from all readers of p and returns their metrics merged by
+// instrumentation scope.
+func (p *readerProducer) Produce(ctx context.Context) ([]metricdata.ScopeMetrics, error) {
+	errs := &multierror{wrapped: errReaderProducer}
+	var out []metricdata.ScopeMetrics
+	index := make(map[instrumentation.Scope]int)
+	for _, r := range p.readers {
+		rm, err := r.Collect(ctx)
+		if err != nil {
+			errs.append(err)
+		}
+		for _, sm := range rm.ScopeMetrics {
+			if i, ok := index[sm.Scope]; ok {
+				out[i].Metrics = append(out[i].Metrics, sm.Metrics...)
+				continue
+			}
+			index[sm.Scope] = len(out)
+			out = append(out, metricdata.ScopeMetrics{
+				Scope:   sm.Scope,
+				Metrics: append([]metricdata.Metrics(nil), sm.Metrics...),
+			})
+		}
+	}
+	return out, errs.errorOrNil()
+}
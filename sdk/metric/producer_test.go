@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func TestReaderProducer(t *testing.T) {
+	ctx := context.Background()
+
+	newComponent := func(counter string) Reader {
+		r := NewManualReader()
+		mp := NewMeterProvider(WithReader(r))
+		ctr, err := mp.Meter("component").SyncInt64().Counter(counter)
+		require.NoError(t, err)
+		ctr.Add(ctx, 1)
+		return r
+	}
+	a, b := newComponent("a"), newComponent("b")
+
+	res := resource.NewSchemaless(attribute.String("service.name", "host"))
+	rdr := NewManualReader(WithProducer(NewReaderProducer(a, b)))
+	_ = NewMeterProvider(WithResource(res), WithReader(rdr))
+
+	got, err := rdr.Collect(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, res, got.Resource)
+	require.Len(t, got.ScopeMetrics, 1, "scopes not merged")
+	assert.Equal(t, instrumentation.Scope{Name: "component"}, got.ScopeMetrics[0].Scope)
+	require.Len(t, got.ScopeMetrics[0].Metrics, 2)
+	assert.Equal(t, "a", got.ScopeMetrics[0].Metrics[0].Name)
+	assert.Equal(t, "b", got.ScopeMetrics[0].Metrics[1].Name)
+}
+
+func TestReaderProducerError(t *testing.T) {
+	ctx := context.Background()
+
+	r := NewManualReader()
+	mp := NewMeterProvider(WithReader(r))
+	ctr, err := mp.Meter("component").SyncInt64().Counter("counter")
+	require.NoError(t, err)
+	ctr.Add(ctx, 1)
+
+	// The second reader is not registered with a MeterProvider.
+	sms, err := NewReaderProducer(r, NewManualReader()).Produce(ctx)
+	assert.ErrorIs(t, err, errReaderProducer)
+	require.Len(t, sms, 1, "metrics of other readers not returned")
+	assert.Len(t, sms[0].Metrics, 1)
+}
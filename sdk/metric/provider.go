@@ -16,9 +16,16 @@ package metric // import "go.opentelemetry.io/otel/sdk/metric"
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/view"
 )
 
 // MeterProvider handles the creation and coordination of Meters. All Meters
@@ -26,10 +33,18 @@ import (
 // the same Views applied to them, and have their produced metric telemetry
 // passed to the configured Readers.
 type MeterProvider struct {
-	pipes  pipelines
-	meters cache[instrumentation.Scope, *meter]
+	meters cache[meterID, *meter]
+	// meterFilter determines the scopes Meters perform operations for. If
+	// nil, all Meters perform operations.
+	meterFilter func(instrumentation.Scope) bool
+	// conf is used to create the pipelines of Readers registered after the
+	// MeterProvider is created.
+	conf config
 
-	forceFlush, shutdown func(context.Context) error
+	mu         sync.RWMutex
+	pipes      pipelines
+	obs        *observability
+	isShutdown bool
 }
 
 // Compile-time check MeterProvider implements metric.MeterProvider.
@@ -38,17 +53,29 @@ var _ metric.MeterProvider = (*MeterProvider)(nil)
 // NewMeterProvider returns a new and configured MeterProvider.
 //
 // By default, the returned MeterProvider is configured with the default
-// Resource and no Readers. Readers cannot be added after a MeterProvider is
-// created. This means the returned MeterProvider, one created with no
-// Readers, will perform no operations.
+// Resource and no Readers. Readers can be added after a MeterProvider is
+// created with RegisterReader. Until then, the returned MeterProvider, one
+// created with no Readers, will perform no operations.
 func NewMeterProvider(options ...Option) *MeterProvider {
 	conf := newConfig(options)
-	flush, sdown := conf.readerSignals()
-	return &MeterProvider{
-		pipes:      newPipelines(conf.res, conf.readers),
-		forceFlush: flush,
-		shutdown:   sdown,
+	mp := &MeterProvider{
+		pipes:       newPipelines(conf.res, conf.readers, conf.exemplarFilter, conf.sumShards, conf.attributeFilter),
+		meterFilter: conf.meterFilter,
 	}
+	// Do not hold references to Readers that may later be unregistered.
+	conf.readers = nil
+	mp.conf = conf
+	if conf.resRefresher != nil {
+		mp.pipes.setResourceRefresher(conf.resRefresher)
+	}
+	if conf.clock != nil {
+		mp.pipes.setClock(conf.clock.Now)
+	}
+	if conf.selfObservability {
+		mp.obs = newObservability(mp.Meter(observabilityScope))
+		mp.pipes.setObservability(mp.obs)
+	}
+	return mp
 }
 
 // Meter returns a Meter with the given name and configured with options.
@@ -63,6 +90,10 @@ func NewMeterProvider(options ...Option) *MeterProvider {
 // Calls to the Meter method after Shutdown has been called will return Meters
 // that perform no operations.
 //
+// If the MeterProvider was configured with a meter filter that does not
+// accept the instrumentation scope of the Meter, a Meter that performs no
+// operations is returned.
+//
 // This method is safe to call concurrently.
 func (mp *MeterProvider) Meter(name string, options ...metric.MeterOption) metric.Meter {
 	c := metric.NewMeterConfig(options...)
@@ -71,11 +102,117 @@ func (mp *MeterProvider) Meter(name string, options ...metric.MeterOption) metri
 		Version:   c.InstrumentationVersion(),
 		SchemaURL: c.SchemaURL(),
 	}
-	return mp.meters.Lookup(s, func() *meter {
-		return newMeter(s, mp.pipes)
+	if attrs := c.InstrumentationAttributes(); attrs.Len() > 0 {
+		s.Attributes = attrs
+	}
+	if mp.meterFilter != nil && !mp.meterFilter(s) {
+		return metric.NewNoopMeter()
+	}
+
+	mp.mu.RLock()
+	defer mp.mu.RUnlock()
+	return mp.meters.Lookup(newMeterID(s), func() *meter {
+		return newMeter(s, mp.pipes, nameValidator{relaxed: mp.conf.relaxedInstrumentNames})
 	})
 }
 
+// meterID uniquely identifies the instrumentation scope of a Meter.
+//
+// An instrumentation.Scope cannot be used directly as a cache key because its
+// attributes are not strictly comparable.
+type meterID struct {
+	name      string
+	version   string
+	schemaURL string
+	attrs     string
+}
+
+func newMeterID(s instrumentation.Scope) meterID {
+	return meterID{
+		name:      s.Name,
+		version:   s.Version,
+		schemaURL: s.SchemaURL,
+		attrs:     s.Attributes.Encoded(attribute.DefaultEncoder()),
+	}
+}
+
+// RegisterReader associates the Reader r with the MeterProvider. Any passed
+// view config will be used to associate a view with r. If no views are
+// passed the default view will be used for r.
+//
+// All instruments already created by Meters of the MeterProvider, and all
+// callbacks already registered with those Meters, are added to r. The
+// instruments do not need to be recreated to have their measurements read
+// by r.
+//
+// A Reader can only be registered once. An error is returned if r is already
+// registered with the MeterProvider or if the MeterProvider has been shut
+// down. If the aggregators of an already created instrument cannot be created
+// for r, an error is returned and r is still registered.
+//
+// This method is safe to call concurrently.
+func (mp *MeterProvider) RegisterReader(r Reader, views ...view.View) error {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	if mp.isShutdown {
+		return ErrReaderShutdown
+	}
+	for _, p := range mp.pipes {
+		if p.reader == r {
+			return errDuplicateRegister
+		}
+	}
+
+	readers := map[Reader][]view.View{r: views}
+	p := newPipelines(mp.conf.res, readers, mp.conf.exemplarFilter, mp.conf.sumShards, mp.conf.attributeFilter)[0]
+	p.setObservability(mp.obs)
+	if mp.conf.resRefresher != nil {
+		p.setResourceRefresher(mp.conf.resRefresher)
+	}
+	if mp.conf.clock != nil {
+		p.setClock(mp.conf.clock.Now)
+	}
+	mp.pipes = append(mp.pipes[:len(mp.pipes):len(mp.pipes)], p)
+
+	errs := &multierror{wrapped: errCreatingAggregators}
+	mp.meters.Range(func(m *meter) { m.addPipeline(p, errs) })
+	return errs.errorOrNil()
+}
+
+// UnregisterReader removes the Reader r from the MeterProvider. Measurements
+// made after this returns are not read by r, and r is no longer flushed or
+// shut down by the MeterProvider.
+//
+// The Reader r is not shut down. It is the callers responsibility to shut
+// down r once it is no longer used. The Reader r cannot be registered again.
+//
+// ErrReaderNotRegistered is returned if r is not registered with the
+// MeterProvider.
+//
+// This method is safe to call concurrently.
+func (mp *MeterProvider) UnregisterReader(r Reader) error {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+
+	var p *pipeline
+	pipes := make(pipelines, 0, len(mp.pipes))
+	for _, pipe := range mp.pipes {
+		if pipe.reader == r {
+			p = pipe
+			continue
+		}
+		pipes = append(pipes, pipe)
+	}
+	if p == nil {
+		return ErrReaderNotRegistered
+	}
+	mp.pipes = pipes
+
+	mp.meters.Range(func(m *meter) { m.removePipeline(p) })
+	return nil
+}
+
 // ForceFlush flushes all pending telemetry.
 //
 // This method honors the deadline or cancellation of ctx. An appropriate
@@ -85,15 +222,25 @@ func (mp *MeterProvider) Meter(name string, options ...metric.MeterOption) metri
 //
 // This method is safe to call concurrently.
 func (mp *MeterProvider) ForceFlush(ctx context.Context) error {
-	if mp.forceFlush != nil {
-		return mp.forceFlush(ctx)
+	mp.mu.RLock()
+	funcs := make([]func(context.Context) error, 0, len(mp.pipes))
+	for _, p := range mp.pipes {
+		funcs = append(funcs, p.reader.ForceFlush)
 	}
-	return nil
+	mp.mu.RUnlock()
+
+	return unify(funcs)(ctx)
 }
 
 // Shutdown shuts down the MeterProvider flushing all pending telemetry and
 // releasing any held computational resources.
 //
+// All Readers are flushed before any Reader is shut down. Each Reader is
+// flushed, and then shut down, concurrently with the other Readers and is
+// given the time set with WithShutdownTimeout to complete each. A Reader that
+// fails does not stop the shut down of the others. All errors are returned
+// in a single error naming the Readers that returned them.
+//
 // This call is idempotent. The first call will perform all flush and
 // releasing operations. Subsequent calls will perform no action and will
 // return an error stating this.
@@ -108,8 +255,130 @@ func (mp *MeterProvider) ForceFlush(ctx context.Context) error {
 //
 // This method is safe to call concurrently.
 func (mp *MeterProvider) Shutdown(ctx context.Context) error {
-	if mp.shutdown != nil {
-		return mp.shutdown(ctx)
+	mp.mu.Lock()
+	if mp.isShutdown {
+		mp.mu.Unlock()
+		return ErrReaderShutdown
 	}
-	return nil
+	mp.isShutdown = true
+	readers := make([]Reader, 0, len(mp.pipes))
+	for _, p := range mp.pipes {
+		readers = append(readers, p.reader)
+	}
+	mp.mu.Unlock()
+
+	errs := runReaders(ctx, readers, "flush", Reader.ForceFlush)
+	errs = append(errs, runReaders(ctx, readers, "shutdown", Reader.Shutdown)...)
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	default:
+		return errs
+	}
+}
+
+// runReaders calls f for each of readers concurrently and waits for all calls
+// to complete. A call for a Reader with a shutdown timeout is abandoned once
+// that timeout is reached. The errors returned are each named with op and
+// the Reader that returned them.
+func runReaders(ctx context.Context, readers []Reader, op string, f func(Reader, context.Context) error) joinedError {
+	results := make([]error, len(readers))
+	var wg sync.WaitGroup
+	for i, r := range readers {
+		wg.Add(1)
+		go func(i int, r Reader) {
+			defer wg.Done()
+			err := callWithTimeout(ctx, r.shutdownTimeout(), func(ctx context.Context) error {
+				return f(r, ctx)
+			})
+			if err != nil {
+				results[i] = &readerError{reader: r, op: op, err: err}
+			}
+		}(i, r)
+	}
+	wg.Wait()
+
+	var errs joinedError
+	for _, err := range results {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// callWithTimeout returns the error returned from f called with ctx. If
+// timeout is greater than zero, the context passed to f is canceled once the
+// timeout is reached and the cancellation error is returned without waiting
+// for f to return.
+func callWithTimeout(ctx context.Context, timeout time.Duration, f func(context.Context) error) error {
+	if timeout <= 0 {
+		return f(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() { done <- f(ctx) }()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// readerError is an error returned by a Reader when performing op.
+type readerError struct {
+	reader Reader
+	op     string
+	err    error
+}
+
+func (e *readerError) Error() string {
+	name := fmt.Sprintf("%T", e.reader)
+	if r, ok := e.reader.(*periodicReader); ok {
+		// Periodic readers are best identified by their exporter.
+		name = fmt.Sprintf("%s(%T)", name, r.exporter)
+	}
+	return fmt.Sprintf("%s %s: %v", name, e.op, e.err)
+}
+
+func (e *readerError) Unwrap() error {
+	return e.err
+}
+
+// joinedError is a group of errors returned as a single error.
+type joinedError []error
+
+func (e joinedError) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Is returns if any error in e matches target.
+func (e joinedError) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first error in e that matches target, and if so, sets target
+// to that error value and returns true.
+func (e joinedError) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
 }
@@ -16,9 +16,21 @@ package metric
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"go.opentelemetry.io/otel/sdk/resource"
 )
 
 func TestMeterConcurrentSafe(t *testing.T) {
@@ -74,3 +86,403 @@ func TestMeterProviderReturnsSameMeter(t *testing.T) {
 	assert.Same(t, mtr, mp.Meter(""))
 	assert.NotSame(t, mtr, mp.Meter("diff"))
 }
+
+func TestMeterProviderMeterAttributes(t *testing.T) {
+	attrs := []attribute.KeyValue{attribute.String("user", "alice")}
+
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr))
+
+	mtr := mp.Meter("scope", metric.WithInstrumentationAttributes(attrs...))
+	assert.Same(t, mtr, mp.Meter("scope", metric.WithInstrumentationAttributes(attrs...)))
+	assert.NotSame(t, mtr, mp.Meter("scope"))
+
+	ctr, err := mtr.SyncInt64().Counter("counter")
+	require.NoError(t, err)
+	ctr.Add(context.Background(), 1)
+
+	got, err := rdr.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	want := instrumentation.Scope{Name: "scope", Attributes: attribute.NewSet(attrs...)}
+	assert.Equal(t, want, got.ScopeMetrics[0].Scope)
+}
+
+func TestMeterProviderMeterFilter(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(
+		WithReader(rdr),
+		WithMeterFilter(func(s instrumentation.Scope) bool {
+			return s.Name != "disabled" || s.Version != "v0.1.0"
+		}),
+	)
+
+	disabled := mp.Meter("disabled", metric.WithInstrumentationVersion("v0.1.0"))
+	assert.Equal(t, metric.NewNoopMeter(), disabled)
+	ctr, err := disabled.SyncInt64().Counter("counter")
+	require.NoError(t, err)
+	ctr.Add(context.Background(), 1)
+
+	enabled := mp.Meter("disabled", metric.WithInstrumentationVersion("v0.2.0"))
+	assert.IsType(t, &meter{}, enabled)
+	ctr, err = enabled.SyncInt64().Counter("counter")
+	require.NoError(t, err)
+	ctr.Add(context.Background(), 1)
+
+	got, err := rdr.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	assert.Equal(t, instrumentation.Scope{Name: "disabled", Version: "v0.2.0"}, got.ScopeMetrics[0].Scope)
+}
+
+func TestMeterProviderReaderResourceTransform(t *testing.T) {
+	host := attribute.String("host.name", "alice")
+	svc := attribute.String("service.name", "bob")
+	res := resource.NewSchemaless(host, svc)
+	redacted := resource.NewSchemaless(svc)
+
+	collect := func(r Reader) metricdata.ResourceMetrics {
+		mp := NewMeterProvider(WithResource(res), WithReader(r))
+		ctr, err := mp.Meter("TestMeterProviderReaderResourceTransform").SyncInt64().Counter("counter")
+		require.NoError(t, err)
+		ctr.Add(context.Background(), 1)
+
+		rm, err := r.Collect(context.Background())
+		require.NoError(t, err)
+		return rm
+	}
+
+	got := collect(NewManualReader())
+	assert.Equal(t, res, got.Resource)
+
+	external := NewManualReader(WithResourceTransform(func(r *resource.Resource) *resource.Resource {
+		attrs, _ := r.Set().Filter(func(kv attribute.KeyValue) bool {
+			return kv.Key != host.Key
+		})
+		return resource.NewSchemaless(attrs.ToSlice()...)
+	}))
+	got = collect(external)
+	assert.Equal(t, redacted, got.Resource)
+	require.Len(t, got.ScopeMetrics, 1, "metrics not collected")
+
+	var streamed int
+	err := external.CollectStream(context.Background(), func(r *resource.Resource, _ metricdata.ScopeMetrics) error {
+		assert.Equal(t, redacted, r)
+		streamed++
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, streamed, "metrics not streamed")
+}
+
+func TestMeterProviderResourceRefresher(t *testing.T) {
+	role := "follower"
+	refresher, err := resource.NewRefresher(context.Background(), 0, resource.WithDetectors(
+		resource.StringDetector("", "role", func() (string, error) { return role, nil }),
+	))
+	require.NoError(t, err)
+
+	r0, r1 := NewManualReader(), NewManualReader()
+	mp := NewMeterProvider(
+		WithResource(resource.NewSchemaless(attribute.String("overridden", "true"))),
+		WithResourceRefresher(refresher),
+		WithReader(r0),
+	)
+	require.NoError(t, mp.RegisterReader(r1))
+
+	ctx := context.Background()
+	for _, r := range []Reader{r0, r1} {
+		rm, err := r.Collect(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, resource.NewSchemaless(attribute.String("role", "follower")), rm.Resource)
+	}
+
+	role = "leader"
+	require.NoError(t, refresher.Refresh(ctx))
+	for _, r := range []Reader{r0, r1} {
+		rm, err := r.Collect(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, resource.NewSchemaless(attribute.String("role", "leader")), rm.Resource)
+	}
+}
+
+func TestMeterProviderReaderSignalsEmpty(t *testing.T) {
+	mp := NewMeterProvider()
+
+	ctx := context.Background()
+	assert.Nil(t, mp.ForceFlush(ctx))
+	assert.Nil(t, mp.Shutdown(ctx))
+	assert.ErrorIs(t, mp.Shutdown(ctx), ErrReaderShutdown)
+}
+
+func TestMeterProviderReaderSignalsForwarded(t *testing.T) {
+	var flush, sdown int
+	r := &reader{
+		forceFlushFunc: func(ctx context.Context) error {
+			flush++
+			return nil
+		},
+		shutdownFunc: func(ctx context.Context) error {
+			sdown++
+			return nil
+		},
+	}
+	mp := NewMeterProvider(WithReader(r))
+
+	ctx := context.Background()
+	assert.NoError(t, mp.ForceFlush(ctx))
+	assert.NoError(t, mp.ForceFlush(ctx))
+	assert.NoError(t, mp.Shutdown(ctx))
+	assert.ErrorIs(t, mp.Shutdown(ctx), ErrReaderShutdown)
+
+	// Shutdown flushes the Reader one final time.
+	assert.Equal(t, 3, flush, "flush not called 3 times")
+	assert.Equal(t, 1, sdown, "shutdown not called 1 time")
+}
+
+func TestMeterProviderReaderSignalsForwardedErrors(t *testing.T) {
+	r := &reader{
+		forceFlushFunc: func(ctx context.Context) error { return assert.AnError },
+		shutdownFunc:   func(ctx context.Context) error { return assert.AnError },
+	}
+	mp := NewMeterProvider(WithReader(r))
+
+	ctx := context.Background()
+	assert.ErrorIs(t, mp.ForceFlush(ctx), assert.AnError)
+	assert.ErrorIs(t, mp.Shutdown(ctx), assert.AnError)
+	assert.ErrorIs(t, mp.Shutdown(ctx), ErrReaderShutdown)
+}
+
+func TestMeterProviderRegisterReader(t *testing.T) {
+	ctx := context.Background()
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr))
+
+	m := mp.Meter("TestMeterProviderRegisterReader")
+	ctr, err := m.SyncInt64().Counter("counter")
+	require.NoError(t, err)
+	gauge, err := m.AsyncInt64().Gauge("gauge")
+	require.NoError(t, err)
+	err = m.RegisterCallback([]instrument.Asynchronous{gauge}, func(ctx context.Context) {
+		gauge.Observe(ctx, 3)
+	})
+	require.NoError(t, err)
+	bound := ctr.Bind(attribute.String("bound", "true"))
+
+	ctr.Add(ctx, 1)
+	bound.Add(ctx, 1)
+
+	dynamic := NewManualReader()
+	require.NoError(t, mp.RegisterReader(dynamic))
+	assert.ErrorIs(t, mp.RegisterReader(dynamic), errDuplicateRegister)
+
+	ctr.Add(ctx, 2)
+	bound.Add(ctx, 2)
+	// Instruments created after the Reader was registered are also read.
+	hist, err := m.SyncFloat64().Histogram("histogram")
+	require.NoError(t, err)
+	hist.Record(ctx, 4)
+
+	scope := instrumentation.Scope{Name: "TestMeterProviderRegisterReader"}
+	sumMetric := func(unbound, bound int64) metricdata.Metrics {
+		return metricdata.Metrics{
+			Name: "counter",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{Attributes: *attribute.EmptySet(), Value: unbound},
+					{Attributes: attribute.NewSet(attribute.String("bound", "true")), Value: bound},
+				},
+			},
+		}
+	}
+	gaugeMetric := metricdata.Metrics{
+		Name: "gauge",
+		Data: metricdata.Gauge[int64]{
+			DataPoints: []metricdata.DataPoint[int64]{{Value: 3}},
+		},
+	}
+	four := 4.
+	histMetric := metricdata.Metrics{
+		Name: "histogram",
+		Data: metricdata.Histogram{
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints: []metricdata.HistogramDataPoint{{
+				Count:        1,
+				Bounds:       []float64{0, 5, 10, 25, 50, 75, 100, 250, 500, 750, 1000, 2500, 5000, 7500, 10000},
+				BucketCounts: []uint64{0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+				Min:          &four,
+				Max:          &four,
+				Sum:          4,
+			}},
+		},
+	}
+
+	got, err := rdr.Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	metricdatatest.AssertEqual(t, metricdata.ScopeMetrics{
+		Scope:   scope,
+		Metrics: []metricdata.Metrics{sumMetric(3, 3), gaugeMetric, histMetric},
+	}, got.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+
+	got, err = dynamic.Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	metricdatatest.AssertEqual(t, metricdata.ScopeMetrics{
+		Scope:   scope,
+		Metrics: []metricdata.Metrics{sumMetric(2, 2), gaugeMetric, histMetric},
+	}, got.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+
+	require.NoError(t, mp.UnregisterReader(dynamic))
+	assert.ErrorIs(t, mp.UnregisterReader(dynamic), ErrReaderNotRegistered)
+
+	ctr.Add(ctx, 4)
+	bound.Add(ctx, 4)
+
+	got, err = rdr.Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, got.ScopeMetrics, 1)
+	metricdatatest.AssertEqual(t, metricdata.ScopeMetrics{
+		Scope:   scope,
+		Metrics: []metricdata.Metrics{sumMetric(7, 7), gaugeMetric, histMetric},
+	}, got.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestMeterProviderUnregisterReaderSignals(t *testing.T) {
+	var flush, sdown int
+	r := &reader{
+		forceFlushFunc: func(ctx context.Context) error {
+			flush++
+			return nil
+		},
+		shutdownFunc: func(ctx context.Context) error {
+			sdown++
+			return nil
+		},
+	}
+	mp := NewMeterProvider()
+	require.NoError(t, mp.RegisterReader(r))
+
+	ctx := context.Background()
+	assert.NoError(t, mp.ForceFlush(ctx))
+	require.NoError(t, mp.UnregisterReader(r))
+	assert.NoError(t, mp.ForceFlush(ctx))
+	assert.NoError(t, mp.Shutdown(ctx))
+
+	assert.Equal(t, 1, flush, "flush not called 1 time")
+	assert.Equal(t, 0, sdown, "unregistered reader shut down")
+}
+
+func TestMeterProviderRegisterReaderAfterShutdown(t *testing.T) {
+	mp := NewMeterProvider()
+	require.NoError(t, mp.Shutdown(context.Background()))
+	assert.ErrorIs(t, mp.RegisterReader(NewManualReader()), ErrReaderShutdown)
+}
+
+func TestMeterProviderRegisterReaderConcurrentSafe(t *testing.T) {
+	ctx := context.Background()
+	mp := NewMeterProvider()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctr, err := mp.Meter("TestMeterProviderRegisterReaderConcurrentSafe").SyncInt64().Counter("counter")
+			assert.NoError(t, err)
+			for j := 0; j < 100; j++ {
+				ctr.Add(ctx, 1)
+			}
+		}()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rdr := NewManualReader()
+			assert.NoError(t, mp.RegisterReader(rdr))
+			_, err := rdr.Collect(ctx)
+			assert.NoError(t, err)
+			assert.NoError(t, mp.UnregisterReader(rdr))
+		}()
+	}
+	wg.Wait()
+}
+
+func TestMeterProviderShutdownFlushesFirst(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+	record := func(call string) func(context.Context) error {
+		return func(context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			calls = append(calls, call)
+			return nil
+		}
+	}
+	newReader := func() *reader {
+		return &reader{
+			forceFlushFunc: record("flush"),
+			shutdownFunc:   record("shutdown"),
+		}
+	}
+	mp := NewMeterProvider(WithReader(newReader()), WithReader(newReader()))
+
+	require.NoError(t, mp.Shutdown(context.Background()))
+	assert.Equal(t, []string{"flush", "flush", "shutdown", "shutdown"}, calls)
+}
+
+func TestMeterProviderShutdownTimeout(t *testing.T) {
+	block := make(chan struct{})
+	t.Cleanup(func() { close(block) })
+
+	var sdown int
+	stuck := &reader{
+		sdTimeout:      10 * time.Millisecond,
+		forceFlushFunc: func(context.Context) error { return nil },
+		shutdownFunc: func(context.Context) error {
+			// Ignore the context to simulate a stuck exporter.
+			<-block
+			return nil
+		},
+	}
+	healthy := &reader{
+		sdTimeout:      time.Second,
+		forceFlushFunc: func(context.Context) error { return nil },
+		shutdownFunc: func(context.Context) error {
+			sdown++
+			return nil
+		},
+	}
+	mp := NewMeterProvider(WithReader(stuck), WithReader(healthy))
+
+	err := mp.Shutdown(context.Background())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.EqualError(t, err, "*metric.reader shutdown: context deadline exceeded")
+	assert.Equal(t, 1, sdown, "healthy reader not shut down")
+}
+
+func TestMeterProviderShutdownJoinedErrors(t *testing.T) {
+	errFlush := errors.New("flush failed")
+	flushFail := &reader{
+		forceFlushFunc: func(context.Context) error { return errFlush },
+		shutdownFunc:   func(context.Context) error { return nil },
+	}
+	exp := &fnExporter{
+		shutdownFunc: func(context.Context) error { return assert.AnError },
+	}
+	mp := NewMeterProvider(WithReader(flushFail), WithReader(NewPeriodicReader(exp)))
+
+	err := mp.Shutdown(context.Background())
+	assert.ErrorIs(t, err, errFlush)
+	assert.ErrorIs(t, err, assert.AnError)
+
+	var rErr *readerError
+	require.ErrorAs(t, err, &rErr)
+	assert.Same(t, flushFail, rErr.reader)
+	assert.Contains(t, err.Error(), "*metric.reader flush: flush failed")
+	assert.Contains(t, err.Error(), "*metric.periodicReader(*metric.fnExporter) shutdown: "+assert.AnError.Error())
+}
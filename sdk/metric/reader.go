@@ -17,11 +17,13 @@ package metric // import "go.opentelemetry.io/otel/sdk/metric"
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/view"
+	"go.opentelemetry.io/otel/sdk/resource"
 )
 
 // errDuplicateRegister is logged by a Reader when an attempt to registered it
@@ -36,6 +38,10 @@ var ErrReaderNotRegistered = fmt.Errorf("reader is not registered")
 // reader has been Shutdown once.
 var ErrReaderShutdown = fmt.Errorf("reader is shutdown")
 
+// errExternalProducer is wrapped by errors returned from Collect if an
+// external Producer fails.
+var errExternalProducer = fmt.Errorf("external producer failed")
+
 // Reader is the interface used between the SDK and an
 // exporter.  Control flow is bi-directional through the
 // Reader, since the SDK initiates ForceFlush and Shutdown
@@ -61,10 +67,40 @@ type Reader interface {
 	// aggregation returns what Aggregation to use for an instrument kind.
 	aggregation(view.InstrumentKind) aggregation.Aggregation // nolint:revive  // import-shadow for method scoped by type.
 
+	// cardinalityLimit returns the maximum number of attribute sets an
+	// instrument aggregates per collection cycle. A value of 0 or less means
+	// no limit.
+	cardinalityLimit() int
+
+	// callbackTimeout returns the time each callback registered with a Meter
+	// is given to complete during a collection. A value of 0 or less means
+	// callbacks are not timed out.
+	callbackTimeout() time.Duration
+
+	// shutdownTimeout returns the time the Reader is given to complete each
+	// of its final ForceFlush and its Shutdown when a MeterProvider is shut
+	// down. A value of 0 or less means no timeout is applied.
+	shutdownTimeout() time.Duration
+
+	// transformResource returns the Resource the Reader reports metric data
+	// with in place of res, the Resource of the MeterProvider it is
+	// registered with.
+	transformResource(res *resource.Resource) *resource.Resource
+
 	// Collect gathers and returns all metric data related to the Reader from
 	// the SDK. An error is returned if this is called after Shutdown.
 	Collect(context.Context) (metricdata.ResourceMetrics, error)
 
+	// CollectStream gathers all metric data related to the Reader from the
+	// SDK, the same as Collect. Instead of returning it, fn is called with
+	// the resource and the metric data of each instrumentation scope as it
+	// is gathered. This bounds the metric data held in memory to that of a
+	// single scope.
+	//
+	// Streaming stops at the first error returned by fn and that error is
+	// returned. An error is returned if this is called after Shutdown.
+	CollectStream(ctx context.Context, fn func(*resource.Resource, metricdata.ScopeMetrics) error) error
+
 	// ForceFlush flushes all metric measurements held in an export pipeline.
 	//
 	// This deadline or cancellation of the passed context are honored. An appropriate
@@ -88,24 +124,98 @@ type Reader interface {
 
 // producer produces metrics for a Reader.
 type producer interface {
-	// produce returns aggregated metrics from a single collection.
+	// produce stores aggregated metrics from a single collection in rm. The
+	// backing arrays of rm, and the data points they reference, are reused
+	// when they have the capacity to hold the collected metrics.
 	//
 	// This method is safe to call concurrently.
-	produce(context.Context) (metricdata.ResourceMetrics, error)
+	produce(ctx context.Context, rm *metricdata.ResourceMetrics) error
+
+	// produceStream calls fn with the resource and the aggregated metrics of
+	// each instrumentation scope from a single collection, followed by the
+	// metrics of producers. Streaming stops at the first error returned by
+	// fn and that error is returned.
+	//
+	// This method is safe to call concurrently.
+	produceStream(ctx context.Context, producers []Producer, fn func(*resource.Resource, metricdata.ScopeMetrics) error) error
+}
+
+// Producer produces metrics for a Reader from an external source, such as a
+// bridge from another metric library.
+type Producer interface {
+	// Produce returns aggregated metrics from an external source. The
+	// returned ScopeMetrics are merged with the metrics produced by the SDK
+	// each time a Reader collects.
+	//
+	// This method needs to be safe to call concurrently.
+	Produce(context.Context) ([]metricdata.ScopeMetrics, error)
+}
+
+// produceExternal appends the metrics of producers to rm. Metrics returned
+// along with an error by a Producer are still appended.
+//
+// The Metrics of each appended scope are copied so reusing the memory of rm
+// in a later collection will not modify data owned by a Producer.
+func produceExternal(ctx context.Context, producers []Producer, rm *metricdata.ResourceMetrics) error {
+	errs := &multierror{wrapped: errExternalProducer}
+	for _, p := range producers {
+		sms, err := p.Produce(ctx)
+		if err != nil {
+			errs.append(err)
+		}
+		for _, sm := range sms {
+			rm.ScopeMetrics = append(rm.ScopeMetrics, metricdata.ScopeMetrics{
+				Scope:   sm.Scope,
+				Metrics: append([]metricdata.Metrics(nil), sm.Metrics...),
+			})
+		}
+	}
+	return errs.errorOrNil()
+}
+
+// streamExternal calls fn with res and each ScopeMetrics produced by
+// producers. Metrics returned along with an error by a Producer are still
+// streamed. Streaming stops at the first error returned by fn and that error
+// is returned.
+func streamExternal(ctx context.Context, producers []Producer, res *resource.Resource, fn func(*resource.Resource, metricdata.ScopeMetrics) error) error {
+	errs := &multierror{wrapped: errExternalProducer}
+	for _, p := range producers {
+		sms, err := p.Produce(ctx)
+		if err != nil {
+			errs.append(err)
+		}
+		for _, sm := range sms {
+			if err := fn(res, sm); err != nil {
+				return err
+			}
+		}
+	}
+	return errs.errorOrNil()
 }
 
 // produceHolder is used as an atomic.Value to wrap the non-concrete producer
 // type.
 type produceHolder struct {
-	produce func(context.Context) (metricdata.ResourceMetrics, error)
+	produce       func(context.Context, *metricdata.ResourceMetrics) error
+	produceStream func(context.Context, []Producer, func(*resource.Resource, metricdata.ScopeMetrics) error) error
+}
+
+// newProduceHolder returns a produceHolder wrapping p.
+func newProduceHolder(p producer) produceHolder {
+	return produceHolder{produce: p.produce, produceStream: p.produceStream}
 }
 
 // shutdownProducer produces an ErrReaderShutdown error always.
 type shutdownProducer struct{}
 
 // produce returns an ErrReaderShutdown error.
-func (p shutdownProducer) produce(context.Context) (metricdata.ResourceMetrics, error) {
-	return metricdata.ResourceMetrics{}, ErrReaderShutdown
+func (p shutdownProducer) produce(context.Context, *metricdata.ResourceMetrics) error {
+	return ErrReaderShutdown
+}
+
+// produceStream returns an ErrReaderShutdown error.
+func (p shutdownProducer) produceStream(context.Context, []Producer, func(*resource.Resource, metricdata.ScopeMetrics) error) error {
+	return ErrReaderShutdown
 }
 
 // ReaderOption applies a configuration option value to either a ManualReader or
@@ -127,7 +237,8 @@ func DefaultTemporalitySelector(view.InstrumentKind) metricdata.Temporality {
 
 // WithTemporalitySelector sets the TemporalitySelector a reader will use to
 // determine the Temporality of an instrument based on its kind. If this
-// option is not used, the reader will use the DefaultTemporalitySelector.
+// option is not used, a ManualReader will use the DefaultTemporalitySelector
+// and a PeriodicReader will use the Temporality of its Exporter.
 func WithTemporalitySelector(selector TemporalitySelector) ReaderOption {
 	return temporalitySelectorOption{selector: selector}
 }
@@ -157,12 +268,12 @@ type AggregationSelector func(view.InstrumentKind) aggregation.Aggregation
 // InstrumentKind. This AggregationSelector using the following selection
 // mapping: Counter ⇨ Sum, Asynchronous Counter ⇨ Sum, UpDownCounter ⇨ Sum,
 // Asynchronous UpDownCounter ⇨ Sum, Asynchronous Gauge ⇨ LastValue,
-// Histogram ⇨ ExplicitBucketHistogram.
+// Gauge ⇨ LastValue, Histogram ⇨ ExplicitBucketHistogram.
 func DefaultAggregationSelector(ik view.InstrumentKind) aggregation.Aggregation {
 	switch ik {
 	case view.SyncCounter, view.SyncUpDownCounter, view.AsyncCounter, view.AsyncUpDownCounter:
 		return aggregation.Sum{}
-	case view.AsyncGauge:
+	case view.AsyncGauge, view.SyncGauge:
 		return aggregation.LastValue{}
 	case view.SyncHistogram:
 		return aggregation.ExplicitBucketHistogram{
@@ -175,11 +286,19 @@ func DefaultAggregationSelector(ik view.InstrumentKind) aggregation.Aggregation
 
 // WithAggregationSelector sets the AggregationSelector a reader will use to
 // determine the aggregation to use for an instrument based on its kind. If
-// this option is not used, the reader will use the DefaultAggregationSelector
-// or the aggregation explicitly passed for a view matching an instrument.
+// this option is not used, a ManualReader will use the
+// DefaultAggregationSelector and a PeriodicReader will use the Aggregation of
+// its Exporter. In both cases, the aggregation explicitly passed for a view
+// matching an instrument takes precedence.
 func WithAggregationSelector(selector AggregationSelector) ReaderOption {
-	// Deep copy and validate before using.
-	wrapped := func(ik view.InstrumentKind) aggregation.Aggregation {
+	return aggregationSelectorOption{selector: validAggregationSelector(selector)}
+}
+
+// validAggregationSelector returns an AggregationSelector that deep copies and
+// validates the aggregation selected by selector. If the selected aggregation
+// is invalid, the DefaultAggregationSelector selection is used instead.
+func validAggregationSelector(selector AggregationSelector) AggregationSelector {
+	return func(ik view.InstrumentKind) aggregation.Aggregation {
 		a := selector(ik)
 		cpA := a.Copy()
 		if err := cpA.Err(); err != nil {
@@ -192,8 +311,6 @@ func WithAggregationSelector(selector AggregationSelector) ReaderOption {
 		}
 		return cpA
 	}
-
-	return aggregationSelectorOption{selector: wrapped}
 }
 
 type aggregationSelectorOption struct {
@@ -211,3 +328,169 @@ func (t aggregationSelectorOption) applyPeriodic(c periodicReaderConfig) periodi
 	c.aggregationSelector = t.selector
 	return c
 }
+
+// WithCardinalityLimit sets the maximum number of distinct attribute sets a
+// reader will aggregate for each instrument per collection cycle. Once the
+// limit is reached, measurements with new attribute sets are aggregated into
+// a single overflow timeseries with the otel.metric.overflow=true attribute.
+// The overflow timeseries counts towards the limit.
+//
+// If this option is not used or limit is not positive, no limit is applied.
+// The limit set with the WithCardinalityLimit option of a view matching an
+// instrument takes precedence.
+func WithCardinalityLimit(limit int) ReaderOption {
+	return cardinalityLimitOption{limit: limit}
+}
+
+type cardinalityLimitOption struct {
+	limit int
+}
+
+// applyManual returns a manualReaderConfig with option applied.
+func (o cardinalityLimitOption) applyManual(c manualReaderConfig) manualReaderConfig {
+	c.cardinalityLimit = o.limit
+	return c
+}
+
+// applyPeriodic returns a periodicReaderConfig with option applied.
+func (o cardinalityLimitOption) applyPeriodic(c periodicReaderConfig) periodicReaderConfig {
+	c.cardinalityLimit = o.limit
+	return c
+}
+
+// WithCallbackTimeout sets the time each callback registered with a Meter is
+// given to complete when a reader collects. A callback that does not complete
+// within d is abandoned: the observations it made during the collection are
+// dropped and an error naming the instrumentation scope and instruments of
+// the callback is passed to the global error handler. The collection then
+// continues with the remaining callbacks.
+//
+// Abandoned callbacks are not stopped, they continue to run in their own
+// goroutine and any observation they make after being abandoned is ignored.
+//
+// If this option is not used or d is less than or equal to zero, callbacks
+// are not timed out and a hung callback will block collection until the
+// context passed to Collect is done.
+func WithCallbackTimeout(d time.Duration) ReaderOption {
+	return callbackTimeoutOption{timeout: d}
+}
+
+type callbackTimeoutOption struct {
+	timeout time.Duration
+}
+
+// applyManual returns a manualReaderConfig with option applied.
+func (o callbackTimeoutOption) applyManual(c manualReaderConfig) manualReaderConfig {
+	c.callbackTimeout = o.timeout
+	return c
+}
+
+// applyPeriodic returns a periodicReaderConfig with option applied.
+func (o callbackTimeoutOption) applyPeriodic(c periodicReaderConfig) periodicReaderConfig {
+	c.callbackTimeout = o.timeout
+	return c
+}
+
+// WithShutdownTimeout sets the duration d a reader is given to complete its
+// final flush, and then its shutdown, when the MeterProvider it is registered
+// with is shut down. A reader that does not complete within d is abandoned
+// and a context.DeadlineExceeded error naming the reader is returned from
+// the MeterProvider Shutdown. The shut down of the other readers of the
+// MeterProvider continues.
+//
+// If this option is not used or d is less than or equal to zero, the reader
+// is given until the context passed to the MeterProvider Shutdown is done.
+func WithShutdownTimeout(d time.Duration) ReaderOption {
+	return shutdownTimeoutOption{timeout: d}
+}
+
+type shutdownTimeoutOption struct {
+	timeout time.Duration
+}
+
+// applyManual returns a manualReaderConfig with option applied.
+func (o shutdownTimeoutOption) applyManual(c manualReaderConfig) manualReaderConfig {
+	c.shutdownTimeout = o.timeout
+	return c
+}
+
+// applyPeriodic returns a periodicReaderConfig with option applied.
+func (o shutdownTimeoutOption) applyPeriodic(c periodicReaderConfig) periodicReaderConfig {
+	c.shutdownTimeout = o.timeout
+	return c
+}
+
+// WithProducer registers producer as an external source of metric data for a
+// reader. Each time the reader collects, the metrics of producer are merged
+// with the metrics produced by the SDK. This option can be used multiple
+// times to register multiple producers.
+func WithProducer(producer Producer) ReaderOption {
+	return producerOption{producer: producer}
+}
+
+type producerOption struct {
+	producer Producer
+}
+
+// applyManual returns a manualReaderConfig with option applied.
+func (o producerOption) applyManual(c manualReaderConfig) manualReaderConfig {
+	c.producers = append(c.producers, o.producer)
+	return c
+}
+
+// applyPeriodic returns a periodicReaderConfig with option applied.
+func (o producerOption) applyPeriodic(c periodicReaderConfig) periodicReaderConfig {
+	c.producers = append(c.producers, o.producer)
+	return c
+}
+
+// WithResourceTransform configures a reader to report the metric data it
+// collects with the Resource returned from fn instead of the Resource of the
+// MeterProvider the reader is registered with. fn is called with the Resource
+// of the MeterProvider each time the reader collects. If fn returns nil, an
+// empty Resource is reported.
+//
+// This can be used when different export pipelines need different resource
+// attributes. For example, to redact the host name from the metric data
+// exported to an external system:
+//
+//	WithResourceTransform(func(res *resource.Resource) *resource.Resource {
+//		attrs, _ := res.Set().Filter(func(kv attribute.KeyValue) bool {
+//			return kv.Key != semconv.HostNameKey
+//		})
+//		return resource.NewWithAttributes(res.SchemaURL(), attrs.ToSlice()...)
+//	})
+//
+// By default, if this option is not used or fn is nil, the Resource of the
+// MeterProvider is reported.
+func WithResourceTransform(fn func(*resource.Resource) *resource.Resource) ReaderOption {
+	return resourceTransformOption{transform: fn}
+}
+
+type resourceTransformOption struct {
+	transform func(*resource.Resource) *resource.Resource
+}
+
+// applyManual returns a manualReaderConfig with option applied.
+func (o resourceTransformOption) applyManual(c manualReaderConfig) manualReaderConfig {
+	c.resourceTransform = o.transform
+	return c
+}
+
+// applyPeriodic returns a periodicReaderConfig with option applied.
+func (o resourceTransformOption) applyPeriodic(c periodicReaderConfig) periodicReaderConfig {
+	c.resourceTransform = o.transform
+	return c
+}
+
+// transformResource returns the Resource fn returns for res. If fn is nil, res
+// is returned. If fn returns nil, an empty Resource is returned.
+func transformResource(fn func(*resource.Resource) *resource.Resource, res *resource.Resource) *resource.Resource {
+	if fn == nil {
+		return res
+	}
+	if r := fn(res); r != nil {
+		return r
+	}
+	return resource.Empty()
+}
@@ -65,6 +65,42 @@ func (ts *readerTestSuite) TestProducer() {
 	ts.Equal(testMetrics, m)
 }
 
+func (ts *readerTestSuite) TestCollectStream() {
+	ts.Reader.register(testProducer{})
+	var got []metricdata.ScopeMetrics
+	err := ts.Reader.CollectStream(context.Background(), func(res *resource.Resource, sm metricdata.ScopeMetrics) error {
+		ts.Equal(testMetrics.Resource, res)
+		got = append(got, sm)
+		return nil
+	})
+	ts.NoError(err)
+	ts.Equal(testMetrics.ScopeMetrics, got)
+}
+
+func (ts *readerTestSuite) TestCollectStreamStopsOnError() {
+	ts.Reader.register(testProducer{})
+	var calls int
+	err := ts.Reader.CollectStream(context.Background(), func(*resource.Resource, metricdata.ScopeMetrics) error {
+		calls++
+		return assert.AnError
+	})
+	ts.ErrorIs(err, assert.AnError)
+	ts.Equal(1, calls)
+}
+
+func (ts *readerTestSuite) TestCollectStreamErrors() {
+	fn := func(*resource.Resource, metricdata.ScopeMetrics) error {
+		ts.Fail("streamed metrics from an unusable reader")
+		return nil
+	}
+	ctx := context.Background()
+	ts.ErrorIs(ts.Reader.CollectStream(ctx, fn), ErrReaderNotRegistered)
+
+	ts.Reader.register(testProducer{})
+	ts.Require().NoError(ts.Reader.Shutdown(ctx))
+	ts.ErrorIs(ts.Reader.CollectStream(ctx, fn), ErrReaderShutdown)
+}
+
 func (ts *readerTestSuite) TestCollectAfterShutdown() {
 	ctx := context.Background()
 	ts.Reader.register(testProducer{})
@@ -175,11 +211,127 @@ type testProducer struct {
 	produceFunc func(context.Context) (metricdata.ResourceMetrics, error)
 }
 
-func (p testProducer) produce(ctx context.Context) (metricdata.ResourceMetrics, error) {
+func (p testProducer) produce(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	if p.produceFunc != nil {
+		var err error
+		*rm, err = p.produceFunc(ctx)
+		return err
+	}
+	*rm = testMetrics
+	return nil
+}
+
+func (p testProducer) produceStream(ctx context.Context, producers []Producer, fn func(*resource.Resource, metricdata.ScopeMetrics) error) error {
+	var rm metricdata.ResourceMetrics
+	if err := p.produce(ctx, &rm); err != nil {
+		return err
+	}
+	for _, sm := range rm.ScopeMetrics {
+		if err := fn(rm.Resource, sm); err != nil {
+			return err
+		}
+	}
+	return streamExternal(ctx, producers, rm.Resource, fn)
+}
+
+var testExternalMetrics = []metricdata.ScopeMetrics{{
+	Scope: instrumentation.Scope{Name: "sdk/metric/test/external"},
+	Metrics: []metricdata.Metrics{{
+		Name: "external data",
+		Data: metricdata.Gauge[float64]{
+			DataPoints: []metricdata.DataPoint[float64]{{
+				Attributes: attribute.NewSet(attribute.String("user", "bob")),
+				Time:       time.Now(),
+				Value:      1,
+			}},
+		},
+	}},
+}}
+
+type testExternalProducer struct {
+	produceFunc func(context.Context) ([]metricdata.ScopeMetrics, error)
+}
+
+func (p testExternalProducer) Produce(ctx context.Context) ([]metricdata.ScopeMetrics, error) {
 	if p.produceFunc != nil {
 		return p.produceFunc(ctx)
 	}
-	return testMetrics, nil
+	return testExternalMetrics, nil
+}
+
+func TestWithProducer(t *testing.T) {
+	errProducer := testExternalProducer{
+		produceFunc: func(context.Context) ([]metricdata.ScopeMetrics, error) {
+			return nil, assert.AnError
+		},
+	}
+
+	readers := map[string]func(...ReaderOption) Reader{
+		"ManualReader": func(opts ...ReaderOption) Reader {
+			mOpts := make([]ManualReaderOption, len(opts))
+			for i, o := range opts {
+				mOpts[i] = o
+			}
+			return NewManualReader(mOpts...)
+		},
+		"PeriodicReader": func(opts ...ReaderOption) Reader {
+			pOpts := make([]PeriodicReaderOption, len(opts))
+			for i, o := range opts {
+				pOpts[i] = o
+			}
+			return NewPeriodicReader(new(fnExporter), pOpts...)
+		},
+	}
+	for name, newReader := range readers {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			r := newReader(WithProducer(testExternalProducer{}))
+			r.register(testProducer{})
+			got, err := r.Collect(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, testMetrics.Resource, got.Resource)
+			assert.Equal(t, append(testMetrics.ScopeMetrics, testExternalMetrics...), got.ScopeMetrics)
+			assert.NoError(t, r.Shutdown(ctx))
+
+			r = newReader(WithProducer(errProducer), WithProducer(testExternalProducer{}))
+			r.register(testProducer{})
+			got, err = r.Collect(ctx)
+			assert.ErrorIs(t, err, errExternalProducer)
+			assert.Equal(t, append(testMetrics.ScopeMetrics, testExternalMetrics...), got.ScopeMetrics, "metrics of other producers should be returned")
+
+			var streamed []metricdata.ScopeMetrics
+			err = r.CollectStream(ctx, func(res *resource.Resource, sm metricdata.ScopeMetrics) error {
+				assert.Equal(t, testMetrics.Resource, res)
+				streamed = append(streamed, sm)
+				return nil
+			})
+			assert.ErrorIs(t, err, errExternalProducer)
+			assert.Equal(t, append(testMetrics.ScopeMetrics, testExternalMetrics...), streamed, "metrics of other producers should be streamed")
+			_ = r.Shutdown(ctx)
+		})
+	}
+}
+
+func TestPeriodicReaderExportsOnExternalProducerError(t *testing.T) {
+	var exported metricdata.ResourceMetrics
+	exp := &fnExporter{
+		exportFunc: func(_ context.Context, m metricdata.ResourceMetrics) error {
+			exported = m
+			return nil
+		},
+	}
+	errProducer := testExternalProducer{
+		produceFunc: func(context.Context) ([]metricdata.ScopeMetrics, error) {
+			return nil, assert.AnError
+		},
+	}
+	r := NewPeriodicReader(exp, WithProducer(errProducer))
+	r.register(testProducer{})
+	t.Cleanup(func() { _ = r.Shutdown(context.Background()) })
+
+	assert.ErrorIs(t, r.ForceFlush(context.Background()), errExternalProducer)
+	assert.Equal(t, testMetrics, exported, "SDK metrics should be exported")
 }
 
 func benchReaderCollectFunc(r Reader) func(*testing.B) {
@@ -212,6 +364,7 @@ func TestDefaultAggregationSelector(t *testing.T) {
 		view.SyncCounter,
 		view.SyncUpDownCounter,
 		view.SyncHistogram,
+		view.SyncGauge,
 		view.AsyncCounter,
 		view.AsyncUpDownCounter,
 		view.AsyncGauge,
@@ -229,6 +382,7 @@ func TestDefaultTemporalitySelector(t *testing.T) {
 		view.SyncCounter,
 		view.SyncUpDownCounter,
 		view.SyncHistogram,
+		view.SyncGauge,
 		view.AsyncCounter,
 		view.AsyncUpDownCounter,
 		view.AsyncGauge,
@@ -236,3 +390,48 @@ func TestDefaultTemporalitySelector(t *testing.T) {
 		assert.Equal(t, metricdata.CumulativeTemporality, DefaultTemporalitySelector(ik))
 	}
 }
+
+func TestWithCardinalityLimit(t *testing.T) {
+	assert.Equal(t, 0, NewManualReader().cardinalityLimit())
+	assert.Equal(t, 10, NewManualReader(WithCardinalityLimit(10)).cardinalityLimit())
+
+	r := NewPeriodicReader(new(fnExporter), WithCardinalityLimit(10))
+	t.Cleanup(func() { _ = r.Shutdown(context.Background()) })
+	assert.Equal(t, 10, r.cardinalityLimit())
+}
+
+func TestWithCallbackTimeout(t *testing.T) {
+	assert.Equal(t, time.Duration(0), NewManualReader().callbackTimeout())
+	assert.Equal(t, time.Second, NewManualReader(WithCallbackTimeout(time.Second)).callbackTimeout())
+
+	r := NewPeriodicReader(new(fnExporter), WithCallbackTimeout(time.Second))
+	t.Cleanup(func() { _ = r.Shutdown(context.Background()) })
+	assert.Equal(t, time.Second, r.callbackTimeout())
+}
+
+func TestWithShutdownTimeout(t *testing.T) {
+	assert.Equal(t, time.Duration(0), NewManualReader().shutdownTimeout())
+	assert.Equal(t, time.Second, NewManualReader(WithShutdownTimeout(time.Second)).shutdownTimeout())
+
+	r := NewPeriodicReader(new(fnExporter), WithShutdownTimeout(time.Second))
+	t.Cleanup(func() { _ = r.Shutdown(context.Background()) })
+	assert.Equal(t, time.Second, r.shutdownTimeout())
+}
+
+func TestWithResourceTransform(t *testing.T) {
+	res := resource.NewSchemaless(attribute.String("host.name", "alice"))
+	redacted := resource.NewSchemaless(attribute.String("service.name", "bob"))
+	redact := func(*resource.Resource) *resource.Resource { return redacted }
+
+	assert.Same(t, res, NewManualReader().transformResource(res))
+	assert.Same(t, redacted, NewManualReader(WithResourceTransform(redact)).transformResource(res))
+
+	empty := NewManualReader(WithResourceTransform(func(*resource.Resource) *resource.Resource {
+		return nil
+	}))
+	assert.Equal(t, resource.Empty(), empty.transformResource(res), "nil resource not replaced")
+
+	r := NewPeriodicReader(new(fnExporter), WithResourceTransform(redact))
+	t.Cleanup(func() { _ = r.Shutdown(context.Background()) })
+	assert.Same(t, redacted, r.transformResource(res))
+}
@@ -0,0 +1,30 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package runtime provides a Producer of Go runtime metrics.
+//
+// The Producer reads the metrics the Go runtime publishes with the
+// runtime/metrics package each time it produces metrics and reports them
+// using the names of the OpenTelemetry semantic conventions for the Go
+// runtime (go.memory.used, go.goroutine.count, go.schedule.duration, ...).
+// Register it with a Reader using the metric.WithProducer option:
+//
+//	reader := metric.NewPeriodicReader(exporter, metric.WithProducer(
+//		runtime.NewProducer(),
+//	))
+//	provider := metric.NewMeterProvider(metric.WithReader(reader))
+//
+// Metrics that are not published by the Go runtime the program was built
+// with are not reported.
+package runtime // import "go.opentelemetry.io/otel/sdk/metric/runtime"
@@ -0,0 +1,266 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime // import "go.opentelemetry.io/otel/sdk/metric/runtime"
+
+import (
+	"context"
+	"math"
+	"runtime/metrics"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/unit"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const scopeName = "go.opentelemetry.io/otel/sdk/metric/runtime"
+
+// Names of the runtime/metrics samples read by the Producer.
+const (
+	memTotal      = "/memory/classes/total:bytes"
+	memReleased   = "/memory/classes/heap/released:bytes"
+	memHeapStacks = "/memory/classes/heap/stacks:bytes"
+	memOSStacks   = "/memory/classes/os-stacks:bytes"
+	memLimit      = "/gc/gomemlimit:bytes"
+	allocBytes    = "/gc/heap/allocs:bytes"
+	allocObjects  = "/gc/heap/allocs:objects"
+	heapGoal      = "/gc/heap/goal:bytes"
+	goroutines    = "/sched/goroutines:goroutines"
+	maxProcs      = "/sched/gomaxprocs:threads"
+	gogc          = "/gc/gogc:percent"
+	schedLatency  = "/sched/latencies:seconds"
+	gcPauses      = "/gc/pauses:seconds"
+)
+
+var sampleNames = []string{
+	memTotal,
+	memReleased,
+	memHeapStacks,
+	memOSStacks,
+	memLimit,
+	allocBytes,
+	allocObjects,
+	heapGoal,
+	goroutines,
+	maxProcs,
+	gogc,
+	schedLatency,
+	gcPauses,
+}
+
+var (
+	memTypeStack = attribute.NewSet(attribute.String("go.memory.type", "stack"))
+	memTypeOther = attribute.NewSet(attribute.String("go.memory.type", "other"))
+)
+
+// Producer is a metric.Producer that provides the metrics of the Go runtime
+// to an OpenTelemetry Reader.
+type Producer struct {
+	// startTime is reported as the start time of all cumulative data.
+	startTime time.Time
+
+	mu      sync.Mutex
+	samples []metrics.Sample
+	index   map[string]int
+}
+
+var _ metric.Producer = (*Producer)(nil)
+
+// NewProducer returns a Producer that reads the metrics of the Go runtime
+// each time it produces metrics.
+func NewProducer() *Producer {
+	supported := make(map[string]bool)
+	for _, d := range metrics.All() {
+		supported[d.Name] = true
+	}
+
+	p := &Producer{
+		startTime: time.Now(),
+		index:     make(map[string]int, len(sampleNames)),
+	}
+	for _, name := range sampleNames {
+		if !supported[name] {
+			continue
+		}
+		p.index[name] = len(p.samples)
+		p.samples = append(p.samples, metrics.Sample{Name: name})
+	}
+	return p
+}
+
+// Produce reads the metrics of the Go runtime and returns them as
+// OpenTelemetry metric data.
+//
+// This method is safe to call concurrently.
+func (p *Producer) Produce(context.Context) ([]metricdata.ScopeMetrics, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	metrics.Read(p.samples)
+	now := time.Now()
+
+	var out []metricdata.Metrics
+	total, okTotal := p.int64Value(memTotal)
+	released, okReleased := p.int64Value(memReleased)
+	heapStacks, okHeapStacks := p.int64Value(memHeapStacks)
+	osStacks, okOSStacks := p.int64Value(memOSStacks)
+	if okTotal && okReleased && okHeapStacks && okOSStacks {
+		stack := heapStacks + osStacks
+		out = append(out, metricdata.Metrics{
+			Name:        "go.memory.used",
+			Description: "Memory used by the Go runtime.",
+			Unit:        unit.Bytes,
+			Data: metricdata.Sum[int64]{
+				DataPoints: []metricdata.DataPoint[int64]{
+					p.dataPoint(memTypeStack, stack, now),
+					p.dataPoint(memTypeOther, total-released-stack, now),
+				},
+				Temporality: metricdata.CumulativeTemporality,
+			},
+		})
+	}
+	out = p.appendSum(out, memLimit, "go.memory.limit", "Go runtime memory limit configured by the user, if a limit exists.", unit.Bytes, false, now)
+	out = p.appendSum(out, allocBytes, "go.memory.allocated", "Memory allocated to the heap by the application.", unit.Bytes, true, now)
+	out = p.appendSum(out, allocObjects, "go.memory.allocations", "Count of allocations to the heap by the application.", "{allocation}", true, now)
+	out = p.appendSum(out, heapGoal, "go.memory.gc.goal", "Heap size target for the end of the GC cycle.", unit.Bytes, false, now)
+	out = p.appendSum(out, goroutines, "go.goroutine.count", "Count of live goroutines.", "{goroutine}", false, now)
+	out = p.appendSum(out, maxProcs, "go.processor.limit", "The number of OS threads that can execute user-level Go code simultaneously.", "{thread}", false, now)
+	out = p.appendSum(out, gogc, "go.config.gogc", "Heap size target percentage configured by the user, otherwise 100.", "%", false, now)
+	out = p.appendHistogram(out, schedLatency, "go.schedule.duration", "The time goroutines have spent in the scheduler in a runnable state before actually running.", now)
+	out = p.appendHistogram(out, gcPauses, "go.gc.pause.duration", "The time of individual stop-the-world pauses of the GC.", now)
+
+	if len(out) == 0 {
+		return nil, nil
+	}
+	return []metricdata.ScopeMetrics{{
+		Scope:   instrumentation.Scope{Name: scopeName},
+		Metrics: out,
+	}}, nil
+}
+
+// int64Value returns the value of the sample with name as an int64. False is
+// returned if the sample is not supported by the Go runtime.
+func (p *Producer) int64Value(name string) (int64, bool) {
+	i, ok := p.index[name]
+	if !ok || p.samples[i].Value.Kind() != metrics.KindUint64 {
+		return 0, false
+	}
+	v := p.samples[i].Value.Uint64()
+	if v > math.MaxInt64 {
+		v = math.MaxInt64
+	}
+	return int64(v), true
+}
+
+func (p *Producer) dataPoint(attrs attribute.Set, value int64, now time.Time) metricdata.DataPoint[int64] {
+	return metricdata.DataPoint[int64]{
+		Attributes: attrs,
+		StartTime:  p.startTime,
+		Time:       now,
+		Value:      value,
+	}
+}
+
+// appendSum appends the sample with name to out as a cumulative Sum if it is
+// supported by the Go runtime.
+func (p *Producer) appendSum(out []metricdata.Metrics, name, otelName, desc string, u unit.Unit, monotonic bool, now time.Time) []metricdata.Metrics {
+	v, ok := p.int64Value(name)
+	if !ok {
+		return out
+	}
+	return append(out, metricdata.Metrics{
+		Name:        otelName,
+		Description: desc,
+		Unit:        u,
+		Data: metricdata.Sum[int64]{
+			DataPoints:  []metricdata.DataPoint[int64]{p.dataPoint(*attribute.EmptySet(), v, now)},
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: monotonic,
+		},
+	})
+}
+
+// appendHistogram appends the sample with name to out as a cumulative
+// Histogram if it is supported by the Go runtime.
+func (p *Producer) appendHistogram(out []metricdata.Metrics, name, otelName, desc string, now time.Time) []metricdata.Metrics {
+	i, ok := p.index[name]
+	if !ok || p.samples[i].Value.Kind() != metrics.KindFloat64Histogram {
+		return out
+	}
+	dPt := convertHistogram(p.samples[i].Value.Float64Histogram())
+	dPt.StartTime = p.startTime
+	dPt.Time = now
+	return append(out, metricdata.Metrics{
+		Name:        otelName,
+		Description: desc,
+		Unit:        unit.Unit("s"),
+		Data: metricdata.Histogram{
+			DataPoints:  []metricdata.HistogramDataPoint{dPt},
+			Temporality: metricdata.CumulativeTemporality,
+		},
+	})
+}
+
+// convertHistogram converts a runtime/metrics histogram to a histogram data
+// point.
+//
+// The Buckets of h are the boundaries of its buckets, the first and last of
+// which may be infinite. The inner boundaries are used as the bounds of the
+// returned data point. The runtime does not record the sum of the values, it
+// is estimated from the midpoints of the buckets.
+func convertHistogram(h *metrics.Float64Histogram) metricdata.HistogramDataPoint {
+	var (
+		count  uint64
+		sum    float64
+		bounds []float64
+	)
+	if n := len(h.Counts); n > 1 {
+		bounds = make([]float64, 0, n-1)
+	}
+	for i, c := range h.Counts {
+		count += c
+		if c > 0 {
+			sum += float64(c) * midpoint(h.Buckets[i], h.Buckets[i+1])
+		}
+		if i < len(h.Counts)-1 {
+			bounds = append(bounds, h.Buckets[i+1])
+		}
+	}
+	return metricdata.HistogramDataPoint{
+		Attributes:   *attribute.EmptySet(),
+		Count:        count,
+		Bounds:       bounds,
+		BucketCounts: append([]uint64(nil), h.Counts...),
+		Sum:          sum,
+	}
+}
+
+// midpoint returns the midpoint of the bucket [lower, upper). If one of the
+// boundaries is infinite, the other is returned.
+func midpoint(lower, upper float64) float64 {
+	lowerInf, upperInf := math.IsInf(lower, -1), math.IsInf(upper, 1)
+	switch {
+	case lowerInf && upperInf:
+		return 0
+	case lowerInf:
+		return upper
+	case upperInf:
+		return lower
+	}
+	return lower + (upper-lower)/2
+}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"math"
+	goruntime "runtime"
+	"runtime/metrics"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestProducer(t *testing.T) {
+	reader := metric.NewManualReader(metric.WithProducer(NewProducer()))
+	_ = metric.NewMeterProvider(metric.WithReader(reader))
+
+	goruntime.GC()
+	rm, err := reader.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, rm.ScopeMetrics, 1)
+
+	sm := rm.ScopeMetrics[0]
+	assert.Equal(t, instrumentation.Scope{Name: scopeName}, sm.Scope)
+
+	got := make(map[string]metricdata.Aggregation, len(sm.Metrics))
+	for _, m := range sm.Metrics {
+		got[m.Name] = m.Data
+	}
+
+	used, ok := got["go.memory.used"].(metricdata.Sum[int64])
+	require.True(t, ok, "go.memory.used")
+	require.Len(t, used.DataPoints, 2)
+	for _, dPt := range used.DataPoints {
+		v, _ := dPt.Attributes.Value(attribute.Key("go.memory.type"))
+		assert.Contains(t, []string{"stack", "other"}, v.AsString())
+		assert.Positive(t, dPt.Value)
+	}
+
+	goroutines, ok := got["go.goroutine.count"].(metricdata.Sum[int64])
+	require.True(t, ok, "go.goroutine.count")
+	assert.False(t, goroutines.IsMonotonic)
+	require.Len(t, goroutines.DataPoints, 1)
+	assert.Positive(t, goroutines.DataPoints[0].Value)
+
+	allocated, ok := got["go.memory.allocated"].(metricdata.Sum[int64])
+	require.True(t, ok, "go.memory.allocated")
+	assert.True(t, allocated.IsMonotonic)
+
+	sched, ok := got["go.schedule.duration"].(metricdata.Histogram)
+	require.True(t, ok, "go.schedule.duration")
+	require.Len(t, sched.DataPoints, 1)
+	dPt := sched.DataPoints[0]
+	assert.Len(t, dPt.BucketCounts, len(dPt.Bounds)+1)
+
+	for _, name := range []string{"go.memory.gc.goal", "go.memory.allocations"} {
+		assert.Contains(t, got, name)
+	}
+}
+
+func TestConvertHistogram(t *testing.T) {
+	h := &metrics.Float64Histogram{
+		Counts:  []uint64{1, 2, 0, 3},
+		Buckets: []float64{math.Inf(-1), 1, 2, 4, math.Inf(1)},
+	}
+	got := convertHistogram(h)
+	assert.Equal(t, uint64(6), got.Count)
+	assert.Equal(t, []float64{1, 2, 4}, got.Bounds)
+	assert.Equal(t, []uint64{1, 2, 0, 3}, got.BucketCounts)
+	// 1*1 (upper of the unbounded first bucket) + 2*1.5 + 3*4 (lower of the
+	// unbounded last bucket).
+	assert.Equal(t, 16.0, got.Sum)
+
+	got = convertHistogram(&metrics.Float64Histogram{
+		Counts:  []uint64{5},
+		Buckets: []float64{math.Inf(-1), math.Inf(1)},
+	})
+	assert.Equal(t, uint64(5), got.Count)
+	assert.Empty(t, got.Bounds)
+	assert.Equal(t, []uint64{5}, got.BucketCounts)
+	assert.Equal(t, 0.0, got.Sum)
+}
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+)
+
+// CollectOnTrigger captures an on-demand snapshot of the metrics of readers
+// each time a value is received from trigger. For every trigger, the metrics
+// collected from each reader are exported with exporter. This can be used to
+// inspect the metrics of a running process, e.g. by exporting to a stdout
+// exporter. Readers are not flushed, a PeriodicReader does not export to its
+// own exporter for a snapshot.
+//
+// Collecting from a Reader that uses delta temporality resets its
+// aggregations. Measurements included in a snapshot are then not included in
+// the next regular export of that Reader.
+//
+// Errors collecting or exporting are sent to the global
+// ErrorHandler. Snapshots stop being captured when trigger is closed or the
+// returned stop function is called. The stop function blocks until any
+// snapshot in progress is complete.
+func CollectOnTrigger(trigger <-chan struct{}, exporter Exporter, readers ...Reader) (stop func()) {
+	return collectOn(trigger, exporter, readers, nil)
+}
+
+// collectOn runs CollectOnTrigger for any type of trigger. The cleanup
+// function, if not nil, is called once snapshots stop being captured.
+func collectOn[T any](trigger <-chan T, exporter Exporter, readers []Reader, cleanup func()) (stop func()) {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if cleanup != nil {
+			defer cleanup()
+		}
+		for {
+			select {
+			case _, ok := <-trigger:
+				if !ok {
+					return
+				}
+				collectSnapshot(context.Background(), exporter, readers)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+		wg.Wait()
+	}
+}
+
+// collectSnapshot collects each of readers and exports the collected metrics
+// with exporter.
+func collectSnapshot(ctx context.Context, exporter Exporter, readers []Reader) {
+	for _, r := range readers {
+		rm, err := r.Collect(ctx)
+		if err != nil {
+			otel.Handle(err)
+			continue
+		}
+		if err := exporter.Export(ctx, rm); err != nil {
+			otel.Handle(err)
+		}
+	}
+}
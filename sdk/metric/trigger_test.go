@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestCollectOnTrigger(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(WithReader(rdr))
+	ctr, err := mp.Meter("TestCollectOnTrigger").SyncInt64().Counter("counter")
+	require.NoError(t, err)
+	ctr.Add(context.Background(), 1)
+
+	exported := make(chan metricdata.ResourceMetrics, 1)
+	exp := &fnExporter{
+		exportFunc: func(_ context.Context, rm metricdata.ResourceMetrics) error {
+			exported <- rm
+			return nil
+		},
+	}
+
+	trigger := make(chan struct{})
+	stop := CollectOnTrigger(trigger, exp, rdr)
+	defer stop()
+
+	trigger <- struct{}{}
+	rm := <-exported
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	assert.Equal(t, "counter", rm.ScopeMetrics[0].Metrics[0].Name)
+}
+
+func TestCollectOnTriggerPeriodicReader(t *testing.T) {
+	var readerExports int64
+	rdr := NewPeriodicReader(&fnExporter{
+		exportFunc: func(context.Context, metricdata.ResourceMetrics) error {
+			atomic.AddInt64(&readerExports, 1)
+			return nil
+		},
+	}, WithInterval(time.Hour))
+	mp := NewMeterProvider(WithReader(rdr))
+	ctr, err := mp.Meter("TestCollectOnTriggerPeriodicReader").SyncInt64().Counter("counter")
+	require.NoError(t, err)
+	ctr.Add(context.Background(), 1)
+
+	exported := make(chan metricdata.ResourceMetrics, 1)
+	exp := &fnExporter{
+		exportFunc: func(_ context.Context, rm metricdata.ResourceMetrics) error {
+			exported <- rm
+			return nil
+		},
+	}
+
+	trigger := make(chan struct{})
+	stop := CollectOnTrigger(trigger, exp, rdr)
+	trigger <- struct{}{}
+	rm := <-exported
+	stop()
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	assert.Zero(t, atomic.LoadInt64(&readerExports), "snapshot exported with the exporter of the reader")
+	require.NoError(t, rdr.Shutdown(context.Background()))
+}
+
+func TestCollectOnTriggerStop(t *testing.T) {
+	exp := &fnExporter{
+		exportFunc: func(context.Context, metricdata.ResourceMetrics) error {
+			t.Error("snapshot exported after stop")
+			return nil
+		},
+	}
+
+	trigger := make(chan struct{}, 1)
+	stop := CollectOnTrigger(trigger, exp, NewManualReader())
+	stop()
+	// Stopping more than once is allowed.
+	stop()
+
+	trigger <- struct{}{}
+}
+
+func TestCollectOnTriggerClosed(t *testing.T) {
+	trigger := make(chan struct{})
+	stop := CollectOnTrigger(trigger, &fnExporter{}, NewManualReader())
+	close(trigger)
+	// Returns once the closed trigger has stopped snapshots.
+	stop()
+}
@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris || zos
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris zos
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// CollectOnSignal is like CollectOnTrigger, but captures a snapshot of the
+// metrics of readers each time the process receives a SIGUSR1 signal. For
+// example, to write the metrics of a running process to stdout:
+//
+//	exp, _ := stdoutmetric.New()
+//	stop := metric.CollectOnSignal(exp, reader)
+//	defer stop()
+//
+// and then signal the process with `kill -USR1 <pid>`.
+//
+// Once the returned stop function is called SIGUSR1 is no longer handled.
+func CollectOnSignal(exporter Exporter, readers ...Reader) (stop func()) {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGUSR1)
+	return collectOn(sig, exporter, readers, func() { signal.Stop(sig) })
+}
@@ -0,0 +1,27 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !aix && !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !solaris && !zos
+// +build !aix,!darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!solaris,!zos
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+// CollectOnSignal is like CollectOnTrigger, but captures a snapshot of the
+// metrics of readers each time the process receives a SIGUSR1 signal.
+//
+// This platform has no SIGUSR1 signal, no snapshots are captured and the
+// returned stop function does nothing.
+func CollectOnSignal(Exporter, ...Reader) (stop func()) {
+	return func() {}
+}
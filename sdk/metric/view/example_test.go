@@ -16,6 +16,7 @@ package view // import "go.opentelemetry.io/otel/sdk/metric/view"
 
 import (
 	"fmt"
+	"regexp"
 
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
@@ -95,6 +96,30 @@ func ExampleMatchInstrumentName() {
 	// Instrument{"latency"} matched: false
 }
 
+func ExampleMatchInstrumentNameRegexp() {
+	v, err := New(
+		MatchInstrumentNameRegexp(regexp.MustCompile(`^http\.(client|server)\.duration$`)),
+		WithRename("http.$1.latency"), // Expanded with the submatches.
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, i := range []Instrument{
+		{Name: "http.client.duration"},
+		{Name: "http.server.duration"},
+		{Name: "rpc.server.duration"},
+	} {
+		// The SDK calls TransformInstrument when an instrument is created.
+		t, ok := v.TransformInstrument(i)
+		fmt.Printf("Instrument{%q} matched: %t, renamed: %q\n", i.Name, ok, t.Name)
+	}
+	// Output:
+	// Instrument{"http.client.duration"} matched: true, renamed: "http.client.latency"
+	// Instrument{"http.server.duration"} matched: true, renamed: "http.server.latency"
+	// Instrument{"rpc.server.duration"} matched: false, renamed: ""
+}
+
 func ExampleMatchInstrumentKind() {
 	v, err := New(MatchInstrumentKind(SyncCounter))
 	if err != nil {
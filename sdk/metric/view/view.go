@@ -23,6 +23,7 @@ import (
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
 // View provides users with the flexibility to customize the metrics that are
@@ -34,13 +35,25 @@ import (
 type View struct {
 	instrumentName *regexp.Regexp
 	hasWildcard    bool
+	// expandName is true if name is a template expanded with the submatches
+	// of instrumentName.
+	expandName     bool
 	scope          instrumentation.Scope
 	instrumentKind InstrumentKind
 
 	filter      attribute.Filter
+	process     func(attribute.Set) (attribute.Set, bool)
 	name        string
 	description string
 	agg         aggregation.Aggregation
+
+	exemplarReservoirSize int
+	cardinalityLimit      int
+	temporality           metricdata.Temporality
+
+	// minMaxSet is true if recordMinMax was set with WithRecordMinMax.
+	minMaxSet    bool
+	recordMinMax bool
 }
 
 // New returns a new configured View. If there are any duplicate Options passed,
@@ -62,7 +75,7 @@ func New(opts ...Option) (View, error) {
 		return View{}, fmt.Errorf("must provide at least 1 match option")
 	}
 
-	if v.hasWildcard && v.name != "" {
+	if v.hasWildcard && v.name != "" && !(v.expandName && strings.Contains(v.name, "$")) {
 		return View{}, fmt.Errorf("invalid view: view name specified for multiple instruments")
 	}
 
@@ -76,7 +89,7 @@ func (v View) TransformInstrument(inst Instrument) (transformed Instrument, matc
 		return Instrument{}, false
 	}
 	if v.name != "" {
-		inst.Name = v.name
+		inst.Name = v.rename(inst.Name)
 	}
 	if v.description != "" {
 		inst.Description = v.description
@@ -99,6 +112,49 @@ func (v View) AttributeFilter() func(attribute.Set) attribute.Set {
 	}
 }
 
+// AttributeProcessor returns the attribute processing function specified by
+// WithAttributeProcessor. If no function was provided nil is returned.
+func (v View) AttributeProcessor() func(attribute.Set) (attribute.Set, bool) {
+	return v.process
+}
+
+// ExemplarReservoirSize returns the size of the fixed size exemplar
+// reservoir specified by WithExemplarReservoirSize. If no size was provided
+// 0 is returned.
+func (v View) ExemplarReservoirSize() int {
+	return v.exemplarReservoirSize
+}
+
+// CardinalityLimit returns the maximum number of attribute sets specified by
+// WithCardinalityLimit. If no limit was provided 0 is returned.
+func (v View) CardinalityLimit() int {
+	return v.cardinalityLimit
+}
+
+// Temporality returns the temporality specified by WithTemporality. If no
+// temporality was provided the zero value, an undefined temporality, is
+// returned.
+func (v View) Temporality() metricdata.Temporality {
+	return v.temporality
+}
+
+// RecordMinMax returns if histogram aggregations of matching instruments
+// record the min and max of measurements, as specified by WithRecordMinMax.
+// If WithRecordMinMax was not used, ok is false.
+func (v View) RecordMinMax() (record, ok bool) {
+	return v.recordMinMax, v.minMaxSet
+}
+
+// rename returns the name of the instrument named name after the view is
+// applied.
+func (v View) rename(name string) string {
+	if !v.expandName {
+		return v.name
+	}
+	submatches := v.instrumentName.FindStringSubmatchIndex(name)
+	return string(v.instrumentName.ExpandString(nil, v.name, name, submatches))
+}
+
 func (v View) matchName(name string) bool {
 	return v.instrumentName == nil || v.instrumentName.MatchString(name)
 }
@@ -151,6 +207,29 @@ func MatchInstrumentName(name string) Option {
 		name = strings.ReplaceAll(name, "\\?", ".")
 		name = strings.ReplaceAll(name, "\\*", ".*")
 		v.instrumentName = regexp.MustCompile(name)
+		v.expandName = false
+		return v
+	})
+}
+
+// MatchInstrumentNameRegexp will match an instrument if its name matches re.
+// Like regexp.MatchString, re can match any part of the name unless it is
+// anchored with ^ and $.
+//
+// The name passed to WithRename for a view using this option is a template
+// expanded with the submatches of re, as described by regexp.Expand. This
+// allows a single view to rename multiple instruments (e.g. a re of
+// `^http\.(.*)\.duration$` and a rename of "http.$1.latency").
+//
+// If re is nil, this option is ignored.
+func MatchInstrumentNameRegexp(re *regexp.Regexp) Option {
+	return optionFunc(func(v View) View {
+		if re == nil {
+			return v
+		}
+		v.instrumentName = re
+		v.hasWildcard = true
+		v.expandName = true
 		return v
 	})
 }
@@ -176,7 +255,9 @@ func MatchInstrumentationScope(scope instrumentation.Scope) Option {
 
 // WithRename will rename the instrument the view matches. If not used or empty the
 // instrument name will not be changed. Must be used with a non-wildcard
-// instrument name match. The default does not change the instrument name.
+// instrument name match, or a MatchInstrumentNameRegexp match with name
+// containing a submatch reference (e.g. "$1"). The default does not change the
+// instrument name.
 func WithRename(name string) Option {
 	return optionFunc(func(v View) View {
 		v.name = name
@@ -213,6 +294,24 @@ func WithFilterAttributes(keys ...attribute.Key) Option {
 	})
 }
 
+// WithAttributeProcessor will pass the attributes of every measurement made
+// by matching instruments to fn. If fn returns false, the measurement is
+// dropped. Otherwise, it is aggregated with the attributes fn returns. This
+// allows data points to be dropped or rewritten based on their attribute
+// values (e.g. dropping all measurements with an http.route of "/healthz").
+//
+// The attributes are processed before any filter set with
+// WithFilterAttributes is applied. The result of fn is cached for each
+// distinct attribute set, therefore fn needs to be deterministic.
+//
+// If not used or fn is nil, all measurements are kept unchanged.
+func WithAttributeProcessor(fn func(attribute.Set) (attribute.Set, bool)) Option {
+	return optionFunc(func(v View) View {
+		v.process = fn
+		return v
+	})
+}
+
 // WithSetAggregation will use the aggregation a for matching instruments. If
 // this option is not provided, the reader defined aggregation for the
 // instrument will be used.
@@ -230,3 +329,71 @@ func WithSetAggregation(a aggregation.Aggregation) Option {
 		return v
 	})
 }
+
+// WithExemplarReservoirSize will sample exemplars of matching instruments
+// with a fixed size reservoir holding up to size exemplars per timeseries. If
+// not used or size is not positive, the default reservoir of the instrument's
+// aggregation will be used: a reservoir aligned with the buckets of an
+// explicit bucket histogram, and a fixed size reservoir of one exemplar
+// otherwise.
+func WithExemplarReservoirSize(size int) Option {
+	return optionFunc(func(v View) View {
+		if size > 0 {
+			v.exemplarReservoirSize = size
+		}
+		return v
+	})
+}
+
+// WithCardinalityLimit will limit the number of distinct attribute sets
+// matching instruments aggregate per collection cycle to limit. Once the limit
+// is reached, measurements with new attribute sets are aggregated into a
+// single overflow timeseries with the otel.metric.overflow=true attribute. The
+// overflow timeseries counts towards the limit.
+//
+// If not used or limit is not positive, the cardinality limit of the Reader
+// is used.
+func WithCardinalityLimit(limit int) Option {
+	return optionFunc(func(v View) View {
+		if limit > 0 {
+			v.cardinalityLimit = limit
+		}
+		return v
+	})
+}
+
+// WithTemporality will use temporality t for matching instruments instead of
+// the temporality the Reader selects for them. This allows a single Reader to
+// export some instruments with delta temporality while others remain
+// cumulative.
+//
+// If not used or t is not metricdata.CumulativeTemporality or
+// metricdata.DeltaTemporality, the temporality of the Reader is used.
+func WithTemporality(t metricdata.Temporality) Option {
+	return optionFunc(func(v View) View {
+		switch t {
+		case metricdata.CumulativeTemporality, metricdata.DeltaTemporality:
+			v.temporality = t
+		}
+		return v
+	})
+}
+
+// WithRecordMinMax will set if the histogram aggregations of matching
+// instruments record the min and max of measurements. This overrides the
+// NoMinMax field of the explicit bucket or exponential histogram aggregation
+// used for the instruments, whether it is set with WithSetAggregation or
+// selected by the Reader. The setting is ignored for all other aggregations.
+//
+// This can be used to enable the min and max for delta pipelines, where they
+// describe each collection cycle, while disabling them for long-lived
+// cumulative pipelines where they are rarely useful.
+//
+// If not used, the NoMinMax field of the aggregation is used.
+func WithRecordMinMax(record bool) Option {
+	return optionFunc(func(v View) View {
+		v.minMaxSet = true
+		v.recordMinMax = record
+		return v
+	})
+}
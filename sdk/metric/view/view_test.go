@@ -15,6 +15,7 @@
 package view // import "go.opentelemetry.io/otel/sdk/metric/view"
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -22,6 +23,7 @@ import (
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
 var matchInstrument = Instrument{
@@ -317,6 +319,42 @@ func TestViewMatchName(t *testing.T) {
 	}
 }
 
+func TestViewMatchNameRegexp(t *testing.T) {
+	v, err := New(MatchInstrumentNameRegexp(regexp.MustCompile(`^http\.(client|server)\.duration$`)))
+	require.NoError(t, err)
+	assert.True(t, v.hasWildcard)
+	for _, name := range []string{"http.client.duration", "http.server.duration"} {
+		assert.Truef(t, v.matchName(name), "name: %s", name)
+	}
+	for _, name := range []string{"http.proxy.duration", "http.client.duration.max", "rpc.client.duration"} {
+		assert.Falsef(t, v.matchName(name), "name: %s", name)
+	}
+
+	v, err = New(MatchInstrumentNameRegexp(nil))
+	assert.Error(t, err, "nil regexp should be ignored")
+	assert.Equal(t, View{}, v)
+}
+
+func TestViewRenameRegexp(t *testing.T) {
+	v, err := New(
+		MatchInstrumentNameRegexp(regexp.MustCompile(`^http\.(?P<side>.*)\.duration$`)),
+		WithRename("http.${side}.latency"),
+	)
+	require.NoError(t, err)
+
+	for name, want := range map[string]string{
+		"http.client.duration": "http.client.latency",
+		"http.server.duration": "http.server.latency",
+	} {
+		got, match := v.TransformInstrument(Instrument{Name: name})
+		assert.True(t, match)
+		assert.Equal(t, want, got.Name)
+	}
+
+	_, match := v.TransformInstrument(Instrument{Name: "rpc.client.duration"})
+	assert.False(t, match)
+}
+
 func TestViewAttributeFilterNoFilter(t *testing.T) {
 	v, err := New(
 		MatchInstrumentName("*"),
@@ -408,6 +446,32 @@ func TestViewAttributeFilter(t *testing.T) {
 	}
 }
 
+func TestViewAttributeProcessor(t *testing.T) {
+	v, err := New(MatchInstrumentName("*"))
+	require.NoError(t, err)
+	assert.Nil(t, v.AttributeProcessor())
+
+	v, err = New(
+		MatchInstrumentName("*"),
+		WithAttributeProcessor(func(set attribute.Set) (attribute.Set, bool) {
+			val, _ := set.Value("http.route")
+			return set, val.AsString() != "/healthz"
+		}),
+	)
+	require.NoError(t, err)
+	process := v.AttributeProcessor()
+	require.NotNil(t, process)
+
+	healthz := attribute.NewSet(attribute.String("http.route", "/healthz"))
+	_, keep := process(healthz)
+	assert.False(t, keep, "health check kept")
+
+	users := attribute.NewSet(attribute.String("http.route", "/users"))
+	got, keep := process(users)
+	assert.True(t, keep, "user route dropped")
+	assert.Equal(t, users, got)
+}
+
 func TestNewErrors(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -431,6 +495,13 @@ func TestNewErrors(t *testing.T) {
 				WithRename("newName"),
 			},
 		},
+		{
+			name: "Match regexp with view name",
+			options: []Option{
+				MatchInstrumentNameRegexp(regexp.MustCompile("^old")),
+				WithRename("newName"),
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -442,3 +513,70 @@ func TestNewErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestViewExemplarReservoirSize(t *testing.T) {
+	v, err := New(MatchInstrumentName("*"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, v.ExemplarReservoirSize())
+
+	v, err = New(MatchInstrumentName("*"), WithExemplarReservoirSize(-1))
+	require.NoError(t, err)
+	assert.Equal(t, 0, v.ExemplarReservoirSize())
+
+	v, err = New(MatchInstrumentName("*"), WithExemplarReservoirSize(4))
+	require.NoError(t, err)
+	assert.Equal(t, 4, v.ExemplarReservoirSize())
+}
+
+func TestViewCardinalityLimit(t *testing.T) {
+	v, err := New(MatchInstrumentName("*"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, v.CardinalityLimit())
+
+	v, err = New(MatchInstrumentName("*"), WithCardinalityLimit(-1))
+	require.NoError(t, err)
+	assert.Equal(t, 0, v.CardinalityLimit())
+
+	v, err = New(MatchInstrumentName("*"), WithCardinalityLimit(100))
+	require.NoError(t, err)
+	assert.Equal(t, 100, v.CardinalityLimit())
+}
+
+func TestViewTemporality(t *testing.T) {
+	var undefinedTemporality metricdata.Temporality
+
+	v, err := New(MatchInstrumentName("*"))
+	require.NoError(t, err)
+	assert.Equal(t, undefinedTemporality, v.Temporality())
+
+	v, err = New(MatchInstrumentName("*"), WithTemporality(undefinedTemporality))
+	require.NoError(t, err)
+	assert.Equal(t, undefinedTemporality, v.Temporality())
+
+	v, err = New(MatchInstrumentName("*"), WithTemporality(metricdata.DeltaTemporality))
+	require.NoError(t, err)
+	assert.Equal(t, metricdata.DeltaTemporality, v.Temporality())
+
+	v, err = New(MatchInstrumentName("*"), WithTemporality(metricdata.CumulativeTemporality))
+	require.NoError(t, err)
+	assert.Equal(t, metricdata.CumulativeTemporality, v.Temporality())
+}
+
+func TestViewRecordMinMax(t *testing.T) {
+	v, err := New(MatchInstrumentName("*"))
+	require.NoError(t, err)
+	_, ok := v.RecordMinMax()
+	assert.False(t, ok)
+
+	v, err = New(MatchInstrumentName("*"), WithRecordMinMax(false))
+	require.NoError(t, err)
+	record, ok := v.RecordMinMax()
+	assert.True(t, ok)
+	assert.False(t, record)
+
+	v, err = New(MatchInstrumentName("*"), WithRecordMinMax(true))
+	require.NoError(t, err)
+	record, ok = v.RecordMinMax()
+	assert.True(t, ok)
+	assert.True(t, record)
+}
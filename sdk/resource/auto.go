@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 )
 
 var (
@@ -42,23 +43,89 @@ type Detector interface {
 	// must never be done outside of a new major release.
 }
 
-// Detect calls all input detectors sequentially and merges each result with the previous one.
-// It returns the merged error too.
+// Detect calls all input detectors concurrently and merges each result with
+// the previous one in the order the detectors are passed. It returns the
+// merged error too.
 func Detect(ctx context.Context, detectors ...Detector) (*Resource, error) {
+	return detect(ctx, 0, detectors)
+}
+
+// detectResult is the outcome of a single Detector.
+type detectResult struct {
+	res *Resource
+	err error
+}
+
+// detect calls all detectors concurrently and merges their results in order.
+// If timeout is greater than zero, each detector is given at most timeout to
+// complete. The Context passed to a detector is canceled after the timeout
+// and the detector's result is not waited for.
+func detect(ctx context.Context, timeout time.Duration, detectors []Detector) (*Resource, error) {
+	results := make([]chan detectResult, len(detectors))
+	for i, detector := range detectors {
+		if detector == nil {
+			continue
+		}
+		// Buffered so a detector that does not complete in time does not
+		// leak its goroutine.
+		ch := make(chan detectResult, 1)
+		results[i] = ch
+		go func(d Detector) {
+			dCtx, cancel := ctx, context.CancelFunc(func() {})
+			if timeout > 0 {
+				dCtx, cancel = context.WithTimeout(ctx, timeout)
+			}
+			defer cancel()
+
+			res, err := d.Detect(dCtx)
+			ch <- detectResult{res: res, err: err}
+		}(detector)
+	}
+
+	// All detectors were started at the same time, a single timer bounds
+	// each of them to timeout.
+	var expired <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+
 	var autoDetectedRes *Resource
 	var errInfo []string
-	for _, detector := range detectors {
-		if detector == nil {
+	for i, ch := range results {
+		if ch == nil {
 			continue
 		}
-		res, err := detector.Detect(ctx)
-		if err != nil {
-			errInfo = append(errInfo, err.Error())
-			if !errors.Is(err, ErrPartialResource) {
+
+		// Prefer results that are already available over timeouts and
+		// cancellation.
+		var r detectResult
+		select {
+		case r = <-ch:
+		default:
+			select {
+			case r = <-ch:
+			case <-expired:
+				// Do not wait for any other detector once the timeout is
+				// reached, only use the results already available.
+				expired = closedTimeChan
+				errInfo = append(errInfo, fmt.Sprintf("%T: timed out after %s", detectors[i], timeout))
+				continue
+			case <-ctx.Done():
+				errInfo = append(errInfo, fmt.Sprintf("%T: %s", detectors[i], ctx.Err()))
 				continue
 			}
 		}
-		autoDetectedRes, err = Merge(autoDetectedRes, res)
+
+		if r.err != nil {
+			errInfo = append(errInfo, fmt.Sprintf("%T: %s", detectors[i], r.err))
+			if !errors.Is(r.err, ErrPartialResource) {
+				continue
+			}
+		}
+		var err error
+		autoDetectedRes, err = Merge(autoDetectedRes, r.res)
 		if err != nil {
 			errInfo = append(errInfo, err.Error())
 		}
@@ -70,3 +137,11 @@ func Detect(ctx context.Context, detectors ...Detector) (*Resource, error) {
 	}
 	return autoDetectedRes, aggregatedError
 }
+
+// closedTimeChan is a closed channel that is always ready to be received
+// from.
+var closedTimeChan = func() <-chan time.Time {
+	ch := make(chan time.Time)
+	close(ch)
+	return ch
+}()
@@ -19,9 +19,12 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/resource"
 	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
 )
@@ -60,3 +63,57 @@ func TestDetect(t *testing.T) {
 		})
 	}
 }
+
+type blockingDetector struct{}
+
+func (blockingDetector) Detect(ctx context.Context) (*resource.Resource, error) {
+	<-ctx.Done()
+	return resource.NewSchemaless(attribute.String("blocked", "true")), ctx.Err()
+}
+
+type sleepDetector struct {
+	d  time.Duration
+	kv attribute.KeyValue
+}
+
+func (d sleepDetector) Detect(context.Context) (*resource.Resource, error) {
+	time.Sleep(d.d)
+	return resource.NewSchemaless(d.kv), nil
+}
+
+func TestDetectConcurrentOrder(t *testing.T) {
+	// The slower detector is listed last and needs to take precedence.
+	r, err := resource.Detect(context.Background(),
+		sleepDetector{kv: attribute.String("key", "first")},
+		sleepDetector{d: 10 * time.Millisecond, kv: attribute.String("key", "second")},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"key": "second"}, toMap(r))
+}
+
+func TestDetectCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	r, err := resource.Detect(ctx, blockingDetector{})
+	assert.ErrorContains(t, err, "blockingDetector")
+	assert.ErrorContains(t, err, context.Canceled.Error())
+	assert.Equal(t, map[string]string{}, toMap(r))
+}
+
+func TestWithDetectorTimeout(t *testing.T) {
+	slow := sleepDetector{d: time.Hour, kv: attribute.String("slow", "true")}
+
+	start := time.Now()
+	r, err := resource.New(context.Background(),
+		resource.WithDetectorTimeout(20*time.Millisecond),
+		resource.WithAttributes(attribute.String("fast", "true")),
+		resource.WithDetectors(blockingDetector{}, slow),
+	)
+	assert.Less(t, time.Since(start), time.Minute)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "resource_test.blockingDetector")
+	assert.Contains(t, err.Error(), "resource_test.sleepDetector: timed out after 20ms")
+	assert.Equal(t, map[string]string{"fast": "true"}, toMap(r))
+}
@@ -16,6 +16,7 @@ package resource // import "go.opentelemetry.io/otel/sdk/resource"
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 )
@@ -26,6 +27,8 @@ type config struct {
 	detectors []Detector
 	// SchemaURL to associate with the Resource.
 	schemaURL string
+	// detectorTimeout is the maximum time each detector is given.
+	detectorTimeout time.Duration
 }
 
 // Option is the interface that applies a configuration option.
@@ -66,16 +69,52 @@ func WithFromEnv() Option {
 	return WithDetectors(fromEnv{})
 }
 
+// WithDetectorsFromEnv adds attributes from the registered Detectors named
+// in the OTEL_EXPERIMENTAL_RESOURCE_DETECTORS environment variable to the
+// configured resource. The variable value is a comma separated list of
+// names, e.g. "host,process". See RegisterDetector for the names registered
+// by default and how to register additional Detectors.
+func WithDetectorsFromEnv() Option {
+	return WithDetectors(fromEnvDetectors{})
+}
+
 // WithHost adds attributes from the host to the configured resource.
 func WithHost() Option {
 	return WithDetectors(host{})
 }
 
+// WithHostID adds host ID information to the configured resource. The
+// machine ID is read from /etc/machine-id or /var/lib/dbus/machine-id on
+// Linux, /etc/hostid or kenv on BSD, the IOKit registry on macOS, and the
+// MachineGuid registry value on Windows.
+func WithHostID() Option {
+	return WithDetectors(hostIDDetector{})
+}
+
 // WithTelemetrySDK adds TelemetrySDK version info to the configured resource.
 func WithTelemetrySDK() Option {
 	return WithDetectors(telemetrySDK{})
 }
 
+// WithDetectorTimeout sets the maximum time each detector is given to
+// detect its resource attributes. Detectors are run concurrently. The
+// attributes of detectors that have not completed within timeout are not
+// included in the configured resource, and an error identifying them is
+// returned.
+//
+// If timeout is less than or equal to zero, detectors are given until the
+// Context passed to New is done. This is the default.
+func WithDetectorTimeout(timeout time.Duration) Option {
+	return detectorTimeoutOption(timeout)
+}
+
+type detectorTimeoutOption time.Duration
+
+func (o detectorTimeoutOption) apply(cfg config) config {
+	cfg.detectorTimeout = time.Duration(o)
+	return cfg
+}
+
 // WithSchemaURL sets the schema URL for the configured resource.
 func WithSchemaURL(schemaURL string) Option {
 	return schemaURLOption(schemaURL)
@@ -185,6 +224,23 @@ func WithProcessRuntimeDescription() Option {
 	return WithDetectors(processRuntimeDescriptionDetector{})
 }
 
+// WithKubernetes adds attributes describing the Kubernetes pod the process
+// is running in to the configured Resource. The information is read from
+// the files of a downward API volume mounted at dir, and the K8S_POD_NAME,
+// K8S_NAMESPACE_NAME, K8S_POD_UID, K8S_NODE_NAME, and K8S_CONTAINER_NAME
+// environment variables. The environment variables take precedence. If dir
+// is empty, "/etc/podinfo" is used.
+//
+// The downward API volume files read are "name", "namespace", "uid", and
+// "labels" for the respective pod metadata fields. Each pod label is added
+// as a k8s.pod.label.<key> attribute. Missing files are ignored.
+func WithKubernetes(dir string) Option {
+	if dir == "" {
+		dir = defaultDownwardAPIDir
+	}
+	return WithDetectors(k8sDetector{dir: dir})
+}
+
 // WithContainer adds all the Container attributes to the configured Resource.
 // See individual WithContainer* functions to configure specific attributes.
 func WithContainer() Option {
@@ -25,4 +25,9 @@
 // OTEL_RESOURCE_ATTRIBUTES the FromEnv Detector can be used. It will interpret
 // the value as a list of comma delimited key/value pairs
 // (e.g. `<key1>=<value1>,<key2>=<value2>,...`).
+//
+// The Detectors to run can also be selected without code changes using the
+// OTEL_EXPERIMENTAL_RESOURCE_DETECTORS environment variable, a comma
+// delimited list of Detector names (e.g. `host,process`). Additional
+// Detectors are made available to it with RegisterDetector.
 package resource // import "go.opentelemetry.io/otel/sdk/resource"
@@ -0,0 +1,192 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	expectedHostID = "f2c668b579780554f70f72a063dc0864"
+
+	readFileNoError = func(filename string) (string, error) {
+		return expectedHostID + "\n", nil
+	}
+
+	readFileError = func(filename string) (string, error) {
+		return "", errors.New("not found")
+	}
+
+	execCommandNoError = func(string, ...string) (string, error) {
+		return expectedHostID + "\n", nil
+	}
+
+	execCommandError = func(string, ...string) (string, error) {
+		return "", errors.New("not found")
+	}
+)
+
+func TestHostIDReaderBSD(t *testing.T) {
+	tt := []struct {
+		name            string
+		fileReader      fileReader
+		commandExecutor commandExecutor
+		expectedHostID  string
+		expectError     bool
+	}{
+		{
+			name:            "hostIDReaderBSD valid primary",
+			fileReader:      readFileNoError,
+			commandExecutor: execCommandError,
+			expectedHostID:  expectedHostID,
+		},
+		{
+			name:            "hostIDReaderBSD invalid primary",
+			fileReader:      readFileError,
+			commandExecutor: execCommandNoError,
+			expectedHostID:  expectedHostID,
+		},
+		{
+			name:            "hostIDReaderBSD invalid primary and secondary",
+			fileReader:      readFileError,
+			commandExecutor: execCommandError,
+			expectError:     true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			reader := hostIDReaderBSD{
+				readFile:    tc.fileReader,
+				execCommand: tc.commandExecutor,
+			}
+			hostID, err := reader.read()
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedHostID, hostID)
+		})
+	}
+}
+
+func TestHostIDReaderLinux(t *testing.T) {
+	readFilePrimaryError := func(filename string) (string, error) {
+		if filename == "/var/lib/dbus/machine-id" {
+			return readFileNoError(filename)
+		}
+		return readFileError(filename)
+	}
+
+	tt := []struct {
+		name           string
+		fileReader     fileReader
+		expectedHostID string
+		expectError    bool
+	}{
+		{
+			name:           "hostIDReaderLinux valid primary",
+			fileReader:     readFileNoError,
+			expectedHostID: expectedHostID,
+		},
+		{
+			name:           "hostIDReaderLinux invalid primary",
+			fileReader:     readFilePrimaryError,
+			expectedHostID: expectedHostID,
+		},
+		{
+			name:        "hostIDReaderLinux invalid primary and secondary",
+			fileReader:  readFileError,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			reader := hostIDReaderLinux{
+				readFile: tc.fileReader,
+			}
+			hostID, err := reader.read()
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedHostID, hostID)
+		})
+	}
+}
+
+func TestHostIDReaderDarwin(t *testing.T) {
+	validOutput := `+-o J316sAP  <class IOPlatformExpertDevice, id 0x10000024d, registered, matched, active, busy 0 (132 ms), retain 37>
+  {
+    "IOPolledInterface" = "AppleARMWatchdogTimerHibernateHandler is not serializable"
+    "#address-cells" = <02000000>
+    "IOPlatformSerialNumber" = "HDWLIF2LM7"
+    "IOPlatformUUID" = "81895B8D-9EAF-5D4E-AB5C-9F7E4AE3E1B6"
+    "IOBusyInterest" = "IOCommand is not serializable"
+    "compatible" = <"J316sAP","MacBookPro18,1","AppleARM">
+  }
+`
+	execCommandValid := func(string, ...string) (string, error) {
+		return validOutput, nil
+	}
+
+	execCommandInvalid := func(string, ...string) (string, error) {
+		return "", nil
+	}
+
+	tt := []struct {
+		name            string
+		commandExecutor commandExecutor
+		expectedHostID  string
+		expectError     bool
+	}{
+		{
+			name:            "hostIDReaderDarwin valid output",
+			commandExecutor: execCommandValid,
+			expectedHostID:  "81895B8D-9EAF-5D4E-AB5C-9F7E4AE3E1B6",
+		},
+		{
+			name:            "hostIDReaderDarwin invalid output",
+			commandExecutor: execCommandInvalid,
+			expectError:     true,
+		},
+		{
+			name:            "hostIDReaderDarwin error",
+			commandExecutor: execCommandError,
+			expectError:     true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			reader := hostIDReaderDarwin{
+				execCommand: tc.commandExecutor,
+			}
+			hostID, err := reader.read()
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedHostID, hostID)
+		})
+	}
+}
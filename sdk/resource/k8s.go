@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+)
+
+// defaultDownwardAPIDir is the directory a Kubernetes downward API volume is
+// conventionally mounted at.
+const defaultDownwardAPIDir = "/etc/podinfo"
+
+// Names of the files in a downward API volume the k8s detector reads.
+const (
+	// podNameFile is the file containing metadata.name.
+	podNameFile = "name"
+	// podNamespaceFile is the file containing metadata.namespace.
+	podNamespaceFile = "namespace"
+	// podUIDFile is the file containing metadata.uid.
+	podUIDFile = "uid"
+	// podLabelsFile is the file containing metadata.labels.
+	podLabelsFile = "labels"
+)
+
+// Environment variables the k8s detector reads. The values of these take
+// precedence over the downward API volume files.
+const (
+	k8sPodNameKey       = "K8S_POD_NAME"
+	k8sNamespaceNameKey = "K8S_NAMESPACE_NAME"
+	k8sPodUIDKey        = "K8S_POD_UID"
+	k8sNodeNameKey      = "K8S_NODE_NAME"
+	k8sContainerNameKey = "K8S_CONTAINER_NAME"
+)
+
+// k8sPodLabelPrefix is the prefix of the attribute keys pod labels are
+// mapped to.
+const k8sPodLabelPrefix = "k8s.pod.label."
+
+var errInvalidLabel = fmt.Errorf("%w: invalid pod label", ErrPartialResource)
+
+// k8sDetector is a Detector that provides information about the Kubernetes
+// pod the process is running in from the downward API.
+type k8sDetector struct {
+	// dir is the directory the downward API volume is mounted at.
+	dir string
+}
+
+var _ Detector = k8sDetector{}
+
+// Detect returns a *Resource that describes the Kubernetes pod the process
+// is running in. An empty Resource is returned if no pod information is
+// exposed to the process.
+func (d k8sDetector) Detect(context.Context) (*Resource, error) {
+	var (
+		attrs []attribute.KeyValue
+		errs  []string
+	)
+	add := func(k attribute.Key, file, env string) {
+		v, err := d.readFile(file)
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+		if e := strings.TrimSpace(os.Getenv(env)); e != "" {
+			v = e
+		}
+		if v != "" {
+			attrs = append(attrs, k.String(v))
+		}
+	}
+	add(semconv.K8SPodNameKey, podNameFile, k8sPodNameKey)
+	add(semconv.K8SNamespaceNameKey, podNamespaceFile, k8sNamespaceNameKey)
+	add(semconv.K8SPodUIDKey, podUIDFile, k8sPodUIDKey)
+	// Only the metadata of a pod can be exposed by a downward API volume.
+	add(semconv.K8SNodeNameKey, "", k8sNodeNameKey)
+	add(semconv.K8SContainerNameKey, "", k8sContainerNameKey)
+
+	labels, err := d.readFile(podLabelsFile)
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+	labelAttrs, err := parsePodLabels(labels)
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+	attrs = append(attrs, labelAttrs...)
+
+	var aggErr error
+	if len(errs) > 0 {
+		aggErr = fmt.Errorf("%w: %s", ErrPartialResource, errs)
+	}
+	return NewWithAttributes(semconv.SchemaURL, attrs...), aggErr
+}
+
+// readFile returns the trimmed content of the downward API file name. An
+// empty string is returned without error if name is empty or the file does
+// not exist.
+func (d k8sDetector) readFile(name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	b, err := os.ReadFile(filepath.Join(d.dir, name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// parsePodLabels parses the pod labels s exposed by a downward API volume.
+// Each label is on its own line in the form key="value", where value is a
+// quoted Go string. Labels are mapped to k8s.pod.label.<key> attributes.
+func parsePodLabels(s string) ([]attribute.KeyValue, error) {
+	var (
+		attrs   []attribute.KeyValue
+		invalid []string
+	)
+	scanner := bufio.NewScanner(strings.NewReader(s))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(line, "=")
+		if !ok || k == "" {
+			invalid = append(invalid, line)
+			continue
+		}
+		value, err := strconv.Unquote(v)
+		if err != nil {
+			invalid = append(invalid, line)
+			continue
+		}
+		attrs = append(attrs, attribute.String(k8sPodLabelPrefix+k, value))
+	}
+
+	if len(invalid) > 0 {
+		return attrs, fmt.Errorf("%w: %q", errInvalidLabel, invalid)
+	}
+	return attrs, nil
+}
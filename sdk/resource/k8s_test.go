@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ottest "go.opentelemetry.io/otel/internal/internaltest"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func writeDownwardAPI(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	return dir
+}
+
+func setK8sEnv(t *testing.T, env map[string]string) {
+	keys := []string{"K8S_POD_NAME", "K8S_NAMESPACE_NAME", "K8S_POD_UID", "K8S_NODE_NAME", "K8S_CONTAINER_NAME"}
+	vars := make(map[string]string, len(keys))
+	for _, k := range keys {
+		vars[k] = env[k]
+	}
+	store, err := ottest.SetEnvVariables(vars)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, store.Restore()) })
+}
+
+func TestWithKubernetes(t *testing.T) {
+	setK8sEnv(t, map[string]string{
+		"K8S_NODE_NAME":      "node-1",
+		"K8S_CONTAINER_NAME": "app",
+		"K8S_NAMESPACE_NAME": "from-env",
+	})
+	dir := writeDownwardAPI(t, map[string]string{
+		"name":      "app-7d4b9c-x2x9z\n",
+		"namespace": "from-file\n",
+		"uid":       "0c8a9e3c-2b8f-4bde-9f1c-0b5d7c2a8f11\n",
+		"labels":    "app=\"checkout\"\npod-template-hash=\"7d4b9c\"\n",
+	})
+
+	res, err := resource.New(context.Background(), resource.WithKubernetes(dir))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"k8s.pod.name":                    "app-7d4b9c-x2x9z",
+		"k8s.namespace.name":              "from-env",
+		"k8s.pod.uid":                     "0c8a9e3c-2b8f-4bde-9f1c-0b5d7c2a8f11",
+		"k8s.node.name":                   "node-1",
+		"k8s.container.name":              "app",
+		"k8s.pod.label.app":               "checkout",
+		"k8s.pod.label.pod-template-hash": "7d4b9c",
+	}, toMap(res))
+}
+
+func TestWithKubernetesNotInCluster(t *testing.T) {
+	setK8sEnv(t, nil)
+
+	res, err := resource.New(context.Background(), resource.WithKubernetes(filepath.Join(t.TempDir(), "missing")))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{}, toMap(res))
+}
+
+func TestWithKubernetesInvalidLabels(t *testing.T) {
+	setK8sEnv(t, nil)
+	dir := writeDownwardAPI(t, map[string]string{
+		"name":   "pod",
+		"labels": "app=\"checkout\"\nbroken\ntier=unquoted\n",
+	})
+
+	res, err := resource.New(context.Background(), resource.WithKubernetes(dir))
+	assert.ErrorContains(t, err, "invalid pod label")
+	assert.Equal(t, map[string]string{
+		"k8s.pod.name":      "pod",
+		"k8s.pod.label.app": "checkout",
+	}, toMap(res))
+}
@@ -103,6 +103,7 @@ func restoreAttributesProviders() {
 	resource.SetDefaultUserProviders()
 	resource.SetDefaultOSDescriptionProvider()
 	resource.SetDefaultContainerProviders()
+	resource.SetDefaultHostIDProvider()
 }
 
 func TestWithProcessFuncsErrors(t *testing.T) {
@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+)
+
+// Refresher holds a Resource whose detectors are re-run to keep it up to
+// date. It is intended for resource attributes that can change during the
+// lifetime of a process, e.g. spot-instance metadata or a leader election
+// role.
+//
+// The Resource held by a Refresher is replaced as a whole each time it is
+// refreshed, Resources themselves remain immutable.
+type Refresher struct {
+	opts []Option
+
+	mu  sync.RWMutex
+	res *Resource
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewRefresher returns a Refresher holding the Resource returned from
+// New(ctx, opts...). Any error New returns is also returned, and the
+// Refresher is not created.
+//
+// If interval is greater than zero, the detectors are re-run every interval
+// until Shutdown is called. Errors from these refreshes are sent to the
+// global ErrorHandler and the previous Resource is kept. Otherwise, the
+// Resource is only refreshed when Refresh is called.
+func NewRefresher(ctx context.Context, interval time.Duration, opts ...Option) (*Refresher, error) {
+	res, err := New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Refresher{
+		opts: opts,
+		res:  res,
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	if interval <= 0 {
+		close(r.done)
+		return r, nil
+	}
+
+	go r.run(interval)
+	return r, nil
+}
+
+// run refreshes r every interval until r is shut down.
+func (r *Refresher) run(interval time.Duration) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.Refresh(context.Background()); err != nil {
+				otel.Handle(err)
+			}
+		case <-r.stop:
+			return
+		}
+	}
+}
+
+// Resource returns the most recently detected Resource.
+//
+// This method is safe to call concurrently.
+func (r *Refresher) Resource() *Resource {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.res
+}
+
+// Refresh re-runs the detectors of r and replaces the held Resource with the
+// one detected. If an error occurs the held Resource is not replaced and the
+// error is returned.
+//
+// This method is safe to call concurrently.
+func (r *Refresher) Refresh(ctx context.Context) error {
+	res, err := New(ctx, r.opts...)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.res = res
+	r.mu.Unlock()
+	return nil
+}
+
+// Shutdown stops the periodic refreshing of r. The most recently detected
+// Resource continues to be returned from Resource.
+//
+// This method returns once the refreshing has stopped or ctx is done,
+// whichever happens first.
+func (r *Refresher) Shutdown(ctx context.Context) error {
+	r.stopOnce.Do(func() { close(r.stop) })
+
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// counterDetector detects the number of times it has been run.
+type counterDetector struct {
+	mu  sync.Mutex
+	n   int
+	err error
+}
+
+func (d *counterDetector) Detect(context.Context) (*resource.Resource, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.err != nil {
+		return nil, d.err
+	}
+	d.n++
+	return resource.StringDetector("", "count", func() (string, error) {
+		return strconv.Itoa(d.n), nil
+	}).Detect(context.Background())
+}
+
+func (d *counterDetector) setErr(err error) {
+	d.mu.Lock()
+	d.err = err
+	d.mu.Unlock()
+}
+
+func TestRefresherRefresh(t *testing.T) {
+	d := &counterDetector{}
+	r, err := resource.NewRefresher(context.Background(), 0, resource.WithDetectors(d))
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, r.Shutdown(context.Background())) })
+
+	assert.Equal(t, map[string]string{"count": "1"}, toMap(r.Resource()))
+
+	require.NoError(t, r.Refresh(context.Background()))
+	assert.Equal(t, map[string]string{"count": "2"}, toMap(r.Resource()))
+
+	// The previous Resource is kept on error.
+	d.setErr(errors.New("detector failure"))
+	assert.Error(t, r.Refresh(context.Background()))
+	assert.Equal(t, map[string]string{"count": "2"}, toMap(r.Resource()))
+}
+
+func TestRefresherInterval(t *testing.T) {
+	d := &counterDetector{}
+	r, err := resource.NewRefresher(context.Background(), time.Millisecond, resource.WithDetectors(d))
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		return toMap(r.Resource())["count"] != "1"
+	}, time.Second, time.Millisecond, "not refreshed")
+
+	require.NoError(t, r.Shutdown(context.Background()))
+	res := r.Resource()
+	time.Sleep(5 * time.Millisecond)
+	assert.Same(t, res, r.Resource(), "refreshed after shutdown")
+	assert.NoError(t, r.Shutdown(context.Background()), "second shutdown")
+}
+
+func TestNewRefresherError(t *testing.T) {
+	d := &counterDetector{err: errors.New("detector failure")}
+	r, err := resource.NewRefresher(context.Background(), time.Millisecond, resource.WithDetectors(d))
+	assert.Error(t, err)
+	assert.Nil(t, r)
+}
@@ -0,0 +1,175 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// detectorsEnvKey is the environment variable name identifying the
+// registered Detectors to use.
+const detectorsEnvKey = "OTEL_EXPERIMENTAL_RESOURCE_DETECTORS"
+
+// detectors is a Detector composed of multiple Detectors. It is used to
+// register a group of related Detectors under a single name.
+type detectors []Detector
+
+var _ Detector = detectors{}
+
+// Detect returns the merged Resource of all Detectors. If any of the
+// Detectors fail, the Resource detected by the others is returned along with
+// an error wrapping ErrPartialResource.
+func (d detectors) Detect(ctx context.Context) (*Resource, error) {
+	res, err := Detect(ctx, d...)
+	if err != nil {
+		err = fmt.Errorf("%w: %v", ErrPartialResource, err)
+	}
+	return res, err
+}
+
+// envRegistry is the registry of Detectors registered with this package. It
+// includes all the Detectors this package provides at startup.
+var envRegistry = &detectorRegistry{
+	names: map[string]Detector{
+		// Host name and ID.
+		"host": detectors{host{}, hostIDDetector{}},
+		// Operating system type and description.
+		"os": detectors{osTypeDetector{}, osDescriptionDetector{}},
+		// All process attributes, see WithProcess.
+		"process": detectors{
+			processPIDDetector{},
+			processExecutableNameDetector{},
+			processExecutablePathDetector{},
+			processCommandArgsDetector{},
+			processOwnerDetector{},
+			processRuntimeNameDetector{},
+			processRuntimeVersionDetector{},
+			processRuntimeDescriptionDetector{},
+		},
+		// Container ID.
+		"container": cgroupContainerIDDetector{},
+		// Kubernetes downward API, see WithKubernetes.
+		"k8s": k8sDetector{dir: defaultDownwardAPIDir},
+		// OTEL_RESOURCE_ATTRIBUTES and OTEL_SERVICE_NAME.
+		"env": fromEnv{},
+	},
+}
+
+var (
+	errDupReg        = errors.New("duplicate registration")
+	errEmptyName     = errors.New("empty detector name")
+	errUnknownDetect = fmt.Errorf("%w: unknown resource detector", ErrPartialResource)
+)
+
+type detectorRegistry struct {
+	mu    sync.Mutex
+	names map[string]Detector
+}
+
+// load returns the value stored in the registry index for a key, or nil if
+// no value is present. The ok result indicates whether value was found in
+// the index.
+func (r *detectorRegistry) load(key string) (d Detector, ok bool) {
+	r.mu.Lock()
+	d, ok = r.names[key]
+	r.mu.Unlock()
+	return d, ok
+}
+
+// store sets the value for a key if is not already in the registry. An error
+// is returned if the key is already registered.
+func (r *detectorRegistry) store(key string, value Detector) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.names[key]; ok {
+		return fmt.Errorf("%w: %q", errDupReg, key)
+	}
+	r.names[key] = value
+	return nil
+}
+
+// RegisterDetector sets the Detector d to be used when the
+// OTEL_EXPERIMENTAL_RESOURCE_DETECTORS environment variable contains the
+// detector name. This will panic if name has already been registered or is
+// empty.
+//
+// Names are case-insensitive, name is registered in lower-case.
+//
+// The "host", "os", "process", "container", "k8s", and "env" names are
+// registered by default.
+func RegisterDetector(name string, d Detector) {
+	name = strings.ToLower(name)
+	if name == "" {
+		panic(errEmptyName)
+	}
+	if err := envRegistry.store(name, d); err != nil {
+		// envRegistry.store will return errDupReg if name is already
+		// registered. Panic here so the user is made aware of the duplicate
+		// registration.
+		panic(err)
+	}
+}
+
+// fromEnvDetectors is a Detector that runs the registered Detectors named in
+// the OTEL_EXPERIMENTAL_RESOURCE_DETECTORS environment variable.
+type fromEnvDetectors struct{}
+
+var _ Detector = fromEnvDetectors{}
+
+// Detect returns the merged Resource of all the registered Detectors named
+// in the OTEL_EXPERIMENTAL_RESOURCE_DETECTORS environment variable. Names are
+// trimmed of whitespace and are case-insensitive. An error wrapping
+// ErrPartialResource is returned for any names that are not registered, and
+// the Resource detected by the registered ones is still returned.
+func (fromEnvDetectors) Detect(ctx context.Context) (*Resource, error) {
+	v := strings.TrimSpace(os.Getenv(detectorsEnvKey))
+	if v == "" {
+		return Empty(), nil
+	}
+
+	var (
+		ds      detectors
+		unknown []string
+	)
+	for _, name := range strings.Split(v, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		d, ok := envRegistry.load(name)
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		ds = append(ds, d)
+	}
+
+	res, err := ds.Detect(ctx)
+	if len(unknown) > 0 {
+		uErr := fmt.Errorf("%w: %s", errUnknownDetect, strings.Join(unknown, ", "))
+		if err == nil {
+			err = uErr
+		} else {
+			err = fmt.Errorf("%w; %v", err, uErr)
+		}
+	}
+	return res, err
+}
@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ottest "go.opentelemetry.io/otel/internal/internaltest"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+)
+
+func init() {
+	resource.RegisterDetector("Custom", resource.StringDetector(
+		semconv.SchemaURL,
+		"custom.key",
+		func() (string, error) { return "custom value", nil },
+	))
+}
+
+func setDetectorsEnv(t *testing.T, env map[string]string) {
+	store, err := ottest.SetEnvVariables(env)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, store.Restore()) })
+}
+
+func TestWithDetectorsFromEnv(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  map[string]string
+	}{
+		{
+			name:  "empty",
+			value: "",
+			want:  map[string]string{},
+		},
+		{
+			name:  "builtin",
+			value: "env",
+			want:  map[string]string{"key": "value"},
+		},
+		{
+			name:  "registered",
+			value: " CUSTOM ,, env",
+			want: map[string]string{
+				"custom.key": "custom value",
+				"key":        "value",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			setDetectorsEnv(t, map[string]string{
+				"OTEL_EXPERIMENTAL_RESOURCE_DETECTORS": tc.value,
+				"OTEL_RESOURCE_ATTRIBUTES":             "key=value",
+			})
+
+			res, err := resource.New(context.Background(), resource.WithDetectorsFromEnv())
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, toMap(res))
+		})
+	}
+}
+
+func TestWithDetectorsFromEnvUnknown(t *testing.T) {
+	setDetectorsEnv(t, map[string]string{
+		"OTEL_EXPERIMENTAL_RESOURCE_DETECTORS": "unknown,custom",
+	})
+
+	res, err := resource.New(context.Background(), resource.WithDetectorsFromEnv())
+	assert.ErrorContains(t, err, "unknown resource detector: unknown")
+	assert.Equal(t, map[string]string{"custom.key": "custom value"}, toMap(res))
+}
+
+func TestWithDetectorsFromEnvGroup(t *testing.T) {
+	resource.SetHostIDProvider(func() (string, error) { return "f2c668b579780554f70f72a063dc0864", nil })
+	t.Cleanup(restoreAttributesProviders)
+	setDetectorsEnv(t, map[string]string{
+		"OTEL_EXPERIMENTAL_RESOURCE_DETECTORS": "host",
+	})
+
+	res, err := resource.New(context.Background(), resource.WithDetectorsFromEnv())
+	require.NoError(t, err)
+	got := toMap(res)
+	assert.Equal(t, "f2c668b579780554f70f72a063dc0864", got["host.id"])
+	assert.Contains(t, got, "host.name")
+}
+
+func TestRegisterDetectorPanics(t *testing.T) {
+	d := resource.StringDetector(semconv.SchemaURL, "key", func() (string, error) { return "", nil })
+
+	assert.Panics(t, func() { resource.RegisterDetector("", d) }, "empty name")
+	assert.Panics(t, func() { resource.RegisterDetector("HOST", d) }, "builtin name")
+	assert.Panics(t, func() { resource.RegisterDetector("custom", d) }, "registered name")
+}
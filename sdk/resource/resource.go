@@ -45,13 +45,17 @@ var (
 var errMergeConflictSchemaURL = errors.New("cannot merge resource due to conflicting Schema URL")
 
 // New returns a Resource combined from the user-provided detectors.
+//
+// The detectors are run concurrently and their results are merged in the
+// order the detectors were provided. Use WithDetectorTimeout to bound the
+// time each detector is given.
 func New(ctx context.Context, opts ...Option) (*Resource, error) {
 	cfg := config{}
 	for _, opt := range opts {
 		cfg = opt.apply(cfg)
 	}
 
-	resource, err := Detect(ctx, cfg.detectors...)
+	resource, err := detect(ctx, cfg.detectorTimeout, cfg.detectors)
 
 	var err2 error
 	resource, err2 = Merge(resource, &Resource{schemaURL: cfg.schemaURL})
@@ -70,6 +74,13 @@ func New(ctx context.Context, opts ...Option) (*Resource, error) {
 // in a schema identified by schemaURL.
 func NewWithAttributes(schemaURL string, attrs ...attribute.KeyValue) *Resource {
 	resource := NewSchemaless(attrs...)
+	if resource == &emptyResource {
+		if schemaURL == "" {
+			return resource
+		}
+		// Do not modify the shared empty Resource.
+		return &Resource{schemaURL: schemaURL}
+	}
 	resource.schemaURL = schemaURL
 	return resource
 }
@@ -165,8 +176,11 @@ func (r *Resource) Equal(eq *Resource) bool {
 //
 // The SchemaURL of the resources will be merged according to the spec rules:
 // https://github.com/open-telemetry/opentelemetry-specification/blob/bad49c714a62da5493f2d1d9bafd7ebe8c8ce7eb/specification/resource/sdk.md#merge
-// If the resources have different non-empty schemaURL an empty resource and an error
-// will be returned.
+// If the resources have different non-empty schemaURL that both identify a
+// published OpenTelemetry schema version, the attributes of the resource with
+// the older version are translated to the newer one and the merged resource
+// uses the newer schemaURL. Otherwise, if the resources have different
+// non-empty schemaURL an empty resource and an error will be returned.
 func Merge(a, b *Resource) (*Resource, error) {
 	if a == nil && b == nil {
 		return Empty(), nil
@@ -188,7 +202,13 @@ func Merge(a, b *Resource) (*Resource, error) {
 	case a.schemaURL == b.schemaURL:
 		schemaURL = a.schemaURL
 	default:
-		return Empty(), errMergeConflictSchemaURL
+		// Translate the Resource with the older OpenTelemetry schema to the
+		// newer one so they can be merged.
+		var ok bool
+		if a, b, ok = upgradeSchema(a, b); !ok {
+			return Empty(), errMergeConflictSchemaURL
+		}
+		schemaURL = a.schemaURL
 	}
 
 	// Note: 'b' attributes will overwrite 'a' with last-value-wins in attribute.Key()
@@ -210,12 +230,18 @@ func Empty() *Resource {
 
 // Default returns an instance of Resource with a default
 // "service.name" and OpenTelemetrySDK attributes.
+//
+// The attributes of the registered Detectors named in the
+// OTEL_EXPERIMENTAL_RESOURCE_DETECTORS environment variable are also
+// included. The default Resource is only detected once, Detectors need to
+// be registered with RegisterDetector before Default is first called.
 func Default() *Resource {
 	defaultResourceOnce.Do(func() {
 		var err error
 		defaultResource, err = Detect(
 			context.Background(),
 			defaultServiceNameDetector{},
+			fromEnvDetectors{},
 			fromEnv{},
 			telemetrySDK{},
 		)
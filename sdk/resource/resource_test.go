@@ -185,6 +185,20 @@ func TestMerge(t *testing.T) {
 			want:  nil,
 			isErr: true,
 		},
+		{
+			name:      "Merge with older published schema",
+			a:         resource.NewWithAttributes("https://opentelemetry.io/schemas/1.12.0", kv11),
+			b:         resource.NewWithAttributes("https://opentelemetry.io/schemas/1.4.0", kv21),
+			want:      []attribute.KeyValue{kv11, kv21},
+			schemaURL: "https://opentelemetry.io/schemas/1.12.0",
+		},
+		{
+			name:      "Merge with newer published schema",
+			a:         resource.NewWithAttributes("https://opentelemetry.io/schemas/1.6.1", kv11),
+			b:         resource.NewWithAttributes("https://opentelemetry.io/schemas/1.10.0", kv21),
+			want:      []attribute.KeyValue{kv11, kv21},
+			schemaURL: "https://opentelemetry.io/schemas/1.10.0",
+		},
 	}
 	for _, c := range cases {
 		t.Run(fmt.Sprintf("case-%s", c.name), func(t *testing.T) {
@@ -501,6 +515,32 @@ func TestWithOS(t *testing.T) {
 	}, toMap(res))
 }
 
+func TestWithHostID(t *testing.T) {
+	resource.SetHostIDProvider(func() (string, error) { return "f2c668b579780554f70f72a063dc0864", nil })
+	t.Cleanup(restoreAttributesProviders)
+
+	res, err := resource.New(context.Background(),
+		resource.WithHostID(),
+	)
+
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]string{
+		"host.id": "f2c668b579780554f70f72a063dc0864",
+	}, toMap(res))
+}
+
+func TestWithHostIDError(t *testing.T) {
+	resource.SetHostIDProvider(func() (string, error) { return "", assert.AnError })
+	t.Cleanup(restoreAttributesProviders)
+
+	res, err := resource.New(context.Background(),
+		resource.WithHostID(),
+	)
+
+	require.Error(t, err)
+	require.EqualValues(t, map[string]string{}, toMap(res))
+}
+
 func TestWithProcessPID(t *testing.T) {
 	mockProcessAttributesProvidersWithErrors()
 	ctx := context.Background()
@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// schemaURLPrefix is the prefix of all published OpenTelemetry schema URLs.
+const schemaURLPrefix = "https://opentelemetry.io/schemas/"
+
+// schemaVersion is a published OpenTelemetry schema version.
+type schemaVersion struct {
+	// version is the schema version, the suffix of its schema URL.
+	version string
+	// renames maps resource attribute keys used by the previous version to
+	// the keys used starting with this version. These are the "all" and
+	// "resources" attribute renames of the published schema file.
+	renames map[attribute.Key]attribute.Key
+}
+
+// schemaVersions are the published OpenTelemetry schema versions, in
+// ascending order, and the resource attribute changes they introduced. This
+// is derived from the schema files published at
+// https://opentelemetry.io/schemas/ and needs to be extended when a new
+// semconv package is added.
+//
+// None of the published versions up to 1.12.0 rename resource attributes,
+// but resources using any of them can still be merged.
+var schemaVersions = []schemaVersion{
+	{version: "1.4.0"},
+	{version: "1.5.0"},
+	{version: "1.6.1"},
+	{version: "1.7.0"},
+	{version: "1.8.0"},
+	{version: "1.9.0"},
+	{version: "1.10.0"},
+	{version: "1.11.0"},
+	{version: "1.12.0"},
+}
+
+// schemaIndex returns the index in schemaVersions of the version identified
+// by schemaURL. False is returned if schemaURL does not identify a known
+// published version.
+func schemaIndex(schemaURL string) (int, bool) {
+	if !strings.HasPrefix(schemaURL, schemaURLPrefix) {
+		return 0, false
+	}
+	v := strings.TrimPrefix(schemaURL, schemaURLPrefix)
+	for i, sv := range schemaVersions {
+		if sv.version == v {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// upgradeSchema translates the Resource with the older schema of a and b to
+// the newer schema of the other. The translated Resources are returned in
+// the same order they were passed. False is returned if either schema is not
+// a known published version and the Resources cannot be translated.
+func upgradeSchema(a, b *Resource) (*Resource, *Resource, bool) {
+	ai, ok := schemaIndex(a.schemaURL)
+	if !ok {
+		return a, b, false
+	}
+	bi, ok := schemaIndex(b.schemaURL)
+	if !ok {
+		return a, b, false
+	}
+
+	switch {
+	case ai < bi:
+		a = translate(a, ai, bi)
+	case bi < ai:
+		b = translate(b, bi, ai)
+	}
+	return a, b, true
+}
+
+// translate returns a copy of r with its attributes renamed from the schema
+// version at index from in schemaVersions to the one at index to, which
+// needs to be greater than from.
+func translate(r *Resource, from, to int) *Resource {
+	schemaURL := schemaURLPrefix + schemaVersions[to].version
+
+	var renamed, kept []attribute.KeyValue
+	for iter := r.Iter(); iter.Next(); {
+		kv := iter.Attribute()
+		key := kv.Key
+		for _, sv := range schemaVersions[from+1 : to+1] {
+			if k, ok := sv.renames[key]; ok {
+				key = k
+			}
+		}
+		if key == kv.Key {
+			kept = append(kept, kv)
+			continue
+		}
+		renamed = append(renamed, attribute.KeyValue{Key: key, Value: kv.Value})
+	}
+
+	// If a renamed attribute conflicts with one already using the new key,
+	// the latter is kept as it was set for the newer schema.
+	return NewWithAttributes(schemaURL, append(renamed, kept...)...)
+}
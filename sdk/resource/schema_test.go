@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func withSchemaVersions(t *testing.T, versions []schemaVersion) {
+	orig := schemaVersions
+	schemaVersions = versions
+	t.Cleanup(func() { schemaVersions = orig })
+}
+
+func TestMergeTranslatesSchema(t *testing.T) {
+	withSchemaVersions(t, []schemaVersion{
+		{version: "1.0.0"},
+		{version: "1.1.0", renames: map[attribute.Key]attribute.Key{"a": "b"}},
+		{version: "1.2.0", renames: map[attribute.Key]attribute.Key{"b": "c"}},
+		{version: "1.3.0", renames: map[attribute.Key]attribute.Key{"x": "y"}},
+	})
+
+	older := NewWithAttributes(
+		schemaURLPrefix+"1.0.0",
+		attribute.String("a", "renamed twice"),
+		attribute.String("unchanged", "older"),
+	)
+	newer := NewWithAttributes(
+		schemaURLPrefix+"1.2.0",
+		attribute.String("x", "not renamed"),
+		attribute.String("unchanged", "newer"),
+	)
+
+	want := []attribute.KeyValue{
+		attribute.String("c", "renamed twice"),
+		attribute.String("unchanged", "newer"),
+		attribute.String("x", "not renamed"),
+	}
+
+	res, err := Merge(older, newer)
+	require.NoError(t, err)
+	assert.Equal(t, schemaURLPrefix+"1.2.0", res.SchemaURL())
+	assert.Equal(t, want, res.Attributes())
+
+	// The newer schema is used regardless of the order.
+	want[1] = attribute.String("unchanged", "older")
+	res, err = Merge(newer, older)
+	require.NoError(t, err)
+	assert.Equal(t, schemaURLPrefix+"1.2.0", res.SchemaURL())
+	assert.Equal(t, want, res.Attributes())
+}
+
+func TestTranslateConflict(t *testing.T) {
+	withSchemaVersions(t, []schemaVersion{
+		{version: "1.0.0"},
+		{version: "1.1.0", renames: map[attribute.Key]attribute.Key{"old": "new"}},
+	})
+
+	res := translate(NewWithAttributes(
+		schemaURLPrefix+"1.0.0",
+		attribute.String("old", "old value"),
+		attribute.String("new", "new value"),
+	), 0, 1)
+	assert.Equal(t, schemaURLPrefix+"1.1.0", res.SchemaURL())
+	assert.Equal(t, []attribute.KeyValue{attribute.String("new", "new value")}, res.Attributes())
+}
+
+func TestMergeUnknownSchema(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+	}{
+		{name: "unpublished version", a: schemaURLPrefix + "1.12.0", b: schemaURLPrefix + "1.3.0"},
+		{name: "other schema", a: schemaURLPrefix + "1.12.0", b: "https://example.com/schemas/1.0.0"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := Merge(NewWithAttributes(tc.a), NewWithAttributes(tc.b))
+			assert.ErrorIs(t, err, errMergeConflictSchemaURL)
+			assert.Equal(t, Empty(), res)
+		})
+	}
+}
@@ -67,31 +67,129 @@ type BatchSpanProcessorOptions struct {
 	// Blocking option should be used carefully as it can severely affect the performance of an
 	// application.
 	BlockOnQueueFull bool
+
+	// MaxQueueBytes is the maximum estimated size, in bytes, of the spans
+	// buffered by the processor. It bounds the spans held in the queue and in
+	// the batch waiting to be exported. If a span would exceed this budget it
+	// is dropped, the same as if the queue were full. The size of a span is
+	// estimated from its name, attributes, events, and links.
+	//
+	// This budget is not enforced when BlockOnQueueFull is set. If
+	// MaxQueueBytes is less than or equal to zero, which is the default, the
+	// queue is bounded only by MaxQueueSize.
+	MaxQueueBytes int64
+
+	// PersistentQueueDir is the directory spans are persisted in while they
+	// wait to be exported. If set, every span accepted by the processor is
+	// written to its own file in this directory before it is queued, and the
+	// file is removed once the exporter has been called with the span.
+	// Spans persisted by a processor that did not export them, because the
+	// process crashed or exited without shutting down the processor, are
+	// exported by the next processor using the directory when it is
+	// created.
+	//
+	// The directory must not be shared by processors that run at the same
+	// time. Spans may be exported more than once if the process stops while
+	// they are being exported. Persisting spans is done synchronously when a
+	// span ends, it adds file system operations to every span and should
+	// only be used for low span volumes. The default value of
+	// PersistentQueueDir is empty, meaning spans are only queued in memory.
+	PersistentQueueDir string
+}
+
+// BatchSpanProcessorStats is a snapshot of the state of a
+// BatchSpanProcessor.
+type BatchSpanProcessorStats struct {
+	// QueueLength is the number of spans in the queue waiting to be
+	// exported.
+	QueueLength int
+
+	// QueueCapacity is the maximum number of spans the queue can hold.
+	QueueCapacity int
+
+	// QueueBytes is the estimated size, in bytes, of the spans buffered by
+	// the processor. It is only tracked if MaxQueueBytes is set.
+	QueueBytes int64
+
+	// DroppedSpans is the total number of spans dropped because the queue
+	// was full or MaxQueueBytes was reached.
+	DroppedSpans uint32
+
+	// Exports is the total number of batches passed to the SpanExporter,
+	// including those it failed to export.
+	Exports uint64
+
+	// ExportedSpans is the total number of spans in the batches passed to
+	// the SpanExporter. The mean batch size is ExportedSpans / Exports.
+	ExportedSpans uint64
+
+	// ExportDuration is the total time spent in calls to the SpanExporter.
+	// The mean export latency is ExportDuration / Exports.
+	ExportDuration time.Duration
+
+	// LastBatchSize is the number of spans in the last batch passed to the
+	// SpanExporter.
+	LastBatchSize int
+
+	// LastExportDuration is the duration of the last call to the
+	// SpanExporter.
+	LastExportDuration time.Duration
+}
+
+// BatchSpanProcessorStatsProvider is implemented by the SpanProcessor
+// returned from NewBatchSpanProcessor. It can be used to monitor the
+// processor without depending on a metric SDK:
+//
+//	if p, ok := sp.(BatchSpanProcessorStatsProvider); ok {
+//		stats := p.Stats()
+//		// ...
+//	}
+type BatchSpanProcessorStatsProvider interface {
+	// Stats returns a snapshot of the current state of the processor.
+	Stats() BatchSpanProcessorStats
 }
 
 // batchSpanProcessor is a SpanProcessor that batches asynchronously-received
 // spans and sends them to a trace.Exporter when complete.
 type batchSpanProcessor struct {
+	// queueBytes is the estimated size of the spans in queue and batch. It
+	// is only tracked if o.MaxQueueBytes is greater than zero. It is the
+	// first field to ensure 64-bit alignment for atomic operations.
+	queueBytes int64
+
 	e SpanExporter
 	o BatchSpanProcessorOptions
 
 	queue   chan ReadOnlySpan
 	dropped uint32
 
+	spool *spanSpool
+
 	batch      []ReadOnlySpan
+	batchBytes int64
+	batchFiles []string
 	batchMutex sync.Mutex
 	timer      *time.Timer
 	stopWait   sync.WaitGroup
 	stopOnce   sync.Once
 	stopCh     chan struct{}
+
+	// exportStats holds the export fields of BatchSpanProcessorStats.
+	exportStats   BatchSpanProcessorStats
+	exportStatsMu sync.Mutex
 }
 
-var _ SpanProcessor = (*batchSpanProcessor)(nil)
+var (
+	_ SpanProcessor                   = (*batchSpanProcessor)(nil)
+	_ BatchSpanProcessorStatsProvider = (*batchSpanProcessor)(nil)
+)
 
 // NewBatchSpanProcessor creates a new SpanProcessor that will send completed
 // span batches to the exporter with the supplied options.
 //
 // If the exporter is nil, the span processor will preform no action.
+//
+// The returned SpanProcessor implements BatchSpanProcessorStatsProvider.
 func NewBatchSpanProcessor(exporter SpanExporter, options ...BatchSpanProcessorOption) SpanProcessor {
 	maxQueueSize := env.BatchSpanProcessorMaxQueueSize(DefaultMaxQueueSize)
 	maxExportBatchSize := env.BatchSpanProcessorMaxExportBatchSize(DefaultMaxExportBatchSize)
@@ -122,9 +220,20 @@ func NewBatchSpanProcessor(exporter SpanExporter, options ...BatchSpanProcessorO
 		stopCh: make(chan struct{}),
 	}
 
+	var recovered []spooledSpan
+	if o.PersistentQueueDir != "" && exporter != nil {
+		var err error
+		if bsp.spool, err = newSpanSpool(o.PersistentQueueDir); err != nil {
+			otel.Handle(err)
+		} else if recovered, err = bsp.spool.recover(); err != nil {
+			otel.Handle(err)
+		}
+	}
+
 	bsp.stopWait.Add(1)
 	go func() {
 		defer bsp.stopWait.Done()
+		bsp.exportRecovered(recovered)
 		bsp.processQueue()
 		bsp.drainQueue()
 	}()
@@ -204,6 +313,9 @@ func (bsp *batchSpanProcessor) ForceFlush(ctx context.Context) error {
 		case <-ctx.Done():
 			err = ctx.Err()
 		}
+		if err == nil {
+			err = flushExporter(ctx, bsp.e)
+		}
 	}
 	return err
 }
@@ -242,6 +354,26 @@ func WithExportTimeout(timeout time.Duration) BatchSpanProcessorOption {
 	}
 }
 
+// WithMaxQueueBytes returns a BatchSpanProcessorOption that configures the
+// maximum estimated size, in bytes, of the spans a BatchSpanProcessor
+// buffers. Spans that would exceed this budget are dropped.
+func WithMaxQueueBytes(bytes int64) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.MaxQueueBytes = bytes
+	}
+}
+
+// WithPersistentQueue returns a BatchSpanProcessorOption that configures a
+// BatchSpanProcessor to persist the spans it queues in the directory dir so
+// they are exported by the next BatchSpanProcessor using dir if they are not
+// exported before the process stops. See the PersistentQueueDir field of
+// BatchSpanProcessorOptions for details.
+func WithPersistentQueue(dir string) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.PersistentQueueDir = dir
+	}
+}
+
 // WithBlocking returns a BatchSpanProcessorOption that configures a
 // BatchSpanProcessor to wait for enqueue operations to succeed instead of
 // dropping data when the queue is full.
@@ -251,6 +383,30 @@ func WithBlocking() BatchSpanProcessorOption {
 	}
 }
 
+// Stats returns a snapshot of the current state of the BatchSpanProcessor.
+func (bsp *batchSpanProcessor) Stats() BatchSpanProcessorStats {
+	bsp.exportStatsMu.Lock()
+	stats := bsp.exportStats
+	bsp.exportStatsMu.Unlock()
+
+	stats.QueueLength = len(bsp.queue)
+	stats.QueueCapacity = cap(bsp.queue)
+	stats.QueueBytes = atomic.LoadInt64(&bsp.queueBytes)
+	stats.DroppedSpans = atomic.LoadUint32(&bsp.dropped)
+	return stats
+}
+
+// recordExport records the export of a batch of n spans that took d.
+func (bsp *batchSpanProcessor) recordExport(n int, d time.Duration) {
+	bsp.exportStatsMu.Lock()
+	defer bsp.exportStatsMu.Unlock()
+	bsp.exportStats.Exports++
+	bsp.exportStats.ExportedSpans += uint64(n)
+	bsp.exportStats.ExportDuration += d
+	bsp.exportStats.LastBatchSize = n
+	bsp.exportStats.LastExportDuration = d
+}
+
 // exportSpans is a subroutine of processing and draining the queue.
 func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 	bsp.timer.Reset(bsp.o.BatchTimeout)
@@ -266,13 +422,17 @@ func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 
 	if l := len(bsp.batch); l > 0 {
 		global.Debug("exporting spans", "count", len(bsp.batch), "total_dropped", atomic.LoadUint32(&bsp.dropped))
+		start := time.Now()
 		err := bsp.e.ExportSpans(ctx, bsp.batch)
+		bsp.recordExport(l, time.Since(start))
 
 		// A new batch is always created after exporting, even if the batch failed to be exported.
 		//
 		// It is up to the exporter to implement any type of retry logic if a batch is failing
 		// to be exported, since it is specific to the protocol and backend being sent to.
 		bsp.batch = bsp.batch[:0]
+		bsp.releaseBatchBytes()
+		bsp.releaseBatchFiles()
 
 		if err != nil {
 			return err
@@ -281,6 +441,81 @@ func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 	return nil
 }
 
+// appendBatch adds sd to the batch. The batchMutex must be held.
+func (bsp *batchSpanProcessor) appendBatch(sd ReadOnlySpan) {
+	if ss, ok := sd.(spooledSpan); ok {
+		bsp.batchFiles = append(bsp.batchFiles, ss.file)
+		sd = ss.ReadOnlySpan
+	}
+	bsp.batch = append(bsp.batch, sd)
+	if bsp.o.MaxQueueBytes > 0 && !bsp.o.BlockOnQueueFull {
+		bsp.batchBytes += estimateSpanSize(sd)
+	}
+}
+
+// releaseBatchBytes removes the size of the exported batch from the buffered
+// size. The batchMutex must be held.
+func (bsp *batchSpanProcessor) releaseBatchBytes() {
+	if bsp.batchBytes != 0 {
+		atomic.AddInt64(&bsp.queueBytes, -bsp.batchBytes)
+		bsp.batchBytes = 0
+	}
+}
+
+// releaseBatchFiles removes the persisted copies of the exported batch. The
+// batchMutex must be held.
+func (bsp *batchSpanProcessor) releaseBatchFiles() {
+	if len(bsp.batchFiles) == 0 {
+		return
+	}
+	if err := bsp.spool.remove(bsp.batchFiles...); err != nil {
+		otel.Handle(err)
+	}
+	bsp.batchFiles = bsp.batchFiles[:0]
+}
+
+// exportRecovered exports spans persisted by a previous processor, in
+// batches of up to MaxExportBatchSize. The last partial batch is exported
+// with the spans that follow.
+func (bsp *batchSpanProcessor) exportRecovered(spans []spooledSpan) {
+	if len(spans) == 0 {
+		return
+	}
+	global.Debug("exporting recovered spans", "count", len(spans))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for _, sd := range spans {
+		if bsp.o.MaxQueueBytes > 0 && !bsp.o.BlockOnQueueFull {
+			// Account for the bytes appendBatch adds to the batch.
+			atomic.AddInt64(&bsp.queueBytes, estimateSpanSize(sd))
+		}
+		bsp.batchMutex.Lock()
+		bsp.appendBatch(sd)
+		shouldExport := len(bsp.batch) >= bsp.o.MaxExportBatchSize
+		bsp.batchMutex.Unlock()
+		if shouldExport {
+			if err := bsp.exportSpans(ctx); err != nil {
+				otel.Handle(err)
+			}
+		}
+	}
+}
+
+// reserveBytes reserves n bytes of the MaxQueueBytes budget. It returns
+// false, reserving nothing, if the budget would be exceeded.
+func (bsp *batchSpanProcessor) reserveBytes(n int64) bool {
+	for {
+		cur := atomic.LoadInt64(&bsp.queueBytes)
+		if cur+n > bsp.o.MaxQueueBytes {
+			return false
+		}
+		if atomic.CompareAndSwapInt64(&bsp.queueBytes, cur, cur+n) {
+			return true
+		}
+	}
+}
+
 // processQueue removes spans from the `queue` channel until processor
 // is shut down. It calls the exporter in batches of up to MaxExportBatchSize
 // waiting up to BatchTimeout to form a batch.
@@ -303,7 +538,7 @@ func (bsp *batchSpanProcessor) processQueue() {
 				continue
 			}
 			bsp.batchMutex.Lock()
-			bsp.batch = append(bsp.batch, sd)
+			bsp.appendBatch(sd)
 			shouldExport := len(bsp.batch) >= bsp.o.MaxExportBatchSize
 			bsp.batchMutex.Unlock()
 			if shouldExport {
@@ -334,7 +569,7 @@ func (bsp *batchSpanProcessor) drainQueue() {
 			}
 
 			bsp.batchMutex.Lock()
-			bsp.batch = append(bsp.batch, sd)
+			bsp.appendBatch(sd)
 			shouldExport := len(bsp.batch) == bsp.o.MaxExportBatchSize
 			bsp.batchMutex.Unlock()
 
@@ -351,10 +586,26 @@ func (bsp *batchSpanProcessor) drainQueue() {
 
 func (bsp *batchSpanProcessor) enqueue(sd ReadOnlySpan) {
 	ctx := context.TODO()
+	if bsp.spool != nil && sd.SpanContext().IsSampled() {
+		ss, err := bsp.spool.write(sd)
+		if err != nil {
+			// Still queue the span, only its persistence failed.
+			otel.Handle(err)
+		} else {
+			sd = ss
+		}
+	}
+
+	var queued bool
 	if bsp.o.BlockOnQueueFull {
-		bsp.enqueueBlockOnQueueFull(ctx, sd)
+		queued = bsp.enqueueBlockOnQueueFull(ctx, sd)
 	} else {
-		bsp.enqueueDrop(ctx, sd)
+		queued = bsp.enqueueDrop(ctx, sd)
+	}
+	if ss, ok := sd.(spooledSpan); ok && !queued {
+		if err := bsp.spool.remove(ss.file); err != nil {
+			otel.Handle(err)
+		}
 	}
 }
 
@@ -409,10 +660,20 @@ func (bsp *batchSpanProcessor) enqueueDrop(ctx context.Context, sd ReadOnlySpan)
 	default:
 	}
 
+	var size int64
+	if bsp.o.MaxQueueBytes > 0 {
+		size = estimateSpanSize(sd)
+		if !bsp.reserveBytes(size) {
+			atomic.AddUint32(&bsp.dropped, 1)
+			return false
+		}
+	}
+
 	select {
 	case bsp.queue <- sd:
 		return true
 	default:
+		atomic.AddInt64(&bsp.queueBytes, -size)
 		atomic.AddUint32(&bsp.dropped, 1)
 	}
 	return false
@@ -0,0 +1,161 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// blockingExporter blocks exports until released.
+type blockingExporter struct {
+	started chan struct{}
+	release chan struct{}
+	once    sync.Once
+}
+
+func newBlockingExporter() *blockingExporter {
+	return &blockingExporter{
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+}
+
+func (e *blockingExporter) ExportSpans(ctx context.Context, _ []sdktrace.ReadOnlySpan) error {
+	e.once.Do(func() { close(e.started) })
+	select {
+	case <-e.release:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return nil
+}
+
+func (e *blockingExporter) Shutdown(context.Context) error { return nil }
+
+// fillQueue ends spans with a tracer from a TracerProvider using bsp until
+// exp is blocked exporting the first one, the queue of bsp is full, and
+// dropped spans have been dropped.
+func fillQueue(t *testing.T, bsp sdktrace.SpanProcessor, exp *blockingExporter, dropped int) {
+	t.Helper()
+
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(bsp)
+	tr := tp.Tracer("fillQueue")
+
+	_, span := tr.Start(context.Background(), "exporting")
+	span.End()
+	<-exp.started
+
+	_, span = tr.Start(context.Background(), "queued")
+	span.End()
+	for i := 0; i < dropped; i++ {
+		_, span = tr.Start(context.Background(), "dropped")
+		span.End()
+	}
+}
+
+func TestBatchSpanProcessorStats(t *testing.T) {
+	exp := newBlockingExporter()
+	bsp := sdktrace.NewBatchSpanProcessor(
+		exp,
+		sdktrace.WithMaxQueueSize(1),
+		sdktrace.WithMaxExportBatchSize(1),
+	)
+	s, ok := bsp.(sdktrace.BatchSpanProcessorStatsProvider)
+	require.True(t, ok, "BatchSpanProcessor does not have a Stats method")
+
+	assert.Equal(t, sdktrace.BatchSpanProcessorStats{QueueCapacity: 1}, s.Stats())
+
+	fillQueue(t, bsp, exp, 3)
+	assert.Equal(t, sdktrace.BatchSpanProcessorStats{
+		QueueLength:   1,
+		QueueCapacity: 1,
+		DroppedSpans:  3,
+	}, s.Stats())
+
+	close(exp.release)
+	require.NoError(t, bsp.Shutdown(context.Background()))
+}
+
+func TestBatchSpanProcessorExportStats(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	bsp := sdktrace.NewBatchSpanProcessor(exp, sdktrace.WithMaxExportBatchSize(2))
+	s := bsp.(sdktrace.BatchSpanProcessorStatsProvider)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(bsp)
+	tr := tp.Tracer("TestBatchSpanProcessorExportStats")
+
+	for i := 0; i < 3; i++ {
+		_, span := tr.Start(context.Background(), "span")
+		span.End()
+	}
+	require.NoError(t, bsp.ForceFlush(context.Background()))
+
+	stats := s.Stats()
+	assert.Equal(t, uint64(2), stats.Exports)
+	assert.Equal(t, uint64(3), stats.ExportedSpans)
+	assert.Equal(t, 1, stats.LastBatchSize)
+	assert.GreaterOrEqual(t, stats.ExportDuration, stats.LastExportDuration)
+	assert.Len(t, exp.GetSpans(), 3)
+	require.NoError(t, bsp.Shutdown(context.Background()))
+}
+
+func TestBatchSpanProcessorMaxQueueBytes(t *testing.T) {
+	exp := newBlockingExporter()
+	bsp := sdktrace.NewBatchSpanProcessor(
+		exp,
+		sdktrace.WithMaxQueueBytes(4096),
+		sdktrace.WithMaxExportBatchSize(1),
+	)
+	s := bsp.(sdktrace.BatchSpanProcessorStatsProvider)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(bsp)
+	tr := tp.Tracer("TestBatchSpanProcessorMaxQueueBytes")
+
+	_, span := tr.Start(context.Background(), "exporting")
+	span.End()
+	<-exp.started
+	held := s.Stats().QueueBytes
+	assert.Greater(t, held, int64(0), "exporting span not accounted for")
+
+	_, span = tr.Start(context.Background(), "small")
+	span.End()
+	stats := s.Stats()
+	assert.Equal(t, 1, stats.QueueLength)
+	assert.Greater(t, stats.QueueBytes, held)
+	assert.Equal(t, uint32(0), stats.DroppedSpans)
+
+	_, span = tr.Start(context.Background(), "large")
+	span.SetAttributes(attribute.String("payload", strings.Repeat("x", 4096)))
+	span.End()
+	stats = s.Stats()
+	assert.Equal(t, 1, stats.QueueLength, "large span not dropped")
+	assert.Equal(t, uint32(1), stats.DroppedSpans)
+
+	close(exp.release)
+	require.NoError(t, bsp.ForceFlush(context.Background()))
+	assert.Equal(t, int64(0), s.Stats().QueueBytes)
+	require.NoError(t, bsp.Shutdown(context.Background()))
+}
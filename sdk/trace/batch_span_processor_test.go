@@ -30,6 +30,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/sdk/internal/env"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
@@ -549,6 +550,15 @@ func (indefiniteExporter) ExportSpans(ctx context.Context, _ []sdktrace.ReadOnly
 	return ctx.Err()
 }
 
+func TestBatchSpanProcessorForceFlushExporter(t *testing.T) {
+	exporter := &flushingExporter{err: errors.New("export failed")}
+	bsp := sdktrace.NewBatchSpanProcessor(exporter)
+	t.Cleanup(func() { assert.NoError(t, bsp.Shutdown(context.Background())) })
+
+	assert.ErrorIs(t, bsp.ForceFlush(context.Background()), exporter.err)
+	assert.Equal(t, 1, exporter.flushed)
+}
+
 func TestBatchSpanProcessorForceFlushTimeout(t *testing.T) {
 	// Add timeout to context to test deadline
 	ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
@@ -594,6 +604,54 @@ func TestBatchSpanProcessorForceFlushQueuedSpans(t *testing.T) {
 	}
 }
 
+func TestBatchSpanProcessorPersistentQueue(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	// The first processor never exports before the second is created,
+	// simulating a process that stops before its spans are exported.
+	stopped := sdktrace.NewBatchSpanProcessor(
+		tracetest.NewNoopExporter(),
+		sdktrace.WithPersistentQueue(dir),
+		sdktrace.WithBatchTimeout(time.Hour),
+		sdktrace.WithMaxExportBatchSize(100),
+	)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(stopped)
+	tr := tp.Tracer("TestBatchSpanProcessorPersistentQueue")
+	for _, name := range []string{"first", "second", "third"} {
+		_, span := tr.Start(ctx, name, trace.WithAttributes(attribute.String("name", name)))
+		span.End()
+	}
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 3)
+
+	exp := tracetest.NewInMemoryExporter()
+	bsp := sdktrace.NewBatchSpanProcessor(
+		exp,
+		sdktrace.WithPersistentQueue(dir),
+		sdktrace.WithMaxExportBatchSize(2),
+	)
+	require.NoError(t, bsp.ForceFlush(ctx))
+
+	got := exp.GetSpans()
+	require.Len(t, got, 3)
+	for i, name := range []string{"first", "second", "third"} {
+		assert.Equal(t, name, got[i].Name)
+		assert.Equal(t, []attribute.KeyValue{attribute.String("name", name)}, got[i].Attributes)
+		assert.Equal(t, "TestBatchSpanProcessorPersistentQueue", got[i].InstrumentationLibrary.Name)
+	}
+
+	files, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files, "exported spans not removed")
+
+	require.NoError(t, bsp.Shutdown(ctx))
+	require.NoError(t, stopped.Shutdown(ctx))
+}
+
 func BenchmarkSpanProcessor(b *testing.B) {
 	tp := sdktrace.NewTracerProvider(
 		sdktrace.WithBatcher(
@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import "time"
+
+// Clock provides the time used for span start, end, and event timestamps.
+//
+// Timestamps explicitly passed to span operations, like with the
+// trace.WithTimestamp option, are used as-is and not taken from a Clock.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// Since returns the time elapsed since t, a time previously returned by
+	// Now. It is used to measure the duration of spans, an implementation
+	// should use a monotonic clock if it can.
+	Since(t time.Time) time.Duration
+}
+
+// defaultClock is a Clock using the system wall and monotonic clocks.
+type defaultClock struct{}
+
+var _ Clock = defaultClock{}
+
+// Now returns time.Now().
+func (defaultClock) Now() time.Time { return time.Now() }
+
+// Since returns time.Since(t).
+func (defaultClock) Since(t time.Time) time.Duration { return time.Since(t) }
@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// stepClock is a Clock that advances by step every time it is read.
+type stepClock struct {
+	now  time.Time
+	step time.Duration
+}
+
+func (c *stepClock) Now() time.Time {
+	c.now = c.now.Add(c.step)
+	return c.now
+}
+
+func (c *stepClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+func TestWithClock(t *testing.T) {
+	clock := &stepClock{now: time.Unix(1000, 0), step: time.Second}
+	rec := new(recorder)
+	tp := NewTracerProvider(WithClock(clock), WithSpanProcessor(rec))
+
+	_, span := tp.Tracer("TestWithClock").Start(context.Background(), "span")
+	span.AddEvent("clock")
+	explicit := time.Unix(5, 0)
+	span.AddEvent("explicit", trace.WithTimestamp(explicit))
+	span.End()
+
+	require.Len(t, *rec, 1)
+	got := (*rec)[0]
+	assert.Equal(t, time.Unix(1001, 0), got.StartTime())
+	require.Len(t, got.Events(), 2)
+	assert.Equal(t, time.Unix(1002, 0), got.Events()[0].Time)
+	assert.Equal(t, explicit, got.Events()[1].Time)
+	assert.Equal(t, time.Unix(1004, 0), got.EndTime())
+}
+
+func TestWithClockExplicitSpanTimestamps(t *testing.T) {
+	clock := &stepClock{now: time.Unix(1000, 0), step: time.Second}
+	rec := new(recorder)
+	tp := NewTracerProvider(WithClock(clock), WithSpanProcessor(rec))
+
+	start, end := time.Unix(1, 0), time.Unix(2, 0)
+	_, span := tp.Tracer("TestWithClock").Start(context.Background(), "span", trace.WithTimestamp(start))
+	span.End(trace.WithTimestamp(end))
+
+	require.Len(t, *rec, 1)
+	assert.Equal(t, start, (*rec)[0].StartTime())
+	assert.Equal(t, end, (*rec)[0].EndTime())
+}
+
+func TestWithClockNil(t *testing.T) {
+	tp := NewTracerProvider(WithClock(nil))
+	assert.Equal(t, defaultClock{}, tp.clock)
+}
@@ -35,3 +35,8 @@ type Event struct {
 	// Time at which this event was recorded.
 	Time time.Time
 }
+
+// EventCountKey is the attribute key used to record the number of times an
+// event was added to a Span when events are coalesced. See
+// WithEventCoalescing.
+const EventCountKey = attribute.Key("otel.event.count")
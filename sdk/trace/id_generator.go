@@ -20,6 +20,7 @@ import (
 	"encoding/binary"
 	"math/rand"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/otel/trace"
 )
@@ -75,3 +76,31 @@ func defaultIDGenerator() IDGenerator {
 	gen.randSource = rand.New(rand.NewSource(rngSeed))
 	return gen
 }
+
+// xrayIDGenerator is an IDGenerator that generates AWS X-Ray compatible
+// trace IDs.
+type xrayIDGenerator struct {
+	*randomIDGenerator
+}
+
+var _ IDGenerator = xrayIDGenerator{}
+
+// NewXRayIDGenerator returns an IDGenerator that generates AWS X-Ray
+// compatible trace IDs. The first 4 bytes of a trace ID are the current time
+// in seconds since the Unix epoch, as big-endian, and the remaining 12 bytes
+// are randomly chosen. Span IDs are randomly chosen.
+//
+// AWS X-Ray rejects trace IDs that do not start with a recent timestamp. Use
+// this with the WithIDGenerator option when sending spans to X-Ray, for
+// example through the AWS Distro for OpenTelemetry Collector.
+func NewXRayIDGenerator() IDGenerator {
+	return xrayIDGenerator{randomIDGenerator: defaultIDGenerator().(*randomIDGenerator)}
+}
+
+// NewIDs returns a trace ID prefixed with the current time and a non-zero
+// span ID from a randomly-chosen sequence.
+func (gen xrayIDGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	tid, sid := gen.randomIDGenerator.NewIDs(ctx)
+	binary.BigEndian.PutUint32(tid[:4], uint32(time.Now().Unix()))
+	return tid, sid
+}
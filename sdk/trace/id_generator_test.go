@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestXRayIDGenerator(t *testing.T) {
+	gen := NewXRayIDGenerator()
+	ctx := context.Background()
+
+	before := time.Now().Unix()
+	tid, sid := gen.NewIDs(ctx)
+	after := time.Now().Unix()
+
+	assert.True(t, tid.IsValid(), "invalid trace ID")
+	assert.True(t, sid.IsValid(), "invalid span ID")
+	ts := int64(binary.BigEndian.Uint32(tid[:4]))
+	assert.GreaterOrEqual(t, ts, before)
+	assert.LessOrEqual(t, ts, after)
+
+	other, _ := gen.NewIDs(ctx)
+	assert.NotEqual(t, tid[4:], other[4:], "trace ID not random")
+
+	child := gen.NewSpanID(ctx, tid)
+	assert.True(t, child.IsValid(), "invalid child span ID")
+	assert.NotEqual(t, sid, child)
+}
+
+func TestXRayIDGeneratorTracerProvider(t *testing.T) {
+	tp := NewTracerProvider(WithIDGenerator(NewXRayIDGenerator()))
+	before := time.Now().Unix()
+	_, span := tp.Tracer("TestXRayIDGeneratorTracerProvider").Start(context.Background(), "span")
+	span.End()
+
+	tid := span.SpanContext().TraceID()
+	assert.GreaterOrEqual(t, int64(binary.BigEndian.Uint32(tid[:4])), before)
+	assert.NotEqual(t, trace.TraceID{}, tid)
+}
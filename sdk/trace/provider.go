@@ -21,6 +21,7 @@ import (
 	"sync/atomic"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/resource"
@@ -49,24 +50,46 @@ type tracerProviderConfig struct {
 	// spanLimits defines the attribute, event, and link limits for spans.
 	spanLimits SpanLimits
 
+	// scopeSpanLimits defines the attribute, event, and link limits for
+	// spans created by Tracers with an instrumentation scope name. These
+	// override spanLimits.
+	scopeSpanLimits map[string]SpanLimits
+
+	// attrValueLengthLimits defines the attribute value length limits for
+	// span attributes with a key. These override the
+	// AttributeValueLengthLimit of spanLimits and scopeSpanLimits.
+	attrValueLengthLimits map[attribute.Key]int
+
 	// resource contains attributes representing an entity that produces telemetry.
 	resource *resource.Resource
+
+	// clock provides the time for span and event timestamps.
+	clock Clock
+
+	// coalesceEvents determines if repeated span events are coalesced.
+	coalesceEvents bool
 }
 
 // MarshalLog is the marshaling function used by the logging system to represent this exporter.
 func (cfg tracerProviderConfig) MarshalLog() interface{} {
 	return struct {
-		SpanProcessors  []SpanProcessor
-		SamplerType     string
-		IDGeneratorType string
-		SpanLimits      SpanLimits
-		Resource        *resource.Resource
+		SpanProcessors             []SpanProcessor
+		SamplerType                string
+		IDGeneratorType            string
+		SpanLimits                 SpanLimits
+		ScopeSpanLimits            map[string]SpanLimits
+		AttributeValueLengthLimits map[attribute.Key]int
+		CoalesceEvents             bool
+		Resource                   *resource.Resource
 	}{
-		SpanProcessors:  cfg.processors,
-		SamplerType:     fmt.Sprintf("%T", cfg.sampler),
-		IDGeneratorType: fmt.Sprintf("%T", cfg.idGenerator),
-		SpanLimits:      cfg.spanLimits,
-		Resource:        cfg.resource,
+		SpanProcessors:             cfg.processors,
+		SamplerType:                fmt.Sprintf("%T", cfg.sampler),
+		IDGeneratorType:            fmt.Sprintf("%T", cfg.idGenerator),
+		SpanLimits:                 cfg.spanLimits,
+		ScopeSpanLimits:            cfg.scopeSpanLimits,
+		AttributeValueLengthLimits: cfg.attrValueLengthLimits,
+		CoalesceEvents:             cfg.coalesceEvents,
+		Resource:                   cfg.resource,
 	}
 }
 
@@ -77,12 +100,20 @@ type TracerProvider struct {
 	namedTracer    map[instrumentation.Scope]*tracer
 	spanProcessors atomic.Value
 
+	// sampler holds the samplerHolder of the current Sampler. It can be
+	// updated with SetSampler.
+	sampler atomic.Value
+
 	// These fields are not protected by the lock mu. They are assumed to be
 	// immutable after creation of the TracerProvider.
-	sampler     Sampler
-	idGenerator IDGenerator
-	spanLimits  SpanLimits
-	resource    *resource.Resource
+	idGenerator     IDGenerator
+	spanLimits      SpanLimits
+	scopeSpanLimits map[string]SpanLimits
+	resource        *resource.Resource
+	clock           Clock
+
+	attrValueLengthLimits map[attribute.Key]int
+	coalesceEvents        bool
 }
 
 var _ trace.TracerProvider = &TracerProvider{}
@@ -110,12 +141,17 @@ func NewTracerProvider(opts ...TracerProviderOption) *TracerProvider {
 	o = ensureValidTracerProviderConfig(o)
 
 	tp := &TracerProvider{
-		namedTracer: make(map[instrumentation.Scope]*tracer),
-		sampler:     o.sampler,
-		idGenerator: o.idGenerator,
-		spanLimits:  o.spanLimits,
-		resource:    o.resource,
+		namedTracer:     make(map[instrumentation.Scope]*tracer),
+		idGenerator:     o.idGenerator,
+		spanLimits:      o.spanLimits,
+		scopeSpanLimits: o.scopeSpanLimits,
+		resource:        o.resource,
+		clock:           o.clock,
+
+		attrValueLengthLimits: o.attrValueLengthLimits,
+		coalesceEvents:        o.coalesceEvents,
 	}
+	tp.sampler.Store(samplerHolder{o.sampler})
 	global.Info("TracerProvider created", "config", o)
 
 	spss := spanProcessorStates{}
@@ -152,6 +188,10 @@ func (p *TracerProvider) Tracer(name string, opts ...trace.TracerOption) trace.T
 		t = &tracer{
 			provider:             p,
 			instrumentationScope: is,
+			spanLimits:           p.spanLimits,
+		}
+		if sl, ok := p.scopeSpanLimits[name]; ok {
+			t.spanLimits = sl
 		}
 		p.namedTracer[is] = t
 		global.Info("Tracer created", "name", name, "version", c.InstrumentationVersion(), "schemaURL", c.SchemaURL())
@@ -159,6 +199,36 @@ func (p *TracerProvider) Tracer(name string, opts ...trace.TracerOption) trace.T
 	return t
 }
 
+// samplerHolder wraps a Sampler so Samplers of different concrete types can be
+// stored in the same atomic.Value.
+type samplerHolder struct {
+	Sampler
+}
+
+// Sampler returns the Sampler the TracerProvider currently uses to make
+// sampling decisions.
+func (p *TracerProvider) Sampler() Sampler {
+	return p.sampler.Load().(samplerHolder).Sampler
+}
+
+// SetSampler replaces the Sampler used by the TracerProvider to make sampling
+// decisions. All Spans started after this method returns, including those
+// started by existing Tracers, are sampled by s. Spans that have already
+// been started are not affected.
+//
+// This can be used to temporarily change the sampling of a running service,
+// like sampling all Spans during an incident. The Sampler returned by the
+// Sampler method can be used to restore the original Sampler afterwards.
+//
+// If s is nil, the Sampler is not changed.
+func (p *TracerProvider) SetSampler(s Sampler) {
+	if s == nil {
+		return
+	}
+	p.sampler.Store(samplerHolder{s})
+	global.Info("TracerProvider sampler updated", "sampler", s.Description())
+}
+
 // RegisterSpanProcessor adds the given SpanProcessor to the list of SpanProcessors.
 func (p *TracerProvider) RegisterSpanProcessor(sp SpanProcessor) {
 	p.mu.Lock()
@@ -410,6 +480,103 @@ func WithRawSpanLimits(limits SpanLimits) TracerProviderOption {
 	})
 }
 
+// WithScopeSpanLimits returns a TracerProviderOption that configures a
+// TracerProvider to use limits for Spans created by Tracers with the
+// instrumentation scope name. These limits override the limits configured
+// with WithRawSpanLimits or WithSpanLimits for those Tracers, regardless of
+// their instrumentation version or schema URL.
+//
+// The limits are used as-is, the same as WithRawSpanLimits. They should be
+// constructed using NewSpanLimits and updated accordingly. For example, to
+// allow more attributes for the spans of a single instrumentation library:
+//
+//	limits := NewSpanLimits()
+//	limits.AttributeCountLimit = 512
+//	tp := NewTracerProvider(WithScopeSpanLimits("example.com/important", limits))
+//
+// If this option is used more than once for the same name, the last limits
+// are used.
+func WithScopeSpanLimits(name string, limits SpanLimits) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		if name == "" {
+			name = defaultTracerName
+		}
+		scoped := make(map[string]SpanLimits, len(cfg.scopeSpanLimits)+1)
+		for k, v := range cfg.scopeSpanLimits {
+			scoped[k] = v
+		}
+		scoped[name] = limits
+		cfg.scopeSpanLimits = scoped
+		return cfg
+	})
+}
+
+// WithAttributeValueLengthLimit returns a TracerProviderOption that configures
+// a TracerProvider to truncate the string and string slice values of span
+// attributes with key to at most limit bytes. This overrides the
+// AttributeValueLengthLimit of the SpanLimits for those attributes, including
+// limits set with WithScopeSpanLimits. A negative limit means the values of
+// attributes with key are not truncated.
+//
+// For example, to truncate long database statements without truncating any
+// other attribute:
+//
+//	WithAttributeValueLengthLimit(semconv.DBStatementKey, 1024)
+//
+// If this option is used more than once for the same key, the last limit is
+// used.
+func WithAttributeValueLengthLimit(key attribute.Key, limit int) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		limits := make(map[attribute.Key]int, len(cfg.attrValueLengthLimits)+1)
+		for k, v := range cfg.attrValueLengthLimits {
+			limits[k] = v
+		}
+		limits[key] = limit
+		cfg.attrValueLengthLimits = limits
+		return cfg
+	})
+}
+
+// WithEventCoalescing returns a TracerProviderOption that configures a
+// TracerProvider to coalesce repeated events added to a Span. When an event
+// is added with the same name and attributes as an event the Span already
+// holds, no new event is recorded. Instead, the EventCountKey attribute of
+// the existing event is set to the number of times it has been added. The
+// existing event keeps the timestamp of its first occurrence.
+//
+// The EventCountKey attribute is reserved, attributes with that key passed
+// when adding an event are dropped. The attribute counts towards the
+// AttributePerEventCountLimit of the SpanLimits, other attributes of the
+// event are dropped to keep it within the limit.
+//
+// This is useful for operations, like retry loops, that record many
+// identical events and would otherwise exceed the EventCountLimit of the
+// SpanLimits.
+func WithEventCoalescing() TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.coalesceEvents = true
+		return cfg
+	})
+}
+
+// WithClock returns a TracerProviderOption that configures the Clock used by
+// a TracerProvider to timestamp the start and end of Spans, and the events
+// added to them, when no timestamp is explicitly provided. The end time of a
+// Span is its start time plus the duration measured with the Since method of
+// clock.
+//
+// This can be used to produce deterministic timestamps, like in tests or
+// when replaying recorded operations.
+//
+// If this option is not used, or clock is nil, the TracerProvider will use
+// the system clock.
+func WithClock(clock Clock) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.clock = clock
+		return cfg
+	})
+}
+
 func applyTracerProviderEnvConfigs(cfg tracerProviderConfig) tracerProviderConfig {
 	for _, opt := range tracerProviderOptionsFromEnv() {
 		cfg = opt.apply(cfg)
@@ -444,5 +611,8 @@ func ensureValidTracerProviderConfig(cfg tracerProviderConfig) tracerProviderCon
 	if cfg.resource == nil {
 		cfg.resource = resource.Default()
 	}
+	if cfg.clock == nil {
+		cfg.clock = defaultClock{}
+	}
 	return cfg
 }
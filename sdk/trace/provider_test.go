@@ -229,7 +229,7 @@ func TestTracerProviderSamplerConfigFromEnv(t *testing.T) {
 			})
 
 			stp := NewTracerProvider(WithSyncer(NewTestExporter()))
-			assert.Equal(t, test.description, stp.sampler.Description())
+			assert.Equal(t, test.description, stp.Sampler().Description())
 			if test.errorType != nil {
 				testStoredError(t, test.errorType)
 			} else {
@@ -252,7 +252,7 @@ func TestTracerProviderSamplerConfigFromEnv(t *testing.T) {
 					t.Cleanup(func() {
 						require.NoError(t, stp.Shutdown(context.Background()))
 					})
-					assert.Equal(t, test.description, stp.sampler.Description())
+					assert.Equal(t, test.description, stp.Sampler().Description())
 
 					if test.invalidArgErrorType != nil {
 						testStoredError(t, test.invalidArgErrorType)
@@ -282,3 +282,26 @@ func testStoredError(t *testing.T, target interface{}) {
 		assert.ErrorAs(t, err, target)
 	}
 }
+
+func TestSetSampler(t *testing.T) {
+	tp := NewTracerProvider(WithSampler(NeverSample()))
+	tr := tp.Tracer("TestSetSampler")
+
+	_, before := tr.Start(context.Background(), "before")
+	assert.False(t, before.SpanContext().IsSampled())
+
+	orig := tp.Sampler()
+	tp.SetSampler(AlwaysSample())
+	assert.Equal(t, AlwaysSample().Description(), tp.Sampler().Description())
+	_, during := tr.Start(context.Background(), "during")
+	assert.True(t, during.SpanContext().IsSampled())
+	assert.False(t, before.SpanContext().IsSampled(), "started span resampled")
+
+	// A nil Sampler is ignored.
+	tp.SetSampler(nil)
+	assert.Equal(t, AlwaysSample().Description(), tp.Sampler().Description())
+
+	tp.SetSampler(orig)
+	_, after := tr.Start(context.Background(), "after")
+	assert.False(t, after.SpanContext().IsSampled())
+}
@@ -17,19 +17,89 @@ package trace // import "go.opentelemetry.io/otel/sdk/trace"
 import (
 	"context"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/otel"
 )
 
+// SimpleSpanProcessorOption configures a SimpleSpanProcessor.
+type SimpleSpanProcessorOption func(o *SimpleSpanProcessorOptions)
+
+// SimpleSpanProcessorOptions is configuration settings for a
+// SimpleSpanProcessor.
+type SimpleSpanProcessorOptions struct {
+	// ExportTimeout specifies the maximum duration of each attempt to export
+	// a span. If the timeout is reached, the export attempt is cancelled.
+	// The default value of ExportTimeout is zero, meaning exports are not
+	// timed out.
+	ExportTimeout time.Duration
+
+	// MaxRetries is the maximum number of times the export of a span is
+	// retried after the exporter returns an error. The default value of
+	// MaxRetries is zero, meaning failed exports are not retried.
+	MaxRetries int
+
+	// RetryInterval is the duration waited between export attempts.
+	RetryInterval time.Duration
+
+	// Async exports spans from a queue drained by a single goroutine instead
+	// of the goroutine ending the span. This means span.End() does not wait
+	// for the export. Spans ending while the queue is full are dropped. The
+	// default value of Async is false.
+	Async bool
+
+	// MaxQueueSize is the maximum number of spans queued for export when
+	// Async is true. The default value of MaxQueueSize is 2048.
+	MaxQueueSize int
+}
+
+// SimpleSpanProcessorStats is a snapshot of the state of a
+// SimpleSpanProcessor.
+type SimpleSpanProcessorStats struct {
+	// QueueLength is the number of spans in the queue waiting to be
+	// exported. It is always zero unless Async is set.
+	QueueLength int
+
+	// QueueCapacity is the maximum number of spans the queue can hold. It is
+	// always zero unless Async is set.
+	QueueCapacity int
+
+	// DroppedSpans is the total number of spans dropped because the queue
+	// was full.
+	DroppedSpans uint32
+}
+
+// SimpleSpanProcessorStatsProvider is implemented by the SpanProcessor
+// returned from NewSimpleSpanProcessor. It can be used to monitor the
+// processor without depending on a metric SDK.
+type SimpleSpanProcessorStatsProvider interface {
+	// Stats returns a snapshot of the current state of the processor.
+	Stats() SimpleSpanProcessorStats
+}
+
 // simpleSpanProcessor is a SpanProcessor that synchronously sends all
 // completed Spans to a trace.Exporter immediately.
 type simpleSpanProcessor struct {
 	exporterMu sync.RWMutex
 	exporter   SpanExporter
 	stopOnce   sync.Once
+
+	o SimpleSpanProcessorOptions
+
+	// queue holds the spans waiting to be exported asynchronously. It is nil
+	// unless o.Async is set.
+	queue   chan ReadOnlySpan
+	dropped uint32
+
+	// inflight tracks the asynchronous exports that have not returned.
+	inflight inflightExports
 }
 
-var _ SpanProcessor = (*simpleSpanProcessor)(nil)
+var (
+	_ SpanProcessor                    = (*simpleSpanProcessor)(nil)
+	_ SimpleSpanProcessorStatsProvider = (*simpleSpanProcessor)(nil)
+)
 
 // NewSimpleSpanProcessor returns a new SpanProcessor that will synchronously
 // send completed spans to the exporter immediately.
@@ -39,9 +109,30 @@ var _ SpanProcessor = (*simpleSpanProcessor)(nil)
 // showing examples of other feature, but it will be slow and have a high
 // computation resource usage overhead. The BatchSpanProcessor is recommended
 // for production use instead.
-func NewSimpleSpanProcessor(exporter SpanExporter) SpanProcessor {
+//
+// By default, the caller ending a span waits for the export of the span to
+// complete, however long that takes. Use WithSimpleExportTimeout,
+// WithSimpleExportRetry, and WithSimpleAsyncExport to change this.
+//
+// The returned SpanProcessor implements SimpleSpanProcessorStatsProvider.
+func NewSimpleSpanProcessor(exporter SpanExporter, options ...SimpleSpanProcessorOption) SpanProcessor {
+	o := SimpleSpanProcessorOptions{
+		MaxQueueSize: DefaultMaxQueueSize,
+	}
+	for _, opt := range options {
+		opt(&o)
+	}
 	ssp := &simpleSpanProcessor{
 		exporter: exporter,
+		o:        o,
+	}
+	if o.Async && exporter != nil {
+		if o.MaxQueueSize <= 0 {
+			o.MaxQueueSize = DefaultMaxQueueSize
+			ssp.o = o
+		}
+		ssp.queue = make(chan ReadOnlySpan, o.MaxQueueSize)
+		go ssp.processQueue(exporter)
 	}
 	return ssp
 }
@@ -55,9 +146,50 @@ func (ssp *simpleSpanProcessor) OnEnd(s ReadOnlySpan) {
 	defer ssp.exporterMu.RUnlock()
 
 	if ssp.exporter != nil && s.SpanContext().TraceFlags().IsSampled() {
-		if err := ssp.exporter.ExportSpans(context.Background(), []ReadOnlySpan{s}); err != nil {
+		if ssp.queue == nil {
+			ssp.export(ssp.exporter, s)
+			return
+		}
+
+		// Register the export while the read lock is held. Shutdown zeroes
+		// the exporter with the write lock held before it closes the queue
+		// and waits for inflight exports, this ensures it does not miss any.
+		ssp.inflight.add()
+		select {
+		case ssp.queue <- s:
+		default:
+			ssp.inflight.done()
+			atomic.AddUint32(&ssp.dropped, 1)
+		}
+	}
+}
+
+// processQueue exports the queued spans with exp, one at a time, until the
+// queue is closed.
+func (ssp *simpleSpanProcessor) processQueue(exp SpanExporter) {
+	for s := range ssp.queue {
+		ssp.export(exp, s)
+		ssp.inflight.done()
+	}
+}
+
+// export exports s with exp, retrying failed attempts as configured.
+func (ssp *simpleSpanProcessor) export(exp SpanExporter, s ReadOnlySpan) {
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := context.Background(), func() {}
+		if ssp.o.ExportTimeout > 0 {
+			ctx, cancel = context.WithTimeout(ctx, ssp.o.ExportTimeout)
+		}
+		err := exp.ExportSpans(ctx, []ReadOnlySpan{s})
+		cancel()
+		if err == nil {
+			return
+		}
+		if attempt >= ssp.o.MaxRetries {
 			otel.Handle(err)
+			return
 		}
+		time.Sleep(ssp.o.RetryInterval)
 	}
 }
 
@@ -67,7 +199,15 @@ func (ssp *simpleSpanProcessor) Shutdown(ctx context.Context) error {
 	ssp.stopOnce.Do(func() {
 		stopFunc := func(exp SpanExporter) (<-chan error, func()) {
 			done := make(chan error)
-			return done, func() { done <- exp.Shutdown(ctx) }
+			return done, func() {
+				// Let asynchronous exports complete before the exporter is
+				// shut down.
+				if err := ssp.inflight.wait(ctx); err != nil {
+					done <- err
+					return
+				}
+				done <- exp.Shutdown(ctx)
+			}
 		}
 
 		// The exporter field of the simpleSpanProcessor needs to be zeroed to
@@ -84,6 +224,9 @@ func (ssp *simpleSpanProcessor) Shutdown(ctx context.Context) error {
 		ssp.exporterMu.Lock()
 		done, shutdown := stopFunc(ssp.exporter)
 		ssp.exporter = nil
+		if ssp.queue != nil {
+			close(ssp.queue)
+		}
 		ssp.exporterMu.Unlock()
 
 		go shutdown()
@@ -111,9 +254,27 @@ func (ssp *simpleSpanProcessor) Shutdown(ctx context.Context) error {
 	return err
 }
 
-// ForceFlush does nothing as there is no data to flush.
-func (ssp *simpleSpanProcessor) ForceFlush(context.Context) error {
-	return nil
+// ForceFlush waits for any asynchronous exports to complete. If the exporter
+// has a ForceFlush method, it is then called to flush any exports the
+// exporter itself sends in the background.
+func (ssp *simpleSpanProcessor) ForceFlush(ctx context.Context) error {
+	if err := ssp.inflight.wait(ctx); err != nil {
+		return err
+	}
+
+	ssp.exporterMu.RLock()
+	exp := ssp.exporter
+	ssp.exporterMu.RUnlock()
+	return flushExporter(ctx, exp)
+}
+
+// Stats returns a snapshot of the current state of the SimpleSpanProcessor.
+func (ssp *simpleSpanProcessor) Stats() SimpleSpanProcessorStats {
+	return SimpleSpanProcessorStats{
+		QueueLength:   len(ssp.queue),
+		QueueCapacity: cap(ssp.queue),
+		DroppedSpans:  atomic.LoadUint32(&ssp.dropped),
+	}
 }
 
 // MarshalLog is the marshaling function used by the logging system to represent this Span Processor.
@@ -121,8 +282,101 @@ func (ssp *simpleSpanProcessor) MarshalLog() interface{} {
 	return struct {
 		Type     string
 		Exporter SpanExporter
+		Config   SimpleSpanProcessorOptions
 	}{
 		Type:     "SimpleSpanProcessor",
 		Exporter: ssp.exporter,
+		Config:   ssp.o,
+	}
+}
+
+// WithSimpleExportTimeout returns a SimpleSpanProcessorOption that configures
+// the amount of time a SimpleSpanProcessor waits for each attempt of an
+// exporter to export a span before abandoning the attempt.
+func WithSimpleExportTimeout(timeout time.Duration) SimpleSpanProcessorOption {
+	return func(o *SimpleSpanProcessorOptions) {
+		o.ExportTimeout = timeout
+	}
+}
+
+// WithSimpleExportRetry returns a SimpleSpanProcessorOption that configures a
+// SimpleSpanProcessor to retry the export of a span up to maxRetries times,
+// waiting interval between attempts, when the exporter returns an error.
+//
+// Unless WithSimpleAsyncExport is also used, the caller ending a span waits
+// for all export attempts.
+func WithSimpleExportRetry(maxRetries int, interval time.Duration) SimpleSpanProcessorOption {
+	return func(o *SimpleSpanProcessorOptions) {
+		o.MaxRetries = maxRetries
+		o.RetryInterval = interval
+	}
+}
+
+// WithSimpleAsyncExport returns a SimpleSpanProcessorOption that configures a
+// SimpleSpanProcessor to queue spans for export by a single background
+// goroutine so ending a span does not wait for the export. ForceFlush and
+// Shutdown wait for the queued spans to be exported.
+//
+// Spans are still exported one at a time. Spans ending while the queue is
+// full are dropped and counted in the DroppedSpans of the processor Stats.
+// Use WithSimpleMaxQueueSize to size the queue and WithSimpleExportTimeout to
+// bound how long each export can hold it up.
+func WithSimpleAsyncExport() SimpleSpanProcessorOption {
+	return func(o *SimpleSpanProcessorOptions) {
+		o.Async = true
+	}
+}
+
+// WithSimpleMaxQueueSize returns a SimpleSpanProcessorOption that configures
+// the maximum number of spans a SimpleSpanProcessor queues for asynchronous
+// export. It has no effect unless WithSimpleAsyncExport is also used.
+func WithSimpleMaxQueueSize(size int) SimpleSpanProcessorOption {
+	return func(o *SimpleSpanProcessorOptions) {
+		o.MaxQueueSize = size
+	}
+}
+
+// inflightExports counts the exports in progress. The zero value is ready to
+// use.
+type inflightExports struct {
+	mu sync.Mutex
+	n  int
+	// idle is closed when n returns to zero.
+	idle chan struct{}
+}
+
+func (e *inflightExports) add() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.n == 0 {
+		e.idle = make(chan struct{})
+	}
+	e.n++
+}
+
+func (e *inflightExports) done() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.n--
+	if e.n == 0 {
+		close(e.idle)
+	}
+}
+
+// wait waits for all exports in progress to complete or ctx to be done.
+func (e *inflightExports) wait(ctx context.Context) error {
+	e.mu.Lock()
+	if e.n == 0 {
+		e.mu.Unlock()
+		return nil
+	}
+	idle := e.idle
+	e.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
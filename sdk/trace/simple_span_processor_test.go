@@ -17,9 +17,13 @@ package trace_test
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -170,3 +174,191 @@ func TestSimpleSpanProcessorShutdownHonorsContextCancel(t *testing.T) {
 		t.Errorf("SimpleSpanProcessor.Shutdown did not return %v, got %v", want, got)
 	}
 }
+
+// flakyExporter fails the first failures exports and records the context
+// deadline of every export.
+type flakyExporter struct {
+	testExporter
+
+	mu        sync.Mutex
+	failures  int
+	attempts  int
+	deadlines []bool
+	block     chan struct{}
+}
+
+func (e *flakyExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if e.block != nil {
+		select {
+		case <-e.block:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.attempts++
+	_, ok := ctx.Deadline()
+	e.deadlines = append(e.deadlines, ok)
+	if e.attempts <= e.failures {
+		return errors.New("export failed")
+	}
+	return e.testExporter.ExportSpans(ctx, spans)
+}
+
+func (e *flakyExporter) exported() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return len(e.spans)
+}
+
+func TestSimpleSpanProcessorExportTimeout(t *testing.T) {
+	exporter := &flakyExporter{block: make(chan struct{})}
+	ssp := sdktrace.NewSimpleSpanProcessor(
+		exporter,
+		sdktrace.WithSimpleExportTimeout(time.Millisecond),
+	)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(ssp)
+
+	// The exporter never returns without a timeout.
+	startSpan(tp).End()
+	assert.Equal(t, 0, exporter.exported())
+}
+
+func TestSimpleSpanProcessorExportRetry(t *testing.T) {
+	exporter := &flakyExporter{failures: 2}
+	ssp := sdktrace.NewSimpleSpanProcessor(
+		exporter,
+		sdktrace.WithSimpleExportTimeout(time.Second),
+		sdktrace.WithSimpleExportRetry(2, time.Millisecond),
+	)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(ssp)
+
+	startSpan(tp).End()
+	assert.Equal(t, 3, exporter.attempts)
+	assert.Equal(t, []bool{true, true, true}, exporter.deadlines)
+	assert.Equal(t, 1, exporter.exported())
+
+	// Retries are bounded.
+	exporter.attempts, exporter.failures = 0, 5
+	startSpan(tp).End()
+	assert.Equal(t, 3, exporter.attempts)
+	assert.Equal(t, 1, exporter.exported())
+}
+
+func TestSimpleSpanProcessorAsyncExport(t *testing.T) {
+	exporter := &flakyExporter{block: make(chan struct{})}
+	ssp := sdktrace.NewSimpleSpanProcessor(exporter, sdktrace.WithSimpleAsyncExport())
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(ssp)
+
+	// Ending the span does not wait for the blocked exporter.
+	startSpan(tp).End()
+	startSpan(tp).End()
+	assert.Equal(t, 0, exporter.exported())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, ssp.ForceFlush(ctx), context.DeadlineExceeded)
+
+	close(exporter.block)
+	require.NoError(t, ssp.ForceFlush(context.Background()))
+	assert.Equal(t, 2, exporter.exported())
+
+	startSpan(tp).End()
+	require.NoError(t, ssp.Shutdown(context.Background()))
+	assert.Equal(t, 3, exporter.exported())
+	assert.True(t, exporter.shutdown)
+}
+
+// serialExporter is a SpanExporter that blocks exports until release is
+// closed and records the most exports it was called with at once.
+type serialExporter struct {
+	testExporter
+
+	started chan struct{}
+	release chan struct{}
+
+	mu        sync.Mutex
+	active    int
+	maxActive int
+}
+
+func (e *serialExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.mu.Lock()
+	e.active++
+	if e.active > e.maxActive {
+		e.maxActive = e.active
+	}
+	e.mu.Unlock()
+
+	e.started <- struct{}{}
+	<-e.release
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.active--
+	return e.testExporter.ExportSpans(ctx, spans)
+}
+
+func TestSimpleSpanProcessorAsyncQueue(t *testing.T) {
+	exporter := &serialExporter{
+		started: make(chan struct{}, 3),
+		release: make(chan struct{}),
+	}
+	ssp := sdktrace.NewSimpleSpanProcessor(
+		exporter,
+		sdktrace.WithSimpleAsyncExport(),
+		sdktrace.WithSimpleMaxQueueSize(1),
+	)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(ssp)
+
+	startSpan(tp).End()
+	<-exporter.started
+	// The first span is being exported, the second is queued, and the third
+	// is dropped.
+	startSpan(tp).End()
+	startSpan(tp).End()
+
+	require.Implements(t, (*sdktrace.SimpleSpanProcessorStatsProvider)(nil), ssp)
+	stats := ssp.(sdktrace.SimpleSpanProcessorStatsProvider).Stats()
+	assert.Equal(t, sdktrace.SimpleSpanProcessorStats{
+		QueueLength:   1,
+		QueueCapacity: 1,
+		DroppedSpans:  1,
+	}, stats)
+
+	close(exporter.release)
+	require.NoError(t, ssp.Shutdown(context.Background()))
+	assert.Len(t, exporter.spans, 2)
+	assert.Equal(t, 1, exporter.maxActive, "concurrent exports")
+}
+
+// flushingExporter is a SpanExporter with a ForceFlush method.
+type flushingExporter struct {
+	testExporter
+
+	flushed int
+	err     error
+}
+
+func (e *flushingExporter) ForceFlush(context.Context) error {
+	e.flushed++
+	return e.err
+}
+
+func TestSimpleSpanProcessorForceFlushExporter(t *testing.T) {
+	exporter := &flushingExporter{err: errors.New("export failed")}
+	ssp := sdktrace.NewSimpleSpanProcessor(exporter)
+
+	assert.ErrorIs(t, ssp.ForceFlush(context.Background()), exporter.err)
+	assert.Equal(t, 1, exporter.flushed)
+
+	require.NoError(t, ssp.Shutdown(context.Background()))
+	assert.NoError(t, ssp.ForceFlush(context.Background()), "flushed after shutdown")
+	assert.Equal(t, 1, exporter.flushed)
+}
@@ -28,7 +28,6 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
-	"go.opentelemetry.io/otel/sdk/internal"
 	"go.opentelemetry.io/otel/sdk/resource"
 	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
 	"go.opentelemetry.io/otel/trace"
@@ -127,6 +126,11 @@ type recordingSpan struct {
 	// value of time.Time until the span is ended.
 	endTime time.Time
 
+	// endingTime is the time this span is ending at while OnEnding is called
+	// for the registered span processors. It contains the zero value of
+	// time.Time until the span starts to end.
+	endingTime time.Time
+
 	// status is the status of this span.
 	status Status
 
@@ -148,6 +152,10 @@ type recordingSpan struct {
 	// events are stored in FIFO queue capped by configured limit.
 	events evictedQueue
 
+	// coalesced indexes the events held in events by their name and
+	// attributes when events are coalesced. It is nil otherwise.
+	coalesced map[eventKey]*coalescedEvent
+
 	// links are stored in FIFO queue capped by configured limit.
 	links evictedQueue
 
@@ -221,7 +229,7 @@ func (s *recordingSpan) SetAttributes(attributes ...attribute.KeyValue) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	limit := s.tracer.provider.spanLimits.AttributeCountLimit
+	limit := s.tracer.spanLimits.AttributeCountLimit
 	if limit == 0 {
 		// No attributes allowed.
 		s.droppedAttributes += len(attributes)
@@ -243,7 +251,7 @@ func (s *recordingSpan) SetAttributes(attributes ...attribute.KeyValue) {
 			s.droppedAttributes++
 			continue
 		}
-		a = truncateAttr(s.tracer.provider.spanLimits.AttributeValueLengthLimit, a)
+		a = s.truncateAttr(a)
 		s.attributes = append(s.attributes, a)
 	}
 }
@@ -281,7 +289,7 @@ func (s *recordingSpan) addOverCapAttrs(limit int, attrs []attribute.KeyValue) {
 
 		if idx, ok := exists[a.Key]; ok {
 			// Perform all updates before dropping, even when at capacity.
-			s.attributes[idx] = a
+			s.attributes[idx] = s.truncateAttr(a)
 			continue
 		}
 
@@ -290,13 +298,26 @@ func (s *recordingSpan) addOverCapAttrs(limit int, attrs []attribute.KeyValue) {
 			// updates are checked and performed.
 			s.droppedAttributes++
 		} else {
-			a = truncateAttr(s.tracer.provider.spanLimits.AttributeValueLengthLimit, a)
+			a = s.truncateAttr(a)
 			s.attributes = append(s.attributes, a)
 			exists[a.Key] = len(s.attributes) - 1
 		}
 	}
 }
 
+// truncateAttr returns attr truncated to the attribute value length limit
+// configured for its key, or to the AttributeValueLengthLimit of the span if
+// none is configured.
+func (s *recordingSpan) truncateAttr(attr attribute.KeyValue) attribute.KeyValue {
+	limit := s.tracer.spanLimits.AttributeValueLengthLimit
+	if keyLimits := s.tracer.provider.attrValueLengthLimits; len(keyLimits) > 0 {
+		if l, ok := keyLimits[attr.Key]; ok {
+			limit = l
+		}
+	}
+	return truncateAttr(limit, attr)
+}
+
 // truncateAttr returns a truncated version of attr. Only string and string
 // slice attribute values are truncated. String values are truncated to at
 // most a length of limit. Each string slice value is truncated in this fashion
@@ -369,7 +390,9 @@ func (s *recordingSpan) End(options ...trace.SpanEndOption) {
 
 	// Store the end time as soon as possible to avoid artificially increasing
 	// the span's duration in case some operation below takes a while.
-	et := internal.MonotonicEndTime(s.startTime)
+	// Offset the end time from the start time with the measured duration so
+	// the default clock uses the monotonic clock.
+	et := s.startTime.Add(s.tracer.provider.clock.Since(s.startTime))
 
 	// Do relative expensive check now that we have an end time and see if we
 	// need to do any more processing.
@@ -401,16 +424,21 @@ func (s *recordingSpan) End(options ...trace.SpanEndOption) {
 		s.executionTracerTaskEnd()
 	}
 
+	if !config.Timestamp().IsZero() {
+		et = config.Timestamp()
+	}
+
+	sps := s.tracer.provider.spanProcessors.Load().(spanProcessorStates)
+	if !s.ending(et, sps) {
+		// End was called from an OnEnding call, the span is already ending.
+		return
+	}
+
 	s.mu.Lock()
 	// Setting endTime to non-zero marks the span as ended and not recording.
-	if config.Timestamp().IsZero() {
-		s.endTime = et
-	} else {
-		s.endTime = config.Timestamp()
-	}
+	s.endTime = et
 	s.mu.Unlock()
 
-	sps := s.tracer.provider.spanProcessors.Load().(spanProcessorStates)
 	if len(sps) == 0 {
 		return
 	}
@@ -420,6 +448,26 @@ func (s *recordingSpan) End(options ...trace.SpanEndOption) {
 	}
 }
 
+// ending calls OnEnding for all sps that implement OnEndingSpanProcessor
+// while the span is still recording and reports true. If the span is already
+// ending, nothing is called and false is returned.
+func (s *recordingSpan) ending(et time.Time, sps spanProcessorStates) bool {
+	s.mu.Lock()
+	if !s.endingTime.IsZero() {
+		s.mu.Unlock()
+		return false
+	}
+	s.endingTime = et
+	s.mu.Unlock()
+
+	for _, sp := range sps {
+		if sp.onEnding != nil {
+			sp.onEnding.OnEnding(s)
+		}
+	}
+	return true
+}
+
 // RecordError will record err as a span event for this span. An additional call to
 // SetStatus is required if the Status of the Span should be set to Error, this method
 // does not change the Span status. If this span is not being recorded or err is nil
@@ -470,24 +518,130 @@ func (s *recordingSpan) AddEvent(name string, o ...trace.EventOption) {
 }
 
 func (s *recordingSpan) addEvent(name string, o ...trace.EventOption) {
+	if _, ok := s.tracer.provider.clock.(defaultClock); !ok {
+		// Default to the time of the configured clock. Any timestamp option
+		// in o is applied after and takes precedence.
+		o = append([]trace.EventOption{trace.WithTimestamp(s.tracer.provider.clock.Now())}, o...)
+	}
 	c := trace.NewEventConfig(o...)
 	e := Event{Name: name, Attributes: c.Attributes(), Time: c.Timestamp()}
 
-	// Discard attributes over limit.
-	limit := s.tracer.provider.spanLimits.AttributePerEventCountLimit
-	if limit == 0 {
+	if s.tracer.provider.coalesceEvents {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.addCoalescedEvent(e)
+		return
+	}
+
+	e.Attributes, e.DroppedAttributeCount = s.limitEventAttributes(e.Attributes, false)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events.add(e)
+}
+
+// limitEventAttributes returns attrs truncated to the
+// AttributePerEventCountLimit, and the number of attributes dropped. If
+// keepLast is true, the last attribute is kept and attributes before it are
+// dropped instead.
+func (s *recordingSpan) limitEventAttributes(attrs []attribute.KeyValue, keepLast bool) ([]attribute.KeyValue, int) {
+	limit := s.tracer.spanLimits.AttributePerEventCountLimit
+	switch {
+	case limit == 0:
 		// Drop all attributes.
-		e.DroppedAttributeCount = len(e.Attributes)
-		e.Attributes = nil
-	} else if limit > 0 && len(e.Attributes) > limit {
+		return nil, len(attrs)
+	case limit < 0 || len(attrs) <= limit:
+		return attrs, 0
+	case keepLast:
+		dropped := len(attrs) - limit
+		limited := make([]attribute.KeyValue, 0, limit)
+		limited = append(limited, attrs[:limit-1]...)
+		return append(limited, attrs[len(attrs)-1]), dropped
+	default:
 		// Drop over capacity.
-		e.DroppedAttributeCount = len(e.Attributes) - limit
-		e.Attributes = e.Attributes[:limit]
+		return attrs[:limit], len(attrs) - limit
 	}
+}
 
-	s.mu.Lock()
+// eventKey identifies events that are coalesced.
+type eventKey struct {
+	name  string
+	attrs attribute.Distinct
+}
+
+// coalescedEvent is an event held by a span that coalesces events.
+type coalescedEvent struct {
+	// seq is the number of events added to the span before this one. The
+	// event is at index seq minus the number of evicted events of the
+	// events queue.
+	seq int
+	// attrs are the attributes of the event, without EventCountKey, before
+	// the AttributePerEventCountLimit is applied.
+	attrs []attribute.KeyValue
+	// dropped is the number of EventCountKey attributes dropped from attrs.
+	dropped int
+	// count is the number of times the event was added.
+	count int64
+}
+
+// addCoalescedEvent adds e to the events of s, or, if s holds an event with
+// the same name and attributes, increments the EventCountKey attribute of
+// that event instead. The EventCountKey attribute is reserved, any attribute
+// with that key passed with e is dropped.
+//
+// The caller must hold s.mu.
+func (s *recordingSpan) addCoalescedEvent(e Event) {
+	attrs, dropped := e.Attributes, 0
+	for i, a := range attrs {
+		if a.Key != EventCountKey {
+			continue
+		}
+		// Copy on first reserved attribute, attrs is owned by the caller.
+		filtered := make([]attribute.KeyValue, i, len(attrs)-1)
+		copy(filtered, attrs[:i])
+		for _, a := range attrs[i:] {
+			if a.Key == EventCountKey {
+				dropped++
+				continue
+			}
+			filtered = append(filtered, a)
+		}
+		attrs = filtered
+		break
+	}
+
+	set := attribute.NewSet(attrs...)
+	key := eventKey{name: e.Name, attrs: set.Equivalent()}
+	if held, ok := s.coalesced[key]; ok {
+		if i := held.seq - s.events.droppedCount; i >= 0 {
+			held.count++
+			counted := make([]attribute.KeyValue, len(held.attrs), len(held.attrs)+1)
+			copy(counted, held.attrs)
+			counted = append(counted, EventCountKey.Int64(held.count))
+
+			ev := s.events.queue[i].(Event)
+			// The count is kept if the limit is reached, it is the reason the
+			// other occurrences of the event are not held.
+			ev.Attributes, ev.DroppedAttributeCount = s.limitEventAttributes(counted, true)
+			ev.DroppedAttributeCount += held.dropped
+			s.events.queue[i] = ev
+			return
+		}
+		// The held event was evicted, hold e in its place.
+	}
+
+	if s.coalesced == nil {
+		s.coalesced = make(map[eventKey]*coalescedEvent)
+	}
+	s.coalesced[key] = &coalescedEvent{
+		seq:     len(s.events.queue) + s.events.droppedCount,
+		attrs:   attrs,
+		dropped: dropped,
+		count:   1,
+	}
+	e.Attributes, e.DroppedAttributeCount = s.limitEventAttributes(attrs, false)
+	e.DroppedAttributeCount += dropped
 	s.events.add(e)
-	s.mu.Unlock()
 }
 
 // SetName sets the name of this span. If this span is not being recorded than
@@ -535,6 +689,10 @@ func (s *recordingSpan) StartTime() time.Time {
 func (s *recordingSpan) EndTime() time.Time {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	if s.endTime.IsZero() {
+		// Zero unless the span is ending.
+		return s.endingTime
+	}
 	return s.endTime
 }
 
@@ -631,6 +789,24 @@ func (s *recordingSpan) Resource() *resource.Resource {
 	return s.tracer.provider.resource
 }
 
+// AddLink adds link to the span. The link is not added if the span is not
+// being recorded or the SpanContext of link is invalid. The span limits for
+// links and their attributes are applied.
+//
+// AddLink is not part of the trace.Span interface. Callers need to check if
+// a span supports it:
+//
+//	if s, ok := span.(interface{ AddLink(trace.Link) }); ok {
+//		s.AddLink(link)
+//	}
+//
+// Adding links with the trace.WithLinks option when the span is started is
+// preferred if the linked SpanContext is known then, because samplers can
+// only consider links present when the span is started.
+func (s *recordingSpan) AddLink(link trace.Link) {
+	s.addLink(link)
+}
+
 func (s *recordingSpan) addLink(link trace.Link) {
 	if !s.IsRecording() || !link.SpanContext.IsValid() {
 		return
@@ -639,7 +815,7 @@ func (s *recordingSpan) addLink(link trace.Link) {
 	l := Link{SpanContext: link.SpanContext, Attributes: link.Attributes}
 
 	// Discard attributes over limit.
-	limit := s.tracer.provider.spanLimits.AttributePerLinkCountLimit
+	limit := s.tracer.spanLimits.AttributePerLinkCountLimit
 	if limit == 0 {
 		// Drop all attributes.
 		l.DroppedAttributeCount = len(l.Attributes)
@@ -803,6 +979,9 @@ func (nonRecordingSpan) RecordError(error, ...trace.EventOption) {}
 // AddEvent does nothing.
 func (nonRecordingSpan) AddEvent(string, ...trace.EventOption) {}
 
+// AddLink does nothing.
+func (nonRecordingSpan) AddLink(trace.Link) {}
+
 // SetName does nothing.
 func (nonRecordingSpan) SetName(string) {}
 
@@ -18,6 +18,10 @@ import "context"
 
 // SpanExporter handles the delivery of spans to external receivers. This is
 // the final component in the trace export pipeline.
+//
+// A SpanExporter that sends exports in the background may also have a
+// ForceFlush(context.Context) error method. The SpanProcessors of this package
+// call it when they are flushed to wait for those exports to complete.
 type SpanExporter interface {
 	// DO NOT CHANGE: any modification will not be backwards compatible and
 	// must never be done outside of a new major release.
@@ -45,3 +49,14 @@ type SpanExporter interface {
 	// DO NOT CHANGE: any modification will not be backwards compatible and
 	// must never be done outside of a new major release.
 }
+
+// flushExporter calls the ForceFlush method of exp, if it has one. Exporters
+// that send exports in the background implement this method so a
+// SpanProcessor can wait for those exports. SpanExporters wrapping another
+// forward it to the wrapped SpanExporter.
+func flushExporter(ctx context.Context, exp SpanExporter) error {
+	if f, ok := exp.(interface{ ForceFlush(context.Context) error }); ok {
+		return f.ForceFlush(ctx)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import "context"
+
+// SpanFilter decides if and how a span is exported.
+//
+// If s is to be exported, the span to export and true are returned. The
+// returned span can be s itself or a ReadOnlySpan that embeds s and overrides
+// some of its methods to modify what is exported. If s is to be dropped,
+// false is returned.
+//
+// A SpanFilter is called synchronously by the exporter, it should not block.
+type SpanFilter func(s ReadOnlySpan) (ReadOnlySpan, bool)
+
+// filteringSpanExporter is a SpanExporter that passes spans through a
+// SpanFilter before exporting them with another SpanExporter.
+type filteringSpanExporter struct {
+	exporter SpanExporter
+	filter   SpanFilter
+}
+
+var _ SpanExporter = (*filteringSpanExporter)(nil)
+
+// NewFilteringSpanExporter returns a SpanExporter that passes every span
+// through filter before exporting it with exporter. Spans filter drops are
+// not exported and, if filter drops all spans in a batch, exporter is not
+// called. If filter is nil, all spans are exported unmodified.
+//
+// This can be used to drop or modify spans without reimplementing a
+// SpanExporter. For example, to drop successful database spans that took
+// less than a millisecond:
+//
+//	exp = NewFilteringSpanExporter(exp, func(s ReadOnlySpan) (ReadOnlySpan, bool) {
+//		fast := s.EndTime().Sub(s.StartTime()) < time.Millisecond
+//		isDB := s.SpanKind() == trace.SpanKindClient && strings.HasPrefix(s.Name(), "db.")
+//		if fast && isDB && s.Status().Code != codes.Error {
+//			return nil, false
+//		}
+//		return s, true
+//	})
+func NewFilteringSpanExporter(exporter SpanExporter, filter SpanFilter) SpanExporter {
+	return &filteringSpanExporter{exporter: exporter, filter: filter}
+}
+
+// ExportSpans exports the spans the filter keeps with the wrapped
+// SpanExporter.
+func (e *filteringSpanExporter) ExportSpans(ctx context.Context, spans []ReadOnlySpan) error {
+	if e.filter == nil {
+		return e.exporter.ExportSpans(ctx, spans)
+	}
+
+	// Do not modify spans in place, the caller owns it.
+	filtered := make([]ReadOnlySpan, 0, len(spans))
+	for _, s := range spans {
+		if out, ok := e.filter(s); ok && out != nil {
+			filtered = append(filtered, out)
+		}
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+	return e.exporter.ExportSpans(ctx, filtered)
+}
+
+// Shutdown shuts down the wrapped SpanExporter.
+func (e *filteringSpanExporter) Shutdown(ctx context.Context) error {
+	return e.exporter.Shutdown(ctx)
+}
+
+// ForceFlush flushes the wrapped SpanExporter if it has a ForceFlush method.
+func (e *filteringSpanExporter) ForceFlush(ctx context.Context) error {
+	return flushExporter(ctx, e.exporter)
+}
+
+// MarshalLog is the marshaling function used by the logging system to
+// represent this exporter.
+func (e *filteringSpanExporter) MarshalLog() interface{} {
+	return struct {
+		Type         string
+		SpanExporter SpanExporter
+	}{
+		Type:         "FilteringSpanExporter",
+		SpanExporter: e.exporter,
+	}
+}
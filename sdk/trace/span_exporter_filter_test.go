@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// renamedSpan overrides the name of the span it wraps.
+type renamedSpan struct {
+	sdktrace.ReadOnlySpan
+	name string
+}
+
+func (s renamedSpan) Name() string { return s.name }
+
+func TestFilteringSpanExporter(t *testing.T) {
+	spans := tracetest.SpanStubs{
+		{Name: "keep"},
+		{Name: "drop"},
+		{Name: "rename", Attributes: []attribute.KeyValue{attribute.Bool("rename", true)}},
+	}.Snapshots()
+	original := make([]sdktrace.ReadOnlySpan, len(spans))
+	copy(original, spans)
+
+	exp := tracetest.NewInMemoryExporter()
+	filtered := sdktrace.NewFilteringSpanExporter(exp, func(s sdktrace.ReadOnlySpan) (sdktrace.ReadOnlySpan, bool) {
+		switch s.Name() {
+		case "drop":
+			return nil, false
+		case "rename":
+			return renamedSpan{ReadOnlySpan: s, name: "renamed"}, true
+		}
+		return s, true
+	})
+
+	require.NoError(t, filtered.ExportSpans(context.Background(), spans))
+	got := exp.GetSpans()
+	require.Len(t, got, 2)
+	assert.Equal(t, "keep", got[0].Name)
+	assert.Equal(t, "renamed", got[1].Name)
+	assert.Equal(t, []attribute.KeyValue{attribute.Bool("rename", true)}, got[1].Attributes)
+	assert.Equal(t, original, spans, "exported spans modified")
+
+	require.NoError(t, filtered.Shutdown(context.Background()))
+	assert.Empty(t, exp.GetSpans(), "Shutdown not passed to wrapped exporter")
+}
+
+type countingExporter struct {
+	calls int
+}
+
+func (e *countingExporter) ExportSpans(context.Context, []sdktrace.ReadOnlySpan) error {
+	e.calls++
+	return nil
+}
+
+func (e *countingExporter) Shutdown(context.Context) error { return nil }
+
+func TestFilteringSpanExporterAllDropped(t *testing.T) {
+	exp := &countingExporter{}
+	filtered := sdktrace.NewFilteringSpanExporter(exp, func(sdktrace.ReadOnlySpan) (sdktrace.ReadOnlySpan, bool) {
+		return nil, false
+	})
+
+	spans := tracetest.SpanStubs{{Name: "span"}}.Snapshots()
+	require.NoError(t, filtered.ExportSpans(context.Background(), spans))
+	assert.Equal(t, 0, exp.calls, "exporter called with empty batch")
+}
+
+func TestFilteringSpanExporterNilFilter(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	filtered := sdktrace.NewFilteringSpanExporter(exp, nil)
+
+	spans := tracetest.SpanStubs{{Name: "a"}, {Name: "b"}}.Snapshots()
+	require.NoError(t, filtered.ExportSpans(context.Background(), spans))
+	assert.Len(t, exp.GetSpans(), 2)
+}
+
+func TestFilteringSpanExporterForceFlush(t *testing.T) {
+	exp := &flushingExporter{err: errors.New("export failed")}
+	ssp := sdktrace.NewSimpleSpanProcessor(sdktrace.NewFilteringSpanExporter(exp, nil))
+
+	assert.ErrorIs(t, ssp.ForceFlush(context.Background()), exp.err)
+	assert.Equal(t, 1, exp.flushed)
+	require.NoError(t, ssp.Shutdown(context.Background()))
+}
@@ -0,0 +1,151 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// MultiSpanExporterError is the error returned by a SpanExporter from
+// NewMultiSpanExporter when one or more of the SpanExporters it fans out to
+// fail.
+type MultiSpanExporterError struct {
+	// Errors are the errors returned by the SpanExporters. They are in the
+	// same order the non-nil SpanExporters were passed to
+	// NewMultiSpanExporter, with a nil value for each SpanExporter that
+	// succeeded.
+	Errors []error
+}
+
+// Error returns the errors of all failed SpanExporters.
+func (e *MultiSpanExporterError) Error() string {
+	var (
+		b      strings.Builder
+		failed int
+	)
+	for i, err := range e.Errors {
+		if err == nil {
+			continue
+		}
+		if failed > 0 {
+			b.WriteString("; ")
+		}
+		failed++
+		fmt.Fprintf(&b, "exporter %d: %v", i, err)
+	}
+	return fmt.Sprintf("%d of %d span exporters failed: %s", failed, len(e.Errors), b.String())
+}
+
+// Is returns true if any of the errors of e matches target.
+func (e *MultiSpanExporterError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if err != nil && errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// multiSpanExporter is a SpanExporter that fans out to several SpanExporters.
+type multiSpanExporter struct {
+	exporters []SpanExporter
+}
+
+var _ SpanExporter = (*multiSpanExporter)(nil)
+
+// NewMultiSpanExporter returns a SpanExporter that exports every batch of
+// spans it is passed with each of exporters concurrently. This allows a
+// single BatchSpanProcessor, and its queue, to be used for several
+// destinations.
+//
+// The exporters are isolated from each other. A failing, or slow,
+// SpanExporter does not prevent the others from exporting, though each
+// export returns only once all exporters have returned. If any exporter
+// fails a *MultiSpanExporterError is returned describing the errors of each
+// exporter. The spans passed to each exporter are shared and must not be
+// modified.
+func NewMultiSpanExporter(exporters ...SpanExporter) SpanExporter {
+	exp := &multiSpanExporter{exporters: make([]SpanExporter, 0, len(exporters))}
+	for _, e := range exporters {
+		if e != nil {
+			exp.exporters = append(exp.exporters, e)
+		}
+	}
+	return exp
+}
+
+// ExportSpans exports spans with all exporters concurrently.
+func (e *multiSpanExporter) ExportSpans(ctx context.Context, spans []ReadOnlySpan) error {
+	return e.fanOut(func(exp SpanExporter) error {
+		// Each exporter gets its own slice so reordering or truncating it
+		// does not affect the others.
+		s := make([]ReadOnlySpan, len(spans))
+		copy(s, spans)
+		return exp.ExportSpans(ctx, s)
+	})
+}
+
+// Shutdown shuts down all exporters concurrently.
+func (e *multiSpanExporter) Shutdown(ctx context.Context) error {
+	return e.fanOut(func(exp SpanExporter) error {
+		return exp.Shutdown(ctx)
+	})
+}
+
+// ForceFlush flushes all exporters that have a ForceFlush method
+// concurrently.
+func (e *multiSpanExporter) ForceFlush(ctx context.Context) error {
+	return e.fanOut(func(exp SpanExporter) error {
+		return flushExporter(ctx, exp)
+	})
+}
+
+// fanOut calls f for all exporters concurrently and returns a
+// *MultiSpanExporterError if any call fails.
+func (e *multiSpanExporter) fanOut(f func(SpanExporter) error) error {
+	errs := make([]error, len(e.exporters))
+	var wg sync.WaitGroup
+	wg.Add(len(e.exporters))
+	for i, exp := range e.exporters {
+		go func(i int, exp SpanExporter) {
+			defer wg.Done()
+			errs[i] = f(exp)
+		}(i, exp)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return &MultiSpanExporterError{Errors: errs}
+		}
+	}
+	return nil
+}
+
+// MarshalLog is the marshaling function used by the logging system to
+// represent this exporter.
+func (e *multiSpanExporter) MarshalLog() interface{} {
+	return struct {
+		Type          string
+		SpanExporters []SpanExporter
+	}{
+		Type:          "MultiSpanExporter",
+		SpanExporters: e.exporters,
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// failingExporter returns err from all its methods.
+type failingExporter struct {
+	err error
+}
+
+func (e failingExporter) ExportSpans(context.Context, []sdktrace.ReadOnlySpan) error { return e.err }
+func (e failingExporter) Shutdown(context.Context) error                             { return e.err }
+
+func TestMultiSpanExporter(t *testing.T) {
+	exp0 := tracetest.NewInMemoryExporter()
+	exp1 := tracetest.NewInMemoryExporter()
+	multi := sdktrace.NewMultiSpanExporter(exp0, nil, exp1)
+
+	spans := tracetest.SpanStubs{{Name: "a"}, {Name: "b"}}.Snapshots()
+	require.NoError(t, multi.ExportSpans(context.Background(), spans))
+	assert.Len(t, exp0.GetSpans(), 2)
+	assert.Len(t, exp1.GetSpans(), 2)
+
+	require.NoError(t, multi.Shutdown(context.Background()))
+	assert.Empty(t, exp0.GetSpans(), "exporter not shut down")
+	assert.Empty(t, exp1.GetSpans(), "exporter not shut down")
+}
+
+func TestMultiSpanExporterErrorIsolation(t *testing.T) {
+	errFail := errors.New("destination down")
+	good := tracetest.NewInMemoryExporter()
+	multi := sdktrace.NewMultiSpanExporter(failingExporter{err: errFail}, good)
+
+	spans := tracetest.SpanStubs{{Name: "a"}}.Snapshots()
+	err := multi.ExportSpans(context.Background(), spans)
+	assert.Len(t, good.GetSpans(), 1, "failing exporter prevented export")
+
+	var multiErr *sdktrace.MultiSpanExporterError
+	require.ErrorAs(t, err, &multiErr)
+	assert.Equal(t, []error{errFail, nil}, multiErr.Errors)
+	assert.ErrorIs(t, err, errFail)
+	assert.EqualError(t, err, "1 of 2 span exporters failed: exporter 0: destination down")
+
+	err = multi.Shutdown(context.Background())
+	assert.ErrorIs(t, err, errFail)
+}
+
+func TestMultiSpanExporterForceFlush(t *testing.T) {
+	exp0 := &flushingExporter{}
+	exp1 := &flushingExporter{err: errors.New("export failed")}
+	ssp := sdktrace.NewSimpleSpanProcessor(sdktrace.NewMultiSpanExporter(
+		exp0, tracetest.NewInMemoryExporter(), exp1,
+	))
+
+	err := ssp.ForceFlush(context.Background())
+	assert.ErrorIs(t, err, exp1.err)
+	var multiErr *sdktrace.MultiSpanExporterError
+	require.ErrorAs(t, err, &multiErr)
+	assert.Equal(t, []error{nil, nil, exp1.err}, multiErr.Errors)
+	assert.Equal(t, 1, exp0.flushed)
+	assert.Equal(t, 1, exp1.flushed)
+	require.NoError(t, ssp.Shutdown(context.Background()))
+}
+
+func TestMultiSpanExporterWithBatchSpanProcessor(t *testing.T) {
+	exp0 := tracetest.NewInMemoryExporter()
+	exp1 := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(sdktrace.NewMultiSpanExporter(exp0, exp1)))
+
+	_, span := tp.Tracer("TestMultiSpanExporterWithBatchSpanProcessor").Start(context.Background(), "span")
+	span.End()
+	require.NoError(t, tp.ForceFlush(context.Background()))
+
+	assert.Len(t, exp0.GetSpans(), 1)
+	assert.Len(t, exp1.GetSpans(), 1)
+	require.NoError(t, tp.Shutdown(context.Background()))
+}
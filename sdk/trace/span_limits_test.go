@@ -285,3 +285,86 @@ func TestSpanLimits(t *testing.T) {
 		}
 	})
 }
+
+func TestScopeSpanLimits(t *testing.T) {
+	global := NewSpanLimits()
+	global.AttributeCountLimit = 1
+	global.EventCountLimit = 1
+	scoped := NewSpanLimits()
+	scoped.AttributeCountLimit = 3
+	scoped.EventCountLimit = 2
+
+	rec := new(recorder)
+	tp := NewTracerProvider(
+		WithRawSpanLimits(global),
+		WithScopeSpanLimits("important", scoped),
+		WithSyncer(noopExporter{}),
+	)
+	tp.RegisterSpanProcessor(rec)
+	t.Cleanup(func() { assert.NoError(t, tp.Shutdown(context.Background())) })
+
+	attrs := []attribute.KeyValue{
+		attribute.Bool("a", true),
+		attribute.Bool("b", true),
+		attribute.Bool("c", true),
+	}
+	newSpan := func(tracer trace.Tracer) ReadOnlySpan {
+		_, span := tracer.Start(context.Background(), "span", trace.WithAttributes(attrs...))
+		span.AddEvent("1")
+		span.AddEvent("2")
+		span.End()
+		return (*rec)[len(*rec)-1]
+	}
+
+	s := newSpan(tp.Tracer("other"))
+	assert.Len(t, s.Attributes(), 1)
+	assert.Len(t, s.Events(), 1)
+
+	s = newSpan(tp.Tracer("important"))
+	assert.Len(t, s.Attributes(), 3)
+	assert.Len(t, s.Events(), 2)
+
+	s = newSpan(tp.Tracer("important", trace.WithInstrumentationVersion("v1.0.0")))
+	assert.Len(t, s.Attributes(), 3, "scope limits not applied for other versions")
+	assert.Len(t, s.Events(), 2, "scope limits not applied for other versions")
+}
+
+func TestAttributeValueLengthLimitPerKey(t *testing.T) {
+	limits := NewSpanLimits()
+	limits.AttributeValueLengthLimit = 2
+	limits.AttributeCountLimit = 4
+
+	rec := new(recorder)
+	tp := NewTracerProvider(
+		WithRawSpanLimits(limits),
+		WithAttributeValueLengthLimit("db.statement", 4),
+		WithAttributeValueLengthLimit("http.url", -1),
+		WithSpanProcessor(rec),
+	)
+
+	_, span := tp.Tracer("TestAttributeValueLengthLimitPerKey").Start(
+		context.Background(),
+		"span",
+		trace.WithAttributes(attribute.String("db.statement", "SELECT *")),
+	)
+	span.SetAttributes(
+		attribute.String("http.url", "http://example.com"),
+		attribute.String("other", "value"),
+		attribute.StringSlice("db.statement.slice", []string{"abc"}),
+	)
+	// Exceed the count limit so updates are applied while deduplicating.
+	span.SetAttributes(
+		attribute.String("db.statement", "UPDATE t"),
+		attribute.String("dropped", "value"),
+	)
+	span.End()
+
+	require.Len(t, *rec, 1)
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String("db.statement", "UPDA"),
+		attribute.String("http.url", "http://example.com"),
+		attribute.String("other", "va"),
+		attribute.StringSlice("db.statement.slice", []string{"ab"}),
+	}, (*rec)[0].Attributes())
+	assert.Equal(t, 1, (*rec)[0].DroppedAttributes())
+}
@@ -60,13 +60,35 @@ type SpanProcessor interface {
 	// must never be done outside of a new major release.
 }
 
+// OnEndingSpanProcessor is a SpanProcessor that is also notified when a span
+// is ending, before it becomes read-only.
+//
+// SpanProcessors registered with a TracerProvider that implement this
+// interface have OnEnding called for every ending span. All OnEnding calls for
+// a span are made, in the order the processors are registered, before any
+// OnEnd call for that span.
+type OnEndingSpanProcessor interface {
+	SpanProcessor
+
+	// OnEnding is called when a span is ending. It is called synchronously
+	// and should not block.
+	//
+	// The span is still mutable during this call, it can be used to set
+	// final attributes, events, or status of the span. The EndTime of the
+	// span is the time it is ending at. Calls to End on the span are
+	// ignored.
+	OnEnding(s ReadWriteSpan)
+}
+
 type spanProcessorState struct {
-	sp    SpanProcessor
-	state *sync.Once
+	sp       SpanProcessor
+	onEnding OnEndingSpanProcessor
+	state    *sync.Once
 }
 
 func newSpanProcessorState(sp SpanProcessor) *spanProcessorState {
-	return &spanProcessorState{sp: sp, state: &sync.Once{}}
+	onEnding, _ := sp.(OnEndingSpanProcessor)
+	return &spanProcessorState{sp: sp, onEnding: onEnding, state: &sync.Once{}}
 }
 
 type spanProcessorStates []*spanProcessorState
@@ -17,6 +17,10 @@ package trace_test
 import (
 	"context"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
@@ -266,3 +270,47 @@ func NewNamedTestSpanProcessors(names []string) []*testSpanProcessor {
 	}
 	return tsp
 }
+
+// endingSpanProcessor is a testSpanProcessor that records the duration of a
+// span as an attribute when it is ending.
+type endingSpanProcessor struct {
+	testSpanProcessor
+
+	endingTimes []time.Time
+}
+
+func (p *endingSpanProcessor) OnEnding(s sdktrace.ReadWriteSpan) {
+	p.endingTimes = append(p.endingTimes, s.EndTime())
+	s.SetAttributes(attribute.Int64("duration_ms", s.EndTime().Sub(s.StartTime()).Milliseconds()))
+	// Ending an ending span is ignored.
+	s.End()
+	if len(p.spansEnded) != 0 {
+		panic("OnEnding called after OnEnd")
+	}
+}
+
+var _ sdktrace.OnEndingSpanProcessor = (*endingSpanProcessor)(nil)
+
+func TestOnEndingSpanProcessor(t *testing.T) {
+	tp := basicTracerProvider(t)
+	ending := &endingSpanProcessor{}
+	after := &testSpanProcessor{name: "after"}
+	tp.RegisterSpanProcessor(ending)
+	tp.RegisterSpanProcessor(after)
+
+	start := time.Unix(100, 0)
+	end := start.Add(1500 * time.Millisecond)
+	_, span := tp.Tracer("TestOnEndingSpanProcessor").Start(context.Background(), "span", trace.WithTimestamp(start))
+	span.End(trace.WithTimestamp(end))
+	// Ending an ended span is ignored.
+	span.End()
+
+	assert.Equal(t, []time.Time{end}, ending.endingTimes)
+	require.Len(t, ending.spansEnded, 1)
+	require.Len(t, after.spansEnded, 1)
+
+	got := after.spansEnded[0]
+	assert.Equal(t, end, got.EndTime())
+	assert.Contains(t, got.Attributes(), attribute.Int64("duration_ms", 1500))
+	assert.False(t, span.IsRecording())
+}
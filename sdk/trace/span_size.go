@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// spanSizeOverhead is the estimated size, in bytes, of a span excluding
+	// its name, status description, attributes, events, and links. It
+	// accounts for the span and parent contexts, timestamps, kind, status
+	// code, counters, and the references to shared values like the resource
+	// and instrumentation scope.
+	spanSizeOverhead = 256
+	// eventSizeOverhead is the estimated size, in bytes, of an event
+	// excluding its name and attributes.
+	eventSizeOverhead = 48
+	// linkSizeOverhead is the estimated size, in bytes, of a link excluding
+	// its attributes.
+	linkSizeOverhead = 64
+	// attrSizeOverhead is the estimated size, in bytes, of an attribute
+	// excluding the contents of its key and string or slice value.
+	attrSizeOverhead = 48
+)
+
+// estimateSpanSize returns an estimate of the number of bytes of memory s
+// holds. The estimate is not exact, but it grows with the number and size of
+// the names, attributes, events, and links of s so a budget based on it
+// bounds the memory used to hold spans.
+func estimateSpanSize(s ReadOnlySpan) int64 {
+	n := int64(spanSizeOverhead + len(s.Name()) + len(s.Status().Description))
+	n += estimateAttrsSize(s.Attributes())
+	for _, e := range s.Events() {
+		n += int64(eventSizeOverhead + len(e.Name))
+		n += estimateAttrsSize(e.Attributes)
+	}
+	for _, l := range s.Links() {
+		n += linkSizeOverhead
+		n += estimateAttrsSize(l.Attributes)
+	}
+	return n
+}
+
+// estimateAttrsSize returns an estimate of the number of bytes of memory
+// attrs holds.
+func estimateAttrsSize(attrs []attribute.KeyValue) int64 {
+	var n int64
+	for _, kv := range attrs {
+		n += int64(attrSizeOverhead + len(kv.Key))
+		switch kv.Value.Type() {
+		case attribute.STRING:
+			n += int64(len(kv.Value.AsString()))
+		case attribute.BYTES:
+			n += int64(len(kv.Value.AsBytes()))
+		case attribute.MAP:
+			n += estimateAttrsSize(mapAttrs(kv.Value.AsMap()))
+		case attribute.BOOLSLICE:
+			n += int64(len(kv.Value.AsBoolSlice()))
+		case attribute.INT64SLICE:
+			n += int64(8 * len(kv.Value.AsInt64Slice()))
+		case attribute.FLOAT64SLICE:
+			n += int64(8 * len(kv.Value.AsFloat64Slice()))
+		case attribute.STRINGSLICE:
+			for _, v := range kv.Value.AsStringSlice() {
+				n += int64(16 + len(v))
+			}
+		}
+	}
+	return n
+}
@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestEstimateSpanSize(t *testing.T) {
+	empty := &snapshot{}
+	assert.Equal(t, int64(spanSizeOverhead), estimateSpanSize(empty))
+
+	named := &snapshot{name: "span"}
+	assert.Equal(t, int64(spanSizeOverhead+4), estimateSpanSize(named))
+
+	attrs := []attribute.KeyValue{
+		attribute.String("k", "value"),
+		attribute.Int64Slice("ints", []int64{1, 2}),
+	}
+	wantAttrs := int64(2*attrSizeOverhead + len("k") + len("value") + len("ints") + 16)
+	assert.Equal(t, wantAttrs, estimateAttrsSize(attrs))
+
+	full := &snapshot{
+		name:       "span",
+		attributes: attrs,
+		events:     []Event{{Name: "event", Attributes: attrs}},
+		links:      []Link{{Attributes: attrs}},
+		status:     Status{Description: "error"},
+	}
+	want := int64(spanSizeOverhead+len("span")+len("error")) +
+		wantAttrs +
+		int64(eventSizeOverhead+len("event")) + wantAttrs +
+		linkSizeOverhead + wantAttrs
+	assert.Equal(t, want, estimateSpanSize(full))
+}
@@ -0,0 +1,404 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// spoolExt is the file extension of spooled spans.
+	spoolExt = ".span"
+	// spoolTmpExt is the file extension of spooled spans that are being
+	// written. These files are incomplete if found on startup.
+	spoolTmpExt = ".tmp"
+)
+
+// spanSpool persists spans as files in a directory so they survive process
+// restarts. Each span is stored in its own file, named so that sorting the
+// names orders the spans by when they were spooled.
+type spanSpool struct {
+	dir    string
+	prefix string
+	seq    uint64
+}
+
+// spooledSpan is a span that has been persisted to file.
+type spooledSpan struct {
+	ReadOnlySpan
+	file string
+}
+
+// newSpanSpool returns a spanSpool storing spans in dir, creating it if
+// needed.
+func newSpanSpool(dir string) (*spanSpool, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("span spool: %w", err)
+	}
+	return &spanSpool{
+		dir:    dir,
+		prefix: fmt.Sprintf("%016x", time.Now().UnixNano()),
+	}, nil
+}
+
+// write persists s and returns it wrapped with the file it is persisted in.
+func (s *spanSpool) write(span ReadOnlySpan) (spooledSpan, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(encodeSpan(span)); err != nil {
+		return spooledSpan{}, fmt.Errorf("span spool: encode: %w", err)
+	}
+
+	seq := atomic.AddUint64(&s.seq, 1)
+	name := filepath.Join(s.dir, fmt.Sprintf("%s-%016x", s.prefix, seq))
+	// Write to a temporary file first so a crash while writing does not
+	// leave a partial span to recover.
+	if err := os.WriteFile(name+spoolTmpExt, buf.Bytes(), 0o600); err != nil {
+		return spooledSpan{}, fmt.Errorf("span spool: %w", err)
+	}
+	if err := os.Rename(name+spoolTmpExt, name+spoolExt); err != nil {
+		_ = os.Remove(name + spoolTmpExt)
+		return spooledSpan{}, fmt.Errorf("span spool: %w", err)
+	}
+	return spooledSpan{ReadOnlySpan: span, file: name + spoolExt}, nil
+}
+
+// remove removes the persisted spans stored in files.
+func (s *spanSpool) remove(files ...string) error {
+	var errs []string
+	for _, f := range files {
+		if err := os.Remove(f); err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("span spool: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// recover returns the spans persisted in the spool directory, in the order
+// they were spooled. Files that cannot be read are removed and reported in
+// the returned error, all readable spans are still returned.
+func (s *spanSpool) recover() ([]spooledSpan, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("span spool: %w", err)
+	}
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		switch filepath.Ext(e.Name()) {
+		case spoolExt:
+			names = append(names, e.Name())
+		case spoolTmpExt:
+			// Incomplete write from a crashed process.
+			_ = os.Remove(filepath.Join(s.dir, e.Name()))
+		}
+	}
+	sort.Strings(names)
+
+	var (
+		spans []spooledSpan
+		errs  []string
+	)
+	for _, name := range names {
+		file := filepath.Join(s.dir, name)
+		span, err := readSpan(file)
+		if err != nil {
+			errs = append(errs, err.Error())
+			_ = os.Remove(file)
+			continue
+		}
+		spans = append(spans, spooledSpan{ReadOnlySpan: span, file: file})
+	}
+	if len(errs) > 0 {
+		return spans, fmt.Errorf("span spool: %s", strings.Join(errs, "; "))
+	}
+	return spans, nil
+}
+
+// readSpan reads the span persisted in file.
+func readSpan(file string) (ReadOnlySpan, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var es encodedSpan
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&es); err != nil {
+		return nil, fmt.Errorf("decode %s: %w", file, err)
+	}
+	return es.decode(), nil
+}
+
+// encodedSpan is the persisted form of a ReadOnlySpan.
+type encodedSpan struct {
+	Name                  string
+	SpanContext           encodedSpanContext
+	Parent                encodedSpanContext
+	SpanKind              int
+	StartTime             time.Time
+	EndTime               time.Time
+	Attributes            []encodedAttr
+	Events                []encodedEvent
+	Links                 []encodedLink
+	StatusCode            uint32
+	StatusDescription     string
+	ChildSpanCount        int
+	DroppedAttributeCount int
+	DroppedEventCount     int
+	DroppedLinkCount      int
+	ResourceSchemaURL     string
+	ResourceAttributes    []encodedAttr
+	ScopeName             string
+	ScopeVersion          string
+	ScopeSchemaURL        string
+}
+
+type encodedSpanContext struct {
+	TraceID    [16]byte
+	SpanID     [8]byte
+	TraceFlags byte
+	TraceState string
+	Remote     bool
+}
+
+type encodedEvent struct {
+	Name                  string
+	Time                  time.Time
+	Attributes            []encodedAttr
+	DroppedAttributeCount int
+}
+
+type encodedLink struct {
+	SpanContext           encodedSpanContext
+	Attributes            []encodedAttr
+	DroppedAttributeCount int
+}
+
+type encodedAttr struct {
+	Key      string
+	Type     int
+	Bool     bool
+	Int64    int64
+	Float64  float64
+	String   string
+	Bools    []bool
+	Int64s   []int64
+	Float64s []float64
+	Strings  []string
+	Map      []encodedAttr
+}
+
+func encodeSpan(s ReadOnlySpan) encodedSpan {
+	es := encodedSpan{
+		Name:                  s.Name(),
+		SpanContext:           encodeSpanContext(s.SpanContext()),
+		Parent:                encodeSpanContext(s.Parent()),
+		SpanKind:              int(s.SpanKind()),
+		StartTime:             s.StartTime(),
+		EndTime:               s.EndTime(),
+		Attributes:            encodeAttrs(s.Attributes()),
+		StatusCode:            uint32(s.Status().Code),
+		StatusDescription:     s.Status().Description,
+		ChildSpanCount:        s.ChildSpanCount(),
+		DroppedAttributeCount: s.DroppedAttributes(),
+		DroppedEventCount:     s.DroppedEvents(),
+		DroppedLinkCount:      s.DroppedLinks(),
+		ScopeName:             s.InstrumentationScope().Name,
+		ScopeVersion:          s.InstrumentationScope().Version,
+		ScopeSchemaURL:        s.InstrumentationScope().SchemaURL,
+	}
+	for _, e := range s.Events() {
+		es.Events = append(es.Events, encodedEvent{
+			Name:                  e.Name,
+			Time:                  e.Time,
+			Attributes:            encodeAttrs(e.Attributes),
+			DroppedAttributeCount: e.DroppedAttributeCount,
+		})
+	}
+	for _, l := range s.Links() {
+		es.Links = append(es.Links, encodedLink{
+			SpanContext:           encodeSpanContext(l.SpanContext),
+			Attributes:            encodeAttrs(l.Attributes),
+			DroppedAttributeCount: l.DroppedAttributeCount,
+		})
+	}
+	if res := s.Resource(); res != nil {
+		es.ResourceSchemaURL = res.SchemaURL()
+		es.ResourceAttributes = encodeAttrs(res.Attributes())
+	}
+	return es
+}
+
+func (es encodedSpan) decode() ReadOnlySpan {
+	s := snapshot{
+		name:                  es.Name,
+		spanContext:           es.SpanContext.decode(),
+		parent:                es.Parent.decode(),
+		spanKind:              trace.SpanKind(es.SpanKind),
+		startTime:             es.StartTime,
+		endTime:               es.EndTime,
+		attributes:            decodeAttrs(es.Attributes),
+		status:                Status{Code: codes.Code(es.StatusCode), Description: es.StatusDescription},
+		childSpanCount:        es.ChildSpanCount,
+		droppedAttributeCount: es.DroppedAttributeCount,
+		droppedEventCount:     es.DroppedEventCount,
+		droppedLinkCount:      es.DroppedLinkCount,
+		resource:              resource.NewWithAttributes(es.ResourceSchemaURL, decodeAttrs(es.ResourceAttributes)...),
+		instrumentationScope: instrumentation.Scope{
+			Name:      es.ScopeName,
+			Version:   es.ScopeVersion,
+			SchemaURL: es.ScopeSchemaURL,
+		},
+	}
+	for _, e := range es.Events {
+		s.events = append(s.events, Event{
+			Name:                  e.Name,
+			Time:                  e.Time,
+			Attributes:            decodeAttrs(e.Attributes),
+			DroppedAttributeCount: e.DroppedAttributeCount,
+		})
+	}
+	for _, l := range es.Links {
+		s.links = append(s.links, Link{
+			SpanContext:           l.SpanContext.decode(),
+			Attributes:            decodeAttrs(l.Attributes),
+			DroppedAttributeCount: l.DroppedAttributeCount,
+		})
+	}
+	return s
+}
+
+func encodeSpanContext(sc trace.SpanContext) encodedSpanContext {
+	return encodedSpanContext{
+		TraceID:    sc.TraceID(),
+		SpanID:     sc.SpanID(),
+		TraceFlags: byte(sc.TraceFlags()),
+		TraceState: sc.TraceState().String(),
+		Remote:     sc.IsRemote(),
+	}
+}
+
+func (esc encodedSpanContext) decode() trace.SpanContext {
+	// The TraceState was valid when encoded, ignore parsing errors.
+	ts, _ := trace.ParseTraceState(esc.TraceState)
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    esc.TraceID,
+		SpanID:     esc.SpanID,
+		TraceFlags: trace.TraceFlags(esc.TraceFlags),
+		TraceState: ts,
+		Remote:     esc.Remote,
+	})
+}
+
+func encodeAttrs(attrs []attribute.KeyValue) []encodedAttr {
+	if len(attrs) == 0 {
+		return nil
+	}
+	out := make([]encodedAttr, len(attrs))
+	for i, kv := range attrs {
+		ea := encodedAttr{Key: string(kv.Key), Type: int(kv.Value.Type())}
+		switch kv.Value.Type() {
+		case attribute.BOOL:
+			ea.Bool = kv.Value.AsBool()
+		case attribute.INT64:
+			ea.Int64 = kv.Value.AsInt64()
+		case attribute.FLOAT64:
+			ea.Float64 = kv.Value.AsFloat64()
+		case attribute.STRING:
+			ea.String = kv.Value.AsString()
+		case attribute.BOOLSLICE:
+			ea.Bools = kv.Value.AsBoolSlice()
+		case attribute.INT64SLICE:
+			ea.Int64s = kv.Value.AsInt64Slice()
+		case attribute.FLOAT64SLICE:
+			ea.Float64s = kv.Value.AsFloat64Slice()
+		case attribute.STRINGSLICE:
+			ea.Strings = kv.Value.AsStringSlice()
+		case attribute.BYTES:
+			ea.String = string(kv.Value.AsBytes())
+		case attribute.TIMESTAMP:
+			ea.Int64 = kv.Value.AsTimestamp().UnixNano()
+		case attribute.MAP:
+			ea.Map = encodeAttrs(mapAttrs(kv.Value.AsMap()))
+		}
+		out[i] = ea
+	}
+	return out
+}
+
+func decodeAttrs(attrs []encodedAttr) []attribute.KeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+	out := make([]attribute.KeyValue, 0, len(attrs))
+	for _, ea := range attrs {
+		k := attribute.Key(ea.Key)
+		switch attribute.Type(ea.Type) {
+		case attribute.BOOL:
+			out = append(out, k.Bool(ea.Bool))
+		case attribute.INT64:
+			out = append(out, k.Int64(ea.Int64))
+		case attribute.FLOAT64:
+			out = append(out, k.Float64(ea.Float64))
+		case attribute.STRING:
+			out = append(out, k.String(ea.String))
+		case attribute.BOOLSLICE:
+			out = append(out, k.BoolSlice(ea.Bools))
+		case attribute.INT64SLICE:
+			out = append(out, k.Int64Slice(ea.Int64s))
+		case attribute.FLOAT64SLICE:
+			out = append(out, k.Float64Slice(ea.Float64s))
+		case attribute.STRINGSLICE:
+			out = append(out, k.StringSlice(ea.Strings))
+		case attribute.BYTES:
+			out = append(out, k.Bytes([]byte(ea.String)))
+		case attribute.TIMESTAMP:
+			out = append(out, k.Timestamp(time.Unix(0, ea.Int64)))
+		case attribute.MAP:
+			m := make(map[string]attribute.Value, len(ea.Map))
+			for _, kv := range decodeAttrs(ea.Map) {
+				m[string(kv.Key)] = kv.Value
+			}
+			out = append(out, k.Map(m))
+		}
+	}
+	return out
+}
+
+// mapAttrs returns the entries of m as attributes.
+func mapAttrs(m map[string]attribute.Value) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, len(m))
+	for k, v := range m {
+		attrs = append(attrs, attribute.KeyValue{Key: attribute.Key(k), Value: v})
+	}
+	return attrs
+}
@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func spoolTestSpan(name string) snapshot {
+	ts, _ := trace.ParseTraceState("k=v")
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+		TraceState: ts,
+	})
+	parent := sc.WithSpanID(trace.SpanID{3}).WithRemote(true)
+	attrs := []attribute.KeyValue{
+		attribute.Bool("bool", true),
+		attribute.Int64("int", 1),
+		attribute.Float64("float", 1.5),
+		attribute.String("string", "s"),
+		attribute.BoolSlice("bools", []bool{true, false}),
+		attribute.Int64Slice("ints", []int64{1, 2}),
+		attribute.Float64Slice("floats", []float64{1.5, 2.5}),
+		attribute.StringSlice("strings", []string{"a", "b"}),
+		attribute.Bytes("bytes", []byte{0, 1}),
+		attribute.Timestamp("timestamp", time.Unix(10, 5)),
+		attribute.Map("map", map[string]attribute.Value{
+			"string": attribute.StringValue("s"),
+			"map":    attribute.MapValue(map[string]attribute.Value{"int": attribute.Int64Value(1)}),
+		}),
+	}
+	start := time.Unix(100, 5).UTC()
+	return snapshot{
+		name:        name,
+		spanContext: sc,
+		parent:      parent,
+		spanKind:    trace.SpanKindServer,
+		startTime:   start,
+		endTime:     start.Add(time.Second),
+		attributes:  attrs,
+		events: []Event{{
+			Name:                  "event",
+			Time:                  start.Add(time.Millisecond),
+			Attributes:            attrs[:1],
+			DroppedAttributeCount: 1,
+		}},
+		links: []Link{{
+			SpanContext:           parent,
+			Attributes:            attrs[1:2],
+			DroppedAttributeCount: 2,
+		}},
+		status:                Status{Code: codes.Error, Description: "failed"},
+		childSpanCount:        3,
+		droppedAttributeCount: 4,
+		droppedEventCount:     5,
+		droppedLinkCount:      6,
+		resource:              resource.NewWithAttributes("https://schema", attribute.String("service.name", "test")),
+		instrumentationScope: instrumentation.Scope{
+			Name:      "scope",
+			Version:   "v1",
+			SchemaURL: "https://scope-schema",
+		},
+	}
+}
+
+func TestSpanSpoolRoundTrip(t *testing.T) {
+	spool, err := newSpanSpool(filepath.Join(t.TempDir(), "spool"))
+	require.NoError(t, err)
+
+	want := spoolTestSpan("span")
+	ss, err := spool.write(want)
+	require.NoError(t, err)
+	assert.Equal(t, want, ss.ReadOnlySpan)
+
+	got, err := readSpan(ss.file)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestSpanSpoolRecover(t *testing.T) {
+	dir := t.TempDir()
+	spool, err := newSpanSpool(dir)
+	require.NoError(t, err)
+
+	for _, name := range []string{"first", "second", "third"} {
+		_, err := spool.write(spoolTestSpan(name))
+		require.NoError(t, err)
+	}
+	removed, err := spool.write(spoolTestSpan("removed"))
+	require.NoError(t, err)
+	require.NoError(t, spool.remove(removed.file))
+
+	tmp := filepath.Join(dir, "partial"+spoolTmpExt)
+	require.NoError(t, os.WriteFile(tmp, []byte("partial"), 0o600))
+	corrupt := filepath.Join(dir, "corrupt"+spoolExt)
+	require.NoError(t, os.WriteFile(corrupt, []byte("corrupt"), 0o600))
+
+	next, err := newSpanSpool(dir)
+	require.NoError(t, err)
+	spans, err := next.recover()
+	assert.Error(t, err, "corrupt file not reported")
+	var names []string
+	for _, s := range spans {
+		names = append(names, s.Name())
+	}
+	assert.Equal(t, []string{"first", "second", "third"}, names)
+
+	assert.NoFileExists(t, tmp)
+	assert.NoFileExists(t, corrupt)
+}
@@ -912,6 +912,7 @@ func cmpDiff(x, y interface{}) string {
 	return cmp.Diff(x, y,
 		cmp.AllowUnexported(snapshot{}),
 		cmp.AllowUnexported(attribute.Value{}),
+		cmp.AllowUnexported(attribute.Set{}, attribute.Distinct{}),
 		cmp.AllowUnexported(Event{}),
 		cmp.AllowUnexported(trace.TraceState{}))
 }
@@ -1770,6 +1771,72 @@ func TestAddLinksWithMoreAttributesThanLimit(t *testing.T) {
 	}
 }
 
+func TestAddLinkAfterStart(t *testing.T) {
+	te := NewTestExporter()
+	sl := NewSpanLimits()
+	sl.LinkCountLimit = 2
+	sl.AttributePerLinkCountLimit = 1
+	tp := NewTracerProvider(
+		WithRawSpanLimits(sl),
+		WithSyncer(te),
+		WithResource(resource.Empty()),
+	)
+
+	k1v1 := attribute.String("key1", "value1")
+	k2v2 := attribute.String("key2", "value2")
+
+	sc1 := trace.NewSpanContext(trace.SpanContextConfig{TraceID: trace.TraceID([16]byte{1, 1}), SpanID: trace.SpanID{3}})
+	sc2 := trace.NewSpanContext(trace.SpanContextConfig{TraceID: trace.TraceID([16]byte{1, 2}), SpanID: trace.SpanID{4}})
+	sc3 := trace.NewSpanContext(trace.SpanContextConfig{TraceID: trace.TraceID([16]byte{1, 3}), SpanID: trace.SpanID{5}})
+
+	s := startSpan(tp, "AddLink", trace.WithLinks(trace.Link{SpanContext: sc1}))
+	span, ok := s.(interface {
+		trace.Span
+		AddLink(trace.Link)
+	})
+	if !ok {
+		t.Fatal("span does not implement AddLink")
+	}
+	span.AddLink(trace.Link{SpanContext: sc2, Attributes: []attribute.KeyValue{k1v1, k2v2}})
+	// Invalid links are not added.
+	span.AddLink(trace.Link{})
+	// The oldest link is dropped when the limit is reached.
+	span.AddLink(trace.Link{SpanContext: sc3})
+
+	got, err := endSpan(te, span)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Links added after the span ended are ignored.
+	span.AddLink(trace.Link{SpanContext: sc1})
+
+	want := &snapshot{
+		spanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    tid,
+			TraceFlags: 0x1,
+		}),
+		parent: sc.WithRemote(true),
+		name:   "span0",
+		links: []Link{
+			{
+				SpanContext:           sc2,
+				Attributes:            []attribute.KeyValue{k1v1},
+				DroppedAttributeCount: 1,
+			},
+			{SpanContext: sc3},
+		},
+		droppedLinkCount:     1,
+		spanKind:             trace.SpanKindInternal,
+		instrumentationScope: instrumentation.Scope{Name: "AddLink"},
+	}
+	if diff := cmpDiff(got, want); diff != "" {
+		t.Errorf("AddLink: -got +want %s", diff)
+	}
+	if n := te.Len(); n != 1 {
+		t.Errorf("got %d exported spans, want 1", n)
+	}
+}
+
 type stateSampler struct {
 	prefix string
 	f      func(trace.TraceState) trace.TraceState
@@ -1970,3 +2037,118 @@ func TestEmptyRecordingSpanAttributes(t *testing.T) {
 func TestEmptyRecordingSpanDroppedAttributes(t *testing.T) {
 	assert.Equal(t, 0, (&recordingSpan{}).DroppedAttributes())
 }
+
+func TestEventCoalescing(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithEventCoalescing(),
+		WithSyncer(te),
+		WithResource(resource.Empty()),
+	)
+
+	t1 := time.Unix(1, 0)
+	t2 := time.Unix(2, 0)
+	retry := trace.WithAttributes(attribute.String("reason", "timeout"))
+
+	span := startSpan(tp, "EventCoalescing")
+	span.AddEvent("retry", retry, trace.WithTimestamp(t1))
+	span.AddEvent("error", trace.WithTimestamp(t1))
+	span.AddEvent("retry", retry, trace.WithTimestamp(t2))
+	span.AddEvent("retry", retry, trace.WithTimestamp(t2))
+	// Different attributes are not coalesced.
+	span.AddEvent("retry", trace.WithAttributes(attribute.String("reason", "reset")), trace.WithTimestamp(t2))
+
+	got, err := endSpan(te, span)
+	require.NoError(t, err)
+
+	want := []Event{
+		{
+			Name: "retry",
+			Attributes: []attribute.KeyValue{
+				attribute.String("reason", "timeout"),
+				EventCountKey.Int64(3),
+			},
+			Time: t1,
+		},
+		{Name: "error", Time: t1},
+		{
+			Name:       "retry",
+			Attributes: []attribute.KeyValue{attribute.String("reason", "reset")},
+			Time:       t2,
+		},
+	}
+	assert.Equal(t, want, got.Events())
+}
+
+func TestEventCoalescingLimits(t *testing.T) {
+	te := NewTestExporter()
+	limits := NewSpanLimits()
+	limits.EventCountLimit = 2
+	limits.AttributePerEventCountLimit = 2
+	tp := NewTracerProvider(
+		WithEventCoalescing(),
+		WithRawSpanLimits(limits),
+		WithSyncer(te),
+		WithResource(resource.Empty()),
+	)
+
+	ts := trace.WithTimestamp(time.Unix(1, 0))
+	attrs := trace.WithAttributes(attribute.String("a", "1"), attribute.String("b", "2"))
+	span := startSpan(tp, "EventCoalescingLimits")
+	span.AddEvent("retry", attrs, ts)
+	span.AddEvent("retry", attrs, ts)
+	// The EventCountKey attribute is reserved.
+	span.AddEvent("reserved", trace.WithAttributes(EventCountKey.Int64(100)), ts)
+	span.AddEvent("reserved", trace.WithAttributes(EventCountKey.Int64(100)), ts)
+
+	got, err := endSpan(te, span)
+	require.NoError(t, err)
+
+	want := []Event{
+		{
+			Name: "retry",
+			// The count is kept within the AttributePerEventCountLimit.
+			Attributes:            []attribute.KeyValue{attribute.String("a", "1"), EventCountKey.Int64(2)},
+			DroppedAttributeCount: 1,
+			Time:                  time.Unix(1, 0),
+		},
+		{
+			Name:                  "reserved",
+			Attributes:            []attribute.KeyValue{EventCountKey.Int64(2)},
+			DroppedAttributeCount: 1,
+			Time:                  time.Unix(1, 0),
+		},
+	}
+	assert.Equal(t, want, got.Events())
+
+	// An evicted event is held again, with a new count, when added.
+	te.Reset()
+	span = startSpan(tp, "EventCoalescingEvicted")
+	span.AddEvent("a", ts)
+	span.AddEvent("b", ts)
+	span.AddEvent("c", ts)
+	span.AddEvent("a", ts)
+	got, err = endSpan(te, span)
+	require.NoError(t, err)
+	want = []Event{
+		{Name: "c", Time: time.Unix(1, 0)},
+		{Name: "a", Time: time.Unix(1, 0)},
+	}
+	assert.Equal(t, want, got.Events())
+	assert.Equal(t, 2, got.DroppedEvents())
+}
+
+func BenchmarkEventCoalescing(b *testing.B) {
+	tp := NewTracerProvider(WithEventCoalescing())
+	_, span := tp.Tracer("BenchmarkEventCoalescing").Start(context.Background(), "span")
+	attrs := make([]trace.EventOption, 100)
+	for i := range attrs {
+		attrs[i] = trace.WithAttributes(attribute.Int("i", i))
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		span.AddEvent("event", attrs[n%len(attrs)])
+	}
+}
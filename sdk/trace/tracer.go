@@ -16,7 +16,6 @@ package trace // import "go.opentelemetry.io/otel/sdk/trace"
 
 import (
 	"context"
-	"time"
 
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/trace"
@@ -25,6 +24,8 @@ import (
 type tracer struct {
 	provider             *TracerProvider
 	instrumentationScope instrumentation.Scope
+	// spanLimits are the limits of the spans this tracer creates.
+	spanLimits SpanLimits
 }
 
 var _ trace.Tracer = &tracer{}
@@ -92,7 +93,7 @@ func (tr *tracer) newSpan(ctx context.Context, name string, config *trace.SpanCo
 		sid = tr.provider.idGenerator.NewSpanID(ctx, tid)
 	}
 
-	samplingResult := tr.provider.sampler.ShouldSample(SamplingParameters{
+	samplingResult := tr.provider.Sampler().ShouldSample(SamplingParameters{
 		ParentContext: ctx,
 		TraceID:       tid,
 		Name:          name,
@@ -123,7 +124,7 @@ func (tr *tracer) newSpan(ctx context.Context, name string, config *trace.SpanCo
 func (tr *tracer) newRecordingSpan(psc, sc trace.SpanContext, name string, sr SamplingResult, config *trace.SpanConfig) *recordingSpan {
 	startTime := config.Timestamp()
 	if startTime.IsZero() {
-		startTime = time.Now()
+		startTime = tr.provider.clock.Now()
 	}
 
 	s := &recordingSpan{
@@ -140,8 +141,8 @@ func (tr *tracer) newRecordingSpan(psc, sc trace.SpanContext, name string, sr Sa
 		spanKind:    trace.ValidateSpanKind(config.SpanKind()),
 		name:        name,
 		startTime:   startTime,
-		events:      newEvictedQueue(tr.provider.spanLimits.EventCountLimit),
-		links:       newEvictedQueue(tr.provider.spanLimits.LinkCountLimit),
+		events:      newEvictedQueue(tr.spanLimits.EventCountLimit),
+		links:       newEvictedQueue(tr.spanLimits.LinkCountLimit),
 		tracer:      tr,
 	}
 
@@ -0,0 +1,303 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracetest // import "go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SpanMatcher matches a SpanStub against a condition.
+type SpanMatcher interface {
+	// Match returns true if s matches the condition of the SpanMatcher.
+	Match(s SpanStub) bool
+	// String returns a description of the condition used in failure
+	// messages.
+	String() string
+}
+
+type matcher struct {
+	desc string
+	fn   func(SpanStub) bool
+}
+
+func (m matcher) Match(s SpanStub) bool { return m.fn(s) }
+func (m matcher) String() string        { return m.desc }
+
+// MatchFunc returns a SpanMatcher that matches a SpanStub if fn returns true
+// for it. The description is used to identify the matcher in failure
+// messages.
+func MatchFunc(description string, fn func(SpanStub) bool) SpanMatcher {
+	return matcher{desc: description, fn: fn}
+}
+
+// HasName returns a SpanMatcher that matches spans named name.
+func HasName(name string) SpanMatcher {
+	return MatchFunc(fmt.Sprintf("name is %q", name), func(s SpanStub) bool {
+		return s.Name == name
+	})
+}
+
+// HasSpanKind returns a SpanMatcher that matches spans of kind.
+func HasSpanKind(kind trace.SpanKind) SpanMatcher {
+	return MatchFunc(fmt.Sprintf("kind is %s", kind), func(s SpanStub) bool {
+		return s.SpanKind == kind
+	})
+}
+
+// HasAttributes returns a SpanMatcher that matches spans containing all of
+// attrs. Spans may contain additional attributes.
+func HasAttributes(attrs ...attribute.KeyValue) SpanMatcher {
+	desc := fmt.Sprintf("attributes contain %s", formatAttributes(attrs))
+	return MatchFunc(desc, func(s SpanStub) bool {
+		return containsAttributes(s.Attributes, attrs)
+	})
+}
+
+// HasEvent returns a SpanMatcher that matches spans with an event named name
+// containing all of attrs.
+func HasEvent(name string, attrs ...attribute.KeyValue) SpanMatcher {
+	desc := fmt.Sprintf("has event %q", name)
+	if len(attrs) > 0 {
+		desc += fmt.Sprintf(" with attributes %s", formatAttributes(attrs))
+	}
+	return MatchFunc(desc, func(s SpanStub) bool {
+		_, ok := findEvent(s.Events, name, attrs)
+		return ok
+	})
+}
+
+// HasStatusCode returns a SpanMatcher that matches spans with a status code
+// of code.
+func HasStatusCode(code codes.Code) SpanMatcher {
+	return MatchFunc(fmt.Sprintf("status code is %s", code), func(s SpanStub) bool {
+		return s.Status.Code == code
+	})
+}
+
+// ChildOf returns a SpanMatcher that matches spans that are direct children
+// of parent.
+func ChildOf(parent SpanStub) SpanMatcher {
+	desc := fmt.Sprintf("child of %q (span ID %s)", parent.Name, parent.SpanContext.SpanID())
+	return MatchFunc(desc, func(s SpanStub) bool {
+		return isChild(parent, s)
+	})
+}
+
+// IsRoot returns a SpanMatcher that matches spans without a parent.
+func IsRoot() SpanMatcher {
+	return MatchFunc("is a root span", func(s SpanStub) bool {
+		return !s.Parent.SpanID().IsValid()
+	})
+}
+
+// Find returns all spans in s that match all matchers. If no matchers are
+// passed all spans are returned.
+func (s SpanStubs) Find(matchers ...SpanMatcher) SpanStubs {
+	var found SpanStubs
+	for _, span := range s {
+		if len(mismatches(span, matchers)) == 0 {
+			found = append(found, span)
+		}
+	}
+	return found
+}
+
+// AssertSpan asserts that at least one span in spans matches all matchers.
+// The first matching span is returned along with the assertion result. If
+// no span matches, the failure message lists all spans with the matchers
+// they did not satisfy.
+func AssertSpan(t *testing.T, spans SpanStubs, matchers ...SpanMatcher) (SpanStub, bool) {
+	t.Helper()
+
+	found := spans.Find(matchers...)
+	if len(found) > 0 {
+		return found[0], true
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "no span matches %s", formatMatchers(matchers))
+	if len(spans) == 0 {
+		b.WriteString("\nno spans recorded")
+	}
+	for _, span := range spans {
+		fmt.Fprintf(&b, "\n\t%s", formatSpan(span))
+		for _, m := range mismatches(span, matchers) {
+			fmt.Fprintf(&b, "\n\t\t- %s", m)
+		}
+	}
+	t.Error(b.String())
+	return SpanStub{}, false
+}
+
+// AssertNoSpan asserts that no span in spans matches all matchers.
+func AssertNoSpan(t *testing.T, spans SpanStubs, matchers ...SpanMatcher) bool {
+	t.Helper()
+
+	found := spans.Find(matchers...)
+	if len(found) == 0 {
+		return true
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d unexpected span(s) match %s", len(found), formatMatchers(matchers))
+	for _, span := range found {
+		fmt.Fprintf(&b, "\n\t%s", formatSpan(span))
+	}
+	t.Error(b.String())
+	return false
+}
+
+// AssertParentChild asserts that child is a direct child of parent.
+func AssertParentChild(t *testing.T, parent, child SpanStub) bool {
+	t.Helper()
+
+	if isChild(parent, child) {
+		return true
+	}
+	t.Errorf(
+		"span %q is not a child of span %q\n\tparent: trace ID %s, span ID %s\n\tchild:  trace ID %s, parent span ID %s",
+		child.Name, parent.Name,
+		parent.SpanContext.TraceID(), parent.SpanContext.SpanID(),
+		child.Parent.TraceID(), child.Parent.SpanID(),
+	)
+	return false
+}
+
+// AssertEvent asserts that span has an event named name containing all of
+// attrs. The first matching event is returned along with the assertion
+// result.
+func AssertEvent(t *testing.T, span SpanStub, name string, attrs ...attribute.KeyValue) (tracesdk.Event, bool) {
+	t.Helper()
+
+	if e, ok := findEvent(span.Events, name, attrs); ok {
+		return e, true
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "span %q has no event %q", span.Name, name)
+	if len(attrs) > 0 {
+		fmt.Fprintf(&b, " with attributes %s", formatAttributes(attrs))
+	}
+	if len(span.Events) == 0 {
+		b.WriteString("\nno events recorded")
+	}
+	for _, e := range span.Events {
+		fmt.Fprintf(&b, "\n\t%q %s", e.Name, formatAttributes(e.Attributes))
+	}
+	t.Error(b.String())
+	return tracesdk.Event{}, false
+}
+
+// AssertStatus asserts that the status of span equals expected.
+func AssertStatus(t *testing.T, span SpanStub, expected tracesdk.Status) bool {
+	t.Helper()
+
+	if span.Status == expected {
+		return true
+	}
+	t.Errorf(
+		"span %q status:\n\texpected: %s\n\tactual:   %s",
+		span.Name, formatStatus(expected), formatStatus(span.Status),
+	)
+	return false
+}
+
+// mismatches returns the description of all matchers that do not match s.
+func mismatches(s SpanStub, matchers []SpanMatcher) []string {
+	var r []string
+	for _, m := range matchers {
+		if !m.Match(s) {
+			r = append(r, m.String())
+		}
+	}
+	return r
+}
+
+// isChild returns true if child is a direct child of parent.
+func isChild(parent, child SpanStub) bool {
+	return child.Parent.IsValid() &&
+		child.Parent.TraceID() == parent.SpanContext.TraceID() &&
+		child.Parent.SpanID() == parent.SpanContext.SpanID()
+}
+
+// findEvent returns the first event in events named name that contains all
+// of attrs.
+func findEvent(events []tracesdk.Event, name string, attrs []attribute.KeyValue) (tracesdk.Event, bool) {
+	for _, e := range events {
+		if e.Name == name && containsAttributes(e.Attributes, attrs) {
+			return e, true
+		}
+	}
+	return tracesdk.Event{}, false
+}
+
+// containsAttributes returns true if all of want are contained in have.
+func containsAttributes(have, want []attribute.KeyValue) bool {
+	for _, w := range want {
+		var found bool
+		for _, h := range have {
+			if h.Key == w.Key && h.Value == w.Value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func formatMatchers(matchers []SpanMatcher) string {
+	desc := make([]string, len(matchers))
+	for i, m := range matchers {
+		desc[i] = m.String()
+	}
+	return "[" + strings.Join(desc, ", ") + "]"
+}
+
+func formatAttributes(attrs []attribute.KeyValue) string {
+	kvs := make([]string, len(attrs))
+	for i, kv := range attrs {
+		kvs[i] = fmt.Sprintf("%s=%s", kv.Key, kv.Value.Emit())
+	}
+	return "{" + strings.Join(kvs, ", ") + "}"
+}
+
+func formatStatus(s tracesdk.Status) string {
+	if s.Description == "" {
+		return s.Code.String()
+	}
+	return fmt.Sprintf("%s (%q)", s.Code, s.Description)
+}
+
+func formatSpan(s SpanStub) string {
+	events := make([]string, len(s.Events))
+	for i, e := range s.Events {
+		events[i] = fmt.Sprintf("%q", e.Name)
+	}
+	return fmt.Sprintf(
+		"%q (span ID %s, parent span ID %s): kind=%s status=%s attributes=%s events=[%s]",
+		s.Name, s.SpanContext.SpanID(), s.Parent.SpanID(), s.SpanKind,
+		formatStatus(s.Status), formatAttributes(s.Attributes), strings.Join(events, ", "),
+	)
+}
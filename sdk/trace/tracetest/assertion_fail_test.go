@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build tests_fail
+// +build tests_fail
+
+package tracetest
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// These tests are used to develop the failure messages of this package's
+// assertions. They can be run with the following.
+//
+//   go test -tags tests_fail ./...
+
+func TestFailAssertSpan(t *testing.T) {
+	spans := recordSpans(t)
+	AssertSpan(t, spans, HasName("child"), IsRoot(), HasAttributes(attribute.String("key", "other")))
+	AssertSpan(t, nil, HasName("child"))
+}
+
+func TestFailAssertNoSpan(t *testing.T) {
+	AssertNoSpan(t, recordSpans(t), HasName("child"))
+}
+
+func TestFailAssertParentChild(t *testing.T) {
+	spans := recordSpans(t)
+	AssertParentChild(t, spans[0], spans[1])
+}
+
+func TestFailAssertEvent(t *testing.T) {
+	spans := recordSpans(t)
+	AssertEvent(t, spans[0], "event", attribute.Bool("flag", false))
+}
+
+func TestFailAssertStatus(t *testing.T) {
+	spans := recordSpans(t)
+	AssertStatus(t, spans[0], sdktrace.Status{Code: codes.Ok})
+}
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func recordSpans(t *testing.T) SpanStubs {
+	t.Helper()
+
+	exp := NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+	tracer := tp.Tracer("testing")
+
+	ctx, parent := tracer.Start(context.Background(), "parent", trace.WithSpanKind(trace.SpanKindServer))
+	_, child := tracer.Start(ctx, "child", trace.WithAttributes(
+		attribute.String("key", "value"),
+		attribute.Int("count", 2),
+	))
+	child.AddEvent("event", trace.WithAttributes(attribute.Bool("flag", true)))
+	child.RecordError(errors.New("failure"))
+	child.SetStatus(codes.Error, "failure")
+	child.End()
+	parent.End()
+
+	spans := exp.GetSpans()
+	require.NoError(t, tp.Shutdown(context.Background()))
+	return spans
+}
+
+func TestMatchers(t *testing.T) {
+	spans := recordSpans(t)
+	require.Len(t, spans, 2)
+	child, parent := spans[0], spans[1]
+
+	tests := []struct {
+		matcher SpanMatcher
+		want    SpanStubs
+	}{
+		{HasName("child"), SpanStubs{child}},
+		{HasName("unknown"), nil},
+		{HasSpanKind(trace.SpanKindServer), SpanStubs{parent}},
+		{HasAttributes(attribute.String("key", "value")), SpanStubs{child}},
+		{HasAttributes(attribute.String("key", "other")), nil},
+		{HasAttributes(), SpanStubs{child, parent}},
+		{HasEvent("event"), SpanStubs{child}},
+		{HasEvent("event", attribute.Bool("flag", true)), SpanStubs{child}},
+		{HasEvent("event", attribute.Bool("flag", false)), nil},
+		{HasStatusCode(codes.Error), SpanStubs{child}},
+		{HasStatusCode(codes.Unset), SpanStubs{parent}},
+		{ChildOf(parent), SpanStubs{child}},
+		{ChildOf(child), nil},
+		{IsRoot(), SpanStubs{parent}},
+		{MatchFunc("custom", func(s SpanStub) bool { return s.ChildSpanCount == 1 }), SpanStubs{parent}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.matcher.String(), func(t *testing.T) {
+			assert.Equal(t, test.want, spans.Find(test.matcher))
+		})
+	}
+}
+
+func TestFindAllMatchers(t *testing.T) {
+	spans := recordSpans(t)
+
+	assert.Len(t, spans.Find(), 2)
+	assert.Len(t, spans.Find(HasName("child"), HasStatusCode(codes.Error)), 1)
+	assert.Empty(t, spans.Find(HasName("child"), IsRoot()))
+}
+
+func TestAssertions(t *testing.T) {
+	spans := recordSpans(t)
+
+	parent, ok := AssertSpan(t, spans, HasName("parent"), IsRoot())
+	require.True(t, ok)
+	child, ok := AssertSpan(t, spans, HasName("child"), ChildOf(parent))
+	require.True(t, ok)
+
+	AssertNoSpan(t, spans, HasName("unknown"))
+	AssertParentChild(t, parent, child)
+	AssertStatus(t, child, sdktrace.Status{Code: codes.Error, Description: "failure"})
+	AssertStatus(t, parent, sdktrace.Status{})
+
+	e, ok := AssertEvent(t, child, "exception", attribute.String("exception.message", "failure"))
+	require.True(t, ok)
+	assert.Equal(t, "exception", e.Name)
+}
+
+func TestMismatches(t *testing.T) {
+	spans := recordSpans(t)
+	child := spans[0]
+
+	got := mismatches(child, []SpanMatcher{HasName("child"), IsRoot(), HasSpanKind(trace.SpanKindClient)})
+	assert.Equal(t, []string{"is a root span", "kind is client"}, got)
+}
+
+func TestFormatSpan(t *testing.T) {
+	s := SpanStub{
+		Name:       "span",
+		SpanKind:   trace.SpanKindInternal,
+		Attributes: []attribute.KeyValue{attribute.String("key", "value")},
+		Events:     []sdktrace.Event{{Name: "event"}},
+		Status:     sdktrace.Status{Code: codes.Error, Description: "failure"},
+	}
+	want := `"span" (span ID 0000000000000000, parent span ID 0000000000000000): ` +
+		`kind=internal status=Error ("failure") attributes={key=value} events=["event"]`
+	assert.Equal(t, want, formatSpan(s))
+}
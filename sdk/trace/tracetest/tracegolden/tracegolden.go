@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tracegolden provides snapshot testing of spans with golden files.
+//
+// Spans are compared using a normalized JSON encoding that is stable across
+// test runs. Importing this package registers the -otel.update flag. Running
+// the tests of a package with it writes the golden files instead of comparing
+// against them:
+//
+//	go test ./... -otel.update
+package tracegolden // import "go.opentelemetry.io/otel/sdk/trace/tracetest/tracegolden"
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/internal/golden"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// notRecorded is the parent name of spans with a parent not in the
+// encoded spans.
+const notRecorded = "(not recorded)"
+
+type span struct {
+	Name string
+	// Parent is the name of the parent span. Trace and span IDs are random,
+	// the parent name is used instead to capture the span hierarchy.
+	Parent                 string `json:",omitempty"`
+	SpanKind               string
+	Attributes             []attribute.KeyValue `json:",omitempty"`
+	Events                 []event              `json:",omitempty"`
+	Links                  []link               `json:",omitempty"`
+	Status                 status
+	DroppedAttributes      int `json:",omitempty"`
+	DroppedEvents          int `json:",omitempty"`
+	DroppedLinks           int `json:",omitempty"`
+	ChildSpanCount         int `json:",omitempty"`
+	Resource               *resource.Resource
+	InstrumentationLibrary instrumentation.Library
+}
+
+type event struct {
+	Name                  string
+	Attributes            []attribute.KeyValue `json:",omitempty"`
+	DroppedAttributeCount int                  `json:",omitempty"`
+}
+
+type link struct {
+	Attributes            []attribute.KeyValue `json:",omitempty"`
+	DroppedAttributeCount int                  `json:",omitempty"`
+}
+
+type status struct {
+	Code        string
+	Description string `json:",omitempty"`
+}
+
+// Marshal returns the normalized JSON encoding of spans. All timestamps,
+// trace IDs, and span IDs are omitted. The parent of a span is identified by
+// its name. Attributes are sorted by key and spans are sorted by their
+// encoding.
+func Marshal(spans tracetest.SpanStubs) ([]byte, error) {
+	names := make(map[trace.SpanID]string, len(spans))
+	for _, s := range spans {
+		names[s.SpanContext.SpanID()] = s.Name
+	}
+
+	encoded := make([]json.RawMessage, 0, len(spans))
+	for _, s := range spans {
+		b, err := json.Marshal(normalize(s, names))
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, b)
+	}
+	sort.Slice(encoded, func(i, j int) bool {
+		return bytes.Compare(encoded[i], encoded[j]) < 0
+	})
+
+	out, err := json.MarshalIndent(encoded, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	return append(out, '\n'), nil
+}
+
+// Assert asserts that the normalized JSON encoding of spans, as returned
+// from Marshal, equals the content of the golden file at path. If the test
+// binary is run with the -otel.update flag, the golden file is written instead.
+func Assert(t *testing.T, path string, spans tracetest.SpanStubs) bool {
+	t.Helper()
+
+	got, err := Marshal(spans)
+	if err != nil {
+		t.Errorf("failed to marshal spans: %v", err)
+		return false
+	}
+	return golden.Assert(t, path, got)
+}
+
+func normalize(s tracetest.SpanStub, names map[trace.SpanID]string) span {
+	out := span{
+		Name:                   s.Name,
+		SpanKind:               s.SpanKind.String(),
+		Attributes:             sortAttributes(s.Attributes),
+		Status:                 status{Code: s.Status.Code.String(), Description: s.Status.Description},
+		DroppedAttributes:      s.DroppedAttributes,
+		DroppedEvents:          s.DroppedEvents,
+		DroppedLinks:           s.DroppedLinks,
+		ChildSpanCount:         s.ChildSpanCount,
+		Resource:               s.Resource,
+		InstrumentationLibrary: s.InstrumentationLibrary,
+	}
+	if s.Parent.SpanID().IsValid() {
+		out.Parent = notRecorded
+		if name, ok := names[s.Parent.SpanID()]; ok {
+			out.Parent = name
+		}
+	}
+	for _, e := range s.Events {
+		out.Events = append(out.Events, event{
+			Name:                  e.Name,
+			Attributes:            sortAttributes(e.Attributes),
+			DroppedAttributeCount: e.DroppedAttributeCount,
+		})
+	}
+	for _, l := range s.Links {
+		out.Links = append(out.Links, link{
+			Attributes:            sortAttributes(l.Attributes),
+			DroppedAttributeCount: l.DroppedAttributeCount,
+		})
+	}
+	return out
+}
+
+// sortAttributes returns a copy of attrs sorted by key.
+func sortAttributes(attrs []attribute.KeyValue) []attribute.KeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+	out := make([]attribute.KeyValue, len(attrs))
+	copy(out, attrs)
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracegolden
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func recordSpans(t *testing.T) tracetest.SpanStubs {
+	t.Helper()
+
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSyncer(exp),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", "test"))),
+	)
+	tracer := tp.Tracer("testing", trace.WithInstrumentationVersion("v0.1.0"))
+
+	ctx, parent := tracer.Start(context.Background(), "parent", trace.WithSpanKind(trace.SpanKindServer))
+	_, child := tracer.Start(ctx, "child", trace.WithAttributes(
+		attribute.String("z", "last"),
+		attribute.String("a", "first"),
+	))
+	child.AddEvent("event", trace.WithAttributes(attribute.Int("count", 1)))
+	child.RecordError(errors.New("failure"))
+	child.SetStatus(codes.Error, "failure")
+	child.End()
+	parent.End()
+
+	remote := trace.ContextWithRemoteSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x01},
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	}))
+	_, s := tracer.Start(remote, "remote child")
+	s.End()
+
+	spans := exp.GetSpans()
+	require.NoError(t, tp.Shutdown(context.Background()))
+	return spans
+}
+
+func TestAssert(t *testing.T) {
+	// Spans recorded in different runs have different IDs and timestamps,
+	// they need to match the same golden file.
+	Assert(t, filepath.Join("testdata", "spans.golden"), recordSpans(t))
+	Assert(t, filepath.Join("testdata", "spans.golden"), recordSpans(t))
+}
+
+func TestMarshalOrder(t *testing.T) {
+	spans := recordSpans(t)
+	reversed := make(tracetest.SpanStubs, len(spans))
+	for i, s := range spans {
+		reversed[len(spans)-1-i] = s
+	}
+
+	want, err := Marshal(spans)
+	require.NoError(t, err)
+	got, err := Marshal(reversed)
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(got))
+}
@@ -0,0 +1,221 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zpages // import "go.opentelemetry.io/otel/sdk/trace/zpages"
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Query parameters of the tracez page.
+const (
+	queryName    = "zspanname"
+	queryType    = "ztype"
+	queryLatency = "zlatencybucket"
+)
+
+// Span types that can be listed on the tracez page.
+const (
+	typeActive  = "active"
+	typeLatency = "latency"
+	typeError   = "error"
+)
+
+// tracezHandler serves the tracez page.
+type tracezHandler struct {
+	sp *SpanProcessor
+}
+
+// NewTracezHandler returns an http.Handler that serves an HTML page showing
+// the spans tracked by sp.
+//
+// The page summarizes, for each span name, the number of active spans, the
+// number of ended spans in each latency bucket, and the number of ended spans
+// with an error status. Each count links to a listing of the active spans or
+// the sampled ended spans it counts.
+func NewTracezHandler(sp *SpanProcessor) http.Handler {
+	return &tracezHandler{sp: sp}
+}
+
+// ServeHTTP serves the tracez page.
+func (h *tracezHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	data := tracezData{
+		Buckets:   bucketNames(),
+		Summaries: h.sp.summaries(),
+	}
+	if name := r.Form.Get(queryName); name != "" {
+		data.Listing = true
+		data.Name = name
+		switch r.Form.Get(queryType) {
+		case typeActive:
+			data.Title = "Active"
+			data.Spans = h.spanRows(h.sp.activeSpans(name), true)
+		case typeLatency:
+			b, err := strconv.Atoi(r.Form.Get(queryLatency))
+			if err != nil || b < 0 || b >= numLatencyBuckets {
+				http.Error(w, "invalid latency bucket", http.StatusBadRequest)
+				return
+			}
+			data.Title = "Latency " + data.Buckets[b]
+			data.Spans = h.spanRows(h.sp.latencySamples(name, b), false)
+		case typeError:
+			data.Title = "Error"
+			data.Spans = h.spanRows(h.sp.errorSamples(name), false)
+		default:
+			http.Error(w, "invalid span type", http.StatusBadRequest)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := tracezTemplate.Execute(w, data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// spanRows returns the rows to display spans with. If active is true, the
+// duration of the spans is the time elapsed since they started.
+func (h *tracezHandler) spanRows(spans []sdktrace.ReadOnlySpan, active bool) []spanRow {
+	now := time.Now()
+	rows := make([]spanRow, 0, len(spans))
+	for _, s := range spans {
+		row := spanRow{
+			Start:      s.StartTime().Format(time.RFC3339Nano),
+			TraceID:    s.SpanContext().TraceID().String(),
+			SpanID:     s.SpanContext().SpanID().String(),
+			Attributes: formatAttrs(s.Attributes()),
+		}
+		if active {
+			row.Duration = now.Sub(s.StartTime()).String()
+		} else {
+			row.Duration = s.EndTime().Sub(s.StartTime()).String()
+		}
+		if p := s.Parent(); p.HasSpanID() {
+			row.ParentID = p.SpanID().String()
+		}
+		if st := s.Status(); st.Code != codes.Unset {
+			row.Status = st.Code.String()
+			if st.Description != "" {
+				row.Status += ": " + st.Description
+			}
+		}
+		for _, e := range s.Events() {
+			row.Events = append(row.Events, fmt.Sprintf(
+				"%s %s %s",
+				e.Time.Format(time.RFC3339Nano),
+				e.Name,
+				formatAttrs(e.Attributes),
+			))
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func formatAttrs(attrs []attribute.KeyValue) string {
+	if len(attrs) == 0 {
+		return ""
+	}
+	set := attribute.NewSet(attrs...)
+	return set.Encoded(attribute.DefaultEncoder())
+}
+
+// bucketNames returns the display names of the latency buckets.
+func bucketNames() []string {
+	names := make([]string, 0, numLatencyBuckets)
+	var lower time.Duration
+	for _, upper := range latencyBounds {
+		names = append(names, fmt.Sprintf("[%s, %s)", lower, upper))
+		lower = upper
+	}
+	return append(names, fmt.Sprintf(">= %s", lower))
+}
+
+// tracezData is the data the tracez page is rendered with.
+type tracezData struct {
+	Buckets   []string
+	Summaries []summary
+
+	// Listing is true if spans are listed.
+	Listing bool
+	Name    string
+	Title   string
+	Spans   []spanRow
+}
+
+// spanRow is the display form of a span.
+type spanRow struct {
+	Start      string
+	Duration   string
+	TraceID    string
+	SpanID     string
+	ParentID   string
+	Status     string
+	Attributes string
+	Events     []string
+}
+
+var tracezTemplate = template.Must(template.New("tracez").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>TraceZ</title>
+<style>
+body { font-family: sans-serif; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #ccc; padding: 0.25em 0.5em; text-align: left; }
+td.count { text-align: right; }
+</style>
+</head>
+<body>
+<h1>TraceZ Summary</h1>
+<table>
+<tr><th>Span Name</th><th>Active</th>{{range .Buckets}}<th>{{.}}</th>{{end}}<th>Errors</th></tr>
+{{range $s := .Summaries}}<tr>
+<td>{{$s.Name}}</td>
+<td class="count"><a href="?zspanname={{$s.Name}}&amp;ztype=active">{{$s.Active}}</a></td>
+{{range $i, $c := $s.LatencyCounts}}<td class="count"><a href="?zspanname={{$s.Name}}&amp;ztype=latency&amp;zlatencybucket={{$i}}">{{$c}}</a></td>
+{{end}}<td class="count"><a href="?zspanname={{$s.Name}}&amp;ztype=error">{{$s.ErrorCount}}</a></td>
+</tr>
+{{end}}</table>
+{{if .Listing}}<h2>{{.Title}} spans: {{.Name}}</h2>
+<table>
+<tr><th>Start</th><th>Duration</th><th>Trace ID</th><th>Span ID</th><th>Parent Span ID</th><th>Status</th><th>Attributes</th><th>Events</th></tr>
+{{range .Spans}}<tr>
+<td>{{.Start}}</td>
+<td>{{.Duration}}</td>
+<td>{{.TraceID}}</td>
+<td>{{.SpanID}}</td>
+<td>{{.ParentID}}</td>
+<td>{{.Status}}</td>
+<td>{{.Attributes}}</td>
+<td>{{range .Events}}{{.}}<br>{{end}}</td>
+</tr>
+{{end}}</table>
+{{end}}</body>
+</html>
+`))
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zpages
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func get(t *testing.T, h http.Handler, target string) (int, string) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	body, err := io.ReadAll(rec.Result().Body)
+	require.NoError(t, err)
+	return rec.Code, string(body)
+}
+
+func TestTracezHandler(t *testing.T) {
+	sp, tracer := newTestProvider(t)
+	h := NewTracezHandler(sp)
+
+	_, active := tracer.Start(context.Background(), "active span")
+	defer active.End()
+	_, failed := tracer.Start(context.Background(), "failed", trace.WithAttributes(attribute.String("key", "value")))
+	failed.AddEvent("retry")
+	failed.SetStatus(codes.Error, "boom")
+	failed.End()
+	endSpan(tracer, "ok", 5*time.Millisecond, codes.Unset)
+
+	code, body := get(t, h, "/tracez")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "active span")
+	assert.Contains(t, body, `href="?zspanname=active%20span&amp;ztype=active"`)
+	assert.Contains(t, body, "[1ms, 10ms)")
+
+	code, body = get(t, h, "/tracez?zspanname=active+span&ztype=active")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, active.SpanContext().SpanID().String())
+
+	code, body = get(t, h, "/tracez?zspanname=failed&ztype=error")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, failed.SpanContext().TraceID().String())
+	assert.Contains(t, body, "Error: boom")
+	assert.Contains(t, body, "key=value")
+	assert.Contains(t, body, "retry")
+
+	code, body = get(t, h, "/tracez?zspanname=ok&ztype=latency&zlatencybucket=3")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Contains(t, body, "5ms")
+}
+
+func TestTracezHandlerInvalidQuery(t *testing.T) {
+	sp, _ := newTestProvider(t)
+	h := NewTracezHandler(sp)
+
+	for _, target := range []string{
+		"/tracez?zspanname=a&ztype=unknown",
+		"/tracez?zspanname=a&ztype=latency",
+		"/tracez?zspanname=a&ztype=latency&zlatencybucket=9",
+		"/tracez?zspanname=a&ztype=latency&zlatencybucket=-1",
+	} {
+		code, _ := get(t, h, target)
+		assert.Equal(t, http.StatusBadRequest, code, target)
+	}
+}
@@ -0,0 +1,307 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package zpages provides in-process debugging pages for spans. The
+// SpanProcessor keeps track of active spans and samples of ended spans, and
+// the handler returned by NewTracezHandler serves them as HTML so spans can be
+// inspected without a tracing backend.
+package zpages // import "go.opentelemetry.io/otel/sdk/trace/zpages"
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultSampleSize is the default number of ended spans kept for each span
+// name and latency bucket, and for each span name with an error status.
+const defaultSampleSize = 10
+
+// latencyBounds are the exclusive upper bounds of the latency buckets ended
+// spans are grouped by. The last bucket has no upper bound.
+var latencyBounds = []time.Duration{
+	10 * time.Microsecond,
+	100 * time.Microsecond,
+	time.Millisecond,
+	10 * time.Millisecond,
+	100 * time.Millisecond,
+	time.Second,
+	10 * time.Second,
+	100 * time.Second,
+}
+
+// numLatencyBuckets is the number of latency buckets.
+var numLatencyBuckets = len(latencyBounds) + 1
+
+// latencyBucket returns the index of the latency bucket d belongs to.
+func latencyBucket(d time.Duration) int {
+	return sort.Search(len(latencyBounds), func(i int) bool {
+		return d < latencyBounds[i]
+	})
+}
+
+// config contains configuration options for a SpanProcessor.
+type config struct {
+	sampleSize int
+}
+
+// Option applies a configuration option value to a SpanProcessor.
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(cfg config) config {
+	return fn(cfg)
+}
+
+// WithSampleSize sets the number of ended spans a SpanProcessor keeps for
+// each span name and latency bucket, and for each span name with an error
+// status. The oldest sampled span is replaced when a new one is sampled.
+//
+// If this option is not used, or n is not positive, 10 spans are kept.
+func WithSampleSize(n int) Option {
+	return optionFunc(func(cfg config) config {
+		if n > 0 {
+			cfg.sampleSize = n
+		}
+		return cfg
+	})
+}
+
+// spanKey identifies a span.
+type spanKey struct {
+	traceID trace.TraceID
+	spanID  trace.SpanID
+}
+
+func keyOf(sc trace.SpanContext) spanKey {
+	return spanKey{traceID: sc.TraceID(), spanID: sc.SpanID()}
+}
+
+// ring holds the most recently added spans, up to its capacity.
+type ring struct {
+	spans []sdktrace.ReadOnlySpan
+	next  int
+}
+
+func newRing(size int) *ring {
+	return &ring{spans: make([]sdktrace.ReadOnlySpan, 0, size)}
+}
+
+func (r *ring) add(s sdktrace.ReadOnlySpan) {
+	if len(r.spans) < cap(r.spans) {
+		r.spans = append(r.spans, s)
+		return
+	}
+	r.spans[r.next] = s
+	r.next = (r.next + 1) % len(r.spans)
+}
+
+// all returns the spans of r, the most recently added first.
+func (r *ring) all() []sdktrace.ReadOnlySpan {
+	out := make([]sdktrace.ReadOnlySpan, 0, len(r.spans))
+	for i := len(r.spans) - 1; i >= 0; i-- {
+		out = append(out, r.spans[(r.next+i)%len(r.spans)])
+	}
+	return out
+}
+
+// endedSpans holds the counts and samples of the ended spans with a name.
+type endedSpans struct {
+	latencyCounts  []uint64
+	latencySamples []*ring
+	errorCount     uint64
+	errorSamples   *ring
+}
+
+func newEndedSpans(sampleSize int) *endedSpans {
+	e := &endedSpans{
+		latencyCounts:  make([]uint64, numLatencyBuckets),
+		latencySamples: make([]*ring, numLatencyBuckets),
+		errorSamples:   newRing(sampleSize),
+	}
+	for i := range e.latencySamples {
+		e.latencySamples[i] = newRing(sampleSize)
+	}
+	return e
+}
+
+// SpanProcessor is a SpanProcessor that keeps track of the active spans and
+// samples the ended spans of a TracerProvider for the pages served by the
+// handler returned from NewTracezHandler.
+//
+// Ended spans are grouped by name. For each name the spans with an error
+// status, and the spans without by latency bucket, are counted and the most
+// recent are kept.
+type SpanProcessor struct {
+	sampleSize int
+
+	mu       sync.Mutex
+	shutdown bool
+	active   map[spanKey]sdktrace.ReadWriteSpan
+	ended    map[string]*endedSpans
+}
+
+var _ sdktrace.SpanProcessor = (*SpanProcessor)(nil)
+
+// NewSpanProcessor returns a new SpanProcessor configured with opts.
+func NewSpanProcessor(opts ...Option) *SpanProcessor {
+	cfg := config{sampleSize: defaultSampleSize}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	return &SpanProcessor{
+		sampleSize: cfg.sampleSize,
+		active:     make(map[spanKey]sdktrace.ReadWriteSpan),
+		ended:      make(map[string]*endedSpans),
+	}
+}
+
+// OnStart tracks s as active.
+func (p *SpanProcessor) OnStart(_ context.Context, s sdktrace.ReadWriteSpan) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.shutdown {
+		return
+	}
+	p.active[keyOf(s.SpanContext())] = s
+}
+
+// OnEnd stops tracking s as active and samples it.
+func (p *SpanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.shutdown {
+		return
+	}
+	delete(p.active, keyOf(s.SpanContext()))
+
+	e, ok := p.ended[s.Name()]
+	if !ok {
+		e = newEndedSpans(p.sampleSize)
+		p.ended[s.Name()] = e
+	}
+	if s.Status().Code == codes.Error {
+		e.errorCount++
+		e.errorSamples.add(s)
+		return
+	}
+	b := latencyBucket(s.EndTime().Sub(s.StartTime()))
+	e.latencyCounts[b]++
+	e.latencySamples[b].add(s)
+}
+
+// Shutdown stops tracking spans and releases all tracked spans.
+func (p *SpanProcessor) Shutdown(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.shutdown = true
+	p.active = make(map[spanKey]sdktrace.ReadWriteSpan)
+	p.ended = make(map[string]*endedSpans)
+	return ctx.Err()
+}
+
+// ForceFlush does nothing, the SpanProcessor holds no spans to export.
+func (p *SpanProcessor) ForceFlush(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// summary is the summary of the spans with a name.
+type summary struct {
+	Name          string
+	Active        int
+	LatencyCounts []uint64
+	ErrorCount    uint64
+}
+
+// summaries returns the summaries of all tracked span names, sorted by name.
+func (p *SpanProcessor) summaries() []summary {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	byName := make(map[string]*summary)
+	get := func(name string) *summary {
+		s, ok := byName[name]
+		if !ok {
+			s = &summary{Name: name, LatencyCounts: make([]uint64, numLatencyBuckets)}
+			byName[name] = s
+		}
+		return s
+	}
+	for _, s := range p.active {
+		// Read the name now, it can change while the span is active.
+		get(s.Name()).Active++
+	}
+	for name, e := range p.ended {
+		s := get(name)
+		copy(s.LatencyCounts, e.latencyCounts)
+		s.ErrorCount = e.errorCount
+	}
+
+	out := make([]summary, 0, len(byName))
+	for _, s := range byName {
+		out = append(out, *s)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// activeSpans returns the active spans named name, the most recently started
+// first.
+func (p *SpanProcessor) activeSpans(name string) []sdktrace.ReadOnlySpan {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var out []sdktrace.ReadOnlySpan
+	for _, s := range p.active {
+		if s.Name() == name {
+			out = append(out, s)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].StartTime().After(out[j].StartTime()) })
+	return out
+}
+
+// latencySamples returns the sampled ended spans named name in the latency
+// bucket, the most recently ended first.
+func (p *SpanProcessor) latencySamples(name string, bucket int) []sdktrace.ReadOnlySpan {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	e, ok := p.ended[name]
+	if !ok || bucket < 0 || bucket >= numLatencyBuckets {
+		return nil
+	}
+	return e.latencySamples[bucket].all()
+}
+
+// errorSamples returns the sampled ended spans named name with an error
+// status, the most recently ended first.
+func (p *SpanProcessor) errorSamples(name string) []sdktrace.ReadOnlySpan {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	e, ok := p.ended[name]
+	if !ok {
+		return nil
+	}
+	return e.errorSamples.all()
+}
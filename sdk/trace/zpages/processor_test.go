@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zpages
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newTestProvider(t *testing.T, opts ...Option) (*SpanProcessor, trace.Tracer) {
+	t.Helper()
+	sp := NewSpanProcessor(opts...)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sp))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	return sp, tp.Tracer("zpages")
+}
+
+// endSpan starts and ends a span named name that took d.
+func endSpan(tracer trace.Tracer, name string, d time.Duration, code codes.Code) {
+	start := time.Now().Add(-d)
+	_, span := tracer.Start(context.Background(), name, trace.WithTimestamp(start))
+	span.SetStatus(code, "")
+	span.End(trace.WithTimestamp(start.Add(d)))
+}
+
+func TestLatencyBucket(t *testing.T) {
+	assert.Equal(t, 0, latencyBucket(0))
+	assert.Equal(t, 0, latencyBucket(9*time.Microsecond))
+	assert.Equal(t, 1, latencyBucket(10*time.Microsecond))
+	assert.Equal(t, 3, latencyBucket(5*time.Millisecond))
+	assert.Equal(t, numLatencyBuckets-1, latencyBucket(time.Hour))
+}
+
+func TestRing(t *testing.T) {
+	r := newRing(2)
+	assert.Empty(t, r.all())
+
+	spans := make([]sdktrace.ReadOnlySpan, 3)
+	for i := range spans {
+		spans[i] = fakeSpan{name: string(rune('a' + i))}
+	}
+	r.add(spans[0])
+	assert.Equal(t, spans[:1], r.all())
+	r.add(spans[1])
+	assert.Equal(t, []sdktrace.ReadOnlySpan{spans[1], spans[0]}, r.all())
+	r.add(spans[2])
+	assert.Equal(t, []sdktrace.ReadOnlySpan{spans[2], spans[1]}, r.all())
+}
+
+type fakeSpan struct {
+	sdktrace.ReadOnlySpan
+	name string
+}
+
+func TestSpanProcessorSummaries(t *testing.T) {
+	sp, tracer := newTestProvider(t)
+
+	_, active := tracer.Start(context.Background(), "active")
+	endSpan(tracer, "ended", 5*time.Millisecond, codes.Ok)
+	endSpan(tracer, "ended", 6*time.Millisecond, codes.Unset)
+	endSpan(tracer, "ended", 2*time.Second, codes.Unset)
+	endSpan(tracer, "ended", time.Millisecond, codes.Error)
+
+	want := []summary{
+		{Name: "active", Active: 1, LatencyCounts: make([]uint64, numLatencyBuckets)},
+		{Name: "ended", LatencyCounts: []uint64{0, 0, 0, 2, 0, 0, 1, 0, 0}, ErrorCount: 1},
+	}
+	assert.Equal(t, want, sp.summaries())
+
+	require.Len(t, sp.activeSpans("active"), 1)
+	assert.Len(t, sp.latencySamples("ended", 3), 2)
+	assert.Len(t, sp.latencySamples("ended", 6), 1)
+	assert.Empty(t, sp.latencySamples("ended", 0))
+	assert.Empty(t, sp.latencySamples("ended", numLatencyBuckets))
+	assert.Len(t, sp.errorSamples("ended"), 1)
+
+	active.End()
+	assert.Empty(t, sp.activeSpans("active"))
+	var ended uint64
+	for _, c := range sp.summaries()[0].LatencyCounts {
+		ended += c
+	}
+	assert.Equal(t, uint64(1), ended)
+}
+
+func TestSpanProcessorActiveRenamed(t *testing.T) {
+	sp, tracer := newTestProvider(t)
+
+	_, span := tracer.Start(context.Background(), "before")
+	span.SetName("after")
+	assert.Empty(t, sp.activeSpans("before"))
+	assert.Len(t, sp.activeSpans("after"), 1)
+	span.End()
+}
+
+func TestSpanProcessorSampleSize(t *testing.T) {
+	sp, tracer := newTestProvider(t, WithSampleSize(2))
+	for i := 0; i < 5; i++ {
+		endSpan(tracer, "span", time.Millisecond, codes.Error)
+	}
+	assert.Equal(t, uint64(5), sp.summaries()[0].ErrorCount)
+	assert.Len(t, sp.errorSamples("span"), 2)
+}
+
+func TestSpanProcessorShutdown(t *testing.T) {
+	sp, tracer := newTestProvider(t)
+	endSpan(tracer, "span", time.Millisecond, codes.Unset)
+	require.NoError(t, sp.Shutdown(context.Background()))
+	assert.Empty(t, sp.summaries())
+
+	endSpan(tracer, "span", time.Millisecond, codes.Unset)
+	assert.Empty(t, sp.summaries(), "span tracked after shutdown")
+}